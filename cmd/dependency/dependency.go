@@ -7,6 +7,7 @@ import (
 	"github.com/champion19/flighthours-api/config"
 	"github.com/champion19/flighthours-api/core/interactor"
 	"github.com/champion19/flighthours-api/core/interactor/services"
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/input"
 	"github.com/champion19/flighthours-api/core/ports/output"
 	"github.com/champion19/flighthours-api/middleware"
@@ -18,13 +19,23 @@ import (
 	airlineEmployeeRepo "github.com/champion19/flighthours-api/platform/databases/repositories/airline_employee"
 	airlineRouteRepo "github.com/champion19/flighthours-api/platform/databases/repositories/airline_route"
 	airportRepo "github.com/champion19/flighthours-api/platform/databases/repositories/airport"
+	credentialRepo "github.com/champion19/flighthours-api/platform/databases/repositories/credential"
 	dailyLogbookRepo "github.com/champion19/flighthours-api/platform/databases/repositories/daily_logbook"
 	dailyLogbookDetailRepo "github.com/champion19/flighthours-api/platform/databases/repositories/daily_logbook_detail"
+	dutyPeriodRepo "github.com/champion19/flighthours-api/platform/databases/repositories/duty_period"
 	repo "github.com/champion19/flighthours-api/platform/databases/repositories/employee"
 	engineRepo "github.com/champion19/flighthours-api/platform/databases/repositories/engine"
+	importMappingRepo "github.com/champion19/flighthours-api/platform/databases/repositories/import_mapping"
+	logbookAmendmentRepo "github.com/champion19/flighthours-api/platform/databases/repositories/logbook_amendment"
+	logbookHistoryRepo "github.com/champion19/flighthours-api/platform/databases/repositories/logbook_history"
 	manufacturerRepo "github.com/champion19/flighthours-api/platform/databases/repositories/manufacturer"
 	messageRepo "github.com/champion19/flighthours-api/platform/databases/repositories/message"
+	notificationRepo "github.com/champion19/flighthours-api/platform/databases/repositories/notification"
+	priorExperienceRepo "github.com/champion19/flighthours-api/platform/databases/repositories/prior_experience"
 	routeRepo "github.com/champion19/flighthours-api/platform/databases/repositories/route"
+	segmentMirrorRepo "github.com/champion19/flighthours-api/platform/databases/repositories/segment_mirror"
+	simulatorSessionRepo "github.com/champion19/flighthours-api/platform/databases/repositories/simulator_session"
+	typeRatingRepo "github.com/champion19/flighthours-api/platform/databases/repositories/type_rating"
 	"github.com/champion19/flighthours-api/platform/identity_provider/keycloak"
 	"github.com/champion19/flighthours-api/platform/jwt"
 	"github.com/champion19/flighthours-api/platform/logger"
@@ -41,6 +52,8 @@ type Dependencies struct {
 	IDEncoder                      *idencoder.HashidsEncoder
 	ResponseHandler                *middleware.ResponseHandler
 	MessagingCache                 *messagingCache.MessageCache
+	LogbookPurgeService            *services.LogbookPurgeService
+	CredentialService              *services.CredentialService
 	MessageInteractor              *interactor.MessageInteractor
 	AirlineInteractor              *interactor.AirlineInteractor
 	AirportInteractor              *interactor.AirportInteractor
@@ -53,6 +66,12 @@ type Dependencies struct {
 	EngineInteractor               *interactor.EngineInteractor
 	ManufacturerInteractor         *interactor.ManufacturerInteractor
 	AirlineEmployeeInteractor      *interactor.AirlineEmployeeInteractor // Release 15
+	SimulatorSessionInteractor     *interactor.SimulatorSessionInteractor
+	PriorExperienceInteractor      *interactor.PriorExperienceInteractor
+	DutyPeriodInteractor           *interactor.DutyPeriodInteractor
+	TypeRatingInteractor           *interactor.TypeRatingInteractor
+	CredentialInteractor           *interactor.CredentialInteractor
+	NotificationInteractor         *interactor.NotificationInteractor
 	JWTValidator                   *jwt.JWKSValidator
 }
 
@@ -156,6 +175,16 @@ func Init() (*Dependencies, error) {
 	airportService := services.NewAirportService(airportRepository, log)
 	airportInteractor := interactor.NewAirportInteractor(airportService, log)
 
+	// Historial de cambios de bitácoras diarias y sus segmentos
+	logbookHistoryRepository, err := logbookHistoryRepo.NewLogbookHistoryRepository(db)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogLogbookHistoryRepoInitOK)
+
+	logbookHistoryService := services.NewLogbookHistoryService(logbookHistoryRepository, log)
+
 	// Inicializar repositorio y servicio de bitácoras diarias
 	dailyLogbookRepository, err := dailyLogbookRepo.NewDailyLogbookRepository(db)
 	if err != nil {
@@ -164,8 +193,7 @@ func Init() (*Dependencies, error) {
 	}
 	log.Success(logger.LogDailyLogbookRepoInitOK)
 
-	dailyLogbookService := services.NewDailyLogbookService(dailyLogbookRepository, log)
-	dailyLogbookInteractor := interactor.NewDailyLogbookInteractor(dailyLogbookService, log)
+	dailyLogbookService := services.NewDailyLogbookService(dailyLogbookRepository, logbookHistoryRepository, log)
 
 	// Inicializar repositorio y servicio de matrículas
 	aircraftRegistrationRepository, err := aircraftRegistrationRepo.NewAircraftRegistrationRepository(db)
@@ -219,8 +247,124 @@ func Init() (*Dependencies, error) {
 	}
 	log.Success(logger.LogDailyLogbookDetailRepoInitOK)
 
-	dailyLogbookDetailService := services.NewDailyLogbookDetailService(dailyLogbookDetailRepository)
-	dailyLogbookDetailInteractor := interactor.NewDailyLogbookDetailInteractor(dailyLogbookDetailService, dailyLogbookService)
+	// Experiencia previa: saldos iniciales sumados a totales, exportación y experiencia reciente
+	priorExperienceRepository, err := priorExperienceRepo.NewPriorExperienceRepository(db)
+	if err != nil {
+		log.Error(logger.LogPriorExperienceRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogPriorExperienceRepoInitOK)
+	priorExperienceService := services.NewPriorExperienceService(priorExperienceRepository, log)
+	priorExperienceInteractor := interactor.NewPriorExperienceInteractor(priorExperienceService)
+
+	dailyLogbookDetailService := services.NewDailyLogbookDetailService(dailyLogbookDetailRepository, logbookHistoryRepository, priorExperienceRepository)
+
+	// Purga definitiva de bitácoras y segmentos eliminados tras el periodo de retención
+	retention, purgeInterval := retentionFromConfig(cfg.Retention)
+	logbookPurgeService := services.NewLogbookPurgeService(dailyLogbookRepository, dailyLogbookDetailRepository, retention, purgeInterval, log)
+	logbookPurgeService.StartPurgeJob(context.Background())
+
+	// Periodos de servicio: agrupan segmentos entre presentación y liberación
+	dutyPeriodRepository, err := dutyPeriodRepo.NewDutyPeriodRepository(db)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogDutyPeriodRepoInitOK)
+	dutyPeriodService := services.NewDutyPeriodService(dutyPeriodRepository, logbookHistoryRepository, log)
+	dutyPeriodInteractor := interactor.NewDutyPeriodInteractor(dutyPeriodService)
+
+	// Limitaciones de tiempo de vuelo (FTL) evaluadas en cada segmento
+	ftlEngine := services.NewFTLEngine(ftlLimitsFromConfig(cfg.FTL), cfg.FTL.WarningRatio)
+	ftlService := services.NewFTLService(dailyLogbookDetailRepository, dutyPeriodRepository, ftlEngine, log)
+
+	// Sesiones en dispositivos de simulación (FSTD), registradas aparte de los segmentos de vuelo
+	simulatorSessionRepository, err := simulatorSessionRepo.NewSimulatorSessionRepository(db)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogSimulatorSessionRepoInitOK)
+	simulatorSessionService := services.NewSimulatorSessionService(simulatorSessionRepository, aircraftModelRepository, log)
+	simulatorSessionInteractor := interactor.NewSimulatorSessionInteractor(simulatorSessionService)
+
+	// Habilitaciones de tipo por familia de aeronave, exigidas al registrar segmentos como piloto volando
+	typeRatingRepository, err := typeRatingRepo.NewTypeRatingRepository(db)
+	if err != nil {
+		log.Error(logger.LogTypeRatingRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogTypeRatingRepoInitOK)
+	typeRatingService := services.NewTypeRatingService(typeRatingRepository, aircraftRegistrationRepository, aircraftModelRepository, log)
+	typeRatingInteractor := interactor.NewTypeRatingInteractor(typeRatingService, employeeService)
+
+	// Notificaciones en la aplicación
+	notificationRepository, err := notificationRepo.NewNotificationRepository(db)
+	if err != nil {
+		log.Error(logger.LogNotificationRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogNotificationRepoInitOK)
+	notificationService := services.NewNotificationService(notificationRepository, log)
+	notificationInteractor := interactor.NewNotificationInteractor(notificationService)
+
+	// Licencias, certificados médicos y formación recurrente, con avisos antes de su vencimiento
+	credentialRepository, err := credentialRepo.NewCredentialRepository(db)
+	if err != nil {
+		log.Error(logger.LogCredentialRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogCredentialRepoInitOK)
+	noticeDays, noticeInterval := credentialNoticesFromConfig(cfg.Credentials)
+	credentialService := services.NewCredentialService(credentialRepository, notificationRepository, noticeDays, noticeInterval, log)
+	credentialService.StartExpiryNoticeJob(context.Background())
+	credentialInteractor := interactor.NewCredentialInteractor(credentialService)
+
+	// Experiencia reciente (currency) por familia de aeronave
+	currencyEngine := services.NewCurrencyEngine(currencyRulesFromConfig(cfg.Currency), cfg.Currency.WarningDays)
+	currencyService := services.NewCurrencyService(dailyLogbookDetailRepository, simulatorSessionRepository, priorExperienceRepository, currencyEngine, log)
+
+	// Anomalías de tiempo de bloque/vuelo frente al tiempo estimado de la ruta
+	flightAnomalyService := services.NewFlightAnomalyService(dailyLogbookDetailRepository, anomalyToleranceFromConfig(cfg.Anomaly), log)
+
+	// Mapeos de importación recordados por empleado (bitácoras electrónicas de terceros)
+	importMappingRepository, err := importMappingRepo.NewImportMappingRepository(db)
+	if err != nil {
+		log.Error(logger.LogImportMappingRepoInitErr, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogImportMappingRepoInitOK)
+
+	// Importación masiva de segmentos (CSV y formatos de terceros)
+	logbookImportService := services.NewLogbookImportService(dailyLogbookRepository, dailyLogbookDetailRepository, airlineRouteRepository,
+		aircraftRegistrationRepository, airportRepository, importMappingRepository, logbookHistoryRepository, log)
+
+	// Enmiendas de bitácoras firmadas (se aplican al firmarlas)
+	logbookAmendmentRepository, err := logbookAmendmentRepo.NewLogbookAmendmentRepository(db)
+	if err != nil {
+		log.Error(logger.LogAmendmentRepoInitError, "error", err)
+		return nil, err
+	}
+	logbookAmendmentService := services.NewLogbookAmendmentService(logbookAmendmentRepository, dailyLogbookRepository, dailyLogbookDetailRepository,
+		logbookHistoryRepository, log)
+
+	// Cadena de hashes sobre los segmentos firmados (se sella al firmar la bitácora)
+	logbookChainService := services.NewLogbookChainService(dailyLogbookRepository, dailyLogbookDetailRepository, logbookHistoryRepository, log)
+	dailyLogbookInteractor := interactor.NewDailyLogbookInteractor(dailyLogbookService, logbookChainService, log)
+
+	// Vuelos espejo del compañero de cabina (oferta, enlace y conflictos)
+	segmentMirrorRepository, err := segmentMirrorRepo.NewSegmentMirrorRepository(db)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorRepoInitError, "error", err)
+		return nil, err
+	}
+	log.Success(logger.LogSegmentMirrorRepoInitOK)
+	segmentMirrorService := services.NewSegmentMirrorService(segmentMirrorRepository, dailyLogbookRepository, dailyLogbookDetailRepository,
+		employeeRepo, logbookHistoryRepository, log)
+
+	dailyLogbookDetailInteractor := interactor.NewDailyLogbookDetailInteractor(dailyLogbookDetailService, dailyLogbookService, ftlService, currencyService,
+		logbookImportService, flightAnomalyService, logbookAmendmentService, logbookChainService, logbookHistoryService, segmentMirrorService,
+		simulatorSessionService, typeRatingService)
 
 	// Inicializar repositorio y servicio de motores (Engine)
 	engineRepository, err := engineRepo.NewEngineRepository(db)
@@ -283,6 +427,8 @@ func Init() (*Dependencies, error) {
 		IDEncoder:                      encoder,
 		ResponseHandler:                responseHandler,
 		MessagingCache:                 messagingCache,
+		LogbookPurgeService:            logbookPurgeService,
+		CredentialService:              credentialService,
 		MessageInteractor:              messageInteractor,
 		AirlineInteractor:              airlineInteractor,
 		AirportInteractor:              airportInteractor,
@@ -295,6 +441,86 @@ func Init() (*Dependencies, error) {
 		EngineInteractor:               engineInteractor,
 		ManufacturerInteractor:         manufacturerInteractor,
 		AirlineEmployeeInteractor:      airlineEmployeeInteractor,
+		SimulatorSessionInteractor:     simulatorSessionInteractor,
+		PriorExperienceInteractor:      priorExperienceInteractor,
+		DutyPeriodInteractor:           dutyPeriodInteractor,
+		TypeRatingInteractor:           typeRatingInteractor,
+		CredentialInteractor:           credentialInteractor,
+		NotificationInteractor:         notificationInteractor,
 		JWTValidator:                   jwtValidator,
 	}, nil
 }
+
+// ftlLimitsFromConfig maps the configured flight time limitations to domain limits
+func ftlLimitsFromConfig(cfg config.FTLConfig) []domain.FTLLimit {
+	limits := make([]domain.FTLLimit, 0, len(cfg.Limits))
+	for _, l := range cfg.Limits {
+		limits = append(limits, domain.FTLLimit{
+			Code:         l.Code,
+			Metric:       domain.FTLMetric(l.Metric),
+			WindowDays:   l.WindowDays,
+			CalendarYear: l.CalendarYear,
+			Max:          time.Duration(l.MaxHours * float64(time.Hour)),
+			Blocking:     l.Blocking,
+		})
+	}
+	return limits
+}
+
+// retentionFromConfig returns the restore window of deleted logbooks and the purge job interval,
+// keeping the defaults for unset values
+func retentionFromConfig(cfg config.RetentionConfig) (time.Duration, time.Duration) {
+	retention, interval := domain.DefaultDeletedRetention, 24*time.Hour
+	if cfg.DeletedDays > 0 {
+		retention = time.Duration(cfg.DeletedDays) * 24 * time.Hour
+	}
+	if cfg.PurgeIntervalHours != 0 {
+		interval = time.Duration(cfg.PurgeIntervalHours) * time.Hour
+	}
+	return retention, interval
+}
+
+// credentialNoticesFromConfig returns the days before expiry credential notices are raised at and the
+// notice job interval, keeping the defaults for unset values
+func credentialNoticesFromConfig(cfg config.CredentialsConfig) ([]int, time.Duration) {
+	noticeDays, interval := domain.DefaultCredentialNoticeDays, 24*time.Hour
+	if len(cfg.NoticeDays) > 0 {
+		noticeDays = cfg.NoticeDays
+	}
+	if cfg.NoticeIntervalHours != 0 {
+		interval = time.Duration(cfg.NoticeIntervalHours) * time.Hour
+	}
+	return noticeDays, interval
+}
+
+// anomalyToleranceFromConfig maps the configured anomaly tolerance, keeping the defaults for unset values
+func anomalyToleranceFromConfig(cfg config.AnomalyConfig) domain.AnomalyTolerance {
+	tolerance := domain.DefaultAnomalyTolerance
+	if cfg.ToleranceRatio > 0 {
+		tolerance.Ratio = cfg.ToleranceRatio
+	}
+	if cfg.MinDeviationMinutes > 0 {
+		tolerance.Min = time.Duration(cfg.MinDeviationMinutes) * time.Minute
+	}
+	return tolerance
+}
+
+// currencyRulesFromConfig maps the configured recency rules to domain rules
+func currencyRulesFromConfig(cfg config.CurrencyConfig) []domain.CurrencyRule {
+	rules := make([]domain.CurrencyRule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		rule := domain.CurrencyRule{
+			Code:       r.Code,
+			WindowDays: r.WindowDays,
+			Takeoffs:   r.Takeoffs,
+			Landings:   r.Landings,
+			Approaches: r.Approaches,
+			Night:      r.Night,
+		}
+		for _, d := range r.SimulatorDevices {
+			rule.SimulatorDevices = append(rule.SimulatorDevices, domain.SimulatorDeviceType(d))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
@@ -12,13 +12,18 @@ import (
 )
 
 type Config struct {
-	Environment  string         `json:"environment"`
-	Database     Database       `json:"database"`
-	Server       Server         `json:"server"`
-	Resend       Resend         `json:"resend"`
-	Verification Verification   `json:"verification"`
-	Keycloak     KeycloakConfig `json:"keycloak"`
-	IDEncoder    IDEncoderConfig `json:"id_encoder"`
+	Environment  string            `json:"environment"`
+	Database     Database          `json:"database"`
+	Server       Server            `json:"server"`
+	Resend       Resend            `json:"resend"`
+	Verification Verification      `json:"verification"`
+	Keycloak     KeycloakConfig    `json:"keycloak"`
+	IDEncoder    IDEncoderConfig   `json:"id_encoder"`
+	FTL          FTLConfig         `json:"ftl"`
+	Currency     CurrencyConfig    `json:"currency"`
+	Anomaly      AnomalyConfig     `json:"anomaly"`
+	Retention    RetentionConfig   `json:"retention"`
+	Credentials  CredentialsConfig `json:"credentials"`
 }
 
 type Verification struct {
@@ -63,6 +68,61 @@ type IDEncoderConfig struct {
 	MinLength int    `json:"min_length"`
 }
 
+// FTLConfig holds the flight time limitations evaluated on every segment.
+// When Limits is empty the built-in defaults are used.
+type FTLConfig struct {
+	WarningRatio float64          `json:"warning_ratio,omitempty"`
+	Limits       []FTLLimitConfig `json:"limits,omitempty"`
+}
+
+type FTLLimitConfig struct {
+	Code         string  `json:"code"`
+	Metric       string  `json:"metric"` // block_time | duty_time
+	WindowDays   int     `json:"window_days,omitempty"`
+	CalendarYear bool    `json:"calendar_year,omitempty"`
+	MaxHours     float64 `json:"max_hours"`
+	Blocking     bool    `json:"blocking"`
+}
+
+// CurrencyConfig holds the pilot recency rules evaluated per aircraft family.
+// When Rules is empty the built-in defaults are used.
+type CurrencyConfig struct {
+	WarningDays int                  `json:"warning_days,omitempty"`
+	Rules       []CurrencyRuleConfig `json:"rules,omitempty"`
+}
+
+type CurrencyRuleConfig struct {
+	Code       string `json:"code"`
+	WindowDays int    `json:"window_days"`
+	Takeoffs   int    `json:"takeoffs,omitempty"`
+	Landings   int    `json:"landings,omitempty"`
+	Approaches int    `json:"approaches,omitempty"` // Instrument approaches (NPA, PA, APV)
+	Night      bool   `json:"night,omitempty"`
+	// FSTD types (FFS, FTD, FNPT, BITD) whose sessions count toward the rule
+	SimulatorDevices []string `json:"simulator_devices,omitempty"`
+}
+
+// AnomalyConfig holds the tolerance for flagging block/air times against the route's estimated flight time.
+// The allowed deviation is the larger of tolerance_ratio × estimate and min_deviation_minutes; zero values use the defaults.
+type AnomalyConfig struct {
+	ToleranceRatio      float64 `json:"tolerance_ratio,omitempty"`
+	MinDeviationMinutes int     `json:"min_deviation_minutes,omitempty"`
+}
+
+// RetentionConfig holds how long soft deleted logbooks and segments can be restored before the purge job
+// removes them, and how often the job runs. Zero values use the defaults; a negative interval disables the job.
+type RetentionConfig struct {
+	DeletedDays        int `json:"deleted_days,omitempty"`
+	PurgeIntervalHours int `json:"purge_interval_hours,omitempty"`
+}
+
+// CredentialsConfig holds how many days before a credential expires its holder is notified, and how often the
+// notice job runs. Empty values use the defaults; a negative interval disables the job.
+type CredentialsConfig struct {
+	NoticeDays          []int `json:"notice_days,omitempty"`
+	NoticeIntervalHours int   `json:"notice_interval_hours,omitempty"`
+}
+
 func LoadConfig() (*Config, error) {
 	root, err := utils.FindModuleRoot()
 	if err != nil {
@@ -174,7 +234,6 @@ func (c *Config) GetKeycloakJWKSURL() string {
 		c.Keycloak.Realm)
 }
 
-
 func (c *Config) GetKeycloakIssuerURL() string {
 	return fmt.Sprintf("%s/realms/%s",
 		c.Keycloak.ServerURL,
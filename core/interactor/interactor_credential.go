@@ -0,0 +1,199 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// CredentialInteractor orchestrates employees' licences, medical certificates, language proficiency and
+// recurrent training. Credentials belong to the employee who recorded them; the expiry report covers the
+// caller's airline.
+type CredentialInteractor struct {
+	service input.CredentialService
+}
+
+// NewCredentialInteractor creates a new CredentialInteractor
+func NewCredentialInteractor(service input.CredentialService) *CredentialInteractor {
+	return &CredentialInteractor{
+		service: service,
+	}
+}
+
+// WarningDays returns how many days before expiry a credential is reported as expiring
+func (i *CredentialInteractor) WarningDays() int {
+	return i.service.WarningDays()
+}
+
+// ListCredentials returns an employee's credentials grouped by type, soonest expiry first
+func (i *CredentialInteractor) ListCredentials(ctx context.Context, traceID, employeeID string) ([]domain.Credential, error) {
+	log.Info(logger.LogCredentialList, "trace_id", traceID, "employee_id", employeeID)
+
+	credentials, err := i.service.ListCredentials(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// GetCredential returns a credential of the employee
+func (i *CredentialInteractor) GetCredential(ctx context.Context, traceID, id, employeeID string) (*domain.Credential, error) {
+	log.Info(logger.LogCredentialGet, "trace_id", traceID, "id", id)
+
+	return i.getOwnCredential(ctx, traceID, id, employeeID)
+}
+
+// CreateCredential validates and saves a new credential of the employee; the scan is attached afterwards
+func (i *CredentialInteractor) CreateCredential(ctx context.Context, traceID string, credential domain.Credential) (*domain.Credential, error) {
+	log.Info(logger.LogCredentialCreate, "trace_id", traceID, "data", credential.ToLogger())
+
+	if err := credential.Validate(); err != nil {
+		log.Warn(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	credential.SetID()
+	credential.Scan = nil
+	credential.CreatedAt = now
+	credential.UpdatedAt = now
+	if err := i.service.CreateCredential(ctx, credential); err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogCredentialCreateOK, "trace_id", traceID, "id", credential.ID)
+	return &credential, nil
+}
+
+// UpdateCredential validates and stores the new values of a credential of the employee, keeping its scan
+func (i *CredentialInteractor) UpdateCredential(ctx context.Context, traceID, id, employeeID string, credential domain.Credential) (*domain.Credential, error) {
+	log.Info(logger.LogCredentialUpdate, "trace_id", traceID, "id", id)
+
+	existing, err := i.getOwnCredential(ctx, traceID, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve protected fields
+	credential.ID = existing.ID
+	credential.EmployeeID = existing.EmployeeID
+	credential.Scan = existing.Scan
+	credential.CreatedAt = existing.CreatedAt
+
+	if err := credential.Validate(); err != nil {
+		log.Warn(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	credential.UpdatedAt = time.Now().UTC()
+	if err := i.service.UpdateCredential(ctx, credential); err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogCredentialUpdateOK, "trace_id", traceID, "id", id)
+	return &credential, nil
+}
+
+// DeleteCredential removes a credential of the employee
+func (i *CredentialInteractor) DeleteCredential(ctx context.Context, traceID, id, employeeID string) error {
+	log.Info(logger.LogCredentialDelete, "trace_id", traceID, "id", id)
+
+	if _, err := i.getOwnCredential(ctx, traceID, id, employeeID); err != nil {
+		return err
+	}
+
+	if err := i.service.DeleteCredential(ctx, id); err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogCredentialDeleteOK, "trace_id", traceID, "id", id)
+	return nil
+}
+
+// UploadCredentialScan validates and attaches a scan to a credential of the employee, replacing the
+// previous one
+func (i *CredentialInteractor) UploadCredentialScan(ctx context.Context, traceID, id, employeeID string, scan domain.CredentialScan) (*domain.Credential, error) {
+	log.Info(logger.LogCredentialScanUpload, "trace_id", traceID, "id", id, "file_name", scan.FileName, "size", scan.Size)
+
+	credential, err := i.getOwnCredential(ctx, traceID, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scan.Validate(); err != nil {
+		log.Warn(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	scan.UploadedAt = time.Now().UTC()
+	if err := i.service.SaveCredentialScan(ctx, id, scan); err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	scan.Content = nil
+	credential.Scan = &scan
+	log.Info(logger.LogCredentialScanUploadOK, "trace_id", traceID, "id", id)
+	return credential, nil
+}
+
+// GetCredentialScan returns the scan attached to a credential of the employee, with its content
+func (i *CredentialInteractor) GetCredentialScan(ctx context.Context, traceID, id, employeeID string) (*domain.CredentialScan, error) {
+	log.Info(logger.LogCredentialScanGet, "trace_id", traceID, "id", id)
+
+	if _, err := i.getOwnCredential(ctx, traceID, id, employeeID); err != nil {
+		return nil, err
+	}
+
+	scan, err := i.service.GetCredentialScan(ctx, id)
+	if err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	return scan, nil
+}
+
+// GetExpiryReport returns the airline's credentials expired or expiring within withinDays of asOf (the
+// warning days when zero). Only employees of the airline may see it; the route is limited to crew managers.
+func (i *CredentialInteractor) GetExpiryReport(ctx context.Context, traceID, airlineID, employeeAirlineID string, asOf time.Time, withinDays int) (*domain.CredentialExpiryReport, error) {
+	log.Info(logger.LogCredentialExpiryReport, "trace_id", traceID, "airline_id", airlineID, "within_days", withinDays)
+
+	if airlineID != employeeAirlineID {
+		log.Warn(logger.LogCredentialError, "trace_id", traceID, "airline_id", airlineID, "error", "unauthorized")
+		return nil, domain.ErrCredentialUnauthorized
+	}
+	if withinDays == 0 {
+		withinDays = i.service.WarningDays()
+	}
+
+	report, err := i.service.GetExpiryReport(ctx, airlineID, asOf, withinDays)
+	if err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogCredentialExpiryReportOK, "trace_id", traceID, "airline_id", airlineID, "count", len(report.Credentials))
+	return report, nil
+}
+
+// getOwnCredential loads a credential, failing with ErrCredentialUnauthorized when it belongs to another
+// employee
+func (i *CredentialInteractor) getOwnCredential(ctx context.Context, traceID, id, employeeID string) (*domain.Credential, error) {
+	credential, err := i.service.GetCredential(ctx, id)
+	if err != nil {
+		log.Error(logger.LogCredentialError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if credential.EmployeeID != employeeID {
+		log.Warn(logger.LogCredentialError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrCredentialUnauthorized
+	}
+	return credential, nil
+}
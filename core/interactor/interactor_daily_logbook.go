@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/input"
@@ -11,15 +12,17 @@ import (
 
 // DailyLogbookInteractor orchestrates daily logbook operations
 type DailyLogbookInteractor struct {
-	service input.DailyLogbookService
-	logger  logger.Logger
+	service      input.DailyLogbookService
+	chainService input.LogbookChainService // Seals the segments when a logbook is signed
+	logger       logger.Logger
 }
 
 // NewDailyLogbookInteractor creates a new daily logbook interactor
-func NewDailyLogbookInteractor(service input.DailyLogbookService, log logger.Logger) *DailyLogbookInteractor {
+func NewDailyLogbookInteractor(service input.DailyLogbookService, chainService input.LogbookChainService, log logger.Logger) *DailyLogbookInteractor {
 	return &DailyLogbookInteractor{
-		service: service,
-		logger:  log,
+		service:      service,
+		chainService: chainService,
+		logger:       log,
 	}
 }
 
@@ -80,12 +83,16 @@ func (i *DailyLogbookInteractor) UpdateDailyLogbook(ctx context.Context, logbook
 
 	log.Info(logger.LogDailyLogbookUpdate, "logbook_id", logbook.ID)
 
-	// Verify logbook exists
-	_, err := i.service.GetDailyLogbookByID(ctx, logbook.ID)
+	// Verify logbook exists and has not been signed
+	existing, err := i.service.GetDailyLogbookByID(ctx, logbook.ID)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookNotFound, "logbook_id", logbook.ID)
 		return err
 	}
+	if !existing.IsEditable() {
+		log.Warn(logger.LogDailyLogbookSignedChange, "logbook_id", logbook.ID, "state", existing.CurrentState())
+		return domain.ErrDailyLogbookSigned
+	}
 
 	if err = i.service.UpdateDailyLogbook(ctx, logbook); err != nil {
 		log.Error(logger.LogDailyLogbookUpdateError, "logbook_id", logbook.ID, "error", err)
@@ -103,12 +110,16 @@ func (i *DailyLogbookInteractor) DeleteDailyLogbook(ctx context.Context, id stri
 
 	log.Info(logger.LogDailyLogbookDelete, "logbook_id", id)
 
-	// Verify logbook exists
-	_, err := i.service.GetDailyLogbookByID(ctx, id)
+	// Verify logbook exists and has not been signed
+	existing, err := i.service.GetDailyLogbookByID(ctx, id)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookNotFound, "logbook_id", id)
 		return err
 	}
+	if !existing.IsEditable() {
+		log.Warn(logger.LogDailyLogbookSignedChange, "logbook_id", id, "state", existing.CurrentState())
+		return domain.ErrDailyLogbookSigned
+	}
 
 	if err = i.service.DeleteDailyLogbook(ctx, id); err != nil {
 		log.Error(logger.LogDailyLogbookDeleteError, "logbook_id", id, "error", err)
@@ -119,6 +130,52 @@ func (i *DailyLogbookInteractor) DeleteDailyLogbook(ctx context.Context, id stri
 	return nil
 }
 
+// GetDeletedDailyLogbookByID retrieves a soft deleted daily logbook that can still be restored
+func (i *DailyLogbookInteractor) GetDeletedDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error) {
+	traceID := middleware.GetTraceIDFromContext(ctx)
+	log := i.logger.WithTraceID(traceID)
+
+	logbook, err := i.service.GetDeletedDailyLogbookByID(ctx, id)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookGetError, "logbook_id", id, "error", err)
+		return nil, err
+	}
+	return logbook, nil
+}
+
+// ListDeletedDailyLogbooks retrieves the employee's soft deleted daily logbooks, most recently deleted first
+func (i *DailyLogbookInteractor) ListDeletedDailyLogbooks(ctx context.Context, employeeID string) ([]domain.DailyLogbook, error) {
+	traceID := middleware.GetTraceIDFromContext(ctx)
+	log := i.logger.WithTraceID(traceID)
+
+	log.Info(logger.LogDailyLogbookDeletedList, "employee_id", employeeID)
+
+	logbooks, err := i.service.ListDeletedDailyLogbooks(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookListError, "error", err)
+		return nil, err
+	}
+
+	log.Success(logger.LogDailyLogbookDeletedListOK, "count", len(logbooks))
+	return logbooks, nil
+}
+
+// RestoreDailyLogbook brings back a soft deleted daily logbook with the segments deleted along with it
+func (i *DailyLogbookInteractor) RestoreDailyLogbook(ctx context.Context, id string) error {
+	traceID := middleware.GetTraceIDFromContext(ctx)
+	log := i.logger.WithTraceID(traceID)
+
+	log.Info(logger.LogDailyLogbookRestore, "logbook_id", id)
+
+	if err := i.service.RestoreDailyLogbook(ctx, id); err != nil {
+		log.Error(logger.LogDailyLogbookRestoreError, "logbook_id", id, "error", err)
+		return err
+	}
+
+	log.Success(logger.LogDailyLogbookRestoreOK, "logbook_id", id)
+	return nil
+}
+
 // ActivateDailyLogbook sets a daily logbook's status to active
 func (i *DailyLogbookInteractor) ActivateDailyLogbook(ctx context.Context, id string) error {
 	traceID := middleware.GetTraceIDFromContext(ctx)
@@ -164,3 +221,37 @@ func (i *DailyLogbookInteractor) DeactivateDailyLogbook(ctx context.Context, id
 	log.Success(logger.LogDailyLogbookDeactivateOK, "logbook_id", id)
 	return nil
 }
+
+// TransitionDailyLogbook moves a daily logbook through the sign-off workflow (submit, reopen, sign, lock)
+func (i *DailyLogbookInteractor) TransitionDailyLogbook(ctx context.Context, id, employeeID string, to domain.LogbookState) (*domain.DailyLogbook, error) {
+	traceID := middleware.GetTraceIDFromContext(ctx)
+	log := i.logger.WithTraceID(traceID)
+
+	log.Info(logger.LogDailyLogbookTransition, "logbook_id", id, "to", to)
+
+	logbook, err := i.service.GetDailyLogbookByID(ctx, id)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookNotFound, "logbook_id", id)
+		return nil, err
+	}
+
+	from := logbook.CurrentState()
+	if err = logbook.Transition(to, employeeID, time.Now().UTC()); err != nil {
+		log.Warn(logger.LogDailyLogbookTransitionError, "logbook_id", id, "from", from, "to", to)
+		return nil, err
+	}
+
+	// Signing chains the day's segments into the employee's tamper-evident hash chain
+	if to == domain.LogbookStateSigned {
+		err = i.chainService.SealDailyLogbook(ctx, *logbook)
+	} else {
+		err = i.service.UpdateDailyLogbookState(ctx, *logbook)
+	}
+	if err != nil {
+		log.Error(logger.LogDailyLogbookTransitionError, "logbook_id", id, "error", err)
+		return nil, err
+	}
+
+	log.Success(logger.LogDailyLogbookTransitionOK, "logbook_id", id, "from", from, "to", to)
+	return logbook, nil
+}
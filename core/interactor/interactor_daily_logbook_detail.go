@@ -2,6 +2,8 @@ package interactor
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/input"
@@ -13,18 +15,48 @@ var log logger.Logger = logger.NewSlogLogger()
 // DailyLogbookDetailInteractor orchestrates daily logbook detail operations
 // This is the CORE interactor for flight segment tracking
 type DailyLogbookDetailInteractor struct {
-	service        input.DailyLogbookDetailService
-	logbookService input.DailyLogbookService // For ownership verification
+	service           input.DailyLogbookDetailService
+	logbookService    input.DailyLogbookService     // For ownership verification
+	ftlService        input.FTLService              // Flight time limitations
+	currencyService   input.CurrencyService         // Pilot recency per aircraft family
+	importService     input.LogbookImportService    // Bulk CSV import
+	anomalyService    input.FlightAnomalyService    // Block/air time vs route estimate
+	amendmentService  input.LogbookAmendmentService // Corrections of signed logbooks
+	chainService      input.LogbookChainService     // Hash chain over signed segments
+	historyService    input.LogbookHistoryService   // Change history of logbooks and segments
+	mirrorService     input.SegmentMirrorService    // Companion pilots' mirrored entries
+	simulatorService  input.SimulatorSessionService // FSTD time, reported apart from flight time
+	typeRatingService input.TypeRatingService       // Pilot flying qualification per aircraft family
 }
 
 // NewDailyLogbookDetailInteractor creates a new DailyLogbookDetailInteractor
 func NewDailyLogbookDetailInteractor(
 	service input.DailyLogbookDetailService,
 	logbookService input.DailyLogbookService,
+	ftlService input.FTLService,
+	currencyService input.CurrencyService,
+	importService input.LogbookImportService,
+	anomalyService input.FlightAnomalyService,
+	amendmentService input.LogbookAmendmentService,
+	chainService input.LogbookChainService,
+	historyService input.LogbookHistoryService,
+	mirrorService input.SegmentMirrorService,
+	simulatorService input.SimulatorSessionService,
+	typeRatingService input.TypeRatingService,
 ) *DailyLogbookDetailInteractor {
 	return &DailyLogbookDetailInteractor{
-		service:        service,
-		logbookService: logbookService,
+		service:           service,
+		logbookService:    logbookService,
+		ftlService:        ftlService,
+		currencyService:   currencyService,
+		importService:     importService,
+		anomalyService:    anomalyService,
+		amendmentService:  amendmentService,
+		chainService:      chainService,
+		historyService:    historyService,
+		mirrorService:     mirrorService,
+		simulatorService:  simulatorService,
+		typeRatingService: typeRatingService,
 	}
 }
 
@@ -42,6 +74,9 @@ func (i *DailyLogbookDetailInteractor) GetDailyLogbookDetailByID(ctx context.Con
 		log.Warn(logger.LogDailyLogbookDetailNotFound, "trace_id", traceID, "id", id)
 		return nil, domain.ErrFlightNotFound
 	}
+	annotated := []domain.DailyLogbookDetail{*detail}
+	i.anomalyService.AnnotateAnomalies(annotated)
+	detail = &annotated[0]
 
 	log.Info(logger.LogDailyLogbookDetailGetOK, "trace_id", traceID, "id", id)
 	return detail, nil
@@ -67,68 +102,496 @@ func (i *DailyLogbookDetailInteractor) ListDailyLogbookDetailsByLogbook(ctx cont
 		log.Error(logger.LogDailyLogbookDetailListError, "trace_id", traceID, "error", err)
 		return nil, err
 	}
+	i.anomalyService.AnnotateAnomalies(details)
 
 	log.Info(logger.LogDailyLogbookDetailListOK, "trace_id", traceID, "count", len(details))
 	return details, nil
 }
 
-// CreateDailyLogbookDetail creates a new detail
-func (i *DailyLogbookDetailInteractor) CreateDailyLogbookDetail(ctx context.Context, traceID string, detail domain.DailyLogbookDetail) error {
-	log.Info(logger.LogDailyLogbookDetailCreate, "trace_id", traceID, "data", detail.ToLogger())
+// GetFlightTotals returns the accumulated flight times of an employee, optionally grouped
+func (i *DailyLogbookDetailInteractor) GetFlightTotals(ctx context.Context, traceID string, filter domain.FlightTotalsFilter) (*domain.FlightTotalsReport, error) {
+	log.Info(logger.LogFlightTotalsGet, "trace_id", traceID, "employee_id", filter.EmployeeID, "group_by", filter.GroupBy)
 
-	// Validate time sequence
-	if err := i.service.ValidateTimeSequence(detail.OutTime, detail.TakeoffTime, detail.LandingTime, detail.InTime); err != nil {
-		log.Error(logger.LogDailyLogbookDetailCreateError, "trace_id", traceID, "error", "invalid time sequence")
-		return err
+	report, err := i.service.GetFlightTotals(ctx, filter)
+	if err != nil {
+		log.Error(logger.LogFlightTotalsGetError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	// Simulator time is never added to flight time; it is reported per device type next to it
+	if filter.IncludeSimulator {
+		report.Simulator, err = i.simulatorService.GetSimulatorTotals(ctx, domain.SimulatorSessionFilter{
+			EmployeeID: filter.EmployeeID,
+			From:       filter.From,
+			To:         filter.To,
+		})
+		if err != nil {
+			log.Error(logger.LogFlightTotalsGetError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
 	}
 
+	log.Info(logger.LogFlightTotalsGetOK, "trace_id", traceID, "segments", report.Totals.SegmentCount)
+	return report, nil
+}
+
+// GetLogbookExport returns an employee's logbook pages for a date range, ready to be rendered
+func (i *DailyLogbookDetailInteractor) GetLogbookExport(ctx context.Context, traceID string, filter domain.LogbookExportFilter) (*domain.LogbookExport, error) {
+	log.Info(logger.LogLogbookExport, "trace_id", traceID, "employee_id", filter.EmployeeID,
+		"from", filter.From.Format("2006-01-02"), "to", filter.To.Format("2006-01-02"))
+
+	export, err := i.service.GetLogbookExport(ctx, filter)
+	if err != nil {
+		log.Error(logger.LogLogbookExportError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogLogbookExportOK, "trace_id", traceID, "pages", len(export.Pages))
+	return export, nil
+}
+
+// CreateDailyLogbookDetail creates a new detail
+// Returns the non-blocking warnings raised while validating the segment
+func (i *DailyLogbookDetailInteractor) CreateDailyLogbookDetail(ctx context.Context, traceID string, detail domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
+	log.Info(logger.LogDailyLogbookDetailCreate, "trace_id", traceID, "data", detail.ToLogger())
+
 	// Generate ID if not set
 	if detail.ID == "" {
 		detail.SetID()
 	}
 
-	err := i.service.CreateDailyLogbookDetail(ctx, detail)
+	logbook, err := i.getEditableLogbook(ctx, traceID, detail.DailyLogbookID)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailCreateError, "trace_id", traceID, "error", err)
-		return err
+		return nil, err
 	}
 
-	log.Info(logger.LogDailyLogbookDetailCreateOK, "trace_id", traceID, "id", detail.ID)
-	return nil
+	if detail.CompanionEmployeeID != nil {
+		if err := i.mirrorService.ValidateCompanion(ctx, logbook.EmployeeID, *detail.CompanionEmployeeID); err != nil {
+			log.Warn(logger.LogDailyLogbookDetailCreateError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+	}
+
+	warnings, err := i.prepareSegment(ctx, traceID, *logbook, &detail)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailCreateError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	err = i.service.CreateDailyLogbookDetail(ctx, detail)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailCreateError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	// Offer the companion a mirrored entry in their own logbook
+	i.offerSegmentMirror(ctx, traceID, detail, logbook.EmployeeID)
+
+	log.Info(logger.LogDailyLogbookDetailCreateOK, "trace_id", traceID, "id", detail.ID, "warnings", len(warnings))
+	return warnings, nil
 }
 
 // UpdateDailyLogbookDetail updates an existing detail
-func (i *DailyLogbookDetailInteractor) UpdateDailyLogbookDetail(ctx context.Context, traceID string, detail domain.DailyLogbookDetail) error {
+// Returns the non-blocking warnings raised while validating the segment
+func (i *DailyLogbookDetailInteractor) UpdateDailyLogbookDetail(ctx context.Context, traceID string, detail domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
 	log.Info(logger.LogDailyLogbookDetailUpdate, "trace_id", traceID, "data", detail.ToLogger())
 
 	// Verify detail exists
 	existing, err := i.service.GetDailyLogbookDetailByID(ctx, detail.ID)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", err)
-		return err
+		return nil, err
 	}
 	if existing == nil {
 		log.Warn(logger.LogDailyLogbookDetailNotFound, "trace_id", traceID, "id", detail.ID)
-		return domain.ErrFlightNotFound
-	}
-
-	// Validate time sequence
-	if err := i.service.ValidateTimeSequence(detail.OutTime, detail.TakeoffTime, detail.LandingTime, detail.InTime); err != nil {
-		log.Error(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", "invalid time sequence")
-		return err
+		return nil, domain.ErrFlightNotFound
 	}
 
 	// Preserve the daily_logbook_id from existing record (cannot change parent)
 	detail.DailyLogbookID = existing.DailyLogbookID
+	// The duty period link is managed through the duty period
+	detail.DutyPeriodID = existing.DutyPeriodID
+
+	logbook, err := i.getEditableLogbook(ctx, traceID, detail.DailyLogbookID)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	if detail.CompanionEmployeeID != nil {
+		if err := i.mirrorService.ValidateCompanion(ctx, logbook.EmployeeID, *detail.CompanionEmployeeID); err != nil {
+			log.Warn(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+	}
+
+	warnings, err := i.prepareSegment(ctx, traceID, *logbook, &detail)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
 
 	err = i.service.UpdateDailyLogbookDetail(ctx, detail)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailUpdateError, "trace_id", traceID, "error", err)
-		return err
+		return nil, err
 	}
 
-	log.Info(logger.LogDailyLogbookDetailUpdateOK, "trace_id", traceID, "id", detail.ID)
-	return nil
+	// Keep the companion's mirrored entry in sync
+	warnings = append(warnings, i.syncSegmentMirror(ctx, traceID, *existing, detail, logbook.EmployeeID)...)
+
+	log.Info(logger.LogDailyLogbookDetailUpdateOK, "trace_id", traceID, "id", detail.ID, "warnings", len(warnings))
+	return warnings, nil
+}
+
+// ImportDailyLogbookDetails validates every row of a bulk import through the same pipeline as a single
+// create (normalization, time checks, derived times, flight time limitations) and, when all rows are valid
+// and it is not a dry run, saves the missing daily logbooks and all details in one transaction.
+// Each row is checked against the stored segments and the valid rows before it, so duplicates, overlaps
+// and flight time limitations are enforced across the whole file.
+func (i *DailyLogbookDetailInteractor) ImportDailyLogbookDetails(ctx context.Context, traceID, employeeID string, rows []domain.LogbookImportRow, dryRun bool) (*domain.LogbookImportResult, error) {
+	log.Info(logger.LogLogbookImport, "trace_id", traceID, "employee_id", employeeID, "rows", len(rows), "dry_run", dryRun)
+
+	if len(rows) > domain.MaxLogbookImportRows {
+		return nil, domain.ErrImportTooManyRows
+	}
+
+	catalog, err := i.importService.LoadImportCatalog(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogLogbookImportError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	return i.runImport(ctx, traceID, employeeID, catalog, rows, i.importService.ResolveImportRow, nil, dryRun)
+}
+
+// ImportElogbook imports rows translated from a third-party electronic logbook. The user's new mapping
+// decisions are applied before resolving and remembered when the import is saved. Rows whose airport,
+// aircraft or route is still unresolved are reported for manual mapping and skipped instead of failing
+// the batch; any other invalid row keeps the whole import from being saved.
+func (i *DailyLogbookDetailInteractor) ImportElogbook(ctx context.Context, traceID, employeeID string, imp domain.ElogbookImport) (*domain.LogbookImportResult, error) {
+	log.Info(logger.LogElogbookImport, "trace_id", traceID, "employee_id", employeeID, "format", imp.Format, "rows", len(imp.Rows), "dry_run", imp.DryRun)
+
+	if len(imp.Rows) > domain.MaxLogbookImportRows {
+		return nil, domain.ErrImportTooManyRows
+	}
+
+	catalog, err := i.importService.LoadImportCatalog(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogLogbookImportError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	mappings := make([]domain.ImportMapping, 0, len(imp.Mappings))
+	for _, m := range imp.Mappings {
+		m.SourceValue = domain.NormalizeMappingSource(m.SourceValue)
+		if m.SourceValue == "" || !catalog.HasTarget(m.Kind, m.TargetID) {
+			return nil, &domain.ImportFieldError{Field: m.SourceValue, Err: domain.ErrImportInvalidMapping}
+		}
+		m.SetID()
+		m.EmployeeID = employeeID
+		m.UpdatedAt = now
+		catalog.AddMapping(m)
+		mappings = append(mappings, m)
+	}
+
+	return i.runImport(ctx, traceID, employeeID, catalog, imp.Rows, i.importService.ResolveElogbookRow, mappings, imp.DryRun)
+}
+
+// importRowResolver resolves one import row against the catalog (see LogbookImportService)
+type importRowResolver func(catalog *domain.LogbookImportCatalog, employeeID string, row domain.LogbookImportRow) (*domain.DailyLogbookDetail, *domain.DailyLogbook, error)
+
+// runImport validates the rows and saves the valid ones with their new logbooks and the mapping decisions
+func (i *DailyLogbookDetailInteractor) runImport(ctx context.Context, traceID, employeeID string, catalog *domain.LogbookImportCatalog,
+	rows []domain.LogbookImportRow, resolve importRowResolver, mappings []domain.ImportMapping, dryRun bool) (*domain.LogbookImportResult, error) {
+	result := &domain.LogbookImportResult{DryRun: dryRun}
+	newLogbooks := make(map[string]domain.DailyLogbook)
+	unresolved := make(map[string]int) // kind + value -> index in result.Unresolved
+	// Valid rows so far; later rows are checked against them as if they were already saved
+	var accepted []domain.DailyLogbookDetail
+	for _, row := range rows {
+		rowResult := domain.LogbookImportRowResult{Line: row.Line}
+
+		detail, created, err := resolve(catalog, employeeID, row)
+		if created != nil {
+			newLogbooks[created.ID] = *created
+		}
+		if err == nil {
+			rowResult.Detail = detail
+			logbook, _ := catalog.Logbook(detail.DailyLogbookID)
+			if logbook.IsEditable() {
+				rowResult.Warnings, err = i.prepareBatchSegment(ctx, traceID, logbook, detail, accepted)
+				if err == nil {
+					accepted = append(accepted, *detail)
+				}
+			} else {
+				err = domain.ErrDailyLogbookSigned
+			}
+		}
+
+		var refErr *domain.UnresolvedReferenceError
+		switch {
+		case errors.As(err, &refErr):
+			log.Warn(logger.LogElogbookImportUnresolved, "trace_id", traceID, "line", row.Line, "kind", refErr.Kind, "value", refErr.Value)
+			key := string(refErr.Kind) + " " + refErr.Value
+			idx, ok := unresolved[key]
+			if !ok {
+				idx = len(result.Unresolved)
+				unresolved[key] = idx
+				result.Unresolved = append(result.Unresolved, domain.UnresolvedReference{Kind: refErr.Kind, Value: refErr.Value})
+			}
+			result.Unresolved[idx].Lines = append(result.Unresolved[idx].Lines, row.Line)
+			rowResult.Err = err
+		case err != nil:
+			log.Warn(logger.LogLogbookImportInvalidRow, "trace_id", traceID, "line", row.Line, "error", err)
+			rowResult.Err = err
+		}
+		result.Rows = append(result.Rows, rowResult)
+	}
+
+	// Only the logbooks of valid rows are (or would be) created
+	var logbooks []domain.DailyLogbook
+	var details []domain.DailyLogbookDetail
+	for _, r := range result.Rows {
+		if r.Err != nil {
+			continue
+		}
+		details = append(details, *r.Detail)
+		if l, ok := newLogbooks[r.Detail.DailyLogbookID]; ok {
+			logbooks = append(logbooks, l)
+			delete(newLogbooks, l.ID)
+		}
+	}
+	result.LogbooksCreated = len(logbooks)
+
+	if dryRun || result.InvalidRows() > 0 {
+		log.Info(logger.LogLogbookImportDryRunOK, "trace_id", traceID, "invalid_rows", result.InvalidRows(), "pending_rows", result.PendingRows())
+		return result, nil
+	}
+
+	if err := i.importService.SaveImport(ctx, logbooks, details, mappings); err != nil {
+		log.Error(logger.LogLogbookImportError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	result.Saved = true
+	result.MappingsSaved = len(mappings)
+
+	log.Info(logger.LogLogbookImportOK, "trace_id", traceID, "details", len(details), "logbooks", len(logbooks), "mappings", len(mappings))
+	return result, nil
+}
+
+// ListImportMappings returns the mapping decisions remembered for the employee
+func (i *DailyLogbookDetailInteractor) ListImportMappings(ctx context.Context, traceID, employeeID string) ([]domain.ImportMapping, error) {
+	log.Info(logger.LogImportMappingList, "trace_id", traceID, "employee_id", employeeID)
+	return i.importService.ListImportMappings(ctx, employeeID)
+}
+
+// DeleteImportMapping forgets one of the employee's mapping decisions
+func (i *DailyLogbookDetailInteractor) DeleteImportMapping(ctx context.Context, traceID, id, employeeID string) error {
+	log.Info(logger.LogImportMappingDelete, "trace_id", traceID, "mapping_id", id, "employee_id", employeeID)
+	return i.importService.DeleteImportMapping(ctx, id, employeeID)
+}
+
+// prepareSegment normalizes, validates and derives the computed fields of a segment before it is saved
+// Shared by create, update and import; returns non-blocking warnings or the first blocking error
+func (i *DailyLogbookDetailInteractor) prepareSegment(ctx context.Context, traceID string, logbook domain.DailyLogbook, detail *domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
+	return i.prepareBatchSegment(ctx, traceID, logbook, detail, nil)
+}
+
+// prepareBatchSegment is prepareSegment for a segment saved together with the batch of already prepared
+// segments (the valid rows of an import), which are checked along with the stored ones
+func (i *DailyLogbookDetailInteractor) prepareBatchSegment(ctx context.Context, traceID string, logbook domain.DailyLogbook,
+	detail *domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
+	employeeID := logbook.EmployeeID
+
+	// Route, airline and aircraft must belong together; the flight date must match the logbook's day
+	if err := i.service.CheckSegmentReferences(ctx, *detail, logbook); err != nil {
+		return nil, err
+	}
+
+	// Normalize local times to UTC using the route airports' time zones
+	if err := i.service.NormalizeSegmentTimes(ctx, detail); err != nil {
+		return nil, err
+	}
+
+	// Validate time sequence
+	if err := i.service.ValidateTimeSequence(detail.FlightRealDate, detail.OutTime, detail.TakeoffTime, detail.LandingTime, detail.InTime); err != nil {
+		return nil, err
+	}
+
+	// Derive air_time and block_time from OUT/OFF/ON/IN
+	if err := i.service.CalculateFlightTimes(detail); err != nil {
+		return nil, err
+	}
+
+	// Derive night time and day/night takeoffs and landings from airport coordinates
+	if err := i.service.CalculateNightTime(ctx, detail); err != nil {
+		return nil, err
+	}
+
+	var warnings []domain.ValidationWarning
+
+	// Duplicates and overlaps with the employee's other segments; an explicit override saves anyway
+	if err := i.service.CheckSegmentConflicts(ctx, employeeID, *detail, batch); err != nil {
+		var conflict *domain.SegmentConflictError
+		if !errors.As(err, &conflict) || !detail.OverrideConflicts {
+			return nil, err
+		}
+		log.Warn(logger.LogDailyLogbookDetailConflictOverride, "trace_id", traceID, "id", detail.ID,
+			"kind", conflict.Kind, "conflicting_id", conflict.DetailID)
+		warnings = append(warnings, domain.ValidationWarning{
+			Code:     domain.MsgFlightConflictOverridden,
+			Params:   []string{string(conflict.Kind)},
+			DetailID: conflict.DetailID,
+		})
+	}
+
+	// Type rating on the aircraft family when flying the segment; enforcement depends on the flight type
+	missing, err := i.typeRatingService.CheckQualification(ctx, employeeID, *detail)
+	if err != nil {
+		var ratingErr *domain.TypeRatingRequiredError
+		if errors.As(err, &ratingErr) {
+			log.Warn(logger.LogTypeRatingMissing, "trace_id", traceID, "id", detail.ID, "aircraft_family", ratingErr.AircraftFamily)
+		}
+		return nil, err
+	}
+	if missing != nil {
+		log.Warn(logger.LogTypeRatingMissing, "trace_id", traceID, "id", detail.ID, "aircraft_family", missing.Params[0])
+		warnings = append(warnings, *missing)
+	}
+
+	// Route continuity with the other legs of the day
+	gaps, err := i.service.CheckRouteContinuity(ctx, *detail, batch)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, gaps...)
+
+	// Flight time limitations: blocking limits reject the segment, the rest become warnings
+	findings, err := i.ftlService.CheckSegment(ctx, employeeID, *detail, batch)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range findings {
+		if f.Level == domain.FTLLevelExceeded && f.Limit.Blocking {
+			log.Warn(logger.LogFTLCheckExceeded, "trace_id", traceID, "limit", f.Limit.Code, "used", domain.FormatFlightDuration(f.Used))
+			return nil, &domain.FTLLimitExceededError{Usage: f}
+		}
+		code := domain.MsgFTLLimitWarning
+		if f.Level == domain.FTLLevelExceeded {
+			code = domain.MsgFTLLimitExceededWarning
+		}
+		warnings = append(warnings, domain.ValidationWarning{Code: code, Params: domain.FTLMessageParams(f)})
+	}
+
+	return warnings, nil
+}
+
+// GetFlightAnomalyReport returns the employee's segments whose block or air time deviates from the route estimate
+func (i *DailyLogbookDetailInteractor) GetFlightAnomalyReport(ctx context.Context, traceID, employeeID string, from, to time.Time) (*domain.FlightAnomalyReport, error) {
+	log.Info(logger.LogFlightAnomalyReport, "trace_id", traceID, "employee_id", employeeID,
+		"from", from.Format("2006-01-02"), "to", to.Format("2006-01-02"))
+
+	report, err := i.anomalyService.GetAnomalyReport(ctx, employeeID, from, to)
+	if err != nil {
+		log.Error(logger.LogFlightAnomalyReportError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogFlightAnomalyReportOK, "trace_id", traceID, "segments", report.SegmentCount, "suspicious", len(report.Segments))
+	return report, nil
+}
+
+// VerifyLogbookChain recomputes the employee's hash chain of signed segments and reports the first broken link
+func (i *DailyLogbookDetailInteractor) VerifyLogbookChain(ctx context.Context, traceID, employeeID string) (*domain.LogbookChainReport, error) {
+	log.Info(logger.LogLogbookChainVerify, "trace_id", traceID, "employee_id", employeeID)
+
+	report, err := i.chainService.VerifyChain(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogLogbookChainError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	if !report.Valid() {
+		log.Warn(logger.LogLogbookChainBroken, "trace_id", traceID, "employee_id", employeeID,
+			"position", report.FirstBroken.Position, "detail_id", report.FirstBroken.DetailID)
+		return report, nil
+	}
+	log.Info(logger.LogLogbookChainVerifyOK, "trace_id", traceID, "entries", report.EntryCount)
+	return report, nil
+}
+
+// ListLogbookHistory returns the change history of a daily logbook and its segments, oldest first
+func (i *DailyLogbookDetailInteractor) ListLogbookHistory(ctx context.Context, traceID, logbookID string) ([]domain.LogbookHistoryEntry, error) {
+	log.Info(logger.LogLogbookHistory, "trace_id", traceID, "logbook_id", logbookID)
+
+	entries, err := i.historyService.ListLogbookHistory(ctx, logbookID)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogLogbookHistoryOK, "trace_id", traceID, "logbook_id", logbookID, "count", len(entries))
+	return entries, nil
+}
+
+// ListDetailHistory returns the change history of a segment, oldest first. Deleted segments keep their
+// history, so ErrLogbookHistoryNotFound is returned only when the segment never existed.
+func (i *DailyLogbookDetailInteractor) ListDetailHistory(ctx context.Context, traceID, detailID string) ([]domain.LogbookHistoryEntry, error) {
+	log.Info(logger.LogLogbookHistory, "trace_id", traceID, "detail_id", detailID)
+
+	entries, err := i.historyService.ListDetailHistory(ctx, detailID)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if len(entries) == 0 {
+		log.Warn(logger.LogLogbookHistoryError, "trace_id", traceID, "detail_id", detailID, "error", domain.ErrLogbookHistoryNotFound)
+		return nil, domain.ErrLogbookHistoryNotFound
+	}
+
+	log.Info(logger.LogLogbookHistoryOK, "trace_id", traceID, "detail_id", detailID, "count", len(entries))
+	return entries, nil
+}
+
+// ValidateDailyLogbook returns the consistency report of a daily logbook's segments
+func (i *DailyLogbookDetailInteractor) ValidateDailyLogbook(ctx context.Context, traceID, logbookID string) (*domain.LogbookValidationReport, error) {
+	log.Info(logger.LogLogbookValidation, "trace_id", traceID, "logbook_id", logbookID)
+
+	logbook, err := i.logbookService.GetDailyLogbookByID(ctx, logbookID)
+	if err != nil {
+		log.Error(logger.LogLogbookValidationError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if logbook == nil {
+		log.Warn(logger.LogDailyLogbookNotFound, "trace_id", traceID, "logbook_id", logbookID)
+		return nil, domain.ErrFlightInvalidLogbook
+	}
+
+	report, err := i.service.ValidateLogbook(ctx, *logbook)
+	if err != nil {
+		log.Error(logger.LogLogbookValidationError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogLogbookValidationOK, "trace_id", traceID, "logbook_id", logbookID, "issues", len(report.Issues))
+	return report, nil
+}
+
+// GetFTLStatus returns the usage of each flight time limitation for an employee as of a date
+func (i *DailyLogbookDetailInteractor) GetFTLStatus(ctx context.Context, traceID, employeeID string, asOf time.Time) (*domain.FTLStatus, error) {
+	log.Info(logger.LogFTLStatus, "trace_id", traceID, "employee_id", employeeID, "as_of", asOf.Format("2006-01-02"))
+
+	status, err := i.ftlService.GetFTLStatus(ctx, employeeID, asOf)
+	if err != nil {
+		log.Error(logger.LogFTLStatusError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogFTLStatusOK, "trace_id", traceID, "employee_id", employeeID)
+	return status, nil
 }
 
 // DeleteDailyLogbookDetail deletes a detail
@@ -146,16 +609,74 @@ func (i *DailyLogbookDetailInteractor) DeleteDailyLogbookDetail(ctx context.Cont
 		return domain.ErrFlightNotFound
 	}
 
+	if _, err = i.getEditableLogbook(ctx, traceID, existing.DailyLogbookID); err != nil {
+		log.Error(logger.LogDailyLogbookDetailDeleteError, "trace_id", traceID, "error", err)
+		return err
+	}
+
 	err = i.service.DeleteDailyLogbookDetail(ctx, id)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailDeleteError, "trace_id", traceID, "error", err)
 		return err
 	}
 
+	// The companion's entry stays, it is no longer kept in sync
+	if mirror, err := i.mirrorService.GetOpenSegmentMirror(ctx, id); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "detail_id", id, "error", err)
+	} else if mirror != nil {
+		i.closeSegmentMirror(ctx, traceID, *mirror)
+	}
+
 	log.Info(logger.LogDailyLogbookDetailDeleteOK, "trace_id", traceID, "id", id)
 	return nil
 }
 
+// RestoreDailyLogbookDetail brings back a soft deleted segment of the employee. Its logbook must not be
+// deleted or signed, and the segment must not clash with the segments recorded since it was deleted.
+func (i *DailyLogbookDetailInteractor) RestoreDailyLogbookDetail(ctx context.Context, traceID, id, employeeID string) (*domain.DailyLogbookDetail, error) {
+	log.Info(logger.LogDailyLogbookDetailRestore, "trace_id", traceID, "id", id)
+
+	deleted, err := i.service.GetDeletedDailyLogbookDetailByID(ctx, id)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if deleted == nil {
+		log.Warn(logger.LogDailyLogbookDetailNotFound, "trace_id", traceID, "id", id)
+		return nil, domain.ErrFlightNotFound
+	}
+
+	logbook, err := i.getLogbook(ctx, deleted.DailyLogbookID)
+	if err == domain.ErrDailyLogbookNotFound {
+		// The segment row is there, so its logbook was deleted too
+		err = domain.ErrFlightLogbookDeleted
+	}
+	if err != nil {
+		log.Warn(logger.LogDailyLogbookDetailRestoreErr, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if logbook.EmployeeID != employeeID {
+		return nil, domain.ErrFlightUnauthorized
+	}
+	if !logbook.IsEditable() {
+		log.Warn(logger.LogDailyLogbookSignedChange, "trace_id", traceID, "logbook_id", logbook.ID, "state", logbook.CurrentState())
+		return nil, domain.ErrDailyLogbookSigned
+	}
+
+	if err = i.service.CheckSegmentConflicts(ctx, employeeID, *deleted, nil); err != nil {
+		log.Warn(logger.LogDailyLogbookDetailConflict, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+
+	if err = i.service.RestoreDailyLogbookDetail(ctx, *deleted); err != nil {
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogDailyLogbookDetailRestoreOK, "trace_id", traceID, "id", id)
+	return i.GetDailyLogbookDetailByID(ctx, traceID, id)
+}
+
 // VerifyLogbookOwnership verifies that a logbook belongs to the specified employee
 func (i *DailyLogbookDetailInteractor) VerifyLogbookOwnership(ctx context.Context, logbookID string, employeeID string) error {
 	logbook, err := i.logbookService.GetDailyLogbookByID(ctx, logbookID)
@@ -171,16 +692,53 @@ func (i *DailyLogbookDetailInteractor) VerifyLogbookOwnership(ctx context.Contex
 	return nil
 }
 
+// GetCurrencyStatus returns the recency status of each currency rule per aircraft family for an employee
+func (i *DailyLogbookDetailInteractor) GetCurrencyStatus(ctx context.Context, traceID, employeeID string, asOf time.Time) (*domain.CurrencyStatus, error) {
+	log.Info(logger.LogCurrencyStatus, "trace_id", traceID, "employee_id", employeeID, "as_of", asOf.Format("2006-01-02"))
+
+	status, err := i.currencyService.GetCurrencyStatus(ctx, employeeID, asOf)
+	if err != nil {
+		log.Error(logger.LogCurrencyStatusError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogCurrencyStatusOK, "trace_id", traceID, "employee_id", employeeID, "rules", len(status.Rules))
+	return status, nil
+}
+
 // GetLogbookOwner returns the employee ID that owns a logbook
 func (i *DailyLogbookDetailInteractor) GetLogbookOwner(ctx context.Context, logbookID string) (string, error) {
-	logbook, err := i.logbookService.GetDailyLogbookByID(ctx, logbookID)
+	logbook, err := i.getLogbook(ctx, logbookID)
 	if err != nil {
 		return "", err
 	}
+	return logbook.EmployeeID, nil
+}
+
+// getLogbook returns a daily logbook or ErrFlightInvalidLogbook when it does not exist
+func (i *DailyLogbookDetailInteractor) getLogbook(ctx context.Context, logbookID string) (*domain.DailyLogbook, error) {
+	logbook, err := i.logbookService.GetDailyLogbookByID(ctx, logbookID)
+	if err != nil {
+		return nil, err
+	}
 	if logbook == nil {
-		return "", domain.ErrFlightInvalidLogbook
+		return nil, domain.ErrFlightInvalidLogbook
 	}
-	return logbook.EmployeeID, nil
+	return logbook, nil
+}
+
+// getEditableLogbook returns a daily logbook whose segments can still be changed directly, or
+// ErrDailyLogbookSigned once it has been submitted, signed or locked
+func (i *DailyLogbookDetailInteractor) getEditableLogbook(ctx context.Context, traceID, logbookID string) (*domain.DailyLogbook, error) {
+	logbook, err := i.getLogbook(ctx, logbookID)
+	if err != nil {
+		return nil, err
+	}
+	if !logbook.IsEditable() {
+		log.Warn(logger.LogDailyLogbookSignedChange, "trace_id", traceID, "logbook_id", logbookID, "state", logbook.CurrentState())
+		return nil, domain.ErrDailyLogbookSigned
+	}
+	return logbook, nil
 }
 
 // GetDetailLogbookOwner returns the employee ID that owns the logbook of a detail
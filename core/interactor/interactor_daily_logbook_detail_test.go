@@ -0,0 +1,144 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services"
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+)
+
+// stubSegmentService passes every segment check except conflicts, which are looked up in the batch only
+// (as if the employee had no stored segments)
+type stubSegmentService struct {
+	input.DailyLogbookDetailService
+}
+
+func (s *stubSegmentService) CheckSegmentReferences(ctx context.Context, detail domain.DailyLogbookDetail, logbook domain.DailyLogbook) error {
+	return nil
+}
+
+func (s *stubSegmentService) NormalizeSegmentTimes(ctx context.Context, detail *domain.DailyLogbookDetail) error {
+	return nil
+}
+
+func (s *stubSegmentService) ValidateTimeSequence(flightDate, outTime, takeoffTime, landingTime, inTime string) error {
+	return nil
+}
+
+func (s *stubSegmentService) CalculateFlightTimes(detail *domain.DailyLogbookDetail) error {
+	return nil
+}
+
+func (s *stubSegmentService) CalculateNightTime(ctx context.Context, detail *domain.DailyLogbookDetail) error {
+	return nil
+}
+
+func (s *stubSegmentService) CheckSegmentConflicts(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) error {
+	if conflict := domain.FindSegmentConflict(detail, batch); conflict != nil {
+		return conflict
+	}
+	return nil
+}
+
+func (s *stubSegmentService) CheckRouteContinuity(ctx context.Context, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
+	return nil, nil
+}
+
+type stubFTLService struct {
+	input.FTLService
+}
+
+func (s *stubFTLService) CheckSegment(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.FTLUsage, error) {
+	return nil, nil
+}
+
+type stubTypeRatingService struct {
+	input.TypeRatingService
+}
+
+func (s *stubTypeRatingService) CheckQualification(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail) (*domain.ValidationWarning, error) {
+	return nil, nil
+}
+
+// stubImportService resolves rows with the real import service against a fixed catalog
+type stubImportService struct {
+	*services.LogbookImportService
+	catalog *domain.LogbookImportCatalog
+	saved   bool
+}
+
+func (s *stubImportService) LoadImportCatalog(ctx context.Context, employeeID string) (*domain.LogbookImportCatalog, error) {
+	return s.catalog, nil
+}
+
+func (s *stubImportService) SaveImport(ctx context.Context, logbooks []domain.DailyLogbook, details []domain.DailyLogbookDetail, mappings []domain.ImportMapping) error {
+	s.saved = true
+	return nil
+}
+
+func TestDailyLogbookDetailInteractor_ImportDailyLogbookDetails(t *testing.T) {
+	importService := &stubImportService{
+		LogbookImportService: services.NewLogbookImportService(nil, nil, nil, nil, nil, nil, nil, nil),
+		catalog: &domain.LogbookImportCatalog{
+			Routes: map[string][]domain.AirlineRoute{
+				"BOG-CLO": {{ID: "ar-1", RouteCode: "BOG-CLO", AirlineCode: "AV"}},
+			},
+			Registrations: map[string]domain.AircraftRegistration{
+				"HK-5000": {ID: "reg-1", LicensePlate: "HK-5000"},
+			},
+			Logbooks: map[string]domain.DailyLogbook{
+				"2024-03-10": {ID: "lb-1", LogDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), EmployeeID: "emp-1"},
+			},
+		},
+	}
+	i := NewDailyLogbookDetailInteractor(&stubSegmentService{}, nil, &stubFTLService{}, nil, importService,
+		nil, nil, nil, nil, nil, nil, &stubTypeRatingService{})
+
+	row := func(line int, flightNumber, out, off, on, in string) domain.LogbookImportRow {
+		return domain.LogbookImportRow{
+			Line: line, FlightNumber: flightNumber, FlightRealDate: "2024-03-10", RouteCode: "BOG-CLO",
+			LicensePlate: "HK-5000", OutTime: out, TakeoffTime: off, LandingTime: on, InTime: in, PilotRole: "PF",
+		}
+	}
+
+	t.Run("row repeated in the same file is rejected", func(t *testing.T) {
+		rows := []domain.LogbookImportRow{
+			row(2, "AV9340", "08:00", "08:15", "09:20", "09:30"),
+			row(3, "AV9340", "08:00", "08:15", "09:20", "09:30"),
+			row(4, "AV9341", "11:00", "11:15", "12:20", "12:30"),
+		}
+		result, err := i.ImportDailyLogbookDetails(context.Background(), "trace", "emp-1", rows, true)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if result.Rows[0].Err != nil || result.Rows[2].Err != nil {
+			t.Fatalf("expected lines 2 and 4 to be valid, got %v and %v", result.Rows[0].Err, result.Rows[2].Err)
+		}
+		var conflict *domain.SegmentConflictError
+		if !errors.As(result.Rows[1].Err, &conflict) || conflict.Kind != domain.SegmentConflictDuplicate ||
+			conflict.DetailID != result.Rows[0].Detail.ID {
+			t.Fatalf("expected line 3 to duplicate line 2, got %v", result.Rows[1].Err)
+		}
+		if result.InvalidRows() != 1 || importService.saved {
+			t.Errorf("expected 1 invalid row and nothing saved, got %d invalid, saved %v", result.InvalidRows(), importService.saved)
+		}
+	})
+
+	t.Run("rows overlapping in time are rejected", func(t *testing.T) {
+		rows := []domain.LogbookImportRow{
+			row(2, "AV9340", "08:00", "08:15", "09:20", "09:30"),
+			row(3, "AV9342", "09:00", "09:15", "10:20", "10:30"),
+		}
+		result, err := i.ImportDailyLogbookDetails(context.Background(), "trace", "emp-1", rows, true)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !errors.Is(result.Rows[1].Err, domain.ErrFlightOverlappingSegment) {
+			t.Fatalf("expected line 3 to overlap line 2, got %v", result.Rows[1].Err)
+		}
+	})
+}
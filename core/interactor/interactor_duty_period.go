@@ -0,0 +1,175 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// DutyPeriodInteractor orchestrates the duty periods that group an employee's segments. Duty periods
+// belong to the employee who recorded them and may only link that employee's segments; a segment is only
+// linked or unlinked while its logbook is a draft, since the link changes its duty accounting.
+type DutyPeriodInteractor struct {
+	service input.DutyPeriodService
+}
+
+// NewDutyPeriodInteractor creates a new DutyPeriodInteractor
+func NewDutyPeriodInteractor(service input.DutyPeriodService) *DutyPeriodInteractor {
+	return &DutyPeriodInteractor{
+		service: service,
+	}
+}
+
+// ListDutyPeriods returns an employee's duty periods in chronological order
+func (i *DutyPeriodInteractor) ListDutyPeriods(ctx context.Context, traceID string, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error) {
+	log.Info(logger.LogDutyPeriodList, "trace_id", traceID, "employee_id", filter.EmployeeID)
+
+	periods, err := i.service.ListDutyPeriods(ctx, filter)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return periods, nil
+}
+
+// GetDutyPeriod returns a duty period of the employee
+func (i *DutyPeriodInteractor) GetDutyPeriod(ctx context.Context, traceID, id, employeeID string) (*domain.DutyPeriod, error) {
+	log.Info(logger.LogDutyPeriodGet, "trace_id", traceID, "id", id)
+
+	return i.getOwnDutyPeriod(ctx, traceID, id, employeeID)
+}
+
+// CreateDutyPeriod validates and saves a new duty period of the employee, linking its segments
+func (i *DutyPeriodInteractor) CreateDutyPeriod(ctx context.Context, traceID string, period domain.DutyPeriod) (*domain.DutyPeriod, error) {
+	log.Info(logger.LogDutyPeriodCreate, "trace_id", traceID, "data", period.ToLogger())
+
+	period.SetID()
+	if err := i.validate(ctx, traceID, &period, nil); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	period.CreatedAt = now
+	period.UpdatedAt = now
+	if err := i.service.CreateDutyPeriod(ctx, period); err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogDutyPeriodCreateOK, "trace_id", traceID, "id", period.ID)
+	return i.service.GetDutyPeriod(ctx, period.ID)
+}
+
+// UpdateDutyPeriod validates and stores the new values of a duty period of the employee, replacing its
+// segments
+func (i *DutyPeriodInteractor) UpdateDutyPeriod(ctx context.Context, traceID, id, employeeID string, period domain.DutyPeriod) (*domain.DutyPeriod, error) {
+	log.Info(logger.LogDutyPeriodUpdate, "trace_id", traceID, "id", id)
+
+	existing, err := i.getOwnDutyPeriod(ctx, traceID, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve protected fields
+	period.ID = existing.ID
+	period.EmployeeID = existing.EmployeeID
+	period.CreatedAt = existing.CreatedAt
+
+	if err := i.validate(ctx, traceID, &period, existing.Segments); err != nil {
+		return nil, err
+	}
+
+	period.UpdatedAt = time.Now().UTC()
+	if err := i.service.UpdateDutyPeriod(ctx, period); err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogDutyPeriodUpdateOK, "trace_id", traceID, "id", id)
+	return i.service.GetDutyPeriod(ctx, id)
+}
+
+// DeleteDutyPeriod removes a duty period of the employee; its segments are unlinked, not deleted
+func (i *DutyPeriodInteractor) DeleteDutyPeriod(ctx context.Context, traceID, id, employeeID string) error {
+	log.Info(logger.LogDutyPeriodDelete, "trace_id", traceID, "id", id)
+
+	existing, err := i.getOwnDutyPeriod(ctx, traceID, id, employeeID)
+	if err != nil {
+		return err
+	}
+	if err := checkRelinkedSegments(traceID, existing.Segments, nil); err != nil {
+		return err
+	}
+
+	if err := i.service.DeleteDutyPeriod(ctx, id); err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogDutyPeriodDeleteOK, "trace_id", traceID, "id", id)
+	return nil
+}
+
+// validate checks the report and release times, that the period does not overlap another one of the
+// employee and that every requested segment can be linked to it. linked are the segments linked so far.
+func (i *DutyPeriodInteractor) validate(ctx context.Context, traceID string, period *domain.DutyPeriod, linked []domain.DutyPeriodSegment) error {
+	if err := period.Validate(); err != nil {
+		log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	overlap, err := i.service.HasOverlappingDutyPeriod(ctx, *period)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return err
+	}
+	if overlap {
+		log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "error", domain.ErrDutyPeriodOverlap)
+		return domain.ErrDutyPeriodOverlap
+	}
+
+	segments, err := i.service.ListSegmentsByID(ctx, period.SegmentIDs)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return err
+	}
+	if len(segments) != len(period.SegmentIDs) {
+		log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "error", "unknown segment")
+		return domain.ErrDutyPeriodInvalidSegment
+	}
+	if err := period.LinkSegments(segments); err != nil {
+		log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "error", err)
+		return err
+	}
+	return checkRelinkedSegments(traceID, linked, period.Segments)
+}
+
+// checkRelinkedSegments fails with ErrDailyLogbookSigned when a segment linked or unlinked by replacing the
+// linked segments with the requested ones belongs to a logbook that is no longer a draft
+func checkRelinkedSegments(traceID string, linked, requested []domain.DutyPeriodSegment) error {
+	for _, s := range domain.RelinkedDutyPeriodSegments(linked, requested) {
+		if !s.IsLogbookEditable() {
+			log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "segment_id", s.ID, "error", domain.ErrDailyLogbookSigned)
+			return domain.ErrDailyLogbookSigned
+		}
+	}
+	return nil
+}
+
+// getOwnDutyPeriod loads a duty period, failing with ErrDutyPeriodUnauthorized when it belongs to another
+// employee
+func (i *DutyPeriodInteractor) getOwnDutyPeriod(ctx context.Context, traceID, id, employeeID string) (*domain.DutyPeriod, error) {
+	period, err := i.service.GetDutyPeriod(ctx, id)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if period.EmployeeID != employeeID {
+		log.Warn(logger.LogDutyPeriodError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrDutyPeriodUnauthorized
+	}
+	return period, nil
+}
@@ -0,0 +1,109 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+)
+
+type stubDutyPeriodService struct {
+	input.DutyPeriodService
+	periods  map[string]domain.DutyPeriod
+	segments map[string]domain.DutyPeriodSegment
+	saved    bool
+}
+
+func (s *stubDutyPeriodService) GetDutyPeriod(ctx context.Context, id string) (*domain.DutyPeriod, error) {
+	period, ok := s.periods[id]
+	if !ok {
+		return nil, domain.ErrDutyPeriodNotFound
+	}
+	return &period, nil
+}
+
+func (s *stubDutyPeriodService) HasOverlappingDutyPeriod(ctx context.Context, period domain.DutyPeriod) (bool, error) {
+	return false, nil
+}
+
+func (s *stubDutyPeriodService) ListSegmentsByID(ctx context.Context, segmentIDs []string) ([]domain.DutyPeriodSegment, error) {
+	var segments []domain.DutyPeriodSegment
+	for _, id := range segmentIDs {
+		if seg, ok := s.segments[id]; ok {
+			segments = append(segments, seg)
+		}
+	}
+	return segments, nil
+}
+
+func (s *stubDutyPeriodService) CreateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error {
+	s.saved = true
+	s.periods[period.ID] = period
+	return nil
+}
+
+func (s *stubDutyPeriodService) UpdateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error {
+	s.saved = true
+	return nil
+}
+
+func (s *stubDutyPeriodService) DeleteDutyPeriod(ctx context.Context, id string) error {
+	s.saved = true
+	return nil
+}
+
+func TestDutyPeriodInteractor_SignedLogbooks(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2026, 3, 1, hour, 0, 0, 0, time.UTC) }
+	own := "dp-1"
+	signed := domain.DutyPeriodSegment{ID: "s-signed", EmployeeID: "emp-1", LogbookState: domain.LogbookStateSigned, Out: at(7), In: at(9)}
+	linkedSigned := signed
+	linkedSigned.DutyPeriodID = &own
+	draft := domain.DutyPeriodSegment{ID: "s-draft", EmployeeID: "emp-1", LogbookState: domain.LogbookStateDraft, Out: at(10), In: at(12)}
+
+	newService := func() *stubDutyPeriodService {
+		return &stubDutyPeriodService{
+			periods: map[string]domain.DutyPeriod{
+				"dp-1": {ID: "dp-1", EmployeeID: "emp-1", ReportTime: at(6), ReleaseTime: at(14),
+					Segments: []domain.DutyPeriodSegment{linkedSigned}},
+			},
+			segments: map[string]domain.DutyPeriodSegment{"s-signed": signed, "s-draft": draft, "s-linked": linkedSigned},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("segment of a signed logbook cannot be linked", func(t *testing.T) {
+		service := newService()
+		i := NewDutyPeriodInteractor(service)
+		_, err := i.CreateDutyPeriod(ctx, "trace", domain.DutyPeriod{EmployeeID: "emp-1", ReportTime: at(6), ReleaseTime: at(14),
+			SegmentIDs: []string{"s-signed"}})
+		if !errors.Is(err, domain.ErrDailyLogbookSigned) || service.saved {
+			t.Fatalf("expected ErrDailyLogbookSigned and nothing saved, got %v", err)
+		}
+	})
+
+	t.Run("segment of a signed logbook cannot be unlinked", func(t *testing.T) {
+		service := newService()
+		i := NewDutyPeriodInteractor(service)
+		_, err := i.UpdateDutyPeriod(ctx, "trace", "dp-1", "emp-1", domain.DutyPeriod{ReportTime: at(6), ReleaseTime: at(14),
+			SegmentIDs: []string{"s-draft"}})
+		if !errors.Is(err, domain.ErrDailyLogbookSigned) || service.saved {
+			t.Fatalf("expected ErrDailyLogbookSigned and nothing saved, got %v", err)
+		}
+		if err := i.DeleteDutyPeriod(ctx, "trace", "dp-1", "emp-1"); !errors.Is(err, domain.ErrDailyLogbookSigned) || service.saved {
+			t.Fatalf("expected ErrDailyLogbookSigned on delete, got %v", err)
+		}
+	})
+
+	t.Run("signed segment already linked can stay", func(t *testing.T) {
+		service := newService()
+		i := NewDutyPeriodInteractor(service)
+		_, err := i.UpdateDutyPeriod(ctx, "trace", "dp-1", "emp-1", domain.DutyPeriod{ReportTime: at(6), ReleaseTime: at(14),
+			SegmentIDs: []string{"s-linked", "s-draft"}})
+		if err != nil || !service.saved {
+			t.Fatalf("expected the draft segment to be linked, got %v", err)
+		}
+	})
+}
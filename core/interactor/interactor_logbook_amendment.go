@@ -0,0 +1,181 @@
+package interactor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// RequestAmendment registers a correction of a signed logbook. The proposed segment goes through the same
+// validation as a direct create/update; nothing changes until the amendment is signed.
+// Returns the non-blocking warnings raised while validating the proposed segment.
+func (i *DailyLogbookDetailInteractor) RequestAmendment(ctx context.Context, traceID string, amendment domain.LogbookAmendment) (*domain.LogbookAmendment, []domain.ValidationWarning, error) {
+	log.Info(logger.LogAmendmentCreate, "trace_id", traceID, "logbook_id", amendment.DailyLogbookID, "action", amendment.Action)
+
+	amendment.Reason = strings.TrimSpace(amendment.Reason)
+	if amendment.Reason == "" || !domain.IsValidAmendmentAction(string(amendment.Action)) {
+		return nil, nil, domain.ErrAmendmentInvalid
+	}
+
+	logbook, err := i.getLogbook(ctx, amendment.DailyLogbookID)
+	if err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+	if !logbook.IsAmendable() {
+		log.Warn(logger.LogAmendmentError, "trace_id", traceID, "logbook_id", logbook.ID, "state", logbook.CurrentState())
+		return nil, nil, domain.ErrAmendmentNotAllowed
+	}
+
+	// The amended segment must belong to the logbook
+	var existing *domain.DailyLogbookDetail
+	if amendment.Action != domain.AmendmentActionCreate {
+		existing, err = i.service.GetDailyLogbookDetailByID(ctx, amendment.DailyLogbookDetailID)
+		if err != nil {
+			log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+			return nil, nil, err
+		}
+		if existing == nil || existing.DailyLogbookID != logbook.ID {
+			return nil, nil, domain.ErrFlightNotFound
+		}
+	}
+
+	var warnings []domain.ValidationWarning
+	if amendment.Action == domain.AmendmentActionDelete {
+		amendment.Proposed = nil
+	} else {
+		if amendment.Proposed == nil {
+			return nil, nil, domain.ErrAmendmentInvalid
+		}
+		proposed := *amendment.Proposed
+		proposed.DailyLogbookID = logbook.ID
+		if existing != nil {
+			proposed.ID = existing.ID
+		} else {
+			proposed.SetID()
+		}
+		amendment.OverrideConflicts = proposed.OverrideConflicts
+
+		warnings, err = i.prepareSegment(ctx, traceID, *logbook, &proposed)
+		if err != nil {
+			log.Warn(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+			return nil, nil, err
+		}
+		// Times are stored normalized to UTC
+		proposed.TimeReference = domain.TimeReferenceUTC
+		amendment.Proposed = &proposed
+		amendment.DailyLogbookDetailID = proposed.ID
+	}
+
+	amendment.SetID()
+	amendment.Changes = domain.DiffDailyLogbookDetail(existing, amendment.Proposed)
+	amendment.Status = domain.AmendmentStatusPending
+	amendment.CreatedAt = time.Now().UTC()
+
+	if err := i.amendmentService.RequestAmendment(ctx, amendment); err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+
+	log.Info(logger.LogAmendmentCreateOK, "trace_id", traceID, "amendment_id", amendment.ID, "changes", len(amendment.Changes))
+	return &amendment, warnings, nil
+}
+
+// SignAmendment signs a pending amendment: the proposed segment is validated again against the current
+// data, applied, and the logbook is re-signed by the employee. The hash chain is resealed from the
+// amended segment on, provided the current chain still verifies.
+func (i *DailyLogbookDetailInteractor) SignAmendment(ctx context.Context, traceID, logbookID, amendmentID, employeeID string) (*domain.LogbookAmendment, error) {
+	log.Info(logger.LogAmendmentApply, "trace_id", traceID, "amendment_id", amendmentID)
+
+	amendment, logbook, err := i.getPendingAmendment(ctx, logbookID, amendmentID)
+	if err != nil {
+		log.Warn(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if !logbook.IsAmendable() {
+		return nil, domain.ErrAmendmentNotAllowed
+	}
+
+	if amendment.Proposed != nil {
+		proposed := *amendment.Proposed
+		proposed.OverrideConflicts = amendment.OverrideConflicts
+		if _, err := i.prepareSegment(ctx, traceID, *logbook, &proposed); err != nil {
+			log.Warn(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+		amendment.Proposed = &proposed
+	}
+
+	chain, err := i.chainService.ChainAfterAmendment(ctx, logbook.EmployeeID, amendment.DailyLogbookDetailID, amendment.Proposed)
+	if err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	amendment.Resolve(domain.AmendmentStatusApplied, employeeID, now)
+	logbook.Sign(employeeID, now)
+
+	if err := i.amendmentService.ApplyAmendment(ctx, *amendment, *logbook, chain); err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogAmendmentApplyOK, "trace_id", traceID, "amendment_id", amendment.ID, "logbook_id", logbook.ID)
+	return amendment, nil
+}
+
+// RejectAmendment discards a pending amendment without changing the logbook
+func (i *DailyLogbookDetailInteractor) RejectAmendment(ctx context.Context, traceID, logbookID, amendmentID, employeeID string) (*domain.LogbookAmendment, error) {
+	log.Info(logger.LogAmendmentReject, "trace_id", traceID, "amendment_id", amendmentID)
+
+	amendment, _, err := i.getPendingAmendment(ctx, logbookID, amendmentID)
+	if err != nil {
+		log.Warn(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	amendment.Resolve(domain.AmendmentStatusRejected, employeeID, time.Now().UTC())
+	if err := i.amendmentService.RejectAmendment(ctx, *amendment); err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogAmendmentRejectOK, "trace_id", traceID, "amendment_id", amendment.ID)
+	return amendment, nil
+}
+
+// ListAmendments returns the amendments of a daily logbook, newest first
+func (i *DailyLogbookDetailInteractor) ListAmendments(ctx context.Context, traceID, logbookID string) ([]domain.LogbookAmendment, error) {
+	log.Info(logger.LogAmendmentList, "trace_id", traceID, "logbook_id", logbookID)
+
+	amendments, err := i.amendmentService.ListAmendments(ctx, logbookID)
+	if err != nil {
+		log.Error(logger.LogAmendmentError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return amendments, nil
+}
+
+// getPendingAmendment returns a pending amendment of the logbook together with the logbook
+func (i *DailyLogbookDetailInteractor) getPendingAmendment(ctx context.Context, logbookID, amendmentID string) (*domain.LogbookAmendment, *domain.DailyLogbook, error) {
+	amendment, err := i.amendmentService.GetAmendment(ctx, amendmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if amendment.DailyLogbookID != logbookID {
+		return nil, nil, domain.ErrAmendmentNotFound
+	}
+	if !amendment.IsPending() {
+		return nil, nil, domain.ErrAmendmentNotPending
+	}
+
+	logbook, err := i.getLogbook(ctx, logbookID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return amendment, logbook, nil
+}
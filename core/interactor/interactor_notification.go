@@ -0,0 +1,64 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// NotificationInteractor orchestrates the in-app notifications of employees. Notifications are raised
+// by background jobs and can only be read by their recipient.
+type NotificationInteractor struct {
+	service input.NotificationService
+}
+
+// NewNotificationInteractor creates a new NotificationInteractor
+func NewNotificationInteractor(service input.NotificationService) *NotificationInteractor {
+	return &NotificationInteractor{
+		service: service,
+	}
+}
+
+// ListNotifications returns an employee's notifications, latest first
+func (i *NotificationInteractor) ListNotifications(ctx context.Context, traceID string, filter domain.NotificationFilter) ([]domain.Notification, error) {
+	log.Info(logger.LogNotificationList, "trace_id", traceID, "employee_id", filter.EmployeeID, "unread_only", filter.UnreadOnly)
+
+	notifications, err := i.service.ListNotifications(ctx, filter)
+	if err != nil {
+		log.Error(logger.LogNotificationError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// MarkNotificationRead marks a notification of the employee as read; a notification already read keeps
+// the time it was first read
+func (i *NotificationInteractor) MarkNotificationRead(ctx context.Context, traceID, id, employeeID string) (*domain.Notification, error) {
+	log.Info(logger.LogNotificationRead, "trace_id", traceID, "id", id)
+
+	notification, err := i.service.GetNotification(ctx, id)
+	if err != nil {
+		log.Error(logger.LogNotificationError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if notification.EmployeeID != employeeID {
+		log.Warn(logger.LogNotificationError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrNotificationUnauthorized
+	}
+	if notification.ReadAt != nil {
+		return notification, nil
+	}
+
+	readAt := time.Now().UTC()
+	if err := i.service.MarkNotificationRead(ctx, id, readAt); err != nil {
+		log.Error(logger.LogNotificationError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	notification.ReadAt = &readAt
+	log.Info(logger.LogNotificationReadOK, "trace_id", traceID, "id", id)
+	return notification, nil
+}
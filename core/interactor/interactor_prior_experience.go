@@ -0,0 +1,126 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// PriorExperienceInteractor orchestrates the opening balances employees bring from before their logged
+// segments. Balances belong to the employee who declared them.
+type PriorExperienceInteractor struct {
+	service input.PriorExperienceService
+}
+
+// NewPriorExperienceInteractor creates a new PriorExperienceInteractor
+func NewPriorExperienceInteractor(service input.PriorExperienceService) *PriorExperienceInteractor {
+	return &PriorExperienceInteractor{
+		service: service,
+	}
+}
+
+// ListPriorExperience returns an employee's opening balances, oldest first
+func (i *PriorExperienceInteractor) ListPriorExperience(ctx context.Context, traceID, employeeID string) ([]domain.PriorExperience, error) {
+	log.Info(logger.LogPriorExperienceList, "trace_id", traceID, "employee_id", employeeID)
+
+	entries, err := i.service.ListPriorExperience(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetPriorExperience returns an opening balance of the employee
+func (i *PriorExperienceInteractor) GetPriorExperience(ctx context.Context, traceID, id, employeeID string) (*domain.PriorExperience, error) {
+	log.Info(logger.LogPriorExperienceGet, "trace_id", traceID, "id", id)
+
+	return i.getOwnPriorExperience(ctx, traceID, id, employeeID)
+}
+
+// CreatePriorExperience validates and saves a new opening balance of the employee
+func (i *PriorExperienceInteractor) CreatePriorExperience(ctx context.Context, traceID string, prior domain.PriorExperience) (*domain.PriorExperience, error) {
+	log.Info(logger.LogPriorExperienceCreate, "trace_id", traceID, "data", prior.ToLogger())
+
+	now := time.Now().UTC()
+	if err := prior.Validate(now); err != nil {
+		log.Warn(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	prior.SetID()
+	prior.CreatedAt = now
+	prior.UpdatedAt = now
+	if err := i.service.CreatePriorExperience(ctx, prior); err != nil {
+		log.Error(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogPriorExperienceCreateOK, "trace_id", traceID, "id", prior.ID)
+	return &prior, nil
+}
+
+// UpdatePriorExperience validates and stores the new values of an opening balance of the employee
+func (i *PriorExperienceInteractor) UpdatePriorExperience(ctx context.Context, traceID, id, employeeID string, prior domain.PriorExperience) (*domain.PriorExperience, error) {
+	log.Info(logger.LogPriorExperienceUpdate, "trace_id", traceID, "id", id)
+
+	existing, err := i.getOwnPriorExperience(ctx, traceID, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve protected fields
+	prior.ID = existing.ID
+	prior.EmployeeID = existing.EmployeeID
+	prior.CreatedAt = existing.CreatedAt
+
+	now := time.Now().UTC()
+	if err := prior.Validate(now); err != nil {
+		log.Warn(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	prior.UpdatedAt = now
+	if err := i.service.UpdatePriorExperience(ctx, prior); err != nil {
+		log.Error(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogPriorExperienceUpdateOK, "trace_id", traceID, "id", id)
+	return &prior, nil
+}
+
+// DeletePriorExperience removes an opening balance of the employee
+func (i *PriorExperienceInteractor) DeletePriorExperience(ctx context.Context, traceID, id, employeeID string) error {
+	log.Info(logger.LogPriorExperienceDelete, "trace_id", traceID, "id", id)
+
+	if _, err := i.getOwnPriorExperience(ctx, traceID, id, employeeID); err != nil {
+		return err
+	}
+
+	if err := i.service.DeletePriorExperience(ctx, id); err != nil {
+		log.Error(logger.LogPriorExperienceError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogPriorExperienceDeleteOK, "trace_id", traceID, "id", id)
+	return nil
+}
+
+// getOwnPriorExperience loads an opening balance, failing with ErrPriorExperienceUnauthorized when it
+// belongs to another employee
+func (i *PriorExperienceInteractor) getOwnPriorExperience(ctx context.Context, traceID, id, employeeID string) (*domain.PriorExperience, error) {
+	prior, err := i.service.GetPriorExperience(ctx, id)
+	if err != nil {
+		log.Error(logger.LogPriorExperienceError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if prior.EmployeeID != employeeID {
+		log.Warn(logger.LogPriorExperienceError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrPriorExperienceUnauthorized
+	}
+	return prior, nil
+}
@@ -0,0 +1,381 @@
+package interactor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListSegmentMirrors returns the links the employee takes part in, optionally filtered by status.
+// Pending offers to the employee carry the pre-filled entry and conflicts the differing fields.
+func (i *DailyLogbookDetailInteractor) ListSegmentMirrors(ctx context.Context, traceID, employeeID, status string) ([]domain.SegmentMirror, error) {
+	log.Info(logger.LogSegmentMirrorList, "trace_id", traceID, "employee_id", employeeID, "status", status)
+
+	mirrors, err := i.mirrorService.ListSegmentMirrors(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	result := make([]domain.SegmentMirror, 0, len(mirrors))
+	for _, m := range mirrors {
+		if status != "" && string(m.Status) != status {
+			continue
+		}
+		if err := i.describeSegmentMirror(ctx, &m, employeeID); err != nil {
+			log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", m.ID, "error", err)
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// GetSegmentMirror returns a link the employee takes part in
+func (i *DailyLogbookDetailInteractor) GetSegmentMirror(ctx context.Context, traceID, id, employeeID string) (*domain.SegmentMirror, error) {
+	mirror, err := i.getInvolvedSegmentMirror(ctx, id, employeeID)
+	if err != nil {
+		log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", id, "error", err)
+		return nil, err
+	}
+	if err := i.describeSegmentMirror(ctx, mirror, employeeID); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", id, "error", err)
+		return nil, err
+	}
+	return mirror, nil
+}
+
+// AcceptSegmentMirror records the pre-filled entry in the companion's logbook of the day, creating the
+// logbook if needed, and links it to the pilot's segment. The entry goes through the same validation as
+// a direct create; pilotRole overrides the complementary role when set.
+// Returns the non-blocking warnings raised while validating the entry.
+func (i *DailyLogbookDetailInteractor) AcceptSegmentMirror(ctx context.Context, traceID, id, employeeID, pilotRole string) (*domain.SegmentMirror, []domain.ValidationWarning, error) {
+	log.Info(logger.LogSegmentMirrorAccept, "trace_id", traceID, "mirror_id", id)
+
+	mirror, err := i.mirrorService.GetSegmentMirror(ctx, id)
+	if err != nil {
+		log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+	if mirror.CompanionEmployeeID != employeeID {
+		return nil, nil, domain.ErrSegmentMirrorNotFound
+	}
+	if mirror.Status != domain.SegmentMirrorStatusPending {
+		return nil, nil, domain.ErrSegmentMirrorNotPending
+	}
+	if pilotRole != "" && !domain.IsValidPilotRole(pilotRole) {
+		return nil, nil, domain.ErrSegmentMirrorInvalid
+	}
+
+	source, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.SourceDetailID)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+	if source == nil {
+		// The pilot deleted the segment in the meantime
+		i.closeSegmentMirror(ctx, traceID, *mirror)
+		return nil, nil, domain.ErrSegmentMirrorNotPending
+	}
+	sourceLogbook, err := i.getLogbook(ctx, source.DailyLogbookID)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+
+	logbook, err := i.mirrorService.FindDailyLogbookByDate(ctx, employeeID, sourceLogbook.LogDate)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+	var newLogbook *domain.DailyLogbook
+	if logbook == nil {
+		newLogbook = &domain.DailyLogbook{LogDate: sourceLogbook.LogDate, EmployeeID: employeeID, Status: true}
+		newLogbook.SetID()
+		logbook = newLogbook
+	} else if !logbook.IsEditable() {
+		log.Warn(logger.LogDailyLogbookSignedChange, "trace_id", traceID, "logbook_id", logbook.ID, "state", logbook.CurrentState())
+		return nil, nil, domain.ErrDailyLogbookSigned
+	}
+
+	detail := domain.NewMirroredDetail(*source, logbook.ID, mirror.SourceEmployeeID, employeeID)
+	if pilotRole != "" {
+		detail.PilotRole = domain.PilotRole(pilotRole)
+	}
+	warnings, err := i.prepareSegment(ctx, traceID, *logbook, &detail)
+	if err != nil {
+		log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+
+	mirror.MirrorDetailID = &detail.ID
+	mirror.Status = domain.SegmentMirrorStatusLinked
+	mirror.UpdatedAt = time.Now().UTC()
+	if err := i.mirrorService.AcceptSegmentMirror(ctx, *mirror, newLogbook, detail); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, nil, err
+	}
+
+	log.Info(logger.LogSegmentMirrorAcceptOK, "trace_id", traceID, "mirror_id", mirror.ID, "detail_id", detail.ID)
+	return mirror, warnings, nil
+}
+
+// DeclineSegmentMirror turns down a pending offer; the pilot's segment is not changed
+func (i *DailyLogbookDetailInteractor) DeclineSegmentMirror(ctx context.Context, traceID, id, employeeID string) (*domain.SegmentMirror, error) {
+	log.Info(logger.LogSegmentMirrorDecline, "trace_id", traceID, "mirror_id", id)
+
+	mirror, err := i.mirrorService.GetSegmentMirror(ctx, id)
+	if err != nil {
+		log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if mirror.CompanionEmployeeID != employeeID {
+		return nil, domain.ErrSegmentMirrorNotFound
+	}
+	if mirror.Status != domain.SegmentMirrorStatusPending {
+		return nil, domain.ErrSegmentMirrorNotPending
+	}
+
+	mirror.Status = domain.SegmentMirrorStatusDeclined
+	mirror.UpdatedAt = time.Now().UTC()
+	if err := i.mirrorService.UpdateSegmentMirror(ctx, *mirror); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogSegmentMirrorDeclineOK, "trace_id", traceID, "mirror_id", mirror.ID)
+	return mirror, nil
+}
+
+// ResolveSegmentMirror settles a link in conflict. With ADOPT the employee's own entry takes the
+// companion's flight data, times included, and the link is back in sync; with UNLINK both entries are
+// kept as they are and no longer synced. Each pilot only ever changes their own entry.
+func (i *DailyLogbookDetailInteractor) ResolveSegmentMirror(ctx context.Context, traceID, id, employeeID, resolution string) (*domain.SegmentMirror, error) {
+	log.Info(logger.LogSegmentMirrorResolve, "trace_id", traceID, "mirror_id", id, "resolution", resolution)
+
+	if !domain.IsValidSegmentMirrorResolution(resolution) {
+		return nil, domain.ErrSegmentMirrorInvalid
+	}
+	mirror, err := i.getInvolvedSegmentMirror(ctx, id, employeeID)
+	if err != nil {
+		log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	if !mirror.IsActive() {
+		return nil, domain.ErrSegmentMirrorNotLinked
+	}
+
+	var adopted *domain.DailyLogbookDetail
+	if domain.SegmentMirrorResolution(resolution) == domain.SegmentMirrorResolutionAdopt {
+		if mirror.Status != domain.SegmentMirrorStatusConflict {
+			return nil, domain.ErrSegmentMirrorNotInConflict
+		}
+		ownID := mirror.OwnDetailID(employeeID)
+		own, err := i.service.GetDailyLogbookDetailByID(ctx, ownID)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+		partner, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.PartnerDetailID(ownID))
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+		if own == nil || partner == nil {
+			i.closeSegmentMirror(ctx, traceID, *mirror)
+			return nil, domain.ErrSegmentMirrorNotLinked
+		}
+
+		logbook, err := i.getEditableLogbook(ctx, traceID, own.DailyLogbookID)
+		if err != nil {
+			return nil, err
+		}
+		candidate := *own
+		domain.CopySharedSegmentFields(&candidate, *partner, true)
+		candidate.TimeReference = domain.TimeReferenceUTC
+		candidate.OverrideConflicts = false
+		if _, err := i.prepareSegment(ctx, traceID, *logbook, &candidate); err != nil {
+			log.Warn(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+			return nil, err
+		}
+		adopted = &candidate
+		mirror.Status = domain.SegmentMirrorStatusLinked
+	} else {
+		mirror.Status = domain.SegmentMirrorStatusUnlinked
+	}
+
+	mirror.UpdatedAt = time.Now().UTC()
+	if err := i.mirrorService.SyncSegmentMirror(ctx, *mirror, adopted); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogSegmentMirrorResolveOK, "trace_id", traceID, "mirror_id", mirror.ID, "status", mirror.Status)
+	return mirror, nil
+}
+
+// offerSegmentMirror offers the companion named on a saved segment a mirrored entry. The segment is
+// already saved, so a failure is only logged.
+func (i *DailyLogbookDetailInteractor) offerSegmentMirror(ctx context.Context, traceID string, detail domain.DailyLogbookDetail, employeeID string) {
+	if detail.CompanionEmployeeID == nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	mirror := domain.SegmentMirror{
+		SourceDetailID:      detail.ID,
+		SourceEmployeeID:    employeeID,
+		CompanionEmployeeID: *detail.CompanionEmployeeID,
+		Status:              domain.SegmentMirrorStatusPending,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	mirror.SetID()
+
+	log.Info(logger.LogSegmentMirrorOffer, "trace_id", traceID, "detail_id", detail.ID, "mirror_id", mirror.ID)
+	if err := i.mirrorService.OfferSegmentMirror(ctx, mirror); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "detail_id", detail.ID, "error", err)
+	}
+}
+
+// syncSegmentMirror keeps the link of an edited segment up to date: a new companion replaces the previous
+// link with a new offer, and the edits of a linked segment are reconciled with the companion's entry.
+// Returns a warning when the two entries are left in conflict.
+func (i *DailyLogbookDetailInteractor) syncSegmentMirror(ctx context.Context, traceID string, before, after domain.DailyLogbookDetail, employeeID string) []domain.ValidationWarning {
+	mirror, err := i.mirrorService.GetOpenSegmentMirror(ctx, after.ID)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "detail_id", after.ID, "error", err)
+		return nil
+	}
+
+	if companionOf(before) != companionOf(after) {
+		if mirror != nil {
+			i.closeSegmentMirror(ctx, traceID, *mirror)
+		}
+		i.offerSegmentMirror(ctx, traceID, after, employeeID)
+		return nil
+	}
+	if mirror == nil || !mirror.IsActive() {
+		return nil
+	}
+
+	log.Info(logger.LogSegmentMirrorSync, "trace_id", traceID, "mirror_id", mirror.ID, "detail_id", after.ID)
+	partner, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.PartnerDetailID(after.ID))
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", mirror.ID, "error", err)
+		return nil
+	}
+	if partner == nil {
+		i.closeSegmentMirror(ctx, traceID, *mirror)
+		return nil
+	}
+
+	// Carry the shared flight data, not the times, to the companion's entry while it can still be edited
+	var changed *domain.DailyLogbookDetail
+	candidate := *partner
+	domain.CopySharedSegmentFields(&candidate, after, false)
+	if len(domain.DiffSharedSegmentFields(candidate, *partner)) > 0 {
+		logbook, err := i.getLogbook(ctx, partner.DailyLogbookID)
+		if err == nil && logbook.IsEditable() {
+			candidate.TimeReference = domain.TimeReferenceUTC
+			candidate.OverrideConflicts = false
+			if _, err = i.prepareSegment(ctx, traceID, *logbook, &candidate); err == nil {
+				changed = &candidate
+			}
+		}
+		if err != nil {
+			log.Warn(logger.LogSegmentMirrorConflict, "trace_id", traceID, "mirror_id", mirror.ID, "error", err)
+		}
+	}
+
+	current := *partner
+	if changed != nil {
+		current = *changed
+	}
+	differences := mirror.Diff(after, current)
+	status := domain.SegmentMirrorStatusLinked
+	if len(differences) > 0 {
+		status = domain.SegmentMirrorStatusConflict
+	}
+
+	if status != mirror.Status || changed != nil {
+		mirror.Status = status
+		mirror.UpdatedAt = time.Now().UTC()
+		if err := i.mirrorService.SyncSegmentMirror(ctx, *mirror, changed); err != nil {
+			log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", mirror.ID, "error", err)
+			return nil
+		}
+	}
+
+	if status != domain.SegmentMirrorStatusConflict {
+		return nil
+	}
+	fields := make([]string, 0, len(differences))
+	for _, d := range differences {
+		fields = append(fields, d.Field)
+	}
+	log.Warn(logger.LogSegmentMirrorConflict, "trace_id", traceID, "mirror_id", mirror.ID, "fields", fields)
+	return []domain.ValidationWarning{{
+		Code:     domain.MsgSegmentMirrorConflict,
+		Params:   []string{strings.Join(fields, ", ")},
+		DetailID: partner.ID,
+	}}
+}
+
+// closeSegmentMirror withdraws a pending offer or unlinks an active link of a segment that was deleted or
+// got a different companion
+func (i *DailyLogbookDetailInteractor) closeSegmentMirror(ctx context.Context, traceID string, mirror domain.SegmentMirror) {
+	mirror.Close(time.Now().UTC())
+	log.Info(logger.LogSegmentMirrorClose, "trace_id", traceID, "mirror_id", mirror.ID, "status", mirror.Status)
+	if err := i.mirrorService.UpdateSegmentMirror(ctx, mirror); err != nil {
+		log.Error(logger.LogSegmentMirrorError, "trace_id", traceID, "mirror_id", mirror.ID, "error", err)
+	}
+}
+
+// getInvolvedSegmentMirror returns a link the employee takes part in; other links are not found
+func (i *DailyLogbookDetailInteractor) getInvolvedSegmentMirror(ctx context.Context, id, employeeID string) (*domain.SegmentMirror, error) {
+	mirror, err := i.mirrorService.GetSegmentMirror(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !mirror.Involves(employeeID) {
+		return nil, domain.ErrSegmentMirrorNotFound
+	}
+	return mirror, nil
+}
+
+// describeSegmentMirror fills the computed fields of a link: the pre-filled entry of a pending offer to
+// the employee, and the differing fields of a link in conflict
+func (i *DailyLogbookDetailInteractor) describeSegmentMirror(ctx context.Context, mirror *domain.SegmentMirror, employeeID string) error {
+	switch {
+	case mirror.Status == domain.SegmentMirrorStatusPending && mirror.CompanionEmployeeID == employeeID:
+		source, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.SourceDetailID)
+		if err != nil || source == nil {
+			return err
+		}
+		proposed := domain.NewMirroredDetail(*source, "", mirror.SourceEmployeeID, employeeID)
+		mirror.Proposed = &proposed
+	case mirror.Status == domain.SegmentMirrorStatusConflict:
+		source, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.SourceDetailID)
+		if err != nil || source == nil {
+			return err
+		}
+		mirrored, err := i.service.GetDailyLogbookDetailByID(ctx, mirror.PartnerDetailID(mirror.SourceDetailID))
+		if err != nil || mirrored == nil {
+			return err
+		}
+		mirror.Differences = domain.DiffSharedSegmentFields(*source, *mirrored)
+	}
+	return nil
+}
+
+// companionOf returns the companion employee ID of a segment ("" when there is none)
+func companionOf(detail domain.DailyLogbookDetail) string {
+	if detail.CompanionEmployeeID == nil {
+		return ""
+	}
+	return *detail.CompanionEmployeeID
+}
@@ -0,0 +1,124 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// SimulatorSessionInteractor orchestrates the sessions an employee flies in flight simulation training
+// devices. Sessions belong to the employee who logged them.
+type SimulatorSessionInteractor struct {
+	service input.SimulatorSessionService
+}
+
+// NewSimulatorSessionInteractor creates a new SimulatorSessionInteractor
+func NewSimulatorSessionInteractor(service input.SimulatorSessionService) *SimulatorSessionInteractor {
+	return &SimulatorSessionInteractor{
+		service: service,
+	}
+}
+
+// ListSimulatorSessions returns an employee's sessions for the filter, latest first
+func (i *SimulatorSessionInteractor) ListSimulatorSessions(ctx context.Context, traceID string, filter domain.SimulatorSessionFilter) ([]domain.SimulatorSession, error) {
+	log.Info(logger.LogSimulatorSessionList, "trace_id", traceID, "employee_id", filter.EmployeeID)
+
+	sessions, err := i.service.ListSimulatorSessions(ctx, filter)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// GetSimulatorSession returns a session of the employee
+func (i *SimulatorSessionInteractor) GetSimulatorSession(ctx context.Context, traceID, id, employeeID string) (*domain.SimulatorSession, error) {
+	log.Info(logger.LogSimulatorSessionGet, "trace_id", traceID, "id", id)
+
+	return i.getOwnSimulatorSession(ctx, traceID, id, employeeID)
+}
+
+// CreateSimulatorSession validates and saves a new session of the employee
+func (i *SimulatorSessionInteractor) CreateSimulatorSession(ctx context.Context, traceID string, session domain.SimulatorSession) (*domain.SimulatorSession, error) {
+	log.Info(logger.LogSimulatorSessionCreate, "trace_id", traceID, "employee_id", session.EmployeeID)
+
+	if err := i.service.ValidateSimulatorSession(ctx, &session); err != nil {
+		log.Warn(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	session.SetID()
+	session.CreatedAt = time.Now().UTC()
+	session.UpdatedAt = session.CreatedAt
+	if err := i.service.CreateSimulatorSession(ctx, session); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogSimulatorSessionCreateOK, "trace_id", traceID, "id", session.ID)
+	return &session, nil
+}
+
+// UpdateSimulatorSession validates and stores the new values of a session of the employee
+func (i *SimulatorSessionInteractor) UpdateSimulatorSession(ctx context.Context, traceID, id, employeeID string, session domain.SimulatorSession) (*domain.SimulatorSession, error) {
+	log.Info(logger.LogSimulatorSessionUpdate, "trace_id", traceID, "id", id)
+
+	existing, err := i.getOwnSimulatorSession(ctx, traceID, id, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve protected fields
+	session.ID = existing.ID
+	session.EmployeeID = existing.EmployeeID
+	session.CreatedAt = existing.CreatedAt
+
+	if err := i.service.ValidateSimulatorSession(ctx, &session); err != nil {
+		log.Warn(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	session.UpdatedAt = time.Now().UTC()
+	if err := i.service.UpdateSimulatorSession(ctx, session); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogSimulatorSessionUpdateOK, "trace_id", traceID, "id", id)
+	return &session, nil
+}
+
+// DeleteSimulatorSession removes a session of the employee
+func (i *SimulatorSessionInteractor) DeleteSimulatorSession(ctx context.Context, traceID, id, employeeID string) error {
+	log.Info(logger.LogSimulatorSessionDelete, "trace_id", traceID, "id", id)
+
+	if _, err := i.getOwnSimulatorSession(ctx, traceID, id, employeeID); err != nil {
+		return err
+	}
+
+	if err := i.service.DeleteSimulatorSession(ctx, id); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogSimulatorSessionDeleteOK, "trace_id", traceID, "id", id)
+	return nil
+}
+
+// getOwnSimulatorSession loads a session, failing with ErrSimulatorSessionUnauthorized when it belongs
+// to another employee
+func (i *SimulatorSessionInteractor) getOwnSimulatorSession(ctx context.Context, traceID, id, employeeID string) (*domain.SimulatorSession, error) {
+	session, err := i.service.GetSimulatorSession(ctx, id)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if session.EmployeeID != employeeID {
+		log.Warn(logger.LogSimulatorSessionError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrSimulatorSessionUnauthorized
+	}
+	return session, nil
+}
@@ -0,0 +1,169 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// TypeRatingInteractor orchestrates the type ratings that qualify employees to log segments as pilot
+// flying. Employees can only read their own ratings; they are recorded by the crew managers of the
+// employee's airline, so a pilot cannot qualify themselves.
+type TypeRatingInteractor struct {
+	service   input.TypeRatingService
+	employees input.Service
+}
+
+// NewTypeRatingInteractor creates a new TypeRatingInteractor
+func NewTypeRatingInteractor(service input.TypeRatingService, employees input.Service) *TypeRatingInteractor {
+	return &TypeRatingInteractor{
+		service:   service,
+		employees: employees,
+	}
+}
+
+// ListTypeRatings returns an employee's type ratings ordered by family and issue date
+func (i *TypeRatingInteractor) ListTypeRatings(ctx context.Context, traceID, employeeID string) ([]domain.TypeRating, error) {
+	log.Info(logger.LogTypeRatingList, "trace_id", traceID, "employee_id", employeeID)
+
+	ratings, err := i.service.ListTypeRatings(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// GetTypeRating returns a type rating of the employee
+func (i *TypeRatingInteractor) GetTypeRating(ctx context.Context, traceID, id, employeeID string) (*domain.TypeRating, error) {
+	log.Info(logger.LogTypeRatingGet, "trace_id", traceID, "id", id)
+
+	return i.getOwnTypeRating(ctx, traceID, id, employeeID)
+}
+
+// ListEmployeeTypeRatings returns the type ratings of an employee of the manager's airline
+func (i *TypeRatingInteractor) ListEmployeeTypeRatings(ctx context.Context, traceID, employeeID, managerAirlineID string) ([]domain.TypeRating, error) {
+	if err := i.checkAirlineEmployee(ctx, traceID, employeeID, managerAirlineID); err != nil {
+		return nil, err
+	}
+	return i.ListTypeRatings(ctx, traceID, employeeID)
+}
+
+// CreateTypeRating validates and saves a new type rating of an employee of the manager's airline
+func (i *TypeRatingInteractor) CreateTypeRating(ctx context.Context, traceID string, rating domain.TypeRating, managerAirlineID string) (*domain.TypeRating, error) {
+	log.Info(logger.LogTypeRatingCreate, "trace_id", traceID, "data", rating.ToLogger())
+
+	if err := i.checkAirlineEmployee(ctx, traceID, rating.EmployeeID, managerAirlineID); err != nil {
+		return nil, err
+	}
+	if err := rating.Validate(); err != nil {
+		log.Warn(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rating.SetID()
+	rating.CreatedAt = now
+	rating.UpdatedAt = now
+	if err := i.service.CreateTypeRating(ctx, rating); err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogTypeRatingCreateOK, "trace_id", traceID, "id", rating.ID)
+	return &rating, nil
+}
+
+// UpdateTypeRating validates and stores the new values of a type rating of an employee of the manager's
+// airline
+func (i *TypeRatingInteractor) UpdateTypeRating(ctx context.Context, traceID, id, managerAirlineID string, rating domain.TypeRating) (*domain.TypeRating, error) {
+	log.Info(logger.LogTypeRatingUpdate, "trace_id", traceID, "id", id)
+
+	existing, err := i.getAirlineTypeRating(ctx, traceID, id, managerAirlineID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve protected fields
+	rating.ID = existing.ID
+	rating.EmployeeID = existing.EmployeeID
+	rating.CreatedAt = existing.CreatedAt
+
+	if err := rating.Validate(); err != nil {
+		log.Warn(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	rating.UpdatedAt = time.Now().UTC()
+	if err := i.service.UpdateTypeRating(ctx, rating); err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogTypeRatingUpdateOK, "trace_id", traceID, "id", id)
+	return &rating, nil
+}
+
+// DeleteTypeRating removes a type rating of an employee of the manager's airline
+func (i *TypeRatingInteractor) DeleteTypeRating(ctx context.Context, traceID, id, managerAirlineID string) error {
+	log.Info(logger.LogTypeRatingDelete, "trace_id", traceID, "id", id)
+
+	if _, err := i.getAirlineTypeRating(ctx, traceID, id, managerAirlineID); err != nil {
+		return err
+	}
+
+	if err := i.service.DeleteTypeRating(ctx, id); err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogTypeRatingDeleteOK, "trace_id", traceID, "id", id)
+	return nil
+}
+
+// getOwnTypeRating loads a type rating, failing with ErrTypeRatingUnauthorized when it belongs to another
+// employee
+func (i *TypeRatingInteractor) getOwnTypeRating(ctx context.Context, traceID, id, employeeID string) (*domain.TypeRating, error) {
+	rating, err := i.service.GetTypeRating(ctx, id)
+	if err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if rating.EmployeeID != employeeID {
+		log.Warn(logger.LogTypeRatingError, "trace_id", traceID, "id", id, "error", "unauthorized")
+		return nil, domain.ErrTypeRatingUnauthorized
+	}
+	return rating, nil
+}
+
+// getAirlineTypeRating loads a type rating, failing with ErrTypeRatingUnauthorized when its holder is not
+// an employee of the manager's airline
+func (i *TypeRatingInteractor) getAirlineTypeRating(ctx context.Context, traceID, id, managerAirlineID string) (*domain.TypeRating, error) {
+	rating, err := i.service.GetTypeRating(ctx, id)
+	if err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "id", id, "error", err)
+		return nil, err
+	}
+	if err := i.checkAirlineEmployee(ctx, traceID, rating.EmployeeID, managerAirlineID); err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// checkAirlineEmployee fails with ErrTypeRatingUnauthorized when the employee does not belong to the
+// manager's airline
+func (i *TypeRatingInteractor) checkAirlineEmployee(ctx context.Context, traceID, employeeID, managerAirlineID string) error {
+	employee, err := i.employees.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogTypeRatingError, "trace_id", traceID, "employee_id", employeeID, "error", err)
+		return err
+	}
+	if managerAirlineID == "" || employee.Airline != managerAirlineID {
+		log.Warn(logger.LogTypeRatingError, "trace_id", traceID, "employee_id", employeeID, "error", "unauthorized")
+		return domain.ErrTypeRatingUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/input"
+)
+
+type stubTypeRatingStore struct {
+	input.TypeRatingService
+	ratings map[string]domain.TypeRating
+}
+
+func (s *stubTypeRatingStore) GetTypeRating(ctx context.Context, id string) (*domain.TypeRating, error) {
+	rating, ok := s.ratings[id]
+	if !ok {
+		return nil, domain.ErrTypeRatingNotFound
+	}
+	return &rating, nil
+}
+
+func (s *stubTypeRatingStore) CreateTypeRating(ctx context.Context, rating domain.TypeRating) error {
+	s.ratings[rating.ID] = rating
+	return nil
+}
+
+func (s *stubTypeRatingStore) UpdateTypeRating(ctx context.Context, rating domain.TypeRating) error {
+	s.ratings[rating.ID] = rating
+	return nil
+}
+
+func (s *stubTypeRatingStore) DeleteTypeRating(ctx context.Context, id string) error {
+	delete(s.ratings, id)
+	return nil
+}
+
+type stubEmployeeDirectory struct {
+	input.Service
+	employees map[string]domain.Employee
+}
+
+func (s *stubEmployeeDirectory) GetEmployeeByID(ctx context.Context, id string) (*domain.Employee, error) {
+	employee, ok := s.employees[id]
+	if !ok {
+		return nil, domain.ErrPersonNotFound
+	}
+	return &employee, nil
+}
+
+func TestTypeRatingInteractor_AirlineManaged(t *testing.T) {
+	store := &stubTypeRatingStore{ratings: map[string]domain.TypeRating{
+		"tr-1": {ID: "tr-1", EmployeeID: "emp-1", AircraftFamily: "A320", IssueDate: "2024-01-10"},
+	}}
+	i := NewTypeRatingInteractor(store, &stubEmployeeDirectory{employees: map[string]domain.Employee{
+		"emp-1": {ID: "emp-1", Airline: "airline-1"},
+		"emp-2": {ID: "emp-2", Airline: "airline-2"},
+	}})
+	ctx := context.Background()
+
+	t.Run("manager records a rating for an employee of their airline", func(t *testing.T) {
+		created, err := i.CreateTypeRating(ctx, "trace", domain.TypeRating{EmployeeID: "emp-1", AircraftFamily: "E190", IssueDate: "2024-02-01"}, "airline-1")
+		if err != nil || created.EmployeeID != "emp-1" {
+			t.Fatalf("expected the rating to be recorded, got %v", err)
+		}
+	})
+
+	t.Run("employee of another airline is rejected", func(t *testing.T) {
+		_, err := i.CreateTypeRating(ctx, "trace", domain.TypeRating{EmployeeID: "emp-2", AircraftFamily: "E190", IssueDate: "2024-02-01"}, "airline-1")
+		if !errors.Is(err, domain.ErrTypeRatingUnauthorized) {
+			t.Fatalf("expected ErrTypeRatingUnauthorized, got %v", err)
+		}
+		if _, err := i.ListEmployeeTypeRatings(ctx, "trace", "emp-2", "airline-1"); !errors.Is(err, domain.ErrTypeRatingUnauthorized) {
+			t.Fatalf("expected ErrTypeRatingUnauthorized listing, got %v", err)
+		}
+	})
+
+	t.Run("rating of another airline's employee cannot be changed", func(t *testing.T) {
+		_, err := i.UpdateTypeRating(ctx, "trace", "tr-1", "airline-2", domain.TypeRating{AircraftFamily: "A320", IssueDate: "2020-01-01"})
+		if !errors.Is(err, domain.ErrTypeRatingUnauthorized) {
+			t.Fatalf("expected ErrTypeRatingUnauthorized, got %v", err)
+		}
+		if err := i.DeleteTypeRating(ctx, "trace", "tr-1", "airline-2"); !errors.Is(err, domain.ErrTypeRatingUnauthorized) {
+			t.Fatalf("expected ErrTypeRatingUnauthorized, got %v", err)
+		}
+		if _, ok := store.ratings["tr-1"]; !ok {
+			t.Fatalf("expected the rating to be kept")
+		}
+	})
+
+	t.Run("update keeps the holder of the rating", func(t *testing.T) {
+		updated, err := i.UpdateTypeRating(ctx, "trace", "tr-1", "airline-1", domain.TypeRating{EmployeeID: "emp-2", AircraftFamily: "A320", IssueDate: "2023-12-01"})
+		if err != nil || updated.EmployeeID != "emp-1" {
+			t.Fatalf("expected the rating to stay with emp-1, got %+v, %v", updated, err)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// CredentialService stores the licences, medical certificates, language proficiency and recurrent
+// training of employees with their scans, reports an airline's upcoming expiries and notifies holders
+// noticeDays before a credential expires
+type CredentialService struct {
+	repo             output.CredentialRepository
+	notificationRepo output.NotificationRepository
+	noticeDays       []int
+	interval         time.Duration
+	stopNotices      chan struct{}
+	logger           logger.Logger
+}
+
+// NewCredentialService creates a new credential service; a zero interval disables the periodic notice job
+func NewCredentialService(repo output.CredentialRepository, notificationRepo output.NotificationRepository,
+	noticeDays []int, interval time.Duration, log logger.Logger) *CredentialService {
+	return &CredentialService{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		noticeDays:       noticeDays,
+		interval:         interval,
+		stopNotices:      make(chan struct{}),
+		logger:           log,
+	}
+}
+
+// GetCredential retrieves a credential by its ID
+func (s *CredentialService) GetCredential(ctx context.Context, id string) (*domain.Credential, error) {
+	return s.repo.GetCredentialByID(ctx, id)
+}
+
+// ListCredentials retrieves an employee's credentials grouped by type, soonest expiry first
+func (s *CredentialService) ListCredentials(ctx context.Context, employeeID string) ([]domain.Credential, error) {
+	return s.repo.ListCredentialsByEmployee(ctx, employeeID)
+}
+
+// CreateCredential saves a new credential
+func (s *CredentialService) CreateCredential(ctx context.Context, credential domain.Credential) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SaveCredential(ctx, tx, credential); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogCredentialError, "credential_id", credential.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateCredential stores the editable fields of a credential
+func (s *CredentialService) UpdateCredential(ctx context.Context, credential domain.Credential) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.UpdateCredential(ctx, tx, credential); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogCredentialError, "credential_id", credential.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteCredential removes a credential with its scan
+func (s *CredentialService) DeleteCredential(ctx context.Context, id string) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.DeleteCredential(ctx, tx, id); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogCredentialError, "credential_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SaveCredentialScan attaches a scan to a credential, replacing the previous one
+func (s *CredentialService) SaveCredentialScan(ctx context.Context, id string, scan domain.CredentialScan) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SaveCredentialScan(ctx, tx, id, scan); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogCredentialError, "credential_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetCredentialScan retrieves the scan attached to a credential, with its content
+func (s *CredentialService) GetCredentialScan(ctx context.Context, id string) (*domain.CredentialScan, error) {
+	return s.repo.GetCredentialScan(ctx, id)
+}
+
+// GetExpiryReport lists the credentials of the airline's employees expired or expiring within withinDays
+// of asOf (a UTC date), soonest expiry first
+func (s *CredentialService) GetExpiryReport(ctx context.Context, airlineID string, asOf time.Time, withinDays int) (*domain.CredentialExpiryReport, error) {
+	credentials, err := s.repo.ListAirlineCredentialsExpiringBy(ctx, airlineID, asOf.AddDate(0, 0, withinDays))
+	if err != nil {
+		s.logger.Error(logger.LogCredentialError, "airline_id", airlineID, "error", err)
+		return nil, err
+	}
+	domain.SortCredentialsByExpiry(credentials)
+
+	return &domain.CredentialExpiryReport{
+		AirlineID:   airlineID,
+		AsOf:        asOf,
+		WithinDays:  withinDays,
+		Credentials: credentials,
+	}, nil
+}
+
+// WarningDays returns how many days before expiry a credential is reported as expiring: the earliest notice
+func (s *CredentialService) WarningDays() int {
+	warningDays := 0
+	for _, days := range s.noticeDays {
+		if days > warningDays {
+			warningDays = days
+		}
+	}
+	return warningDays
+}
+
+// RaiseExpiryNotices notifies, in one transaction, the holders of credentials that reached a notice
+// threshold on the day of now (UTC). Notices already raised are skipped, so running it more than once a
+// day is harmless. Returns how many notifications were raised.
+func (s *CredentialService) RaiseExpiryNotices(ctx context.Context, now time.Time) (int, error) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	credentials, err := s.repo.ListCredentialsExpiringBetween(ctx, today, today.AddDate(0, 0, s.WarningDays()))
+	if err != nil {
+		s.logger.Error(logger.LogCredentialNoticeError, "error", err)
+		return 0, err
+	}
+	notices := domain.CredentialExpiryNotices(credentials, today, s.noticeDays)
+	if len(notices) == 0 {
+		return 0, nil
+	}
+
+	tx, err := s.notificationRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return 0, err
+	}
+
+	raised := 0
+	for _, notice := range notices {
+		notice.SetID()
+		notice.CreatedAt = now
+		saved, err := s.notificationRepo.SaveNotification(ctx, tx, notice)
+		if err != nil {
+			tx.Rollback()
+			s.logger.Error(logger.LogCredentialNoticeError, "credential_id", notice.ReferenceID, "error", err)
+			return 0, err
+		}
+		if saved {
+			raised++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return 0, err
+	}
+
+	s.logger.Info(logger.LogCredentialNoticeOK, "day", today.Format("2006-01-02"), "raised", raised)
+	return raised, nil
+}
+
+// StartExpiryNoticeJob raises the due notices once and then on every interval until StopExpiryNoticeJob is
+// called
+func (s *CredentialService) StartExpiryNoticeJob(ctx context.Context) {
+	if s.interval <= 0 {
+		s.logger.Info(logger.LogCredentialNoticeDisabled)
+		return
+	}
+
+	s.logger.Info(logger.LogCredentialNoticeStart, "interval", s.interval.String(), "notice_days", s.noticeDays)
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			// Errors are logged by RaiseExpiryNotices; the next run retries
+			s.RaiseExpiryNotices(ctx, time.Now())
+
+			select {
+			case <-ticker.C:
+			case <-s.stopNotices:
+				s.logger.Info(logger.LogCredentialNoticeStop)
+				return
+			}
+		}
+	}()
+}
+
+// StopExpiryNoticeJob stops the periodic expiry notices
+func (s *CredentialService) StopExpiryNoticeJob() {
+	if s.interval > 0 {
+		close(s.stopNotices)
+	}
+}
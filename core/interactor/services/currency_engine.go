@@ -0,0 +1,140 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// CurrencyEngine evaluates takeoff, landing and instrument approach recency per aircraft family.
+// Days are flight dates (UTC); a rule is met on a day when its window ending that day holds enough events.
+type CurrencyEngine struct {
+	rules       []domain.CurrencyRule
+	warningDays int
+}
+
+// NewCurrencyEngine creates an engine for the given rules.
+// Falls back to domain.DefaultCurrencyRules and domain.DefaultCurrencyWarningDays when not configured.
+func NewCurrencyEngine(rules []domain.CurrencyRule, warningDays int) *CurrencyEngine {
+	if len(rules) == 0 {
+		rules = domain.DefaultCurrencyRules()
+	}
+	if warningDays <= 0 {
+		warningDays = domain.DefaultCurrencyWarningDays
+	}
+	return &CurrencyEngine{
+		rules:       rules,
+		warningDays: warningDays,
+	}
+}
+
+// LookBack returns how many days of events must be loaded. Twice the longest window is kept
+// so the date a lapsed rule expired on can still be reported.
+func (e *CurrencyEngine) LookBack() int {
+	days := 0
+	for _, r := range e.rules {
+		if r.WindowDays > days {
+			days = r.WindowDays
+		}
+	}
+	return 2 * days
+}
+
+// Status returns the status of every rule for each aircraft family present in the events,
+// ordered by family and then by rule as configured
+func (e *CurrencyEngine) Status(events []domain.CurrencyEvent, asOf time.Time) []domain.CurrencyRuleStatus {
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+
+	byFamily := make(map[string][]domain.CurrencyEvent)
+	for _, ev := range events {
+		if ev.Date.After(asOf) {
+			continue
+		}
+		byFamily[ev.AircraftFamily] = append(byFamily[ev.AircraftFamily], ev)
+	}
+
+	families := make([]string, 0, len(byFamily))
+	for f := range byFamily {
+		families = append(families, f)
+	}
+	sort.Strings(families)
+
+	statuses := make([]domain.CurrencyRuleStatus, 0, len(families)*len(e.rules))
+	for _, f := range families {
+		for _, r := range e.rules {
+			statuses = append(statuses, e.evaluate(r, f, byFamily[f], asOf))
+		}
+	}
+	return statuses
+}
+
+// evaluate computes the counts within the window ending on asOf and the last day the rule is met
+func (e *CurrencyEngine) evaluate(rule domain.CurrencyRule, family string, events []domain.CurrencyEvent, asOf time.Time) domain.CurrencyRuleStatus {
+	start := asOf.AddDate(0, 0, -(rule.WindowDays - 1))
+	status := domain.CurrencyRuleStatus{Rule: rule, AircraftFamily: family}
+
+	var takeoffs, landings, approaches []time.Time
+	for _, ev := range events {
+		if !rule.Credits(ev) {
+			continue
+		}
+		takeoffs = appendDates(takeoffs, ev.Date, ev.Takeoffs(rule.Night))
+		landings = appendDates(landings, ev.Date, ev.Landings(rule.Night))
+		approaches = appendDates(approaches, ev.Date, ev.Approaches())
+		if ev.Date.Before(start) {
+			continue
+		}
+		status.Takeoffs += ev.Takeoffs(rule.Night)
+		status.Landings += ev.Landings(rule.Night)
+		status.Approaches += ev.Approaches()
+	}
+
+	// The rule lapses when the oldest of the required events leaves the window;
+	// with several requirements the earliest lapse wins
+	var expires *time.Time
+	for _, req := range []struct {
+		dates    []time.Time
+		required int
+	}{{takeoffs, rule.Takeoffs}, {landings, rule.Landings}, {approaches, rule.Approaches}} {
+		if req.required <= 0 {
+			continue
+		}
+		last, ok := lastQualifyingDay(req.dates, req.required, rule.WindowDays)
+		if !ok {
+			// Not enough events in the loaded period: never met (or lapsed long ago)
+			status.Level = domain.CurrencyLevelExpired
+			return status
+		}
+		if expires == nil || last.Before(*expires) {
+			expires = &last
+		}
+	}
+
+	status.ExpiresOn = expires
+	switch {
+	case expires == nil || expires.Before(asOf):
+		status.Level = domain.CurrencyLevelExpired
+	case expires.Before(asOf.AddDate(0, 0, e.warningDays)):
+		status.Level = domain.CurrencyLevelExpiring
+	default:
+		status.Level = domain.CurrencyLevelCurrent
+	}
+	return status
+}
+
+// lastQualifyingDay returns the last day the n most recent events still fall inside a window
+func lastQualifyingDay(dates []time.Time, n, windowDays int) (time.Time, bool) {
+	if len(dates) < n {
+		return time.Time{}, false
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].After(dates[j]) })
+	return dates[n-1].AddDate(0, 0, windowDays-1), true
+}
+
+func appendDates(dates []time.Time, date time.Time, count int) []time.Time {
+	for i := 0; i < count; i++ {
+		dates = append(dates, date)
+	}
+	return dates
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+func TestCurrencyEngine_Status(t *testing.T) {
+	engine := NewCurrencyEngine([]domain.CurrencyRule{
+		{Code: "takeoff_landing_90d", WindowDays: 90, Takeoffs: 3, Landings: 3},
+	}, 14)
+
+	events := []domain.CurrencyEvent{
+		{Date: ftlDay("2026-01-10"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF},
+		{Date: ftlDay("2026-02-01"), AircraftFamily: "A320", PilotRole: domain.PilotRolePFTO},
+		{Date: ftlDay("2026-02-05"), AircraftFamily: "A320", PilotRole: domain.PilotRolePFL},
+		{Date: ftlDay("2026-02-20"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF},
+		{Date: ftlDay("2026-03-01"), AircraftFamily: "A320", PilotRole: domain.PilotRolePM},
+		{Date: ftlDay("2026-02-01"), AircraftFamily: "B737", PilotRole: domain.PilotRolePF},
+	}
+
+	statuses := engine.Status(events, ftlDay("2026-03-01"))
+	if len(statuses) != 2 {
+		t.Fatalf("expected one status per family, got %d", len(statuses))
+	}
+
+	a320 := statuses[0]
+	if a320.AircraftFamily != "A320" || a320.Level != domain.CurrencyLevelCurrent {
+		t.Fatalf("A320: got %s %s", a320.AircraftFamily, a320.Level)
+	}
+	if a320.Takeoffs != 3 || a320.Landings != 3 {
+		t.Errorf("A320 counts: got %d takeoffs, %d landings", a320.Takeoffs, a320.Landings)
+	}
+	// Third most recent takeoff is 2026-01-10 -> lapses after 2026-04-09
+	if a320.ExpiresOn == nil || !a320.ExpiresOn.Equal(ftlDay("2026-04-09")) {
+		t.Errorf("A320 expires on: got %v", a320.ExpiresOn)
+	}
+
+	if b737 := statuses[1]; b737.Level != domain.CurrencyLevelExpired || b737.ExpiresOn != nil {
+		t.Errorf("B737: expected expired without expiry date, got %s %v", b737.Level, b737.ExpiresOn)
+	}
+}
+
+func TestCurrencyEngine_Expiring(t *testing.T) {
+	engine := NewCurrencyEngine([]domain.CurrencyRule{
+		{Code: "takeoff_landing_90d", WindowDays: 90, Takeoffs: 1, Landings: 1},
+	}, 14)
+
+	events := []domain.CurrencyEvent{{Date: ftlDay("2026-01-01"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF}}
+
+	statuses := engine.Status(events, ftlDay("2026-03-25"))
+	if statuses[0].Level != domain.CurrencyLevelExpiring {
+		t.Errorf("expected EXPIRING, got %s", statuses[0].Level)
+	}
+
+	statuses = engine.Status(events, ftlDay("2026-04-01"))
+	if statuses[0].Level != domain.CurrencyLevelExpired || statuses[0].ExpiresOn == nil {
+		t.Errorf("expected EXPIRED with a past expiry date, got %s %v", statuses[0].Level, statuses[0].ExpiresOn)
+	}
+}
+
+func TestCurrencyEngine_NightAndInstrument(t *testing.T) {
+	engine := NewCurrencyEngine([]domain.CurrencyRule{
+		{Code: "night_90d", WindowDays: 90, Takeoffs: 1, Landings: 1, Night: true},
+		{Code: "instrument_90d", WindowDays: 90, Approaches: 2},
+	}, 14)
+
+	pa := domain.ApproachTypePA
+	visual := domain.ApproachTypeVisual
+	events := []domain.CurrencyEvent{
+		{Date: ftlDay("2026-03-01"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF, NightTakeoffs: 1, ApproachType: &pa},
+		{Date: ftlDay("2026-03-02"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF, ApproachType: &visual},
+		{Date: ftlDay("2026-03-03"), AircraftFamily: "A320", PilotRole: domain.PilotRolePFTO, ApproachType: &pa},
+	}
+
+	statuses := engine.Status(events, ftlDay("2026-03-10"))
+
+	// No night landing flown
+	if night := statuses[0]; night.Level != domain.CurrencyLevelExpired || night.Takeoffs != 1 || night.Landings != 0 {
+		t.Errorf("night: got %s, %d takeoffs, %d landings", night.Level, night.Takeoffs, night.Landings)
+	}
+	// Only the PF precision approach counts (visual and PFTO do not)
+	if inst := statuses[1]; inst.Level != domain.CurrencyLevelExpired || inst.Approaches != 1 {
+		t.Errorf("instrument: got %s, %d approaches", inst.Level, inst.Approaches)
+	}
+}
+
+func TestCurrencyEngine_SimulatorSessions(t *testing.T) {
+	engine := NewCurrencyEngine([]domain.CurrencyRule{
+		{Code: "takeoff_landing_90d", WindowDays: 90, Takeoffs: 3, Landings: 3,
+			SimulatorDevices: []domain.SimulatorDeviceType{domain.SimulatorDeviceFFS}},
+		{Code: "instrument_90d", WindowDays: 90, Approaches: 3,
+			SimulatorDevices: []domain.SimulatorDeviceType{domain.SimulatorDeviceFFS, domain.SimulatorDeviceFNPT}},
+	}, 14)
+
+	events := []domain.CurrencyEvent{
+		{Date: ftlDay("2026-02-01"), AircraftFamily: "A320", Device: domain.SimulatorDeviceFNPT, RecordedTakeoffs: 5, RecordedLandings: 5, RecordedApproaches: 2},
+		{Date: ftlDay("2026-02-15"), AircraftFamily: "A320", Device: domain.SimulatorDeviceFFS, RecordedTakeoffs: 2, RecordedLandings: 2, RecordedApproaches: 1},
+		{Date: ftlDay("2026-03-01"), AircraftFamily: "A320", PilotRole: domain.PilotRolePF},
+	}
+
+	statuses := engine.Status(events, ftlDay("2026-03-10"))
+
+	// The FNPT session does not count toward takeoffs and landings
+	if tl := statuses[0]; tl.Level != domain.CurrencyLevelCurrent || tl.Takeoffs != 3 || tl.Landings != 3 {
+		t.Errorf("takeoff_landing_90d: got %s %d/%d", tl.Level, tl.Takeoffs, tl.Landings)
+	}
+	if ifr := statuses[1]; ifr.Level != domain.CurrencyLevelCurrent || ifr.Approaches != 3 {
+		t.Errorf("instrument_90d: got %s %d", ifr.Level, ifr.Approaches)
+	}
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// CurrencyService evaluates pilot recency (currency) rules from an employee's segments, the
+// simulator sessions the rules credit and the recent experience declared with the opening balances
+type CurrencyService struct {
+	repo          output.DailyLogbookDetailRepository
+	simulatorRepo output.SimulatorSessionRepository
+	priorRepo     output.PriorExperienceRepository
+	engine        *CurrencyEngine
+	logger        logger.Logger
+}
+
+// NewCurrencyService creates a new currency service
+func NewCurrencyService(repo output.DailyLogbookDetailRepository, simulatorRepo output.SimulatorSessionRepository,
+	priorRepo output.PriorExperienceRepository, engine *CurrencyEngine, log logger.Logger) *CurrencyService {
+	return &CurrencyService{
+		repo:          repo,
+		simulatorRepo: simulatorRepo,
+		priorRepo:     priorRepo,
+		engine:        engine,
+		logger:        log,
+	}
+}
+
+// GetCurrencyStatus returns the status of every rule per aircraft family as of a date
+func (s *CurrencyService) GetCurrencyStatus(ctx context.Context, employeeID string, asOf time.Time) (*domain.CurrencyStatus, error) {
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	from := asOf.AddDate(0, 0, -s.engine.LookBack())
+
+	events, err := s.repo.GetCurrencyEvents(ctx, employeeID, from, asOf)
+	if err != nil {
+		s.logger.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	sessions, err := s.simulatorRepo.ListSimulatorSessions(ctx, domain.SimulatorSessionFilter{EmployeeID: employeeID, From: &from, To: &asOf})
+	if err != nil {
+		s.logger.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	for _, session := range sessions {
+		date, err := time.Parse("2006-01-02", session.SessionDate)
+		if err != nil {
+			continue
+		}
+		events = append(events, session.CurrencyEvent(date))
+	}
+
+	entries, err := s.priorRepo.ListPriorExperienceByEmployee(ctx, employeeID)
+	if err != nil {
+		s.logger.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	for _, p := range entries {
+		if p.HasRecentExperience() && p.Within(&from, &asOf) {
+			events = append(events, p.CurrencyEvent())
+		}
+	}
+
+	return &domain.CurrencyStatus{
+		EmployeeID: employeeID,
+		AsOf:       asOf,
+		Rules:      s.engine.Status(events, asOf),
+	}, nil
+}
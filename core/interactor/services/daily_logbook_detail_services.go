@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
@@ -14,13 +15,17 @@ var log logger.Logger = logger.NewSlogLogger()
 // DailyLogbookDetailService implements the daily logbook detail service interface
 // This is the CORE service for flight segment tracking
 type DailyLogbookDetailService struct {
-	repo output.DailyLogbookDetailRepository
+	repo    output.DailyLogbookDetailRepository
+	history output.LogbookHistoryRepository
+	prior   output.PriorExperienceRepository
 }
 
 // NewDailyLogbookDetailService creates a new DailyLogbookDetailService
-func NewDailyLogbookDetailService(repo output.DailyLogbookDetailRepository) *DailyLogbookDetailService {
+func NewDailyLogbookDetailService(repo output.DailyLogbookDetailRepository, history output.LogbookHistoryRepository, prior output.PriorExperienceRepository) *DailyLogbookDetailService {
 	return &DailyLogbookDetailService{
-		repo: repo,
+		repo:    repo,
+		history: history,
+		prior:   prior,
 	}
 }
 
@@ -41,6 +46,156 @@ func (s *DailyLogbookDetailService) ListDailyLogbookDetailsByLogbook(ctx context
 	return s.repo.ListDailyLogbookDetailsByLogbook(ctx, logbookID)
 }
 
+// GetFlightTotals aggregates an employee's segments for the filter and adds up the overall totals
+func (s *DailyLogbookDetailService) GetFlightTotals(ctx context.Context, filter domain.FlightTotalsFilter) (*domain.FlightTotalsReport, error) {
+	log.Info(logger.LogFlightTotalsGet, "employee_id", filter.EmployeeID)
+
+	groups, err := s.repo.GetFlightTotals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.FlightTotalsReport{
+		Filter: filter,
+		Groups: groups,
+	}
+	for _, g := range groups {
+		report.Totals.Add(g)
+	}
+
+	// Opening balances have no month, aircraft or airline, so they are added to the totals only
+	entries, err := s.prior.ListPriorExperienceByEmployee(ctx, filter.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range entries {
+		if !p.Within(filter.From, filter.To) {
+			continue
+		}
+		if report.Prior == nil {
+			report.Prior = &domain.FlightTotals{}
+		}
+		report.Prior.Add(p.FlightTotals())
+	}
+	if report.Prior != nil {
+		report.Totals.Add(*report.Prior)
+	}
+
+	return report, nil
+}
+
+// GetLogbookExport loads an employee's segments for the date range and groups them into logbook pages
+// with brought forward, page and carried forward totals, in logbook page order. Segments dated outside
+// the range are printed when their page falls between the first and last page of the range, so pages
+// are never split. Totals of the segments before the first page and the opening balances of prior
+// experience are brought forward into the first page so the running totals match the complete logbook.
+func (s *DailyLogbookDetailService) GetLogbookExport(ctx context.Context, filter domain.LogbookExportFilter) (*domain.LogbookExport, error) {
+	log.Info(logger.LogLogbookExport, "employee_id", filter.EmployeeID)
+
+	export := &domain.LogbookExport{Filter: filter}
+	inRange, err := s.repo.ListDailyLogbookDetailsByEmployee(ctx, filter.EmployeeID, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	if len(inRange) == 0 {
+		return export, nil
+	}
+
+	first, last := domain.LogbookPositionOf(inRange[0]), domain.LogbookPositionOf(inRange[0])
+	for _, d := range inRange[1:] {
+		position := domain.LogbookPositionOf(d)
+		if position.Before(first) {
+			first = position
+		}
+		if last.Before(position) {
+			last = position
+		}
+	}
+
+	details, err := s.repo.ListDailyLogbookDetailsBetween(ctx, filter.EmployeeID, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, err := s.repo.GetLogbookTotalsBefore(ctx, filter.EmployeeID, first)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.prior.ListPriorExperienceByEmployee(ctx, filter.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range entries {
+		if p.Within(nil, &filter.To) {
+			opening.Add(p.LogbookTotals())
+		}
+	}
+
+	export.Pages = BuildLogbookPages(filter, opening, details)
+	for _, page := range export.Pages {
+		for _, inc := range page.Inconsistencies {
+			log.Warn(logger.LogLogbookExportInconsistency, "employee_id", filter.EmployeeID,
+				"detail_id", inc.DetailID, "flight_real_date", inc.FlightRealDate, "kind", inc.Kind)
+		}
+	}
+	return export, nil
+}
+
+// BuildLogbookPages orders the details in logbook page order, the order the opening totals are
+// computed on, groups the details with the same BookPage into pages and chains the running totals:
+// each page brings forward what the previous one carried forward. A page is never split: details
+// dated outside the filter range or before a flight of an earlier page stay on their page and are
+// reported as inconsistencies of it.
+func BuildLogbookPages(filter domain.LogbookExportFilter, opening domain.LogbookTotals, details []domain.DailyLogbookDetail) []domain.LogbookExportPage {
+	ordered := append([]domain.DailyLogbookDetail(nil), details...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return domain.LogbookPositionOf(ordered[i]).Before(domain.LogbookPositionOf(ordered[j]))
+	})
+
+	from, to := filter.From.Format("2006-01-02"), filter.To.Format("2006-01-02")
+	var pages []domain.LogbookExportPage
+	var latestEarlier, latest string // Latest flight date of the pages before the current one, and up to it
+	running := opening
+	for _, d := range ordered {
+		if len(pages) == 0 || !sameBookPage(pages[len(pages)-1].BookPage, d.BookPage) {
+			pages = append(pages, domain.LogbookExportPage{
+				BookPage:       d.BookPage,
+				BroughtForward: running,
+			})
+			latestEarlier = latest
+		}
+		page := &pages[len(pages)-1]
+		page.Details = append(page.Details, d)
+		page.PageTotals.AddDetail(d)
+		running.AddDetail(d)
+		page.CarriedForward = running
+
+		date := domain.LogbookPositionOf(d).FlightRealDate
+		switch {
+		case date < from || date > to:
+			page.Inconsistencies = append(page.Inconsistencies, domain.LogbookPageInconsistency{
+				Kind: domain.LogbookPageOutsideRange, DetailID: d.ID, FlightRealDate: date,
+			})
+		case date < latestEarlier:
+			page.Inconsistencies = append(page.Inconsistencies, domain.LogbookPageInconsistency{
+				Kind: domain.LogbookPageOutOfDateOrder, DetailID: d.ID, FlightRealDate: date,
+			})
+		}
+		if date > latest {
+			latest = date
+		}
+	}
+	return pages
+}
+
+func sameBookPage(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 // CreateDailyLogbookDetail creates a new detail with transaction management
 func (s *DailyLogbookDetailService) CreateDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error {
 	log.Info(logger.LogDailyLogbookDetailCreate, "data", detail.ToLogger())
@@ -52,6 +207,9 @@ func (s *DailyLogbookDetailService) CreateDailyLogbookDetail(ctx context.Context
 	}
 
 	err = s.repo.SaveDailyLogbookDetail(ctx, tx, detail)
+	if err == nil {
+		err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookDetailHistory(ctx, nil, &detail))
+	}
 	if err != nil {
 		tx.Rollback()
 		log.Error(logger.LogDailyLogbookDetailCreateError, "error", err)
@@ -71,6 +229,15 @@ func (s *DailyLogbookDetailService) CreateDailyLogbookDetail(ctx context.Context
 func (s *DailyLogbookDetailService) UpdateDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error {
 	log.Info(logger.LogDailyLogbookDetailUpdate, "data", detail.ToLogger())
 
+	before, err := s.repo.GetDailyLogbookDetailByID(ctx, detail.ID)
+	if err == nil && before == nil {
+		err = domain.ErrFlightNotFound
+	}
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailUpdateError, "error", err)
+		return err
+	}
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		log.Error(logger.LogDBTransactionBeginErr, "error", err)
@@ -78,6 +245,9 @@ func (s *DailyLogbookDetailService) UpdateDailyLogbookDetail(ctx context.Context
 	}
 
 	err = s.repo.UpdateDailyLogbookDetail(ctx, tx, detail)
+	if err == nil {
+		err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookDetailHistory(ctx, before, &detail))
+	}
 	if err != nil {
 		tx.Rollback()
 		log.Error(logger.LogDailyLogbookDetailUpdateError, "error", err)
@@ -97,13 +267,26 @@ func (s *DailyLogbookDetailService) UpdateDailyLogbookDetail(ctx context.Context
 func (s *DailyLogbookDetailService) DeleteDailyLogbookDetail(ctx context.Context, id string) error {
 	log.Info(logger.LogDailyLogbookDetailDelete, "id", id)
 
+	// The deleted segment is kept in its history entry
+	before, err := s.repo.GetDailyLogbookDetailByID(ctx, id)
+	if err == nil && before == nil {
+		err = domain.ErrFlightNotFound
+	}
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailDeleteError, "error", err)
+		return err
+	}
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		log.Error(logger.LogDBTransactionBeginErr, "error", err)
 		return err
 	}
 
-	err = s.repo.DeleteDailyLogbookDetail(ctx, tx, id)
+	err = s.repo.DeleteDailyLogbookDetail(ctx, tx, id, time.Now().UTC())
+	if err == nil {
+		err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookDetailHistory(ctx, before, nil))
+	}
 	if err != nil {
 		tx.Rollback()
 		log.Error(logger.LogDailyLogbookDetailDeleteError, "error", err)
@@ -119,59 +302,303 @@ func (s *DailyLogbookDetailService) DeleteDailyLogbookDetail(ctx context.Context
 	return nil
 }
 
-// ValidateTimeSequence validates that times follow the correct sequence: out < takeoff < landing < in
-// Accepts both HH:MM and HH:MM:SS formats
-func (s *DailyLogbookDetailService) ValidateTimeSequence(outTime, takeoffTime, landingTime, inTime string) error {
-	// Parse time with flexible format (HH:MM or HH:MM:SS)
-	parseTime := func(timeStr string) (time.Time, error) {
-		// Try HH:MM:SS first
-		t, err := time.Parse("15:04:05", timeStr)
-		if err == nil {
-			return t, nil
+// GetDeletedDailyLogbookDetailByID retrieves a soft deleted detail by ID; nil when there is none
+func (s *DailyLogbookDetailService) GetDeletedDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error) {
+	return s.repo.GetDeletedDailyLogbookDetailByID(ctx, id)
+}
+
+// RestoreDailyLogbookDetail brings back a soft deleted detail with transaction management
+func (s *DailyLogbookDetailService) RestoreDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error {
+	log.Info(logger.LogDailyLogbookDetailRestore, "id", detail.ID)
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		log.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	detail.DeletedAt = nil
+	err = s.repo.RestoreDailyLogbookDetail(ctx, tx, detail.ID)
+	if err == nil {
+		err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookDetailRestoreHistory(ctx, &detail))
+	}
+	if err != nil {
+		tx.Rollback()
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+
+	log.Info(logger.LogDailyLogbookDetailRestoreOK, "id", detail.ID)
+	return nil
+}
+
+// CheckSegmentReferences enforces the rules between the segment and the records it points to: the airline
+// route and its airline must be active, the aircraft must belong to the route's airline unless the segment is
+// a wet lease, and the flight date must match the daily logbook's log date (see domain.MaxLogDateOffset)
+func (s *DailyLogbookDetailService) CheckSegmentReferences(ctx context.Context, detail domain.DailyLogbookDetail, logbook domain.DailyLogbook) error {
+	if err := domain.CheckFlightDateAgainstLogDate(detail.FlightRealDate, logbook.LogDate); err != nil {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", err, "flight_real_date", detail.FlightRealDate,
+			"log_date", logbook.LogDate.Format("2006-01-02"))
+		return err
+	}
+
+	refs, err := s.repo.GetSegmentReferences(ctx, detail.AirlineRouteID, detail.ActualAircraftRegistrationID)
+	if err != nil {
+		return err
+	}
+	if err := refs.Check(detail.WetLease); err != nil {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", err, "airline_route_id", detail.AirlineRouteID,
+			"aircraft_registration_id", detail.ActualAircraftRegistrationID, "wet_lease", detail.WetLease)
+		return err
+	}
+	return nil
+}
+
+// CheckSegmentConflicts looks for another segment of the employee, in any of their logbooks or among the
+// batch of segments being saved with it, that the detail duplicates (same flight number, flight date and
+// aircraft) or overlaps in time. Returns a *domain.SegmentConflictError naming the clashing segment, or nil.
+func (s *DailyLogbookDetailService) CheckSegmentConflicts(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) error {
+	day, err := domain.ParseFlightDate(detail.FlightRealDate)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := s.repo.ListConflictCandidates(ctx, employeeID, day.Add(-domain.SegmentConflictWindow), day.Add(domain.SegmentConflictWindow), detail.ID)
+	if err != nil {
+		return err
+	}
+
+	if conflict := domain.FindSegmentConflict(detail, append(candidates, batch...)); conflict != nil {
+		log.Warn(logger.LogDailyLogbookDetailConflict, "id", detail.ID, "kind", conflict.Kind, "conflicting_id", conflict.DetailID)
+		return conflict
+	}
+	return nil
+}
+
+// CheckRouteContinuity compares the segment with the other legs of its daily logbook, stored or among the
+// batch of segments being saved with it, in OUT-time order, and returns a warning for each neighbour whose
+// destination/origin does not connect with it. The detail's airports are resolved from its route, since
+// they are not part of the request.
+func (s *DailyLogbookDetailService) CheckRouteContinuity(ctx context.Context, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.ValidationWarning, error) {
+	origin, destination, err := s.repo.GetRouteAirports(ctx, detail.AirlineRouteID)
+	if err != nil {
+		return nil, err
+	}
+	detail.OriginIataCode = origin.IATACode
+	detail.DestinationIataCode = destination.IATACode
+
+	siblings, err := s.repo.ListDailyLogbookDetailsByLogbook(ctx, detail.DailyLogbookID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := []domain.DailyLogbookDetail{detail}
+	for _, sibling := range siblings {
+		if sibling.ID != detail.ID {
+			day = append(day, sibling)
 		}
-		// Fallback to HH:MM
-		return time.Parse("15:04", timeStr)
 	}
+	for _, pending := range batch {
+		if pending.DailyLogbookID == detail.DailyLogbookID && pending.ID != detail.ID {
+			day = append(day, pending)
+		}
+	}
+
+	var warnings []domain.ValidationWarning
+	for _, gap := range domain.FindRouteContinuityGaps(day) {
+		related := ""
+		switch detail.ID {
+		case gap.DetailID:
+			related = gap.PreviousDetailID
+		case gap.PreviousDetailID:
+			related = gap.DetailID
+		default:
+			continue // Existing gap between other legs
+		}
+		log.Warn(logger.LogRouteContinuityGap, "id", detail.ID, "arrived_at", gap.ArrivedAt, "departed_from", gap.DepartedFrom)
+		warnings = append(warnings, domain.ValidationWarning{
+			Code:     domain.MsgFlightRouteGap,
+			Params:   []string{gap.ArrivedAt, gap.DepartedFrom},
+			DetailID: related,
+		})
+	}
+	return warnings, nil
+}
 
-	out, err := parseTime(outTime)
+// ValidateLogbook builds the consistency report of a daily logbook from its stored segments
+func (s *DailyLogbookDetailService) ValidateLogbook(ctx context.Context, logbook domain.DailyLogbook) (*domain.LogbookValidationReport, error) {
+	details, err := s.repo.ListDailyLogbookDetailsByLogbook(ctx, logbook.ID)
 	if err != nil {
-		log.Error(logger.LogDailyLogbookDetailCreateError, "error", "invalid out_time format", "value", outTime)
-		return domain.ErrFlightInvalidTimeSequence
+		log.Error(logger.LogLogbookValidationError, "logbook_id", logbook.ID, "error", err)
+		return nil, err
 	}
 
-	takeoff, err := parseTime(takeoffTime)
+	return domain.BuildLogbookValidationReport(logbook, details), nil
+}
+
+// ValidateTimeSequence validates that times follow the correct sequence: out < takeoff < landing < in
+// Times are anchored to the flight date (YYYY-MM-DD); a time earlier than the previous one is treated as
+// the next calendar day, so segments crossing midnight are accepted. Accepts both HH:MM and HH:MM:SS formats
+func (s *DailyLogbookDetailService) ValidateTimeSequence(flightDate, outTime, takeoffTime, landingTime, inTime string) error {
+	if _, err := domain.ResolveSegmentTimes(flightDate, outTime, takeoffTime, landingTime, inTime); err != nil {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", err,
+			"flight_real_date", flightDate, "out_time", outTime, "takeoff_time", takeoffTime,
+			"landing_time", landingTime, "in_time", inTime)
+		return err
+	}
+
+	return nil
+}
+
+// CalculateFlightTimes derives air time (ON - OFF) and block time (IN - OUT) from the segment times
+// and stores them on the detail in HH:MM format. Client-supplied values are optional; when present
+// they must match the derived values, otherwise ErrFlightTimeMismatch is returned. The optional IFR time
+// is normalized to HH:MM and must fit within the block time.
+func (s *DailyLogbookDetailService) CalculateFlightTimes(detail *domain.DailyLogbookDetail) error {
+	times, err := detail.SegmentTimes()
 	if err != nil {
-		log.Error(logger.LogDailyLogbookDetailCreateError, "error", "invalid takeoff_time format", "value", takeoffTime)
-		return domain.ErrFlightInvalidTimeSequence
+		return err
+	}
+
+	airTime := domain.FormatFlightDuration(times.AirTime())
+	blockTime := domain.FormatFlightDuration(times.BlockTime())
+
+	if !flightDurationMatches(detail.AirTime, airTime) {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "air_time does not match landing_time - takeoff_time",
+			"received", detail.AirTime, "expected", airTime)
+		return domain.ErrFlightTimeMismatch
+	}
+
+	if !flightDurationMatches(detail.BlockTime, blockTime) {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "block_time does not match in_time - out_time",
+			"received", detail.BlockTime, "expected", blockTime)
+		return domain.ErrFlightTimeMismatch
+	}
+
+	// IFR time is entered by the pilot and cannot exceed the block time
+	if detail.IFRTime != nil && *detail.IFRTime != "" {
+		ifr, err := domain.ParseFlightDuration(*detail.IFRTime)
+		if err != nil || ifr > times.BlockTime() {
+			log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "ifr_time invalid or longer than block_time",
+				"received", *detail.IFRTime, "block_time", blockTime)
+			return domain.ErrFlightInvalidIFRTime
+		}
+		ifrTime := domain.FormatFlightDuration(ifr)
+		detail.IFRTime = &ifrTime
+	} else {
+		detail.IFRTime = nil
+	}
+
+	detail.AirTime = airTime
+	detail.BlockTime = blockTime
+	return nil
+}
+
+// flightDurationMatches reports whether a client-supplied duration equals the derived one.
+// An empty value always matches (the derived value is used).
+func flightDurationMatches(received, expected string) bool {
+	if received == "" {
+		return true
+	}
+	d, err := domain.ParseFlightDuration(received)
+	if err != nil {
+		return false
+	}
+	return domain.FormatFlightDuration(d) == expected
+}
+
+// NormalizeSegmentTimes converts segment times entered in airport local time to UTC.
+// With TimeReferenceLocal, OUT/OFF (and FlightRealDate) are read in the origin time zone and ON/IN in the
+// destination time zone; the detail is rewritten with the UTC flight date and HH:MM:SS UTC times.
+// UTC entries (the default) are left untouched.
+func (s *DailyLogbookDetailService) NormalizeSegmentTimes(ctx context.Context, detail *domain.DailyLogbookDetail) error {
+	switch detail.TimeReference {
+	case "", domain.TimeReferenceUTC:
+		detail.TimeReference = domain.TimeReferenceUTC
+		return nil
+	case domain.TimeReferenceLocal:
+	default:
+		return domain.ErrFlightInvalidTimeRef
 	}
 
-	landing, err := parseTime(landingTime)
+	origin, destination, err := s.repo.GetRouteAirports(ctx, detail.AirlineRouteID)
 	if err != nil {
-		log.Error(logger.LogDailyLogbookDetailCreateError, "error", "invalid landing_time format", "value", landingTime)
-		return domain.ErrFlightInvalidTimeSequence
+		return err
 	}
 
-	in, err := parseTime(inTime)
+	originLoc, err := origin.Location()
 	if err != nil {
-		log.Error(logger.LogDailyLogbookDetailCreateError, "error", "invalid in_time format", "value", inTime)
-		return domain.ErrFlightInvalidTimeSequence
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", err, "airport", origin.IATACode, "time_zone", origin.TimeZone)
+		return domain.ErrFlightTimeZoneUnavailable
+	}
+	destinationLoc, err := destination.Location()
+	if err != nil {
+		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", err, "airport", destination.IATACode, "time_zone", destination.TimeZone)
+		return domain.ErrFlightTimeZoneUnavailable
 	}
 
-	// Validate sequence: out < takeoff < landing < in
-	if !out.Before(takeoff) {
-		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "out_time must be before takeoff_time")
-		return domain.ErrFlightInvalidTimeSequence
+	times, err := domain.ResolveLocalSegmentTimes(detail.FlightRealDate, detail.OutTime, detail.TakeoffTime,
+		detail.LandingTime, detail.InTime, originLoc, destinationLoc)
+	if err != nil {
+		return err
 	}
 
-	if !takeoff.Before(landing) {
-		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "takeoff_time must be before landing_time")
-		return domain.ErrFlightInvalidTimeSequence
+	detail.FlightRealDate = times.Out.UTC().Format("2006-01-02")
+	detail.OutTime = times.Out.UTC().Format("15:04:05")
+	detail.TakeoffTime = times.Takeoff.UTC().Format("15:04:05")
+	detail.LandingTime = times.Landing.UTC().Format("15:04:05")
+	detail.InTime = times.In.UTC().Format("15:04:05")
+	detail.TimeReference = domain.TimeReferenceUTC
+	return nil
+}
+
+// CalculateNightTime derives the night portion of the segment and the day/night takeoff and landing
+// counts from the route airports' coordinates (civil twilight along the great-circle track).
+// Takeoffs and landings are credited according to the pilot role. When either airport has no
+// coordinates the night fields are left empty instead of failing the segment.
+func (s *DailyLogbookDetailService) CalculateNightTime(ctx context.Context, detail *domain.DailyLogbookDetail) error {
+	detail.NightTime = nil
+	detail.DayTakeoffs, detail.NightTakeoffs = nil, nil
+	detail.DayLandings, detail.NightLandings = nil, nil
+
+	times, err := detail.SegmentTimes()
+	if err != nil {
+		return err
 	}
 
-	if !landing.Before(in) {
-		log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "landing_time must be before in_time")
-		return domain.ErrFlightInvalidTimeSequence
+	origin, destination, err := s.repo.GetRouteAirports(ctx, detail.AirlineRouteID)
+	if err != nil {
+		return err
+	}
+	if !origin.HasCoordinates() || !destination.HasCoordinates() {
+		log.Warn(logger.LogDailyLogbookDetailCreate, "warning", "night time not calculated, airport coordinates missing",
+			"origin", origin.IATACode, "destination", destination.IATACode)
+		return nil
 	}
 
+	night := CalculateNightFlight(times, *origin.Latitude, *origin.Longitude, *destination.Latitude, *destination.Longitude)
+
+	nightTime := domain.FormatFlightDuration(night.NightTime)
+	detail.NightTime = &nightTime
+	detail.DayTakeoffs, detail.NightTakeoffs = dayNightCounts(detail.PilotRole.PerformsTakeoff(), night.NightTakeoff)
+	detail.DayLandings, detail.NightLandings = dayNightCounts(detail.PilotRole.PerformsLanding(), night.NightLanding)
 	return nil
 }
+
+// dayNightCounts returns the day and night counters for a single takeoff or landing
+func dayNightCounts(performed, atNight bool) (*int, *int) {
+	day, night := 0, 0
+	if performed {
+		if atNight {
+			night = 1
+		} else {
+			day = 1
+		}
+	}
+	return &day, &night
+}
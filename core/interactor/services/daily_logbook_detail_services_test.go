@@ -0,0 +1,631 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+)
+
+// stubDetailRepository is a minimal output.DailyLogbookDetailRepository for service tests
+type stubDetailRepository struct {
+	output.DailyLogbookDetailRepository
+	origin      *domain.Airport
+	destination *domain.Airport
+	totals      []domain.FlightTotals
+	candidates  []domain.DailyLogbookDetail
+	siblings    []domain.DailyLogbookDetail
+	references  *domain.SegmentReferences
+}
+
+func (r *stubDetailRepository) GetRouteAirports(ctx context.Context, airlineRouteID string) (*domain.Airport, *domain.Airport, error) {
+	return r.origin, r.destination, nil
+}
+
+func (r *stubDetailRepository) GetFlightTotals(ctx context.Context, filter domain.FlightTotalsFilter) ([]domain.FlightTotals, error) {
+	return r.totals, nil
+}
+
+func (r *stubDetailRepository) ListConflictCandidates(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyLogbookDetail, error) {
+	return r.candidates, nil
+}
+
+func (r *stubDetailRepository) ListDailyLogbookDetailsByLogbook(ctx context.Context, logbookID string) ([]domain.DailyLogbookDetail, error) {
+	return r.siblings, nil
+}
+
+func (r *stubDetailRepository) GetSegmentReferences(ctx context.Context, airlineRouteID, aircraftRegistrationID string) (*domain.SegmentReferences, error) {
+	return r.references, nil
+}
+
+// stubPriorExperienceRepository is a minimal output.PriorExperienceRepository for service tests
+type stubPriorExperienceRepository struct {
+	output.PriorExperienceRepository
+	entries []domain.PriorExperience
+}
+
+func (r *stubPriorExperienceRepository) ListPriorExperienceByEmployee(ctx context.Context, employeeID string) ([]domain.PriorExperience, error) {
+	return r.entries, nil
+}
+
+func TestDailyLogbookDetailService_ValidateTimeSequence(t *testing.T) {
+	svc := NewDailyLogbookDetailService(nil, nil, nil)
+
+	t.Run("accepts same-day segment", func(t *testing.T) {
+		if err := svc.ValidateTimeSequence("2024-03-10", "08:00", "08:15", "09:20", "09:30"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("accepts segment crossing midnight", func(t *testing.T) {
+		if err := svc.ValidateTimeSequence("2024-03-10", "23:10", "23:25", "00:50", "01:05"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("rejects equal consecutive times", func(t *testing.T) {
+		err := svc.ValidateTimeSequence("2024-03-10", "08:00", "08:00", "09:20", "09:30")
+		if !errors.Is(err, domain.ErrFlightInvalidTimeSequence) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightInvalidTimeSequence, err)
+		}
+	})
+
+	t.Run("rejects span longer than the maximum", func(t *testing.T) {
+		// takeoff before out can only be read as the next day, giving a ~24h block
+		err := svc.ValidateTimeSequence("2024-03-10", "10:00", "09:50", "11:00", "11:10")
+		if !errors.Is(err, domain.ErrFlightSegmentSpanExceeded) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightSegmentSpanExceeded, err)
+		}
+	})
+
+	t.Run("rejects invalid flight date", func(t *testing.T) {
+		err := svc.ValidateTimeSequence("10/03/2024", "08:00", "08:15", "09:20", "09:30")
+		if !errors.Is(err, domain.ErrFlightInvalidTimeSequence) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightInvalidTimeSequence, err)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_CalculateFlightTimes(t *testing.T) {
+	svc := NewDailyLogbookDetailService(nil, nil, nil)
+
+	t.Run("derives air and block time when not provided", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+		}
+
+		if err := svc.CalculateFlightTimes(&detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.AirTime != "01:05" {
+			t.Fatalf("expected air_time 01:05, got %s", detail.AirTime)
+		}
+		if detail.BlockTime != "01:30" {
+			t.Fatalf("expected block_time 01:30, got %s", detail.BlockTime)
+		}
+	})
+
+	t.Run("accepts matching client values in HH:MM:SS format", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00:00",
+			TakeoffTime:    "08:15:00",
+			LandingTime:    "09:20:00",
+			InTime:         "09:30:00",
+			AirTime:        "01:05:00",
+			BlockTime:      "01:30",
+		}
+
+		if err := svc.CalculateFlightTimes(&detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.AirTime != "01:05" || detail.BlockTime != "01:30" {
+			t.Fatalf("expected normalized 01:05/01:30, got %s/%s", detail.AirTime, detail.BlockTime)
+		}
+	})
+
+	t.Run("derives durations across midnight", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10T00:00:00-05:00",
+			OutTime:        "23:10:00",
+			TakeoffTime:    "23:25:00",
+			LandingTime:    "00:50:00",
+			InTime:         "01:05:00",
+		}
+
+		if err := svc.CalculateFlightTimes(&detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.AirTime != "01:25" {
+			t.Fatalf("expected air_time 01:25, got %s", detail.AirTime)
+		}
+		if detail.BlockTime != "01:55" {
+			t.Fatalf("expected block_time 01:55, got %s", detail.BlockTime)
+		}
+	})
+
+	t.Run("rejects block time that disagrees with OUT/IN", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+			BlockTime:      "12:30",
+		}
+
+		err := svc.CalculateFlightTimes(&detail)
+		if !errors.Is(err, domain.ErrFlightTimeMismatch) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightTimeMismatch, err)
+		}
+	})
+
+	t.Run("rejects malformed air time", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+			AirTime:        "1h05",
+		}
+
+		err := svc.CalculateFlightTimes(&detail)
+		if !errors.Is(err, domain.ErrFlightTimeMismatch) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightTimeMismatch, err)
+		}
+	})
+
+	t.Run("normalizes IFR time and rejects more than the block time", func(t *testing.T) {
+		ifr := "01:10:00"
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+			IFRTime:        &ifr,
+		}
+		if err := svc.CalculateFlightTimes(&detail); err != nil || *detail.IFRTime != "01:10" {
+			t.Fatalf("expected ifr_time 01:10, got %v (%v)", detail.IFRTime, err)
+		}
+
+		tooLong := "01:31"
+		detail.IFRTime = &tooLong
+		if err := svc.CalculateFlightTimes(&detail); !errors.Is(err, domain.ErrFlightInvalidIFRTime) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightInvalidIFRTime, err)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_NormalizeSegmentTimes(t *testing.T) {
+	repo := &stubDetailRepository{
+		origin:      &domain.Airport{IATACode: "BOG", TimeZone: "America/Bogota"},
+		destination: &domain.Airport{IATACode: "MAD", TimeZone: "Europe/Madrid"},
+	}
+	svc := NewDailyLogbookDetailService(repo, nil, nil)
+
+	t.Run("leaves UTC entries untouched", func(t *testing.T) {
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+		}
+
+		if err := svc.NormalizeSegmentTimes(context.Background(), &detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.OutTime != "08:00" || detail.TimeReference != domain.TimeReferenceUTC {
+			t.Fatalf("expected unchanged UTC detail, got %+v", detail)
+		}
+	})
+
+	t.Run("converts local times across time zones to UTC", func(t *testing.T) {
+		// BOG 22:00 (UTC-5) -> MAD 14:30 next day (UTC+1): 10h30 block
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "22:00",
+			TakeoffTime:    "22:20",
+			LandingTime:    "14:15",
+			InTime:         "14:30",
+			TimeReference:  domain.TimeReferenceLocal,
+		}
+
+		if err := svc.NormalizeSegmentTimes(context.Background(), &detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.FlightRealDate != "2024-03-11" || detail.OutTime != "03:00:00" || detail.InTime != "13:30:00" {
+			t.Fatalf("unexpected UTC values: %s %s-%s", detail.FlightRealDate, detail.OutTime, detail.InTime)
+		}
+		if err := svc.CalculateFlightTimes(&detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.BlockTime != "10:30" || detail.AirTime != "09:55" {
+			t.Fatalf("expected 10:30/09:55, got %s/%s", detail.BlockTime, detail.AirTime)
+		}
+	})
+
+	t.Run("rejects airports without time zone", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			origin:      &domain.Airport{IATACode: "BOG"},
+			destination: &domain.Airport{IATACode: "MDE", TimeZone: "America/Bogota"},
+		}, nil, nil)
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "08:00",
+			TakeoffTime:    "08:15",
+			LandingTime:    "09:20",
+			InTime:         "09:30",
+			TimeReference:  domain.TimeReferenceLocal,
+		}
+
+		err := svc.NormalizeSegmentTimes(context.Background(), &detail)
+		if !errors.Is(err, domain.ErrFlightTimeZoneUnavailable) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightTimeZoneUnavailable, err)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_CalculateNightTime(t *testing.T) {
+	lat, lon := 4.70, -74.15
+	mdeLat, mdeLon := 6.16, -75.42
+
+	t.Run("credits only the takeoff to PFTO", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			origin:      &domain.Airport{IATACode: "BOG", Latitude: &lat, Longitude: &lon},
+			destination: &domain.Airport{IATACode: "MDE", Latitude: &mdeLat, Longitude: &mdeLon},
+		}, nil, nil)
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "04:00",
+			TakeoffTime:    "04:15",
+			LandingTime:    "04:55",
+			InTime:         "05:05",
+			PilotRole:      domain.PilotRolePFTO,
+		}
+
+		if err := svc.CalculateNightTime(context.Background(), &detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.NightTime == nil || *detail.NightTime != "01:05" {
+			t.Fatalf("expected night_time 01:05, got %v", detail.NightTime)
+		}
+		if *detail.NightTakeoffs != 1 || *detail.DayTakeoffs != 0 || *detail.NightLandings != 0 || *detail.DayLandings != 0 {
+			t.Fatalf("unexpected counts: %d/%d takeoffs, %d/%d landings",
+				*detail.DayTakeoffs, *detail.NightTakeoffs, *detail.DayLandings, *detail.NightLandings)
+		}
+	})
+
+	t.Run("leaves night fields empty without coordinates", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			origin:      &domain.Airport{IATACode: "BOG"},
+			destination: &domain.Airport{IATACode: "MDE"},
+		}, nil, nil)
+		detail := domain.DailyLogbookDetail{
+			FlightRealDate: "2024-03-10",
+			OutTime:        "04:00",
+			TakeoffTime:    "04:15",
+			LandingTime:    "04:55",
+			InTime:         "05:05",
+			PilotRole:      domain.PilotRolePF,
+		}
+
+		if err := svc.CalculateNightTime(context.Background(), &detail); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.NightTime != nil || detail.NightLandings != nil {
+			t.Fatalf("expected empty night fields, got %v", detail.NightTime)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_GetFlightTotals(t *testing.T) {
+	svc := NewDailyLogbookDetailService(&stubDetailRepository{
+		totals: []domain.FlightTotals{
+			{Keys: map[domain.FlightTotalsGroupBy]string{domain.FlightTotalsByMonth: "2024-02"}, SegmentCount: 2, BlockTime: 3 * time.Hour, AirTime: 150 * time.Minute},
+			{Keys: map[domain.FlightTotalsGroupBy]string{domain.FlightTotalsByMonth: "2024-03"}, SegmentCount: 1, BlockTime: 90 * time.Minute, AirTime: 65 * time.Minute, DutyTime: 4 * time.Hour},
+		},
+	}, nil, &stubPriorExperienceRepository{})
+
+	report, err := svc.GetFlightTotals(context.Background(), domain.FlightTotalsFilter{
+		EmployeeID: "employee-1",
+		GroupBy:    []domain.FlightTotalsGroupBy{domain.FlightTotalsByMonth},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(report.Groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(report.Groups))
+	}
+	if report.Totals.SegmentCount != 3 || report.Totals.BlockTime != 270*time.Minute ||
+		report.Totals.AirTime != 215*time.Minute || report.Totals.DutyTime != 4*time.Hour {
+		t.Fatalf("unexpected overall totals: %+v", report.Totals)
+	}
+	if report.Prior != nil {
+		t.Fatalf("expected no prior experience, got %+v", report.Prior)
+	}
+}
+
+func TestDailyLogbookDetailService_GetFlightTotalsWithPriorExperience(t *testing.T) {
+	svc := NewDailyLogbookDetailService(&stubDetailRepository{
+		totals: []domain.FlightTotals{
+			{Keys: map[domain.FlightTotalsGroupBy]string{domain.FlightTotalsByMonth: "2024-02"}, SegmentCount: 2, BlockTime: 3 * time.Hour,
+				Columns: domain.LogbookColumnTimes{MultiPilot: 3 * time.Hour, SIC: 3 * time.Hour}},
+		},
+	}, nil, &stubPriorExperienceRepository{entries: []domain.PriorExperience{
+		{AsOfDate: "2023-12-31", Flights: 900, TotalTime: 2500 * time.Hour, NightTime: 400 * time.Hour,
+			Columns: domain.LogbookColumnTimes{MultiPilot: 2000 * time.Hour, SinglePilotSE: 500 * time.Hour, PIC: 500 * time.Hour, SIC: 2000 * time.Hour}},
+		{AsOfDate: "2024-06-30", Flights: 10, TotalTime: 20 * time.Hour},
+	}})
+
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	report, err := svc.GetFlightTotals(context.Background(), domain.FlightTotalsFilter{
+		EmployeeID: "employee-1",
+		To:         &to,
+		GroupBy:    []domain.FlightTotalsGroupBy{domain.FlightTotalsByMonth},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Only the balance dated within the range is added, to the totals but not to the groups
+	if report.Prior == nil || report.Prior.SegmentCount != 900 || report.Prior.BlockTime != 2500*time.Hour {
+		t.Fatalf("unexpected prior experience: %+v", report.Prior)
+	}
+	if len(report.Groups) != 1 || report.Groups[0].SegmentCount != 2 {
+		t.Fatalf("expected groups of logged segments only, got %+v", report.Groups)
+	}
+	if report.Totals.SegmentCount != 902 || report.Totals.BlockTime != 2503*time.Hour ||
+		report.Totals.Columns.MultiPilot != 2003*time.Hour || report.Totals.Columns.SIC != 2003*time.Hour ||
+		report.Totals.Columns.PIC != 500*time.Hour {
+		t.Fatalf("unexpected overall totals: %+v", report.Totals)
+	}
+}
+
+func TestBuildLogbookPages(t *testing.T) {
+	page1, page2 := 1, 2
+	night := "00:30"
+	details := []domain.DailyLogbookDetail{
+		{FlightRealDate: "2024-03-01", BookPage: &page1, BlockTime: "01:30", AirTime: "01:10"},
+		{FlightRealDate: "2024-03-01", BookPage: &page1, BlockTime: "02:00", AirTime: "01:40", NightTime: &night},
+		{FlightRealDate: "2024-03-02", BookPage: &page2, BlockTime: "01:00", AirTime: "00:45"},
+		{FlightRealDate: "2024-03-03", BlockTime: "00:30", AirTime: "00:20"},
+	}
+	opening := domain.LogbookTotals{Segments: 10, BlockTime: 20 * time.Hour}
+	filter := domain.LogbookExportFilter{From: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)}
+
+	pages := BuildLogbookPages(filter, opening, details)
+	if len(pages) != 3 {
+		t.Fatalf("expected 3 pages, got %d", len(pages))
+	}
+
+	if pages[0].BroughtForward.BlockTime != 20*time.Hour {
+		t.Errorf("page 1 brought forward: got %v", pages[0].BroughtForward.BlockTime)
+	}
+	if pages[0].PageTotals.Segments != 2 || pages[0].PageTotals.BlockTime != 210*time.Minute || pages[0].PageTotals.NightTime != 30*time.Minute {
+		t.Errorf("page 1 totals: got %+v", pages[0].PageTotals)
+	}
+	if pages[0].CarriedForward.BlockTime != 23*time.Hour+30*time.Minute || pages[0].CarriedForward.Segments != 12 {
+		t.Errorf("page 1 carried forward: got %+v", pages[0].CarriedForward)
+	}
+
+	// Each page brings forward what the previous carried forward
+	for i := 1; i < len(pages); i++ {
+		if pages[i].BroughtForward != pages[i-1].CarriedForward {
+			t.Errorf("page %d brought forward does not match previous carried forward", i+1)
+		}
+	}
+	if pages[2].BookPage != nil || pages[2].CarriedForward.BlockTime != 25*time.Hour {
+		t.Errorf("unassigned page: got %v %v", pages[2].BookPage, pages[2].CarriedForward.BlockTime)
+	}
+}
+
+func TestBuildLogbookPages_OutOfDateOrder(t *testing.T) {
+	page1, page2 := 1, 2
+	// Page 1 holds the 1st and the 5th, page 2 the 3rd and a flight of February; the 6th has no page
+	details := []domain.DailyLogbookDetail{
+		{ID: "d1", FlightRealDate: "2024-03-01", BookPage: &page1, BlockTime: "01:00", OutTime: "08:00"},
+		{ID: "d2", FlightRealDate: "2024-03-06", BlockTime: "00:30", OutTime: "08:00"},
+		{ID: "d3", FlightRealDate: "2024-03-03", BookPage: &page2, BlockTime: "03:00", OutTime: "14:00"},
+		{ID: "d4", FlightRealDate: "2024-02-20", BookPage: &page2, BlockTime: "01:30", OutTime: "09:00"},
+		{ID: "d5", FlightRealDate: "2024-03-05", BookPage: &page1, BlockTime: "02:00", OutTime: "08:00"},
+		{ID: "d6", FlightRealDate: "2024-03-03", BookPage: &page2, BlockTime: "00:45", OutTime: "09:00"},
+	}
+	// Opening totals cover the segments before the first one of page 1
+	opening := domain.LogbookTotals{Segments: 10, BlockTime: 20 * time.Hour}
+	filter := domain.LogbookExportFilter{From: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)}
+
+	pages := BuildLogbookPages(filter, opening, details)
+	want := []struct {
+		page     *int
+		segments int
+		block    time.Duration
+	}{
+		{&page1, 2, 3 * time.Hour},
+		{&page2, 3, 315 * time.Minute},
+		{nil, 1, 30 * time.Minute},
+	}
+	if len(pages) != len(want) {
+		t.Fatalf("expected %d pages, got %d", len(want), len(pages))
+	}
+	for i, w := range want {
+		if !sameBookPage(pages[i].BookPage, w.page) || pages[i].PageTotals.Segments != w.segments || pages[i].PageTotals.BlockTime != w.block {
+			t.Errorf("page %d: got page %v with %+v", i+1, pages[i].BookPage, pages[i].PageTotals)
+		}
+		if i > 0 && pages[i].BroughtForward != pages[i-1].CarriedForward {
+			t.Errorf("page %d brought forward does not match previous carried forward", i+1)
+		}
+	}
+	if got := []string{pages[1].Details[0].ID, pages[1].Details[1].ID, pages[1].Details[2].ID}; got[0] != "d4" || got[1] != "d6" || got[2] != "d3" {
+		t.Errorf("expected page 2 in flight date and OUT time order, got %v", got)
+	}
+
+	// Dates that disagree with the pages are reported, the segments stay on their page
+	if len(pages[0].Inconsistencies) != 0 || len(pages[2].Inconsistencies) != 0 {
+		t.Errorf("unexpected inconsistencies: %+v %+v", pages[0].Inconsistencies, pages[2].Inconsistencies)
+	}
+	if inc, ok := pages[1].Inconsistency("d4"); !ok || inc.Kind != domain.LogbookPageOutsideRange {
+		t.Errorf("expected d4 outside the range, got %+v", inc)
+	}
+	for _, id := range []string{"d6", "d3"} {
+		if inc, ok := pages[1].Inconsistency(id); !ok || inc.Kind != domain.LogbookPageOutOfDateOrder || inc.FlightRealDate != "2024-03-03" {
+			t.Errorf("expected %s out of date order, got %+v", id, inc)
+		}
+	}
+
+	// The last page carries forward the complete logbook
+	last := pages[len(pages)-1].CarriedForward
+	if last.Segments != 16 || last.BlockTime != 28*time.Hour+45*time.Minute {
+		t.Errorf("carried forward: got %d segments %v", last.Segments, last.BlockTime)
+	}
+	if details[1].ID != "d2" {
+		t.Error("expected the input order to be left untouched")
+	}
+}
+
+func TestDailyLogbookDetailService_CheckSegmentConflicts(t *testing.T) {
+	segment := func(id, flightNumber, aircraftID, date, out, off, on, in string) domain.DailyLogbookDetail {
+		return domain.DailyLogbookDetail{
+			ID: id, FlightNumber: flightNumber, ActualAircraftRegistrationID: aircraftID, FlightRealDate: date,
+			OutTime: out, TakeoffTime: off, LandingTime: on, InTime: in,
+		}
+	}
+	detail := segment("new", "AV120", "aircraft-1", "2024-03-10", "10:00", "10:15", "11:45", "12:00")
+
+	t.Run("duplicate flight number, date and aircraft", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			candidates: []domain.DailyLogbookDetail{segment("existing", "av120", "aircraft-1", "2024-03-10", "15:00", "15:10", "15:50", "16:00")},
+		}, nil, nil)
+		err := svc.CheckSegmentConflicts(context.Background(), "employee-1", detail, nil)
+		var conflict *domain.SegmentConflictError
+		if !errors.As(err, &conflict) || conflict.Kind != domain.SegmentConflictDuplicate || conflict.DetailID != "existing" {
+			t.Fatalf("expected duplicate of existing, got %v", err)
+		}
+		if !errors.Is(err, domain.ErrFlightDuplicateSegment) {
+			t.Fatalf("expected ErrFlightDuplicateSegment, got %v", err)
+		}
+	})
+
+	t.Run("overlap across midnight of the previous day", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			candidates: []domain.DailyLogbookDetail{segment("night", "AV900", "aircraft-2", "2024-03-09", "22:00", "22:15", "10:15", "10:30")},
+		}, nil, nil)
+		err := svc.CheckSegmentConflicts(context.Background(), "employee-1", detail, nil)
+		if !errors.Is(err, domain.ErrFlightOverlappingSegment) {
+			t.Fatalf("expected ErrFlightOverlappingSegment, got %v", err)
+		}
+	})
+
+	t.Run("duplicate of a segment in the same batch", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{}, nil, nil)
+		batch := []domain.DailyLogbookDetail{segment("row-2", "AV120", "aircraft-1", "2024-03-10", "10:00", "10:15", "11:45", "12:00")}
+		err := svc.CheckSegmentConflicts(context.Background(), "employee-1", detail, batch)
+		var conflict *domain.SegmentConflictError
+		if !errors.As(err, &conflict) || conflict.Kind != domain.SegmentConflictDuplicate || conflict.DetailID != "row-2" {
+			t.Fatalf("expected duplicate of row-2, got %v", err)
+		}
+	})
+
+	t.Run("adjacent segments and the segment itself do not clash", func(t *testing.T) {
+		svc := NewDailyLogbookDetailService(&stubDetailRepository{
+			candidates: []domain.DailyLogbookDetail{
+				segment("before", "AV119", "aircraft-1", "2024-03-10", "08:00", "08:15", "09:45", "10:00"),
+				segment("new", "AV120", "aircraft-1", "2024-03-10", "10:00", "10:15", "11:45", "12:00"),
+			},
+		}, nil, nil)
+		if err := svc.CheckSegmentConflicts(context.Background(), "employee-1", detail, nil); err != nil {
+			t.Fatalf("expected no conflict, got %v", err)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_CheckRouteContinuity(t *testing.T) {
+	leg := func(id, origin, destination, out, in string) domain.DailyLogbookDetail {
+		return domain.DailyLogbookDetail{
+			ID: id, FlightRealDate: "2024-03-10", OriginIataCode: origin, DestinationIataCode: destination,
+			OutTime: out, TakeoffTime: out + ":30", LandingTime: in, InTime: in + ":30",
+		}
+	}
+	svc := NewDailyLogbookDetailService(&stubDetailRepository{
+		origin:      &domain.Airport{IATACode: "MDE"},
+		destination: &domain.Airport{IATACode: "CTG"},
+		siblings: []domain.DailyLogbookDetail{
+			leg("first", "CLO", "BOG", "08:00", "09:00"),
+			leg("last", "CTG", "CLO", "14:00", "15:00"),
+		},
+	}, nil, nil)
+
+	warnings, err := svc.CheckRouteContinuity(context.Background(), leg("new", "", "", "11:00", "12:00"), nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %+v", warnings)
+	}
+	if warnings[0].Code != domain.MsgFlightRouteGap || warnings[0].DetailID != "first" ||
+		warnings[0].Params[0] != "BOG" || warnings[0].Params[1] != "MDE" {
+		t.Fatalf("unexpected warning: %+v", warnings[0])
+	}
+
+	t.Run("rows of the same import are legs of the day", func(t *testing.T) {
+		previous := leg("row-2", "BOG", "CLO", "09:30", "10:30")
+		otherDay := leg("row-3", "BOG", "BOG", "10:40", "10:50")
+		otherDay.DailyLogbookID = "logbook-2"
+		warnings, err := svc.CheckRouteContinuity(context.Background(), leg("new", "", "", "11:00", "12:00"),
+			[]domain.DailyLogbookDetail{previous, otherDay})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(warnings) != 1 || warnings[0].DetailID != "row-2" ||
+			warnings[0].Params[0] != "CLO" || warnings[0].Params[1] != "MDE" {
+			t.Fatalf("expected a gap after the batch row, got %+v", warnings)
+		}
+	})
+}
+
+func TestDailyLogbookDetailService_CheckSegmentReferences(t *testing.T) {
+	logbook := domain.DailyLogbook{ID: "logbook-1", LogDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+	active := domain.SegmentReferences{RouteAirlineID: "avianca", RouteActive: true, AirlineActive: true, AircraftAirlineID: "avianca"}
+	leased := active
+	leased.AircraftAirlineID = "latam"
+	inactiveRoute := active
+	inactiveRoute.RouteActive = false
+	inactiveAirline := active
+	inactiveAirline.AirlineActive = false
+
+	tests := []struct {
+		name       string
+		refs       domain.SegmentReferences
+		flightDate string
+		wetLease   bool
+		wantErr    error
+	}{
+		{name: "consistent segment", refs: active, flightDate: "2024-03-10"},
+		{name: "overnight flight date on the next day", refs: active, flightDate: "2024-03-11"},
+		{name: "flight date two days after the log date", refs: active, flightDate: "2024-03-12", wantErr: domain.ErrFlightDateOutsideLogDate},
+		{name: "aircraft of another airline", refs: leased, flightDate: "2024-03-10", wantErr: domain.ErrFlightAircraftNotInAirline},
+		{name: "wet lease aircraft of another airline", refs: leased, flightDate: "2024-03-10", wetLease: true},
+		{name: "inactive route", refs: inactiveRoute, flightDate: "2024-03-10", wantErr: domain.ErrFlightRouteInactive},
+		{name: "inactive airline", refs: inactiveAirline, flightDate: "2024-03-10", wantErr: domain.ErrFlightAirlineInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs := tt.refs
+			svc := NewDailyLogbookDetailService(&stubDetailRepository{references: &refs}, nil, nil)
+			detail := domain.DailyLogbookDetail{FlightRealDate: tt.flightDate, WetLease: tt.wetLease}
+			if err := svc.CheckSegmentReferences(context.Background(), detail, logbook); err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/output"
@@ -10,15 +11,17 @@ import (
 
 // DailyLogbookService implements the business logic for daily logbook operations
 type DailyLogbookService struct {
-	repo   output.DailyLogbookRepository
-	logger logger.Logger
+	repo    output.DailyLogbookRepository
+	history output.LogbookHistoryRepository
+	logger  logger.Logger
 }
 
 // NewDailyLogbookService creates a new daily logbook service
-func NewDailyLogbookService(repo output.DailyLogbookRepository, log logger.Logger) *DailyLogbookService {
+func NewDailyLogbookService(repo output.DailyLogbookRepository, history output.LogbookHistoryRepository, log logger.Logger) *DailyLogbookService {
 	return &DailyLogbookService{
-		repo:   repo,
-		logger: log,
+		repo:    repo,
+		history: history,
+		logger:  log,
 	}
 }
 
@@ -37,6 +40,16 @@ func (s *DailyLogbookService) ListDailyLogbooksByEmployee(ctx context.Context, e
 	return s.repo.ListDailyLogbooksByEmployee(ctx, employeeID, filters)
 }
 
+// GetDeletedDailyLogbookByID retrieves a soft deleted daily logbook by its ID
+func (s *DailyLogbookService) GetDeletedDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error) {
+	return s.repo.GetDeletedDailyLogbookByID(ctx, id)
+}
+
+// ListDeletedDailyLogbooks retrieves the soft deleted daily logbooks of an employee that can still be restored
+func (s *DailyLogbookService) ListDeletedDailyLogbooks(ctx context.Context, employeeID string) ([]domain.DailyLogbook, error) {
+	return s.repo.ListDeletedDailyLogbooksByEmployee(ctx, employeeID)
+}
+
 // CreateDailyLogbook creates a new daily logbook entry with transaction handling
 func (s *DailyLogbookService) CreateDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error {
 	tx, err := s.repo.BeginTx(ctx)
@@ -59,11 +72,22 @@ func (s *DailyLogbookService) CreateDailyLogbook(ctx context.Context, logbook do
 		return err
 	}
 
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookHistory(ctx, nil, &logbook)); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 // UpdateDailyLogbook updates an existing daily logbook with transaction handling
 func (s *DailyLogbookService) UpdateDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error {
+	before, err := s.repo.GetDailyLogbookByID(ctx, logbook.ID)
+	if err != nil {
+		return err
+	}
+	after := *before
+	after.LogDate, after.BookPage, after.Status = logbook.LogDate, logbook.BookPage, logbook.Status
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		return err
@@ -79,11 +103,51 @@ func (s *DailyLogbookService) UpdateDailyLogbook(ctx context.Context, logbook do
 		return err
 	}
 
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookHistory(ctx, before, &after)); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 // DeleteDailyLogbook deletes a daily logbook with transaction handling
 func (s *DailyLogbookService) DeleteDailyLogbook(ctx context.Context, id string) error {
+	before, err := s.repo.GetDailyLogbookByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = s.repo.DeleteDailyLogbook(ctx, tx, id, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookHistory(ctx, before, nil)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RestoreDailyLogbook brings back a soft deleted daily logbook and the segments deleted with it
+func (s *DailyLogbookService) RestoreDailyLogbook(ctx context.Context, id string) error {
+	deleted, err := s.repo.GetDeletedDailyLogbookByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	restored := *deleted
+	restored.DeletedAt = nil
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		return err
@@ -95,7 +159,11 @@ func (s *DailyLogbookService) DeleteDailyLogbook(ctx context.Context, id string)
 		}
 	}()
 
-	if err = s.repo.DeleteDailyLogbook(ctx, tx, id); err != nil {
+	if err = s.repo.RestoreDailyLogbook(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookRestoreHistory(ctx, &restored)); err != nil {
 		return err
 	}
 
@@ -104,6 +172,13 @@ func (s *DailyLogbookService) DeleteDailyLogbook(ctx context.Context, id string)
 
 // UpdateDailyLogbookStatus updates the status of a daily logbook with transaction handling
 func (s *DailyLogbookService) updateDailyLogbookStatus(ctx context.Context, id string, status bool) error {
+	before, err := s.repo.GetDailyLogbookByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	after := *before
+	after.Status = status
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		return err
@@ -119,6 +194,39 @@ func (s *DailyLogbookService) updateDailyLogbookStatus(ctx context.Context, id s
 		return err
 	}
 
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookHistory(ctx, before, &after)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateDailyLogbookState stores the sign-off state of a daily logbook with transaction handling
+func (s *DailyLogbookService) UpdateDailyLogbookState(ctx context.Context, logbook domain.DailyLogbook) error {
+	before, err := s.repo.GetDailyLogbookByID(ctx, logbook.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = s.repo.UpdateDailyLogbookState(ctx, tx, logbook); err != nil {
+		return err
+	}
+
+	if err = recordHistory(ctx, s.history, tx, domain.NewDailyLogbookHistory(ctx, before, &logbook)); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
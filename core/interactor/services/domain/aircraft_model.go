@@ -1,7 +1,10 @@
 package domain
 
+import "strconv"
+
 // AircraftModel represents the aircraft model domain model
-// Contains the model name, aircraft type, engine type, family and manufacturer
+// Contains the model name, aircraft type, engine type, family and manufacturer, and the category
+// attributes that decide the logbook columns its flight time is logged under
 type AircraftModel struct {
 	ID               string `json:"id"`
 	ModelName        string `json:"model_name"`
@@ -9,6 +12,9 @@ type AircraftModel struct {
 	EngineTypeName   string `json:"engine_type_name"`
 	Family           string `json:"family"`
 	Manufacturer     string `json:"manufacturer"`
+	MultiEngine      bool   `json:"multi_engine"` // Single-engine when false
+	Turbine          bool   `json:"turbine"`      // Piston when false
+	MultiPilot       bool   `json:"multi_pilot"`  // Certified for a minimum crew of two pilots
 }
 
 // ToLogger returns a slice of strings for logging aircraft model information
@@ -20,5 +26,8 @@ func (am *AircraftModel) ToLogger() []string {
 		"engine_type_name:" + am.EngineTypeName,
 		"family:" + am.Family,
 		"manufacturer:" + am.Manufacturer,
+		"multi_engine:" + strconv.FormatBool(am.MultiEngine),
+		"turbine:" + strconv.FormatBool(am.Turbine),
+		"multi_pilot:" + strconv.FormatBool(am.MultiPilot),
 	}
 }
@@ -1,14 +1,22 @@
 package domain
 
+import (
+	"time"
+	_ "time/tzdata" // embed the IANA database so airport time zones resolve in minimal containers
+)
+
 // Airport represents the airport domain model
 type Airport struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	City        string `json:"city"`
-	Country     string `json:"country"`
-	IATACode    string `json:"iata_code"`
-	Status      bool   `json:"status"`
-	AirportType string `json:"airport_type"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	City        string   `json:"city"`
+	Country     string   `json:"country"`
+	IATACode    string   `json:"iata_code"`
+	Status      bool     `json:"status"`
+	AirportType string   `json:"airport_type"`
+	TimeZone    string   `json:"time_zone"`           // IANA time zone (e.g., "America/Bogota")
+	Latitude    *float64 `json:"latitude,omitempty"`  // Decimal degrees, north positive
+	Longitude   *float64 `json:"longitude,omitempty"` // Decimal degrees, east positive
 }
 
 // ToLogger returns a slice of strings for logging airport information
@@ -31,3 +39,21 @@ func (a *Airport) ToLogger() []string {
 func (a *Airport) IsActive() bool {
 	return a.Status
 }
+
+// Location loads the airport IANA time zone
+// Returns ErrAirportTimeZoneMissing when the airport has no time zone configured
+func (a *Airport) Location() (*time.Location, error) {
+	if a.TimeZone == "" {
+		return nil, ErrAirportTimeZoneMissing
+	}
+	loc, err := time.LoadLocation(a.TimeZone)
+	if err != nil {
+		return nil, ErrAirportTimeZoneInvalid
+	}
+	return loc, nil
+}
+
+// HasCoordinates returns true if the airport latitude and longitude are known
+func (a *Airport) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
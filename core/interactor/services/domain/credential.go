@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CredentialType is the kind of document an employee must hold to fly
+type CredentialType string
+
+const (
+	CredentialLicence             CredentialType = "LICENCE"              // Pilot licence
+	CredentialMedical             CredentialType = "MEDICAL"              // Medical certificate
+	CredentialLanguageProficiency CredentialType = "LANGUAGE_PROFICIENCY" // ICAO language proficiency endorsement
+	CredentialRecurrentTraining   CredentialType = "RECURRENT_TRAINING"   // Recurrent training or check (CRM, dangerous goods, ...)
+)
+
+// ValidCredentialTypes contains all valid credential types
+var ValidCredentialTypes = []CredentialType{
+	CredentialLicence,
+	CredentialMedical,
+	CredentialLanguageProficiency,
+	CredentialRecurrentTraining,
+}
+
+// IsValidCredentialType checks if a string is a valid credential type
+func IsValidCredentialType(credentialType string) bool {
+	for _, t := range ValidCredentialTypes {
+		if string(t) == credentialType {
+			return true
+		}
+	}
+	return false
+}
+
+// CredentialStatus is the validity of a credential on a given day
+type CredentialStatus string
+
+const (
+	CredentialStatusValid    CredentialStatus = "VALID"
+	CredentialStatusExpiring CredentialStatus = "EXPIRING" // Expires within the warning days
+	CredentialStatusExpired  CredentialStatus = "EXPIRED"
+)
+
+// DefaultCredentialNoticeDays are the days before expiry an expiry notice is raised at
+var DefaultCredentialNoticeDays = []int{60, 30, 7}
+
+// MaxCredentialScanSize limits the attached scan (bytes)
+const MaxCredentialScanSize = 10 << 20
+
+// CredentialScanContentTypes are the accepted formats of the attached scan
+var CredentialScanContentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// CredentialScan is the scanned document attached to a credential. Content is only loaded when the scan
+// is downloaded.
+type CredentialScan struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     []byte
+	UploadedAt  time.Time
+}
+
+// Validate checks the size and format of the scan
+func (s *CredentialScan) Validate() error {
+	if s.FileName == "" || s.Size <= 0 || s.Size > MaxCredentialScanSize || int64(len(s.Content)) != s.Size {
+		return ErrCredentialInvalidScan
+	}
+	for _, contentType := range CredentialScanContentTypes {
+		if s.ContentType == contentType {
+			return nil
+		}
+	}
+	return ErrCredentialInvalidScan
+}
+
+// Credential is a licence, medical certificate, language proficiency endorsement or recurrent training
+// held by an employee, valid from its issue date until its optional expiry date (both inclusive)
+type Credential struct {
+	ID               string
+	EmployeeID       string
+	Type             CredentialType
+	Title            string  // e.g. ATPL(A), Class 1, ICAO English level 4, CRM
+	Number           *string // Licence or certificate number
+	IssuingAuthority *string
+	IssueDate        string          // YYYY-MM-DD
+	ExpiryDate       *string         // YYYY-MM-DD, nil when the credential does not expire
+	Scan             *CredentialScan // Attached scan, nil until uploaded
+	Remarks          *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	// From employee, populated on the airline expiry report
+	EmployeeName string
+}
+
+// SetID generates a new UUID for the credential
+func (c *Credential) SetID() {
+	c.ID = uuid.New().String()
+}
+
+// Validate checks the type and title and that the dates are well formed, with the expiry not before the
+// issue
+func (c *Credential) Validate() error {
+	if !IsValidCredentialType(string(c.Type)) || c.Title == "" {
+		return ErrCredentialInvalid
+	}
+	issue, err := time.Parse("2006-01-02", c.IssueDate)
+	if err != nil {
+		return ErrCredentialInvalid
+	}
+	if c.ExpiryDate != nil {
+		expiry, err := time.Parse("2006-01-02", *c.ExpiryDate)
+		if err != nil || expiry.Before(issue) {
+			return ErrCredentialInvalid
+		}
+	}
+	return nil
+}
+
+// DaysToExpiry returns the days from the day (a UTC date at midnight) to the expiry date, negative once
+// expired; false when the credential does not expire
+func (c *Credential) DaysToExpiry(day time.Time) (int, bool) {
+	if c.ExpiryDate == nil {
+		return 0, false
+	}
+	expiry, err := time.Parse("2006-01-02", *c.ExpiryDate)
+	if err != nil {
+		return 0, false
+	}
+	return int(expiry.Sub(day).Hours() / 24), true
+}
+
+// Status returns the validity of the credential on the day; it is EXPIRING within warningDays of the
+// expiry date. A credential expires at the end of its expiry date.
+func (c *Credential) Status(day time.Time, warningDays int) CredentialStatus {
+	days, expires := c.DaysToExpiry(day)
+	switch {
+	case !expires:
+		return CredentialStatusValid
+	case days < 0:
+		return CredentialStatusExpired
+	case days <= warningDays:
+		return CredentialStatusExpiring
+	default:
+		return CredentialStatusValid
+	}
+}
+
+// ToLogger returns a slice of strings for logging credential information
+func (c *Credential) ToLogger() []string {
+	return []string{
+		"id:" + c.ID,
+		"employee_id:" + c.EmployeeID,
+		"type:" + string(c.Type),
+		"title:" + c.Title,
+		"issue_date:" + c.IssueDate,
+		"expiry_date:" + stringPtrValue(c.ExpiryDate),
+	}
+}
+
+// CredentialExpiryReport lists an airline's credentials expired or expiring within WithinDays of AsOf,
+// soonest expiry first
+type CredentialExpiryReport struct {
+	AirlineID   string
+	AsOf        time.Time
+	WithinDays  int
+	Credentials []Credential
+}
+
+// SortCredentialsByExpiry orders credentials by expiry date, then employee name and title; credentials
+// without expiry go last
+func SortCredentialsByExpiry(credentials []Credential) {
+	sort.SliceStable(credentials, func(i, j int) bool {
+		a, b := credentials[i], credentials[j]
+		if (a.ExpiryDate == nil) != (b.ExpiryDate == nil) {
+			return b.ExpiryDate == nil
+		}
+		if a.ExpiryDate != nil && *a.ExpiryDate != *b.ExpiryDate {
+			return *a.ExpiryDate < *b.ExpiryDate
+		}
+		if a.EmployeeName != b.EmployeeName {
+			return a.EmployeeName < b.EmployeeName
+		}
+		return a.Title < b.Title
+	})
+}
+
+// CredentialExpiryNotices returns the notices due on the day for credentials expiring within the largest
+// of noticeDays. Each credential gets the notice of the smallest threshold it has reached, keyed so that
+// a notice is raised once per threshold and expiry date; renewing the credential starts over.
+func CredentialExpiryNotices(credentials []Credential, day time.Time, noticeDays []int) []Notification {
+	thresholds := append([]int(nil), noticeDays...)
+	sort.Ints(thresholds)
+
+	var notices []Notification
+	for _, c := range credentials {
+		days, expires := c.DaysToExpiry(day)
+		if !expires || days < 0 {
+			continue
+		}
+		for _, threshold := range thresholds {
+			if days > threshold {
+				continue
+			}
+			notices = append(notices, Notification{
+				EmployeeID:  c.EmployeeID,
+				Kind:        NotificationCredentialExpiry,
+				Code:        MsgCredentialExpiryNotice,
+				Params:      []string{c.Title, *c.ExpiryDate, strconv.Itoa(days)},
+				ReferenceID: c.ID,
+				DedupKey:    fmt.Sprintf("%s:%s:%s:%d", NotificationCredentialExpiry, c.ID, *c.ExpiryDate, threshold),
+			})
+			break
+		}
+	}
+	return notices
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCredential(t *testing.T) {
+	day := func(month, d int) time.Time {
+		return time.Date(2026, time.Month(month), d, 0, 0, 0, 0, time.UTC)
+	}
+	date := func(s string) *string { return &s }
+
+	t.Run("rejects invalid credentials", func(t *testing.T) {
+		cases := map[string]Credential{
+			"unknown type":        {Type: "PASSPORT", Title: "P", IssueDate: "2026-01-10"},
+			"missing title":       {Type: CredentialMedical, IssueDate: "2026-01-10"},
+			"malformed issue":     {Type: CredentialMedical, Title: "Class 1", IssueDate: "10/01/2026"},
+			"malformed expiry":    {Type: CredentialMedical, Title: "Class 1", IssueDate: "2026-01-10", ExpiryDate: date("2027-13-01")},
+			"expiry before issue": {Type: CredentialMedical, Title: "Class 1", IssueDate: "2026-01-10", ExpiryDate: date("2026-01-09")},
+		}
+		for name, c := range cases {
+			if err := c.Validate(); err != ErrCredentialInvalid {
+				t.Errorf("%s: expected %v, got %v", name, ErrCredentialInvalid, err)
+			}
+		}
+		c := Credential{Type: CredentialLicence, Title: "ATPL(A)", IssueDate: "2026-01-10"}
+		if err := c.Validate(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("status by days to expiry", func(t *testing.T) {
+		c := Credential{Type: CredentialMedical, Title: "Class 1", IssueDate: "2025-07-31", ExpiryDate: date("2026-07-31")}
+		cases := map[time.Time]struct {
+			days   int
+			status CredentialStatus
+		}{
+			day(5, 31): {61, CredentialStatusValid},
+			day(6, 1):  {60, CredentialStatusExpiring},
+			day(7, 31): {0, CredentialStatusExpiring},
+			day(8, 1):  {-1, CredentialStatusExpired},
+		}
+		for d, want := range cases {
+			days, expires := c.DaysToExpiry(d)
+			if !expires || days != want.days {
+				t.Errorf("%s: expected %d days, got %d (%v)", d.Format("2006-01-02"), want.days, days, expires)
+			}
+			if got := c.Status(d, 60); got != want.status {
+				t.Errorf("%s: expected %v, got %v", d.Format("2006-01-02"), want.status, got)
+			}
+		}
+
+		open := Credential{Type: CredentialLanguageProficiency, Title: "ICAO English level 6", IssueDate: "2020-01-01"}
+		if _, expires := open.DaysToExpiry(day(1, 1)); expires {
+			t.Error("expected a credential without expiry not to expire")
+		}
+		if got := open.Status(day(1, 1), 60); got != CredentialStatusValid {
+			t.Errorf("expected %v, got %v", CredentialStatusValid, got)
+		}
+	})
+
+	t.Run("notice of the smallest threshold reached", func(t *testing.T) {
+		credentials := []Credential{
+			{ID: "a", EmployeeID: "e1", Title: "Class 1", ExpiryDate: date("2026-03-31")}, // 58 days
+			{ID: "b", EmployeeID: "e1", Title: "CRM", ExpiryDate: date("2026-02-08")},     // 7 days
+			{ID: "c", EmployeeID: "e2", Title: "ATPL(A)", ExpiryDate: date("2026-06-01")}, // 120 days
+			{ID: "d", EmployeeID: "e2", Title: "DG", ExpiryDate: date("2026-01-31")},      // expired
+			{ID: "e", EmployeeID: "e2", Title: "ICAO English level 6"},
+		}
+		notices := CredentialExpiryNotices(credentials, day(2, 1), []int{7, 60, 30})
+		if len(notices) != 2 {
+			t.Fatalf("expected 2 notices, got %d", len(notices))
+		}
+
+		want := map[string]struct {
+			dedupKey string
+			days     string
+		}{
+			"a": {"CREDENTIAL_EXPIRY:a:2026-03-31:60", "58"},
+			"b": {"CREDENTIAL_EXPIRY:b:2026-02-08:7", "7"},
+		}
+		for _, n := range notices {
+			w, ok := want[n.ReferenceID]
+			if !ok {
+				t.Fatalf("unexpected notice for %s", n.ReferenceID)
+			}
+			if n.DedupKey != w.dedupKey {
+				t.Errorf("%s: expected dedup key %s, got %s", n.ReferenceID, w.dedupKey, n.DedupKey)
+			}
+			if n.Code != MsgCredentialExpiryNotice || n.Kind != NotificationCredentialExpiry || n.EmployeeID != "e1" {
+				t.Errorf("%s: unexpected notice %+v", n.ReferenceID, n)
+			}
+			if len(n.Params) != 3 || n.Params[2] != w.days {
+				t.Errorf("%s: expected %s days in params, got %v", n.ReferenceID, w.days, n.Params)
+			}
+		}
+	})
+
+	t.Run("sorted by expiry", func(t *testing.T) {
+		credentials := []Credential{
+			{Title: "ICAO English level 6"},
+			{Title: "CRM", EmployeeName: "Zapata", ExpiryDate: date("2026-03-01")},
+			{Title: "Class 1", EmployeeName: "Alvarez", ExpiryDate: date("2026-03-01")},
+			{Title: "ATPL(A)", EmployeeName: "Zapata", ExpiryDate: date("2026-02-01")},
+		}
+		SortCredentialsByExpiry(credentials)
+		order := []string{"ATPL(A)", "Class 1", "CRM", "ICAO English level 6"}
+		for i, title := range order {
+			if credentials[i].Title != title {
+				t.Errorf("position %d: expected %s, got %s", i, title, credentials[i].Title)
+			}
+		}
+	})
+
+	t.Run("scan size and format", func(t *testing.T) {
+		content := []byte("%PDF-1.4")
+		valid := CredentialScan{FileName: "medical.pdf", ContentType: "application/pdf", Size: int64(len(content)), Content: content}
+		if err := valid.Validate(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		cases := map[string]CredentialScan{
+			"empty":         {FileName: "medical.pdf", ContentType: "application/pdf"},
+			"size mismatch": {FileName: "medical.pdf", ContentType: "application/pdf", Size: 99, Content: content},
+			"format":        {FileName: "medical.txt", ContentType: "text/plain", Size: int64(len(content)), Content: content},
+			"too large":     {FileName: "medical.pdf", ContentType: "application/pdf", Size: MaxCredentialScanSize + 1, Content: make([]byte, MaxCredentialScanSize+1)},
+		}
+		for name, s := range cases {
+			if err := s.Validate(); err != ErrCredentialInvalidScan {
+				t.Errorf("%s: expected %v, got %v", name, ErrCredentialInvalidScan, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package domain
+
+import "time"
+
+// CurrencyLevel is the recency status of an employee against a rule
+type CurrencyLevel string
+
+const (
+	CurrencyLevelCurrent  CurrencyLevel = "CURRENT"
+	CurrencyLevelExpiring CurrencyLevel = "EXPIRING" // Current, but lapses within the warning days
+	CurrencyLevelExpired  CurrencyLevel = "EXPIRED"
+)
+
+// DefaultCurrencyWarningDays is how many days before lapsing a rule is reported as EXPIRING
+const DefaultCurrencyWarningDays = 14
+
+// CurrencyRule is a recency requirement evaluated per aircraft family,
+// e.g. 3 takeoffs and 3 landings within the last 90 days
+type CurrencyRule struct {
+	Code       string `json:"code"`        // Identifier shown to the user (e.g., "takeoff_landing_90d")
+	WindowDays int    `json:"window_days"` // Rolling window length in days, including the evaluation day
+	Takeoffs   int    `json:"takeoffs,omitempty"`
+	Landings   int    `json:"landings,omitempty"`
+	Approaches int    `json:"approaches,omitempty"` // Instrument approaches (NPA, PA, APV) flown as landing pilot
+	Night      bool   `json:"night,omitempty"`      // Only night takeoffs and landings count
+
+	// SimulatorDevices are the FSTD types whose sessions count toward the rule; none when empty
+	SimulatorDevices []SimulatorDeviceType `json:"simulator_devices,omitempty"`
+}
+
+// Credits reports whether an event counts toward the rule: every flight and prior experience balance, and
+// the simulator sessions flown in one of the rule's devices
+func (r CurrencyRule) Credits(e CurrencyEvent) bool {
+	if e.Device == "" {
+		return true
+	}
+	for _, d := range r.SimulatorDevices {
+		if d == e.Device {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultCurrencyRules returns the rules applied when none are configured
+func DefaultCurrencyRules() []CurrencyRule {
+	return []CurrencyRule{
+		{Code: "takeoff_landing_90d", WindowDays: 90, Takeoffs: 3, Landings: 3,
+			SimulatorDevices: []SimulatorDeviceType{SimulatorDeviceFFS}},
+		{Code: "night_90d", WindowDays: 90, Takeoffs: 3, Landings: 3, Night: true,
+			SimulatorDevices: []SimulatorDeviceType{SimulatorDeviceFFS}},
+		{Code: "instrument_90d", WindowDays: 90, Approaches: 3,
+			SimulatorDevices: []SimulatorDeviceType{SimulatorDeviceFFS, SimulatorDeviceFTD, SimulatorDeviceFNPT}},
+	}
+}
+
+// IsInstrumentApproach reports whether the approach type is flown on instruments
+func IsInstrumentApproach(approachType *ApproachType) bool {
+	if approachType == nil {
+		return false
+	}
+	switch *approachType {
+	case ApproachTypeNPA, ApproachTypePA, ApproachTypeAPV:
+		return true
+	}
+	return false
+}
+
+// CurrencyEvent is one segment, simulator session or prior experience balance of an employee as seen by
+// the currency rules
+type CurrencyEvent struct {
+	Date           time.Time
+	AircraftFamily string
+	PilotRole      PilotRole
+	ApproachType   *ApproachType
+	NightTakeoffs  int
+	NightLandings  int
+
+	// Simulator sessions and prior experience credit their recorded counts instead of one takeoff and
+	// landing per segment
+	Device             SimulatorDeviceType // Empty for flights and prior experience
+	Prior              bool                // Recent experience declared with the opening balances
+	RecordedTakeoffs   int
+	RecordedLandings   int
+	RecordedApproaches int
+}
+
+// recorded reports whether the event credits its recorded counts
+func (e CurrencyEvent) recorded() bool {
+	return e.Device != "" || e.Prior
+}
+
+// Takeoffs returns the takeoffs credited by the event (night takeoffs only when night is set)
+func (e CurrencyEvent) Takeoffs(night bool) int {
+	if !e.recorded() && !e.PilotRole.PerformsTakeoff() {
+		return 0
+	}
+	if night {
+		return e.NightTakeoffs
+	}
+	if e.recorded() {
+		return e.RecordedTakeoffs
+	}
+	return 1
+}
+
+// Landings returns the landings credited by the event (night landings only when night is set)
+func (e CurrencyEvent) Landings(night bool) int {
+	if !e.recorded() && !e.PilotRole.PerformsLanding() {
+		return 0
+	}
+	if night {
+		return e.NightLandings
+	}
+	if e.recorded() {
+		return e.RecordedLandings
+	}
+	return 1
+}
+
+// Approaches returns the instrument approaches credited by the event
+func (e CurrencyEvent) Approaches() int {
+	if e.recorded() {
+		return e.RecordedApproaches
+	}
+	if !e.PilotRole.PerformsLanding() || !IsInstrumentApproach(e.ApproachType) {
+		return 0
+	}
+	return 1
+}
+
+// CurrencyRuleStatus is the status of one rule for one aircraft family
+type CurrencyRuleStatus struct {
+	Rule           CurrencyRule
+	AircraftFamily string
+	Takeoffs       int // Credited takeoffs within the window
+	Landings       int // Credited landings within the window
+	Approaches     int // Credited instrument approaches within the window
+	Level          CurrencyLevel
+	ExpiresOn      *time.Time // Last day the rule is met; in the past when expired, nil when never met
+}
+
+// CurrencyStatus is the status of every rule for each aircraft family flown by an employee
+type CurrencyStatus struct {
+	EmployeeID string
+	AsOf       time.Time
+	Rules      []CurrencyRuleStatus
+}
@@ -12,9 +12,21 @@ type DailyLogbook struct {
 	LogDate    time.Time `json:"log_date"`
 	EmployeeID string    `json:"employee_id"`
 	BookPage   *int      `json:"book_page,omitempty"`
-	Status     bool      `json:"status"`
+	Status     bool      `json:"status"` // Active/inactive; the sign-off workflow uses State
+
+	// Sign-off workflow (see logbook_signoff.go)
+	State       LogbookState `json:"state"`
+	SubmittedAt *time.Time   `json:"submitted_at,omitempty"`
+	SignedAt    *time.Time   `json:"signed_at,omitempty"`
+	SignedBy    *string      `json:"signed_by,omitempty"` // Employee who signed (or re-signed after an amendment)
+	LockedAt    *time.Time   `json:"locked_at,omitempty"`
+
+	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft deleted: restorable until purged
 }
 
+// DefaultDeletedRetention is how long deleted logbooks and segments can be restored before they are purged
+const DefaultDeletedRetention = 30 * 24 * time.Hour
+
 // SetID generates a new UUID for the logbook
 func (d *DailyLogbook) SetID() {
 	d.ID = uuid.New().String()
@@ -26,6 +38,7 @@ func (d *DailyLogbook) ToLogger() []string {
 		"id:" + d.ID,
 		"employee_id:" + d.EmployeeID,
 		"log_date:" + d.LogDate.Format("2006-01-02"),
+		"state:" + string(d.CurrentState()),
 	}
 }
 
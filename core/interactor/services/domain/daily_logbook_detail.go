@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // PilotRole represents the role of the pilot in a flight segment
 type PilotRole string
@@ -12,6 +16,17 @@ const (
 	PilotRolePFL  PilotRole = "PFL"  // Pilot Flying Landing
 )
 
+// PilotFunction is the capacity the pilot logs the segment in (function time columns of the logbook)
+type PilotFunction string
+
+const (
+	PilotFunctionPIC        PilotFunction = "PIC"        // Pilot in command
+	PilotFunctionSIC        PilotFunction = "SIC"        // Co-pilot (second in command)
+	PilotFunctionDual       PilotFunction = "DUAL"       // Dual instruction received
+	PilotFunctionInstructor PilotFunction = "INSTRUCTOR" // Flight instructor
+	PilotFunctionPICUS      PilotFunction = "PICUS"      // Pilot in command under supervision
+)
+
 // ApproachType represents the type of approach during landing
 type ApproachType string
 
@@ -22,12 +37,38 @@ const (
 	ApproachTypeVisual ApproachType = "VISUAL" // Visual Approach
 )
 
+// TimeReference declares how the OUT/OFF/ON/IN times of a segment were entered
+type TimeReference string
+
+const (
+	TimeReferenceUTC   TimeReference = "UTC"   // All times in UTC (default)
+	TimeReferenceLocal TimeReference = "LOCAL" // OUT/OFF in origin local time, ON/IN in destination local time
+)
+
 // ValidPilotRoles contains all valid pilot roles
 var ValidPilotRoles = []PilotRole{PilotRolePF, PilotRolePM, PilotRolePFTO, PilotRolePFL}
 
+// ValidPilotFunctions contains all valid pilot functions
+var ValidPilotFunctions = []PilotFunction{PilotFunctionPIC, PilotFunctionSIC, PilotFunctionDual, PilotFunctionInstructor, PilotFunctionPICUS}
+
 // ValidApproachTypes contains all valid approach types
 var ValidApproachTypes = []ApproachType{ApproachTypeNPA, ApproachTypePA, ApproachTypeAPV, ApproachTypeVisual}
 
+// PerformsTakeoff returns true if the role flies the takeoff (PF or PFTO)
+func (r PilotRole) PerformsTakeoff() bool {
+	return r == PilotRolePF || r == PilotRolePFTO
+}
+
+// PerformsLanding returns true if the role flies the landing (PF or PFL)
+func (r PilotRole) PerformsLanding() bool {
+	return r == PilotRolePF || r == PilotRolePFL
+}
+
+// IsPilotFlying returns true if the role flies any part of the segment (PF, PFTO or PFL)
+func (r PilotRole) IsPilotFlying() bool {
+	return r == PilotRolePF || r == PilotRolePFTO || r == PilotRolePFL
+}
+
 // IsValidPilotRole checks if a string is a valid pilot role
 func IsValidPilotRole(role string) bool {
 	for _, r := range ValidPilotRoles {
@@ -38,6 +79,27 @@ func IsValidPilotRole(role string) bool {
 	return false
 }
 
+// IsValidPilotFunction checks if a string is a valid pilot function
+func IsValidPilotFunction(function string) bool {
+	if function == "" {
+		return true // NULL is valid
+	}
+	for _, f := range ValidPilotFunctions {
+		if string(f) == function {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidTimeReference checks if a string is a valid time reference
+func IsValidTimeReference(ref string) bool {
+	if ref == "" {
+		return true // Defaults to UTC
+	}
+	return ref == string(TimeReferenceUTC) || ref == string(TimeReferenceLocal)
+}
+
 // IsValidApproachType checks if a string is a valid approach type
 func IsValidApproachType(approachType string) bool {
 	if approachType == "" {
@@ -62,39 +124,84 @@ type DailyLogbookDetail struct {
 	ActualAircraftRegistrationID string `json:"actual_aircraft_registration_id"`
 	Passengers                   *int   `json:"passengers,omitempty"`
 
-	// Flight times (stored as TIME format HH:MM)
+	// Flight times (stored as TIME format HH:MM, always in UTC)
+	// Times are relative to FlightRealDate; a time earlier than the previous one belongs to the next day
 	OutTime     string `json:"out_time"`     // Hora salida de bloque (OUT)
 	TakeoffTime string `json:"takeoff_time"` // Hora despegue (OFF)
 	LandingTime string `json:"landing_time"` // Hora aterrizaje (ON)
 	InTime      string `json:"in_time"`      // Hora llegada a bloque (IN)
 
-	// Pilot role and companion
-	PilotRole     PilotRole `json:"pilot_role"`
-	CompanionName *string   `json:"companion_name,omitempty"`
+	// TimeReference is the mode the times were entered in; not persisted, times are normalized to UTC before saving
+	TimeReference TimeReference `json:"-"`
+
+	// OverrideConflicts accepts the segment even if it duplicates or overlaps another one of the employee;
+	// request-only, not persisted
+	OverrideConflicts bool `json:"-"`
+
+	// Pilot role and companion; a companion referenced by employee ID is offered a mirrored entry
+	PilotRole           PilotRole `json:"pilot_role"`
+	CompanionName       *string   `json:"companion_name,omitempty"`
+	CompanionEmployeeID *string   `json:"companion_employee_id,omitempty"`
+
+	// PilotFunction is the function time column the block time is logged under (PIC, SIC, ...)
+	PilotFunction *PilotFunction `json:"pilot_function,omitempty"`
 
 	// Calculated times (stored as TIME format HH:MM)
 	AirTime   string  `json:"air_time"`            // Tiempo de vuelo (ON - OFF)
 	BlockTime string  `json:"block_time"`          // Tiempo de bloque (IN - OUT)
 	DutyTime  *string `json:"duty_time,omitempty"` // Tiempo de servicio (DUTY)
+	IFRTime   *string `json:"ifr_time,omitempty"`  // Tiempo bajo reglas de vuelo por instrumentos, registrado por el piloto
+
+	// DutyPeriodID is the duty period the segment was flown in; linked through the duty period, which then
+	// replaces DutyTime for duty accounting
+	DutyPeriodID *string `json:"duty_period_id,omitempty"`
 
 	// Approach and flight type
 	ApproachType      *ApproachType `json:"approach_type,omitempty"`
 	FlightType        *string       `json:"flight_type,omitempty"` // 'COMMERCIAL', 'TRAINING', 'FERRY', 'CHECK', 'POSITIONING'
 	EmployeeLogbookID *string       `json:"employee_logbook_id,omitempty"`
 
+	// WetLease marks a segment flown on an aircraft leased with crew from another airline,
+	// so the registration does not have to belong to the route's airline
+	WetLease bool `json:"wet_lease"`
+
+	// Night flying (derived from airport coordinates using civil twilight; nil when coordinates are unknown)
+	NightTime     *string `json:"night_time,omitempty"`     // Porción nocturna del tiempo de bloque (HH:MM)
+	DayTakeoffs   *int    `json:"day_takeoffs,omitempty"`   // Despegues diurnos realizados por el piloto
+	NightTakeoffs *int    `json:"night_takeoffs,omitempty"` // Despegues nocturnos realizados por el piloto
+	DayLandings   *int    `json:"day_landings,omitempty"`   // Aterrizajes diurnos realizados por el piloto
+	NightLandings *int    `json:"night_landings,omitempty"` // Aterrizajes nocturnos realizados por el piloto
+
+	// ChainHash links the segment into the employee's tamper-evident chain of signed segments;
+	// nil while the logbook has not been signed (see logbook_chain.go)
+	ChainHash *string `json:"chain_hash,omitempty"`
+
+	// DeletedAt is set on soft deleted segments, restorable until purged
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// Anomalies are the block/air times outside the tolerance around the route estimate; computed on read, not persisted
+	Anomalies []FlightTimeAnomaly `json:"-"`
+
 	// Denormalized fields for display (populated via JOINs)
 	// From daily_logbook
-	LogDate string `json:"log_date,omitempty"`
+	LogDate  string `json:"log_date,omitempty"`
+	BookPage *int   `json:"book_page,omitempty"`
 
 	// From airline_route -> route -> airports
 	RouteCode           string `json:"route_code,omitempty"`            // e.g., "BOG-CLO"
 	OriginIataCode      string `json:"origin_iata_code,omitempty"`      // Origin airport IATA
 	DestinationIataCode string `json:"destination_iata_code,omitempty"` // Destination airport IATA
 	AirlineCode         string `json:"airline_code,omitempty"`          // Airline IATA code
+	OriginTimeZone      string `json:"origin_time_zone,omitempty"`      // Origin airport IANA time zone
+	DestinationTimeZone string `json:"destination_time_zone,omitempty"` // Destination airport IANA time zone
+	EstimatedFlightTime string `json:"estimated_flight_time,omitempty"` // Route estimate (HH:MM:SS)
 
 	// From aircraft_registration -> aircraft_model
 	LicensePlate string `json:"license_plate,omitempty"` // Aircraft registration
 	ModelName    string `json:"model_name,omitempty"`    // Aircraft model name
+	MultiEngine  bool   `json:"multi_engine,omitempty"`  // Aircraft category, for the logbook columns
+	Turbine      bool   `json:"turbine,omitempty"`
+	MultiPilot   bool   `json:"multi_pilot,omitempty"`
 }
 
 // SetID generates a new UUID for the detail
@@ -102,6 +209,11 @@ func (d *DailyLogbookDetail) SetID() {
 	d.ID = uuid.New().String()
 }
 
+// SegmentTimes resolves the OUT/OFF/ON/IN times against FlightRealDate, applying day rollover
+func (d *DailyLogbookDetail) SegmentTimes() (*SegmentTimes, error) {
+	return ResolveSegmentTimes(d.FlightRealDate, d.OutTime, d.TakeoffTime, d.LandingTime, d.InTime)
+}
+
 // ToLogger returns a slice of strings for logging purposes
 func (d *DailyLogbookDetail) ToLogger() []string {
 	pilotRole := string(d.PilotRole)
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxDutyPeriodSpan is the longest report-to-release span accepted for a single duty period
+const MaxDutyPeriodSpan = 24 * time.Hour
+
+// DutyPeriod groups the segments an employee flew between reporting for duty and being released from it.
+// It replaces the free-form duty time of each segment for duty accounting: the FTL duty limits count the
+// duty period on its report date, and the duty time of its linked segments is ignored.
+type DutyPeriod struct {
+	ID          string
+	EmployeeID  string
+	ReportTime  time.Time // UTC instant the employee reported for duty
+	ReleaseTime time.Time // UTC instant the employee was released from duty
+	SplitDuty   bool      // The duty was interrupted by a break on the ground
+	Standby     bool      // Airport or home standby counted as duty
+	Remarks     *string
+
+	// SegmentIDs are the segments to link on create/update; request-only, not persisted
+	SegmentIDs []string
+
+	// Segments are the linked segments in OUT order; loaded on read
+	Segments []DutyPeriodSegment
+
+	// RestBefore is the rest since the release of the employee's previous duty period, nil for the first one;
+	// computed on read, not persisted
+	RestBefore *time.Duration
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DutyPeriodSegment is a flight segment as seen by a duty period, with its block instants resolved
+type DutyPeriodSegment struct {
+	ID             string
+	EmployeeID     string // Owner of the segment's logbook
+	DailyLogbookID string
+	LogbookState   LogbookState // Sign-off state of the segment's logbook
+	DutyPeriodID   *string      // Duty period the segment is linked to, nil when unlinked
+	FlightNumber   string
+	FlightRealDate string // YYYY-MM-DD
+	RouteCode      string
+	Out            time.Time
+	In             time.Time
+}
+
+// BlockTime returns the block duration (IN - OUT) of the segment
+func (s *DutyPeriodSegment) BlockTime() time.Duration {
+	return s.In.Sub(s.Out)
+}
+
+// IsLogbookEditable reports whether the segment's logbook is still a draft, so its duty period link can change
+func (s *DutyPeriodSegment) IsLogbookEditable() bool {
+	logbook := DailyLogbook{State: s.LogbookState}
+	return logbook.IsEditable()
+}
+
+// DutyPeriodFilter defines the employee and optional date range of a duty periods query
+type DutyPeriodFilter struct {
+	EmployeeID string
+	From       *time.Time // Inclusive, compared against the UTC report date
+	To         *time.Time // Inclusive, compared against the UTC report date
+}
+
+// SetID generates a new UUID for the duty period
+func (p *DutyPeriod) SetID() {
+	p.ID = uuid.New().String()
+}
+
+// DutyTime returns the duty duration, from report to release
+func (p *DutyPeriod) DutyTime() time.Duration {
+	return p.ReleaseTime.Sub(p.ReportTime)
+}
+
+// FlightDutyPeriod returns the flight duty period (FDP), from report to the IN of the last linked segment;
+// zero when no segment is linked
+func (p *DutyPeriod) FlightDutyPeriod() time.Duration {
+	var lastIn time.Time
+	for _, s := range p.Segments {
+		if s.In.After(lastIn) {
+			lastIn = s.In
+		}
+	}
+	if lastIn.IsZero() {
+		return 0
+	}
+	return lastIn.Sub(p.ReportTime)
+}
+
+// BlockTime returns the block time of the linked segments
+func (p *DutyPeriod) BlockTime() time.Duration {
+	var total time.Duration
+	for _, s := range p.Segments {
+		total += s.BlockTime()
+	}
+	return total
+}
+
+// ReportDate returns the UTC calendar date of the report time, the day its duty is counted on
+func (p *DutyPeriod) ReportDate() time.Time {
+	return time.Date(p.ReportTime.Year(), p.ReportTime.Month(), p.ReportTime.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Validate checks that the release follows the report within MaxDutyPeriodSpan
+func (p *DutyPeriod) Validate() error {
+	if p.ReportTime.IsZero() || !p.ReleaseTime.After(p.ReportTime) || p.DutyTime() > MaxDutyPeriodSpan {
+		return ErrDutyPeriodInvalid
+	}
+	return nil
+}
+
+// LinkSegments sets the segments of the duty period, in OUT order. Every segment must belong to the
+// employee, not be linked to another duty period and be flown between report and release.
+func (p *DutyPeriod) LinkSegments(segments []DutyPeriodSegment) error {
+	for _, s := range segments {
+		if s.EmployeeID != p.EmployeeID || (s.DutyPeriodID != nil && *s.DutyPeriodID != p.ID) {
+			return ErrDutyPeriodInvalidSegment
+		}
+		if s.Out.Before(p.ReportTime) || s.In.After(p.ReleaseTime) {
+			return ErrDutyPeriodSegmentOutside
+		}
+	}
+
+	p.Segments = append([]DutyPeriodSegment(nil), segments...)
+	sort.SliceStable(p.Segments, func(a, b int) bool { return p.Segments[a].Out.Before(p.Segments[b].Out) })
+	return nil
+}
+
+// RelinkedDutyPeriodSegments returns the segments whose link changes when the segments linked to a duty
+// period are replaced: the linked ones no longer requested, then the requested ones not linked yet
+func RelinkedDutyPeriodSegments(linked, requested []DutyPeriodSegment) []DutyPeriodSegment {
+	isLinked := make(map[string]bool, len(linked))
+	for _, s := range linked {
+		isLinked[s.ID] = true
+	}
+	isRequested := make(map[string]bool, len(requested))
+	for _, s := range requested {
+		isRequested[s.ID] = true
+	}
+
+	var relinked []DutyPeriodSegment
+	for _, s := range linked {
+		if !isRequested[s.ID] {
+			relinked = append(relinked, s)
+		}
+	}
+	for _, s := range requested {
+		if !isLinked[s.ID] {
+			relinked = append(relinked, s)
+		}
+	}
+	return relinked
+}
+
+// ComputeDutyPeriodRest sets the rest before each of the chronologically ordered periods. previous is the
+// employee's duty period right before the first one, nil when there is none.
+func ComputeDutyPeriodRest(previous *DutyPeriod, periods []DutyPeriod) {
+	for i := range periods {
+		if previous != nil {
+			rest := periods[i].ReportTime.Sub(previous.ReleaseTime)
+			periods[i].RestBefore = &rest
+		}
+		previous = &periods[i]
+	}
+}
+
+// ToLogger returns the duty period fields for structured logging
+func (p *DutyPeriod) ToLogger() []string {
+	return []string{
+		"id:" + p.ID,
+		"employee_id:" + p.EmployeeID,
+		"report_time:" + p.ReportTime.UTC().Format(time.RFC3339),
+		"release_time:" + p.ReleaseTime.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDutyPeriod(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	valid := func() DutyPeriod {
+		return DutyPeriod{
+			ID:          "dp-1",
+			EmployeeID:  "emp-1",
+			ReportTime:  at(1, 5, 0),
+			ReleaseTime: at(1, 15, 30),
+		}
+	}
+
+	t.Run("rejects invalid spans", func(t *testing.T) {
+		cases := map[string]func(*DutyPeriod){
+			"missing report":     func(p *DutyPeriod) { p.ReportTime = time.Time{} },
+			"release at report":  func(p *DutyPeriod) { p.ReleaseTime = p.ReportTime },
+			"release before":     func(p *DutyPeriod) { p.ReleaseTime = at(1, 4, 0) },
+			"span above maximum": func(p *DutyPeriod) { p.ReleaseTime = at(2, 5, 1) },
+		}
+		for name, mutate := range cases {
+			p := valid()
+			mutate(&p)
+			if err := p.Validate(); err != ErrDutyPeriodInvalid {
+				t.Errorf("%s: expected %v, got %v", name, ErrDutyPeriodInvalid, err)
+			}
+		}
+		p := valid()
+		if err := p.Validate(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("computes duty, FDP and block time", func(t *testing.T) {
+		p := valid()
+		err := p.LinkSegments([]DutyPeriodSegment{
+			{ID: "s2", EmployeeID: "emp-1", Out: at(1, 10, 0), In: at(1, 12, 45)},
+			{ID: "s1", EmployeeID: "emp-1", Out: at(1, 6, 0), In: at(1, 8, 15)},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if p.Segments[0].ID != "s1" {
+			t.Errorf("expected segments in OUT order, got %s first", p.Segments[0].ID)
+		}
+		if got := p.DutyTime(); got != 10*time.Hour+30*time.Minute {
+			t.Errorf("duty time: got %v", got)
+		}
+		if got := p.FlightDutyPeriod(); got != 7*time.Hour+45*time.Minute {
+			t.Errorf("flight duty period: got %v", got)
+		}
+		if got := p.BlockTime(); got != 5*time.Hour {
+			t.Errorf("block time: got %v", got)
+		}
+	})
+
+	t.Run("no FDP without segments", func(t *testing.T) {
+		p := valid()
+		p.Standby = true
+		if got := p.FlightDutyPeriod(); got != 0 {
+			t.Errorf("expected no flight duty period, got %v", got)
+		}
+	})
+
+	t.Run("rejects segments that cannot be linked", func(t *testing.T) {
+		other := "dp-2"
+		own := "dp-1"
+		cases := map[string]struct {
+			segment DutyPeriodSegment
+			want    error
+		}{
+			"other employee":    {DutyPeriodSegment{EmployeeID: "emp-2", Out: at(1, 6, 0), In: at(1, 8, 0)}, ErrDutyPeriodInvalidSegment},
+			"other duty period": {DutyPeriodSegment{EmployeeID: "emp-1", DutyPeriodID: &other, Out: at(1, 6, 0), In: at(1, 8, 0)}, ErrDutyPeriodInvalidSegment},
+			"out before report": {DutyPeriodSegment{EmployeeID: "emp-1", Out: at(1, 4, 30), In: at(1, 8, 0)}, ErrDutyPeriodSegmentOutside},
+			"in after release":  {DutyPeriodSegment{EmployeeID: "emp-1", Out: at(1, 14, 0), In: at(1, 16, 0)}, ErrDutyPeriodSegmentOutside},
+			"already linked":    {DutyPeriodSegment{EmployeeID: "emp-1", DutyPeriodID: &own, Out: at(1, 6, 0), In: at(1, 8, 0)}, nil},
+		}
+		for name, tc := range cases {
+			p := valid()
+			if err := p.LinkSegments([]DutyPeriodSegment{tc.segment}); err != tc.want {
+				t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+			}
+		}
+	})
+
+	t.Run("relinked segments and their logbook state", func(t *testing.T) {
+		own := "dp-1"
+		linked := []DutyPeriodSegment{
+			{ID: "s-1", DutyPeriodID: &own, LogbookState: LogbookStateSigned},
+			{ID: "s-2", DutyPeriodID: &own},
+		}
+		requested := []DutyPeriodSegment{linked[0], {ID: "s-3", LogbookState: LogbookStateDraft}}
+		relinked := RelinkedDutyPeriodSegments(linked, requested)
+		if len(relinked) != 2 || relinked[0].ID != "s-2" || relinked[1].ID != "s-3" {
+			t.Fatalf("expected s-2 unlinked and s-3 linked, got %+v", relinked)
+		}
+		if !relinked[0].IsLogbookEditable() || !relinked[1].IsLogbookEditable() || linked[0].IsLogbookEditable() {
+			t.Errorf("expected only draft logbooks (or none recorded) to be editable")
+		}
+	})
+
+	t.Run("rest between consecutive periods", func(t *testing.T) {
+		previous := DutyPeriod{ReportTime: at(1, 5, 0), ReleaseTime: at(1, 15, 30)}
+		periods := []DutyPeriod{
+			{ReportTime: at(2, 6, 0), ReleaseTime: at(2, 14, 0)},
+			{ReportTime: at(3, 4, 0), ReleaseTime: at(3, 12, 0)},
+		}
+		ComputeDutyPeriodRest(&previous, periods)
+		if periods[0].RestBefore == nil || *periods[0].RestBefore != 14*time.Hour+30*time.Minute {
+			t.Errorf("first rest: got %v", periods[0].RestBefore)
+		}
+		if periods[1].RestBefore == nil || *periods[1].RestBefore != 14*time.Hour {
+			t.Errorf("second rest: got %v", periods[1].RestBefore)
+		}
+
+		first := []DutyPeriod{{ReportTime: at(2, 6, 0), ReleaseTime: at(2, 14, 0)}}
+		ComputeDutyPeriodRest(nil, first)
+		if first[0].RestBefore != nil {
+			t.Errorf("expected no rest before the first duty period, got %v", *first[0].RestBefore)
+		}
+	})
+}
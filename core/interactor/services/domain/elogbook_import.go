@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ElogbookFormat identifies the export format of a third-party electronic logbook
+type ElogbookFormat string
+
+const (
+	ElogbookFormatForeFlight  ElogbookFormat = "foreflight"  // ForeFlight logbook CSV export (Flights Table)
+	ElogbookFormatLogTen      ElogbookFormat = "logten"      // LogTen Pro tab separated export
+	ElogbookFormatMccPilotLog ElogbookFormat = "mccpilotlog" // mccPILOTLOG CSV export
+	ElogbookFormatCustom      ElogbookFormat = "custom"      // Any CSV described entirely by a field map
+)
+
+// IsValidElogbookFormat checks if the format has an adapter
+func IsValidElogbookFormat(format string) bool {
+	switch ElogbookFormat(format) {
+	case ElogbookFormatForeFlight, ElogbookFormatLogTen, ElogbookFormatMccPilotLog, ElogbookFormatCustom:
+		return true
+	}
+	return false
+}
+
+// ImportMappingKind is the kind of reference a mapping decision resolves
+type ImportMappingKind string
+
+const (
+	ImportMappingAirport  ImportMappingKind = "AIRPORT"  // Source airport code -> airport ID
+	ImportMappingAircraft ImportMappingKind = "AIRCRAFT" // Source registration -> aircraft registration ID
+	ImportMappingRoute    ImportMappingKind = "ROUTE"    // Source route (ORIGIN-DESTINATION) -> airline route ID
+)
+
+// IsValidImportMappingKind checks if the mapping kind is supported
+func IsValidImportMappingKind(kind string) bool {
+	switch ImportMappingKind(kind) {
+	case ImportMappingAirport, ImportMappingAircraft, ImportMappingRoute:
+		return true
+	}
+	return false
+}
+
+// ImportMapping is a mapping decision remembered for an employee, applied automatically on later imports
+type ImportMapping struct {
+	ID          string            `json:"id"`
+	EmployeeID  string            `json:"employee_id"`
+	Kind        ImportMappingKind `json:"kind"`
+	SourceValue string            `json:"source_value"` // Normalized (trimmed, upper case)
+	TargetID    string            `json:"target_id"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// SetID generates a new UUID for the mapping
+func (m *ImportMapping) SetID() {
+	m.ID = uuid.New().String()
+}
+
+// NormalizeMappingSource normalizes a source value so mappings match regardless of case and spacing
+func NormalizeMappingSource(value string) string {
+	return strings.ToUpper(strings.TrimSpace(value))
+}
+
+// UnresolvedReference is a source value no catalog entry or mapping resolves, with the rows that use it
+type UnresolvedReference struct {
+	Kind  ImportMappingKind
+	Value string
+	Lines []int
+}
+
+// UnresolvedReferenceError is returned for a row whose airport, aircraft or route needs a mapping decision
+type UnresolvedReferenceError struct {
+	Kind  ImportMappingKind
+	Value string
+}
+
+func (e *UnresolvedReferenceError) Error() string {
+	return ErrImportUnresolvedReference.Error() + ": " + string(e.Kind) + " " + e.Value
+}
+
+// Unwrap allows errors.Is(err, ErrImportUnresolvedReference)
+func (e *UnresolvedReferenceError) Unwrap() error {
+	return ErrImportUnresolvedReference
+}
+
+// IsUnresolvedReference reports whether the error is a reference pending a mapping decision
+func IsUnresolvedReference(err error) bool {
+	return err != nil && errors.Is(err, ErrImportUnresolvedReference)
+}
+
+// ElogbookImport is a third-party import request: rows already translated by a format adapter,
+// plus the mapping decisions taken by the user for previously unresolved references
+type ElogbookImport struct {
+	Format   ElogbookFormat
+	Rows     []LogbookImportRow
+	Mappings []ImportMapping
+	DryRun   bool
+}
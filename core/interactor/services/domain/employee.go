@@ -6,6 +6,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// Employee roles checked by middleware.RequireRole
+const (
+	RolePilot       = "pilot"
+	RoleCrewManager = "crew_manager" // Records the type ratings and sees the credential expiries of the employees of their airline
+)
+
 type Employee struct {
 	ID                   string    `json:"id"`
 	Name                 string    `json:"name"`
@@ -108,7 +108,9 @@ var (
 
 // Airport Management Errors (MOD_APT_*)
 var (
-	ErrAirportNotFound = errors.New("ERR_AIRPORT_NOT_FOUND")
+	ErrAirportNotFound        = errors.New("ERR_AIRPORT_NOT_FOUND")
+	ErrAirportTimeZoneMissing = errors.New("ERR_AIRPORT_TIME_ZONE_MISSING")
+	ErrAirportTimeZoneInvalid = errors.New("ERR_AIRPORT_TIME_ZONE_INVALID")
 )
 
 // Aircraft Registration Management Errors (MAT_*)
@@ -491,11 +493,26 @@ const (
 
 // DailyLogbook Management Errors (BIT_*)
 var (
-	ErrDailyLogbookNotFound     = errors.New("ERR_DAILY_LOGBOOK_NOT_FOUND")
-	ErrDailyLogbookCannotSave   = errors.New("ERR_DAILY_LOGBOOK_CANNOT_SAVE")
-	ErrDailyLogbookCannotUpdate = errors.New("ERR_DAILY_LOGBOOK_CANNOT_UPDATE")
-	ErrDailyLogbookCannotDelete = errors.New("ERR_DAILY_LOGBOOK_CANNOT_DELETE")
-	ErrDailyLogbookUnauthorized = errors.New("ERR_DAILY_LOGBOOK_UNAUTHORIZED")
+	ErrDailyLogbookNotFound      = errors.New("ERR_DAILY_LOGBOOK_NOT_FOUND")
+	ErrDailyLogbookCannotSave    = errors.New("ERR_DAILY_LOGBOOK_CANNOT_SAVE")
+	ErrDailyLogbookCannotUpdate  = errors.New("ERR_DAILY_LOGBOOK_CANNOT_UPDATE")
+	ErrDailyLogbookCannotDelete  = errors.New("ERR_DAILY_LOGBOOK_CANNOT_DELETE")
+	ErrDailyLogbookUnauthorized  = errors.New("ERR_DAILY_LOGBOOK_UNAUTHORIZED")
+	ErrDailyLogbookCannotRestore = errors.New("ERR_DAILY_LOGBOOK_CANNOT_RESTORE")
+
+	// Sign-off workflow
+	ErrDailyLogbookInvalidTransition = errors.New("ERR_DAILY_LOGBOOK_INVALID_TRANSITION")
+	ErrDailyLogbookSigned            = errors.New("ERR_DAILY_LOGBOOK_SIGNED") // Signed or locked: changes go through an amendment
+	ErrAmendmentNotFound             = errors.New("ERR_AMENDMENT_NOT_FOUND")
+	ErrAmendmentNotPending           = errors.New("ERR_AMENDMENT_NOT_PENDING")
+	ErrAmendmentNotAllowed           = errors.New("ERR_AMENDMENT_NOT_ALLOWED") // Only signed logbooks take amendments
+	ErrAmendmentInvalid              = errors.New("ERR_AMENDMENT_INVALID")
+	ErrAmendmentCannotSave           = errors.New("ERR_AMENDMENT_CANNOT_SAVE")
+	ErrLogbookChainBroken            = errors.New("ERR_LOGBOOK_CHAIN_BROKEN") // Stored hashes were altered; nothing is sealed on top of them
+
+	// Change history
+	ErrLogbookHistoryNotFound   = errors.New("ERR_LOGBOOK_HISTORY_NOT_FOUND")
+	ErrLogbookHistoryCannotSave = errors.New("ERR_LOGBOOK_HISTORY_CANNOT_SAVE")
 )
 
 // DailyLogbook Module (BIT_*) - Bitácora Diaria
@@ -537,6 +554,13 @@ const (
 	MsgDailyLogbookDeactivateOK  = "BIT_INA_EXI_01401" // Éxito - Bitácora inactivada
 	MsgDailyLogbookDeactivateErr = "BIT_INA_ERR_01404" // Error - Error técnico al inactivar
 
+	// ========================================
+	// Papelera y restauración - BIT_RES_*
+	// ========================================
+	MsgDailyLogbookRestored      = "BIT_RES_EXI_01101" // Éxito - Bitácora restaurada con sus segmentos
+	MsgDailyLogbookDeletedListOK = "BIT_RES_EXI_01102" // Éxito - Bitácoras eliminadas consultadas
+	MsgDailyLogbookRestoreErr    = "BIT_RES_ERR_01103" // Error - Error técnico al restaurar
+
 	// ========================================
 	// Listar - BIT_LIST_*
 	// ========================================
@@ -547,6 +571,27 @@ const (
 	// Autorización - BIT_AUTH_*
 	// ========================================
 	MsgDailyLogbookUnauthorized = "BIT_AUTH_ERR_00001" // Error - No autorizado para esta bitácora
+
+	// ========================================
+	// Firma y bloqueo - BIT_EST_*
+	// ========================================
+	MsgDailyLogbookStateOK      = "BIT_EST_EXI_01301" // Éxito - Estado de la bitácora actualizado
+	MsgDailyLogbookInvalidState = "BIT_EST_ERR_01302" // Error - Transición de estado no permitida
+	MsgDailyLogbookSigned       = "BIT_EST_ERR_01303" // Error - Bitácora fuera de borrador; si está firmada requiere enmienda
+	MsgDailyLogbookStateErr     = "BIT_EST_ERR_01304" // Error - Error técnico al cambiar el estado
+
+	// ========================================
+	// Enmiendas - BIT_ENM_*
+	// ========================================
+	MsgAmendmentCreated    = "BIT_ENM_EXI_01201" // Éxito - Enmienda registrada, pendiente de firma
+	MsgAmendmentApplied    = "BIT_ENM_EXI_01202" // Éxito - Enmienda firmada y aplicada
+	MsgAmendmentRejected   = "BIT_ENM_EXI_01203" // Éxito - Enmienda rechazada
+	MsgAmendmentListOK     = "BIT_ENM_EXI_01204" // Éxito - Enmiendas consultadas
+	MsgAmendmentNotFound   = "BIT_ENM_ERR_01205" // Error - Enmienda no encontrada
+	MsgAmendmentNotPending = "BIT_ENM_ERR_01206" // Error - La enmienda ya fue resuelta
+	MsgAmendmentInvalid    = "BIT_ENM_ERR_01207" // Error - Enmienda incompleta (acción, motivo o segmento)
+	MsgAmendmentNotAllowed = "BIT_ENM_ERR_01208" // Error - Solo las bitácoras firmadas admiten enmiendas
+	MsgAmendmentErr        = "BIT_ENM_ERR_01209" // Error - Error técnico en enmiendas
 )
 
 // AirlineRoute Management Errors (RUT_AIR_*)
@@ -560,15 +605,124 @@ var (
 
 // Flight Management Errors (VUE_*) - Also used for DailyLogbookDetail
 var (
-	ErrFlightNotFound            = errors.New("ERR_FLIGHT_NOT_FOUND")
-	ErrFlightCannotSave          = errors.New("ERR_FLIGHT_CANNOT_SAVE")
-	ErrFlightCannotUpdate        = errors.New("ERR_FLIGHT_CANNOT_UPDATE")
-	ErrFlightCannotDelete        = errors.New("ERR_FLIGHT_CANNOT_DELETE")
-	ErrFlightUnauthorized        = errors.New("ERR_FLIGHT_UNAUTHORIZED")
-	ErrFlightInvalidRoute        = errors.New("ERR_FLIGHT_INVALID_ROUTE")
-	ErrFlightInvalidLogbook      = errors.New("ERR_FLIGHT_INVALID_LOGBOOK")
-	ErrFlightInvalidAircraft     = errors.New("ERR_FLIGHT_INVALID_AIRCRAFT")
-	ErrFlightInvalidTimeSequence = errors.New("ERR_FLIGHT_INVALID_TIME_SEQUENCE")
+	ErrFlightNotFound             = errors.New("ERR_FLIGHT_NOT_FOUND")
+	ErrFlightCannotSave           = errors.New("ERR_FLIGHT_CANNOT_SAVE")
+	ErrFlightCannotUpdate         = errors.New("ERR_FLIGHT_CANNOT_UPDATE")
+	ErrFlightCannotDelete         = errors.New("ERR_FLIGHT_CANNOT_DELETE")
+	ErrFlightUnauthorized         = errors.New("ERR_FLIGHT_UNAUTHORIZED")
+	ErrFlightInvalidRoute         = errors.New("ERR_FLIGHT_INVALID_ROUTE")
+	ErrFlightInvalidLogbook       = errors.New("ERR_FLIGHT_INVALID_LOGBOOK")
+	ErrFlightInvalidAircraft      = errors.New("ERR_FLIGHT_INVALID_AIRCRAFT")
+	ErrFlightInvalidTimeSequence  = errors.New("ERR_FLIGHT_INVALID_TIME_SEQUENCE")
+	ErrFlightTimeMismatch         = errors.New("ERR_FLIGHT_TIME_MISMATCH")
+	ErrFlightSegmentSpanExceeded  = errors.New("ERR_FLIGHT_SEGMENT_SPAN_EXCEEDED")
+	ErrFlightTimeZoneUnavailable  = errors.New("ERR_FLIGHT_TIME_ZONE_UNAVAILABLE")
+	ErrFlightInvalidTimeRef       = errors.New("ERR_FLIGHT_INVALID_TIME_REFERENCE")
+	ErrFlightDuplicateSegment     = errors.New("ERR_FLIGHT_DUPLICATE_SEGMENT")
+	ErrFlightOverlappingSegment   = errors.New("ERR_FLIGHT_OVERLAPPING_SEGMENT")
+	ErrFlightAircraftNotInAirline = errors.New("ERR_FLIGHT_AIRCRAFT_NOT_IN_AIRLINE")
+	ErrFlightRouteInactive        = errors.New("ERR_FLIGHT_ROUTE_INACTIVE")
+	ErrFlightAirlineInactive      = errors.New("ERR_FLIGHT_AIRLINE_INACTIVE")
+	ErrFlightDateOutsideLogDate   = errors.New("ERR_FLIGHT_DATE_OUTSIDE_LOG_DATE")
+	ErrFlightInvalidIFRTime       = errors.New("ERR_FLIGHT_INVALID_IFR_TIME") // Unparseable or longer than the block time
+	ErrFlightCannotRestore        = errors.New("ERR_FLIGHT_CANNOT_RESTORE")
+	ErrFlightLogbookDeleted       = errors.New("ERR_FLIGHT_LOGBOOK_DELETED") // The segment's logbook must be restored first
+)
+
+// Segment Mirror Errors (VUE_ESP_*)
+var (
+	ErrSegmentMirrorNotFound         = errors.New("ERR_SEGMENT_MIRROR_NOT_FOUND")
+	ErrSegmentMirrorNotPending       = errors.New("ERR_SEGMENT_MIRROR_NOT_PENDING")
+	ErrSegmentMirrorNotInConflict    = errors.New("ERR_SEGMENT_MIRROR_NOT_IN_CONFLICT")
+	ErrSegmentMirrorNotLinked        = errors.New("ERR_SEGMENT_MIRROR_NOT_LINKED")
+	ErrSegmentMirrorInvalidCompanion = errors.New("ERR_SEGMENT_MIRROR_INVALID_COMPANION") // Unknown employee or the pilot themselves
+	ErrSegmentMirrorInvalid          = errors.New("ERR_SEGMENT_MIRROR_INVALID")
+	ErrSegmentMirrorCannotSave       = errors.New("ERR_SEGMENT_MIRROR_CANNOT_SAVE")
+)
+
+// Simulator Session Errors (SIM_*)
+var (
+	ErrSimulatorSessionNotFound        = errors.New("ERR_SIMULATOR_SESSION_NOT_FOUND")
+	ErrSimulatorSessionUnauthorized    = errors.New("ERR_SIMULATOR_SESSION_UNAUTHORIZED")
+	ErrSimulatorSessionInvalid         = errors.New("ERR_SIMULATOR_SESSION_INVALID")
+	ErrSimulatorSessionInvalidDuration = errors.New("ERR_SIMULATOR_SESSION_INVALID_DURATION") // Unparseable, zero or longer than the maximum
+	ErrSimulatorSessionInvalidModel    = errors.New("ERR_SIMULATOR_SESSION_INVALID_MODEL")
+	ErrSimulatorSessionCannotSave      = errors.New("ERR_SIMULATOR_SESSION_CANNOT_SAVE")
+)
+
+// Prior Experience Errors (PRX_*)
+var (
+	ErrPriorExperienceNotFound        = errors.New("ERR_PRIOR_EXPERIENCE_NOT_FOUND")
+	ErrPriorExperienceUnauthorized    = errors.New("ERR_PRIOR_EXPERIENCE_UNAUTHORIZED")
+	ErrPriorExperienceInvalid         = errors.New("ERR_PRIOR_EXPERIENCE_INVALID")
+	ErrPriorExperienceInvalidTime     = errors.New("ERR_PRIOR_EXPERIENCE_INVALID_TIME")     // A balance is not in HH:MM format
+	ErrPriorExperienceInconsistent    = errors.New("ERR_PRIOR_EXPERIENCE_INCONSISTENT")     // A column exceeds the total time
+	ErrPriorExperienceInvalidDocument = errors.New("ERR_PRIOR_EXPERIENCE_INVALID_DOCUMENT") // Missing or unknown supporting document
+	ErrPriorExperienceCannotSave      = errors.New("ERR_PRIOR_EXPERIENCE_CANNOT_SAVE")
+)
+
+// Duty Period Errors (DUT_*)
+var (
+	ErrDutyPeriodNotFound       = errors.New("ERR_DUTY_PERIOD_NOT_FOUND")
+	ErrDutyPeriodUnauthorized   = errors.New("ERR_DUTY_PERIOD_UNAUTHORIZED")
+	ErrDutyPeriodInvalid        = errors.New("ERR_DUTY_PERIOD_INVALID")         // Release not after report, or span above the maximum
+	ErrDutyPeriodOverlap        = errors.New("ERR_DUTY_PERIOD_OVERLAP")         // Overlaps another duty period of the employee
+	ErrDutyPeriodInvalidSegment = errors.New("ERR_DUTY_PERIOD_INVALID_SEGMENT") // Unknown segment, of another employee or linked to another duty period
+	ErrDutyPeriodSegmentOutside = errors.New("ERR_DUTY_PERIOD_SEGMENT_OUTSIDE") // Segment flown outside report and release
+	ErrDutyPeriodCannotSave     = errors.New("ERR_DUTY_PERIOD_CANNOT_SAVE")
+)
+
+// Type Rating Errors (HAB_*)
+var (
+	ErrTypeRatingNotFound     = errors.New("ERR_TYPE_RATING_NOT_FOUND")
+	ErrTypeRatingUnauthorized = errors.New("ERR_TYPE_RATING_UNAUTHORIZED")
+	ErrTypeRatingInvalid      = errors.New("ERR_TYPE_RATING_INVALID")  // Missing family, or malformed or inverted dates
+	ErrTypeRatingRequired     = errors.New("ERR_TYPE_RATING_REQUIRED") // Pilot flying without a valid rating on the aircraft family
+	ErrTypeRatingCannotSave   = errors.New("ERR_TYPE_RATING_CANNOT_SAVE")
+)
+
+// Credential Errors (CRD_*)
+var (
+	ErrCredentialNotFound     = errors.New("ERR_CREDENTIAL_NOT_FOUND")
+	ErrCredentialUnauthorized = errors.New("ERR_CREDENTIAL_UNAUTHORIZED")
+	ErrCredentialInvalid      = errors.New("ERR_CREDENTIAL_INVALID")      // Unknown type, missing title, or malformed or inverted dates
+	ErrCredentialInvalidScan  = errors.New("ERR_CREDENTIAL_INVALID_SCAN") // Empty, too large or not a PDF, JPEG or PNG
+	ErrCredentialScanNotFound = errors.New("ERR_CREDENTIAL_SCAN_NOT_FOUND")
+	ErrCredentialCannotSave   = errors.New("ERR_CREDENTIAL_CANNOT_SAVE")
+)
+
+// Notification Errors (NTF_*)
+var (
+	ErrNotificationNotFound     = errors.New("ERR_NOTIFICATION_NOT_FOUND")
+	ErrNotificationUnauthorized = errors.New("ERR_NOTIFICATION_UNAUTHORIZED")
+	ErrNotificationCannotSave   = errors.New("ERR_NOTIFICATION_CANNOT_SAVE")
+)
+
+// Logbook Import Errors (IMP_*)
+var (
+	ErrImportInvalidFile    = errors.New("ERR_IMPORT_INVALID_FILE")
+	ErrImportTooManyRows    = errors.New("ERR_IMPORT_TOO_MANY_ROWS")
+	ErrImportMissingField   = errors.New("ERR_IMPORT_MISSING_FIELD")
+	ErrImportInvalidField   = errors.New("ERR_IMPORT_INVALID_FIELD")
+	ErrImportRouteAmbiguous = errors.New("ERR_IMPORT_ROUTE_AMBIGUOUS")
+)
+
+// Electronic Logbook Import Errors (ELB_*)
+var (
+	ErrImportUnresolvedReference = errors.New("ERR_IMPORT_UNRESOLVED_REFERENCE")
+	ErrImportInvalidMapping      = errors.New("ERR_IMPORT_INVALID_MAPPING")
+	ErrImportMappingNotFound     = errors.New("ERR_IMPORT_MAPPING_NOT_FOUND")
+	ErrImportMappingCannotSave   = errors.New("ERR_IMPORT_MAPPING_CANNOT_SAVE")
+)
+
+// Flight Time Limitations Errors (FTL_*)
+var (
+	ErrFTLLimitExceeded = errors.New("ERR_FTL_LIMIT_EXCEEDED")
+)
+
+// Flight Totals Errors (VUE_TOT_*)
+var (
+	ErrFlightTotalsInvalidGroupBy = errors.New("ERR_FLIGHT_TOTALS_INVALID_GROUP_BY")
 )
 
 // AirlineRoute Module (RUT_AIR_*) - Ruta Aerolinea
@@ -635,10 +789,36 @@ const (
 	// ========================================
 	// Validaciones - VUE_VAL_*
 	// ========================================
-	MsgFlightInvalidRoute        = "VUE_VAL_ERR_04805" // Error - Ruta de aerolínea inválida
-	MsgFlightInvalidLogbook      = "VUE_VAL_ERR_04806" // Error - Bitácora inválida
-	MsgFlightInvalidAircraft     = "VUE_VAL_ERR_04807" // Error - Matrícula de aeronave inválida
-	MsgFlightInvalidTimeSequence = "VUE_VAL_ERR_04808" // Error - Secuencia de tiempos inválida (out < takeoff < landing < in)
+	MsgFlightInvalidRoute         = "VUE_VAL_ERR_04805" // Error - Ruta de aerolínea inválida
+	MsgFlightInvalidLogbook       = "VUE_VAL_ERR_04806" // Error - Bitácora inválida
+	MsgFlightInvalidAircraft      = "VUE_VAL_ERR_04807" // Error - Matrícula de aeronave inválida
+	MsgFlightInvalidTimeSequence  = "VUE_VAL_ERR_04808" // Error - Secuencia de tiempos inválida (out < takeoff < landing < in)
+	MsgFlightTimeMismatch         = "VUE_VAL_ERR_04809" // Error - air_time/block_time no coinciden con OUT/OFF/ON/IN
+	MsgFlightSegmentSpanExceeded  = "VUE_VAL_ERR_04810" // Error - Duración del segmento excede el máximo permitido
+	MsgFlightTimeZoneUnavailable  = "VUE_VAL_ERR_04811" // Error - Aeropuerto de origen/destino sin zona horaria válida
+	MsgFlightInvalidTimeRef       = "VUE_VAL_ERR_04812" // Error - time_reference inválido (UTC o LOCAL)
+	MsgFlightDuplicateSegment     = "VUE_VAL_ERR_04813" // Error - Segmento duplicado (mismo vuelo, fecha y aeronave que ${0})
+	MsgFlightOverlappingSegment   = "VUE_VAL_ERR_04814" // Error - Segmento se solapa en tiempo con ${0}
+	MsgFlightConflictOverridden   = "VUE_VAL_WRN_04815" // Advertencia - Conflicto ${0} con otro segmento aceptado por override_conflicts
+	MsgFlightRouteGap             = "VUE_VAL_WRN_04816" // Advertencia - Discontinuidad de ruta: el tramo anterior llegó a ${0} y este sale de ${1}
+	MsgFlightAircraftNotInAirline = "VUE_VAL_ERR_04819" // Error - La matrícula no pertenece a la aerolínea de la ruta (salvo wet lease)
+	MsgFlightRouteInactive        = "VUE_VAL_ERR_04820" // Error - La ruta de aerolínea está inactiva
+	MsgFlightAirlineInactive      = "VUE_VAL_ERR_04821" // Error - La aerolínea de la ruta está inactiva
+	MsgFlightDateOutsideLogDate   = "VUE_VAL_ERR_04822" // Error - La fecha real de vuelo no corresponde a la fecha de la bitácora (±1 día)
+	MsgFlightInvalidIFRTime       = "VUE_VAL_ERR_04823" // Error - ifr_time inválido o mayor que el tiempo de bloque
+
+	// ========================================
+	// Consistencia del día - VUE_VAL_*
+	// ========================================
+	MsgLogbookValidationOK  = "VUE_VAL_EXI_04817" // Éxito - Reporte de consistencia generado
+	MsgLogbookValidationErr = "VUE_VAL_ERR_04818" // Error - Error técnico al validar la bitácora
+
+	// ========================================
+	// Totales - VUE_TOT_*
+	// ========================================
+	MsgFlightTotalsGetOK          = "VUE_TOT_EXI_05201" // Éxito - Totales de tiempo de vuelo calculados
+	MsgFlightTotalsGetErr         = "VUE_TOT_ERR_05202" // Error - Error técnico al calcular totales
+	MsgFlightTotalsInvalidGroupBy = "VUE_TOT_ERR_05203" // Error - Agrupación (group_by) no soportada
 
 	// ========================================
 	// Eliminar (HU18) - VUE_DEL_*
@@ -646,12 +826,192 @@ const (
 	MsgFlightDeleted     = "VUE_DEL_EXI_01801" // Éxito - Vuelo eliminado exitosamente
 	MsgFlightDeleteError = "VUE_DEL_ERR_01804" // Error - Error técnico al eliminar
 
+	// ========================================
+	// Restaurar - VUE_RES_*
+	// ========================================
+	MsgFlightRestored       = "VUE_RES_EXI_06101" // Éxito - Vuelo restaurado
+	MsgFlightLogbookDeleted = "VUE_RES_ERR_06102" // Error - La bitácora del vuelo está eliminada; restaurarla primero
+	MsgFlightRestoreErr     = "VUE_RES_ERR_06103" // Error - Error técnico al restaurar
+
+	// ========================================
+	// Vuelo espejo del acompañante - VUE_ESP_*
+	// ========================================
+	MsgSegmentMirrorListOK           = "VUE_ESP_EXI_06201" // Éxito - Vuelos espejo consultados
+	MsgSegmentMirrorAccepted         = "VUE_ESP_EXI_06202" // Éxito - Vuelo espejo registrado en la bitácora del acompañante
+	MsgSegmentMirrorDeclined         = "VUE_ESP_EXI_06203" // Éxito - Vuelo espejo rechazado
+	MsgSegmentMirrorResolved         = "VUE_ESP_EXI_06204" // Éxito - Conflicto del vuelo espejo resuelto
+	MsgSegmentMirrorNotFound         = "VUE_ESP_ERR_06205" // Error - Vuelo espejo no encontrado
+	MsgSegmentMirrorNotPending       = "VUE_ESP_ERR_06206" // Error - El vuelo espejo ya fue respondido
+	MsgSegmentMirrorNotInConflict    = "VUE_ESP_ERR_06207" // Error - Los vuelos enlazados no están en conflicto
+	MsgSegmentMirrorInvalidCompanion = "VUE_ESP_ERR_06208" // Error - El acompañante no es un empleado válido
+	MsgSegmentMirrorInvalid          = "VUE_ESP_ERR_06209" // Error - Resolución o rol inválido
+	MsgSegmentMirrorErr              = "VUE_ESP_ERR_06210" // Error - Error técnico en vuelos espejo
+	MsgSegmentMirrorConflict         = "VUE_ESP_WRN_06211" // Advertencia - El vuelo enlazado del acompañante difiere en ${0}
+	MsgSegmentMirrorNotLinked        = "VUE_ESP_ERR_06212" // Error - Los vuelos ya no están enlazados
+
 	// ========================================
 	// Autorización - VUE_AUTH_*
 	// ========================================
 	MsgFlightUnauthorized = "VUE_AUTH_ERR_00001" // Error - No autorizado para este vuelo
 )
 
+// Flight Time Limitations Module (FTL_*) - Limitaciones de tiempo de vuelo
+// Params: ${0}=limit code, ${1}=used (HH:MM), ${2}=limit (HH:MM), ${3}=window start, ${4}=window end
+const (
+	MsgFTLLimitExceeded        = "FTL_VAL_ERR_05301" // Error - El segmento excede una limitación bloqueante
+	MsgFTLLimitWarning         = "FTL_VAL_WRN_05302" // Advertencia - Uso cercano a la limitación
+	MsgFTLLimitExceededWarning = "FTL_VAL_WRN_05303" // Advertencia - Limitación no bloqueante excedida
+	MsgFTLStatusGetOK          = "FTL_CON_EXI_05304" // Éxito - Estado de limitaciones consultado
+	MsgFTLStatusGetErr         = "FTL_CON_ERR_05305" // Error - Error técnico al consultar el estado
+)
+
+// Currency Module (CUR_*) - Experiencia reciente por familia de aeronave
+const (
+	MsgCurrencyGetOK  = "CUR_CON_EXI_05401" // Éxito - Experiencia reciente consultada
+	MsgCurrencyGetErr = "CUR_CON_ERR_05402" // Error - Error técnico al consultar la experiencia reciente
+)
+
+// Logbook Export Module (EXP_*) - Exportación imprimible de la bitácora
+const (
+	MsgLogbookExportInvalidFormat = "EXP_VAL_ERR_05501" // Error - Formato de exportación no soportado (pdf, csv)
+	MsgLogbookExportErr           = "EXP_CON_ERR_05502" // Error - Error técnico al generar la exportación
+)
+
+// Logbook Import Module (IMP_*) - Importación masiva de segmentos desde CSV
+const (
+	MsgImportOK             = "IMP_REG_EXI_05601" // Éxito - Segmentos importados
+	MsgImportDryRunOK       = "IMP_VAL_EXI_05602" // Éxito - Validación (dry-run) sin errores, nada fue guardado
+	MsgImportValidationErr  = "IMP_VAL_ERR_05603" // Error - Filas con errores de validación, nada fue guardado
+	MsgImportInvalidFile    = "IMP_VAL_ERR_05604" // Error - Archivo CSV ilegible o sin columnas requeridas (${0})
+	MsgImportTooManyRows    = "IMP_VAL_ERR_05605" // Error - Excede el máximo de filas (${0})
+	MsgImportRouteAmbiguous = "IMP_VAL_ERR_05606" // Error - Ruta operada por varias aerolíneas, indicar airline_code
+	MsgImportErr            = "IMP_REG_ERR_05607" // Error - Error técnico al importar
+)
+
+// Electronic Logbook Import Module (ELB_*) - Importación desde bitácoras electrónicas de terceros
+const (
+	MsgElogbookImportOK        = "ELB_REG_EXI_05701" // Éxito - Segmentos importados, ${0} filas pendientes de mapeo
+	MsgElogbookPreviewOK       = "ELB_VAL_EXI_05702" // Éxito - Vista previa, nada fue guardado (${0} filas pendientes de mapeo)
+	MsgElogbookInvalidFormat   = "ELB_VAL_ERR_05703" // Error - Formato de bitácora electrónica no soportado (${0})
+	MsgElogbookInvalidFieldMap = "ELB_VAL_ERR_05704" // Error - Mapeo de campos inválido (${0})
+	MsgElogbookInvalidMapping  = "ELB_VAL_ERR_05705" // Error - Decisión de mapeo inválida (${0})
+	MsgElogbookUnresolved      = "ELB_VAL_ERR_05706" // Error - ${0} ${1} sin mapeo, fila pendiente
+	MsgImportMappingListOK     = "ELB_CON_EXI_05707" // Éxito - Mapeos recordados consultados
+	MsgImportMappingNotFound   = "ELB_CON_ERR_05708" // Error - Mapeo no encontrado
+	MsgImportMappingDeleted    = "ELB_DEL_EXI_05709" // Éxito - Mapeo eliminado
+	MsgImportMappingErr        = "ELB_CON_ERR_05710" // Error - Error técnico en mapeos de importación
+)
+
+// Flight Time Anomaly Module (ANO_*) - Tiempos de bloque/vuelo frente al estimado de la ruta
+const (
+	MsgFlightAnomalyReportOK  = "ANO_CON_EXI_05801" // Éxito - Reporte de anomalías generado
+	MsgFlightAnomalyReportErr = "ANO_CON_ERR_05802" // Error - Error técnico al generar el reporte de anomalías
+)
+
+// Logbook Chain Module (CAD_*) - Cadena de hashes sobre los segmentos firmados
+const (
+	MsgLogbookChainValid  = "CAD_VER_EXI_05901" // Éxito - Cadena verificada, ${0} segmentos sin alteraciones
+	MsgLogbookChainBroken = "CAD_VER_WRN_05902" // Advertencia - Cadena rota en la posición ${0}
+	MsgLogbookChainSeal   = "CAD_SEL_ERR_05903" // Error - La cadena existente está rota, no se puede firmar sobre ella
+	MsgLogbookChainErr    = "CAD_VER_ERR_05904" // Error - Error técnico al verificar la cadena
+)
+
+// Logbook History Module (HIS_*) - Historial de cambios de bitácoras y segmentos
+const (
+	MsgLogbookHistoryOK       = "HIS_CON_EXI_06001" // Éxito - Historial de cambios consultado
+	MsgLogbookHistoryNotFound = "HIS_CON_ERR_06002" // Error - Sin historial para el registro
+	MsgLogbookHistoryErr      = "HIS_CON_ERR_06003" // Error - Error técnico al consultar el historial
+)
+
+// Simulator Session Module (SIM_*) - Sesiones en dispositivos de simulación (FSTD)
+const (
+	MsgSimulatorSessionListOK          = "SIM_CON_EXI_06301"  // Éxito - Sesiones de simulador consultadas
+	MsgSimulatorSessionGetOK           = "SIM_CON_EXI_06302"  // Éxito - Sesión de simulador consultada
+	MsgSimulatorSessionCreated         = "SIM_REG_EXI_06303"  // Éxito - Sesión de simulador registrada
+	MsgSimulatorSessionUpdated         = "SIM_ACT_EXI_06304"  // Éxito - Sesión de simulador actualizada
+	MsgSimulatorSessionDeleted         = "SIM_DEL_EXI_06305"  // Éxito - Sesión de simulador eliminada
+	MsgSimulatorSessionNotFound        = "SIM_CON_ERR_06306"  // Error - Sesión de simulador no encontrada
+	MsgSimulatorSessionInvalid         = "SIM_VAL_ERR_06307"  // Error - Tipo de dispositivo, rol, fecha o conteos inválidos
+	MsgSimulatorSessionInvalidDuration = "SIM_VAL_ERR_06308"  // Error - Duración inválida o mayor que el máximo permitido
+	MsgSimulatorSessionInvalidModel    = "SIM_VAL_ERR_06309"  // Error - Modelo de aeronave emulado inválido
+	MsgSimulatorSessionUnauthorized    = "SIM_AUTH_ERR_06310" // Error - No autorizado para esta sesión
+	MsgSimulatorSessionErr             = "SIM_CON_ERR_06311"  // Error - Error técnico en sesiones de simulador
+)
+
+// Prior Experience Module (PRX_*) - Experiencia previa y saldos iniciales
+const (
+	MsgPriorExperienceListOK          = "PRX_CON_EXI_06401"  // Éxito - Experiencia previa consultada
+	MsgPriorExperienceGetOK           = "PRX_CON_EXI_06402"  // Éxito - Saldo inicial consultado
+	MsgPriorExperienceCreated         = "PRX_REG_EXI_06403"  // Éxito - Saldo inicial registrado
+	MsgPriorExperienceUpdated         = "PRX_ACT_EXI_06404"  // Éxito - Saldo inicial actualizado
+	MsgPriorExperienceDeleted         = "PRX_DEL_EXI_06405"  // Éxito - Saldo inicial eliminado
+	MsgPriorExperienceNotFound        = "PRX_CON_ERR_06406"  // Error - Saldo inicial no encontrado
+	MsgPriorExperienceInvalid         = "PRX_VAL_ERR_06407"  // Error - Fecha, conteos o familia de aeronave inválidos
+	MsgPriorExperienceInvalidTime     = "PRX_VAL_ERR_06408"  // Error - Tiempo con formato distinto de HH:MM
+	MsgPriorExperienceInconsistent    = "PRX_VAL_ERR_06409"  // Error - Una columna supera el tiempo total
+	MsgPriorExperienceInvalidDocument = "PRX_VAL_ERR_06410"  // Error - Documento de soporte ausente o inválido
+	MsgPriorExperienceUnauthorized    = "PRX_AUTH_ERR_06411" // Error - No autorizado para este saldo inicial
+	MsgPriorExperienceErr             = "PRX_CON_ERR_06412"  // Error - Error técnico en experiencia previa
+)
+
+// Duty Period Module (DUT_*) - Periodos de servicio
+const (
+	MsgDutyPeriodListOK         = "DUT_CON_EXI_06501"  // Éxito - Periodos de servicio consultados
+	MsgDutyPeriodGetOK          = "DUT_CON_EXI_06502"  // Éxito - Periodo de servicio consultado
+	MsgDutyPeriodCreated        = "DUT_REG_EXI_06503"  // Éxito - Periodo de servicio registrado
+	MsgDutyPeriodUpdated        = "DUT_ACT_EXI_06504"  // Éxito - Periodo de servicio actualizado
+	MsgDutyPeriodDeleted        = "DUT_DEL_EXI_06505"  // Éxito - Periodo de servicio eliminado
+	MsgDutyPeriodNotFound       = "DUT_CON_ERR_06506"  // Error - Periodo de servicio no encontrado
+	MsgDutyPeriodInvalid        = "DUT_VAL_ERR_06507"  // Error - Presentación o liberación inválidas
+	MsgDutyPeriodOverlap        = "DUT_VAL_ERR_06508"  // Error - Se superpone con otro periodo de servicio
+	MsgDutyPeriodInvalidSegment = "DUT_VAL_ERR_06509"  // Error - Vuelo inexistente, ajeno o enlazado a otro periodo
+	MsgDutyPeriodSegmentOutside = "DUT_VAL_ERR_06510"  // Error - Vuelo fuera de la presentación y la liberación
+	MsgDutyPeriodUnauthorized   = "DUT_AUTH_ERR_06511" // Error - No autorizado para este periodo de servicio
+	MsgDutyPeriodErr            = "DUT_CON_ERR_06512"  // Error - Error técnico en periodos de servicio
+)
+
+// Type Rating Module (HAB_*) - Habilitaciones de tipo
+const (
+	MsgTypeRatingListOK       = "HAB_CON_EXI_06601"  // Éxito - Habilitaciones de tipo consultadas
+	MsgTypeRatingGetOK        = "HAB_CON_EXI_06602"  // Éxito - Habilitación de tipo consultada
+	MsgTypeRatingCreated      = "HAB_REG_EXI_06603"  // Éxito - Habilitación de tipo registrada
+	MsgTypeRatingUpdated      = "HAB_ACT_EXI_06604"  // Éxito - Habilitación de tipo actualizada
+	MsgTypeRatingDeleted      = "HAB_DEL_EXI_06605"  // Éxito - Habilitación de tipo eliminada
+	MsgTypeRatingNotFound     = "HAB_CON_ERR_06606"  // Error - Habilitación de tipo no encontrada
+	MsgTypeRatingInvalid      = "HAB_VAL_ERR_06607"  // Error - Familia o fechas de vigencia inválidas
+	MsgTypeRatingRequired     = "HAB_VAL_ERR_06608"  // Error - Piloto volando sin habilitación vigente en la familia ${0}
+	MsgTypeRatingMissing      = "HAB_VAL_WRN_06609"  // Advertencia - Sin habilitación vigente en la familia ${0}
+	MsgTypeRatingUnauthorized = "HAB_AUTH_ERR_06610" // Error - No autorizado para esta habilitación de tipo
+	MsgTypeRatingErr          = "HAB_CON_ERR_06611"  // Error - Error técnico en habilitaciones de tipo
+)
+
+// Credential Module (CRD_*) - Licencias, certificados médicos y vencimientos
+const (
+	MsgCredentialListOK         = "CRD_CON_EXI_06701"  // Éxito - Credenciales consultadas
+	MsgCredentialGetOK          = "CRD_CON_EXI_06702"  // Éxito - Credencial consultada
+	MsgCredentialCreated        = "CRD_REG_EXI_06703"  // Éxito - Credencial registrada
+	MsgCredentialUpdated        = "CRD_ACT_EXI_06704"  // Éxito - Credencial actualizada
+	MsgCredentialDeleted        = "CRD_DEL_EXI_06705"  // Éxito - Credencial eliminada
+	MsgCredentialScanUploaded   = "CRD_ACT_EXI_06706"  // Éxito - Documento escaneado adjuntado
+	MsgCredentialExpiryReportOK = "CRD_CON_EXI_06707"  // Éxito - Reporte de vencimientos de la aerolínea generado
+	MsgCredentialNotFound       = "CRD_CON_ERR_06708"  // Error - Credencial no encontrada
+	MsgCredentialScanNotFound   = "CRD_CON_ERR_06709"  // Error - La credencial no tiene documento escaneado
+	MsgCredentialInvalid        = "CRD_VAL_ERR_06710"  // Error - Tipo, título o fechas inválidos
+	MsgCredentialInvalidScan    = "CRD_VAL_ERR_06711"  // Error - Documento vacío, demasiado grande o de formato no admitido
+	MsgCredentialExpiryNotice   = "CRD_NOT_WRN_06712"  // Advertencia - ${0} vence el ${1} (faltan ${2} días)
+	MsgCredentialUnauthorized   = "CRD_AUTH_ERR_06713" // Error - No autorizado para esta credencial o aerolínea
+	MsgCredentialErr            = "CRD_CON_ERR_06714"  // Error - Error técnico en credenciales
+)
+
+// Notification Module (NTF_*) - Notificaciones en la aplicación
+const (
+	MsgNotificationListOK       = "NTF_CON_EXI_06801"  // Éxito - Notificaciones consultadas
+	MsgNotificationRead         = "NTF_ACT_EXI_06802"  // Éxito - Notificación marcada como leída
+	MsgNotificationNotFound     = "NTF_CON_ERR_06803"  // Error - Notificación no encontrada
+	MsgNotificationUnauthorized = "NTF_AUTH_ERR_06804" // Error - No autorizado para esta notificación
+	MsgNotificationErr          = "NTF_CON_ERR_06805"  // Error - Error técnico en notificaciones
+)
+
 // Airline Employee Module (EMP_AIR_*) - Empleado Aerolínea (Release 15)
 const (
 	// ========================================
@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+// FlightTimeMetric is the logged time compared against the route's estimated flight time
+type FlightTimeMetric string
+
+const (
+	FlightTimeMetricBlock FlightTimeMetric = "BLOCK_TIME"
+	FlightTimeMetricAir   FlightTimeMetric = "AIR_TIME"
+)
+
+// AnomalyTolerance is how far a logged time may deviate from the route estimate before it is flagged.
+// The allowed deviation is the larger of Ratio × estimate and Min, so short routes are not flagged for
+// ordinary taxi times.
+type AnomalyTolerance struct {
+	Ratio float64
+	Min   time.Duration
+}
+
+// DefaultAnomalyTolerance is applied when none is configured
+var DefaultAnomalyTolerance = AnomalyTolerance{Ratio: 0.5, Min: 45 * time.Minute}
+
+// Allowed returns the deviation accepted for an estimate
+func (t AnomalyTolerance) Allowed(estimate time.Duration) time.Duration {
+	allowed := time.Duration(float64(estimate) * t.Ratio)
+	if allowed < t.Min {
+		return t.Min
+	}
+	return allowed
+}
+
+// FlightTimeAnomaly is a logged block or air time outside the tolerance around the route estimate
+type FlightTimeAnomaly struct {
+	Metric    FlightTimeMetric
+	Logged    time.Duration
+	Estimated time.Duration
+}
+
+// Deviation returns logged minus estimated time (negative when shorter than estimated)
+func (a FlightTimeAnomaly) Deviation() time.Duration {
+	return a.Logged - a.Estimated
+}
+
+// DetectFlightTimeAnomalies compares the segment's block and air time with the route's estimated flight
+// time. Returns nil when the route has no estimate or the segment times cannot be parsed.
+func DetectFlightTimeAnomalies(detail DailyLogbookDetail, tolerance AnomalyTolerance) []FlightTimeAnomaly {
+	if detail.EstimatedFlightTime == "" {
+		return nil
+	}
+	estimate, err := ParseFlightDuration(detail.EstimatedFlightTime)
+	if err != nil || estimate <= 0 {
+		return nil
+	}
+	allowed := tolerance.Allowed(estimate)
+
+	var anomalies []FlightTimeAnomaly
+	for _, logged := range []struct {
+		metric FlightTimeMetric
+		value  string
+	}{
+		{FlightTimeMetricBlock, detail.BlockTime},
+		{FlightTimeMetricAir, detail.AirTime},
+	} {
+		d, err := ParseFlightDuration(logged.value)
+		if err != nil {
+			continue
+		}
+		anomaly := FlightTimeAnomaly{Metric: logged.metric, Logged: d, Estimated: estimate}
+		if deviation := anomaly.Deviation(); deviation > allowed || deviation < -allowed {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	return anomalies
+}
+
+// FlightAnomalyReport lists an employee's segments with block or air time anomalies in a date range
+type FlightAnomalyReport struct {
+	EmployeeID   string
+	From         time.Time
+	To           time.Time
+	Tolerance    AnomalyTolerance
+	SegmentCount int                  // Segments checked
+	Segments     []DailyLogbookDetail // Suspicious segments with their Anomalies, in logbook order
+}
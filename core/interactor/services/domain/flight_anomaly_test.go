@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectFlightTimeAnomalies(t *testing.T) {
+	tolerance := AnomalyTolerance{Ratio: 0.5, Min: 45 * time.Minute}
+	segment := func(estimate, air, block string) DailyLogbookDetail {
+		return DailyLogbookDetail{EstimatedFlightTime: estimate, AirTime: air, BlockTime: block}
+	}
+
+	t.Run("within tolerance", func(t *testing.T) {
+		if got := DetectFlightTimeAnomalies(segment("01:00:00", "00:55:00", "01:20:00"), tolerance); len(got) != 0 {
+			t.Fatalf("expected no anomalies, got %+v", got)
+		}
+	})
+
+	t.Run("minimum deviation applies to short routes", func(t *testing.T) {
+		// 50% of 0:40 is 0:20, but up to 0:45 is accepted
+		if got := DetectFlightTimeAnomalies(segment("00:40:00", "00:40:00", "01:20:00"), tolerance); len(got) != 0 {
+			t.Fatalf("expected no anomalies, got %+v", got)
+		}
+	})
+
+	t.Run("block time far above the estimate", func(t *testing.T) {
+		got := DetectFlightTimeAnomalies(segment("01:00:00", "01:00:00", "03:30:00"), tolerance)
+		if len(got) != 1 || got[0].Metric != FlightTimeMetricBlock || got[0].Deviation() != 150*time.Minute {
+			t.Fatalf("expected a block time anomaly of +2:30, got %+v", got)
+		}
+	})
+
+	t.Run("times far below the estimate", func(t *testing.T) {
+		got := DetectFlightTimeAnomalies(segment("05:00:00", "01:00:00", "01:10:00"), tolerance)
+		if len(got) != 2 || got[0].Deviation() >= 0 || got[1].Deviation() >= 0 {
+			t.Fatalf("expected two negative anomalies, got %+v", got)
+		}
+	})
+
+	t.Run("route without estimate is not checked", func(t *testing.T) {
+		if got := DetectFlightTimeAnomalies(segment("", "09:00:00", "10:00:00"), tolerance); got != nil {
+			t.Fatalf("expected nil, got %+v", got)
+		}
+	})
+}
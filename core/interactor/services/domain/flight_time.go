@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseClockTime parses a time of day in HH:MM:SS or HH:MM format
+func ParseClockTime(value string) (time.Time, error) {
+	t, err := time.Parse("15:04:05", value)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse("15:04", value)
+}
+
+// ParseFlightDate parses a flight date in YYYY-MM-DD format.
+// Values read back from MySQL DATE columns (parseTime=true) arrive as RFC3339, so only the date part is used.
+func ParseFlightDate(value string) (time.Time, error) {
+	if len(value) > len("2006-01-02") {
+		value = value[:len("2006-01-02")]
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// ParseFlightDuration parses a duration stored as HH:MM or HH:MM:SS (e.g., "01:30" or "01:30:00")
+// Hours are not limited to 23 so that MySQL TIME values above one day are accepted
+func ParseFlightDuration(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, errors.New("invalid duration format: " + value)
+	}
+
+	values := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, errors.New("invalid duration format: " + value)
+		}
+		if i > 0 && n > 59 {
+			return 0, errors.New("invalid duration format: " + value)
+		}
+		values[i] = n
+	}
+
+	return time.Duration(values[0])*time.Hour +
+		time.Duration(values[1])*time.Minute +
+		time.Duration(values[2])*time.Second, nil
+}
+
+// FormatFlightDuration formats a duration as HH:MM (seconds are truncated)
+func FormatFlightDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	totalMinutes := int(d / time.Minute)
+	return fmt.Sprintf("%02d:%02d", totalMinutes/60, totalMinutes%60)
+}
+
+// MaxSegmentBlockTime is the longest OUT-to-IN span accepted for a single flight segment.
+// Segment times are plain clock times, so anything longer would be ambiguous once day rollover is applied.
+const MaxSegmentBlockTime = 20 * time.Hour
+
+// SegmentTimes holds the OUT/OFF/ON/IN instants of a segment anchored to its flight date
+type SegmentTimes struct {
+	Out     time.Time
+	Takeoff time.Time
+	Landing time.Time
+	In      time.Time
+}
+
+// AirTime returns the airborne duration (ON - OFF)
+func (s *SegmentTimes) AirTime() time.Duration {
+	return s.Landing.Sub(s.Takeoff)
+}
+
+// BlockTime returns the block duration (IN - OUT)
+func (s *SegmentTimes) BlockTime() time.Duration {
+	return s.In.Sub(s.Out)
+}
+
+// ResolveSegmentTimes anchors OUT/OFF/ON/IN UTC clock times to the flight date (YYYY-MM-DD).
+// OUT is placed on the flight date; every following time that is earlier than the previous one
+// rolls over to the next calendar day, so a red-eye such as OUT 23:10 / IN 01:05 resolves correctly.
+// Returns ErrFlightInvalidTimeSequence for unparseable values and ErrFlightSegmentSpanExceeded
+// when the resulting block time exceeds MaxSegmentBlockTime.
+func ResolveSegmentTimes(flightDate, outTime, takeoffTime, landingTime, inTime string) (*SegmentTimes, error) {
+	return resolveSegmentTimes(flightDate,
+		[]string{outTime, takeoffTime, landingTime, inTime},
+		[]*time.Location{time.UTC, time.UTC, time.UTC, time.UTC})
+}
+
+// ResolveLocalSegmentTimes works like ResolveSegmentTimes for times entered in airport local time:
+// flightDate and OUT/OFF are read in the origin time zone, ON/IN in the destination time zone.
+// The returned instants can be converted with UTC() for storage.
+func ResolveLocalSegmentTimes(flightDate, outTime, takeoffTime, landingTime, inTime string, origin, destination *time.Location) (*SegmentTimes, error) {
+	return resolveSegmentTimes(flightDate,
+		[]string{outTime, takeoffTime, landingTime, inTime},
+		[]*time.Location{origin, origin, destination, destination})
+}
+
+// resolveSegmentTimes places each clock time at its first occurrence, in its own location,
+// on or after the calendar day of the previous instant
+func resolveSegmentTimes(flightDate string, clocks []string, locations []*time.Location) (*SegmentTimes, error) {
+	date, err := ParseFlightDate(flightDate)
+	if err != nil {
+		return nil, ErrFlightInvalidTimeSequence
+	}
+
+	instants := make([]time.Time, len(clocks))
+	for i, value := range clocks {
+		clock, err := ParseClockTime(value)
+		if err != nil {
+			return nil, ErrFlightInvalidTimeSequence
+		}
+		loc := locations[i]
+		year, month, day := date.Date()
+		if i > 0 {
+			year, month, day = instants[i-1].In(loc).Date()
+		}
+		instant := time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+		if i > 0 {
+			if instant.Equal(instants[i-1]) {
+				return nil, ErrFlightInvalidTimeSequence
+			}
+			if instant.Before(instants[i-1]) {
+				instant = time.Date(year, month, day+1, clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+			}
+		}
+		instants[i] = instant
+	}
+
+	times := &SegmentTimes{
+		Out:     instants[0],
+		Takeoff: instants[1],
+		Landing: instants[2],
+		In:      instants[3],
+	}
+
+	if times.BlockTime() > MaxSegmentBlockTime {
+		return nil, ErrFlightSegmentSpanExceeded
+	}
+
+	return times, nil
+}
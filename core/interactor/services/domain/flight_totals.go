@@ -0,0 +1,79 @@
+package domain
+
+import "time"
+
+// FlightTotalsGroupBy is a dimension the flight totals can be grouped by
+type FlightTotalsGroupBy string
+
+const (
+	FlightTotalsByMonth          FlightTotalsGroupBy = "month"           // YYYY-MM of flight_real_date
+	FlightTotalsByAircraftModel  FlightTotalsGroupBy = "aircraft_model"  // aircraft_model.model_name
+	FlightTotalsByAircraftFamily FlightTotalsGroupBy = "aircraft_family" // aircraft_model.family
+	FlightTotalsByAirline        FlightTotalsGroupBy = "airline"         // airline.airline_code
+	FlightTotalsByPilotRole      FlightTotalsGroupBy = "pilot_role"
+	FlightTotalsByPilotFunction  FlightTotalsGroupBy = "pilot_function"
+	FlightTotalsByFlightType     FlightTotalsGroupBy = "flight_type"
+	FlightTotalsByApproachType   FlightTotalsGroupBy = "approach_type"
+)
+
+// ValidFlightTotalsGroupBy contains all supported grouping dimensions
+var ValidFlightTotalsGroupBy = []FlightTotalsGroupBy{
+	FlightTotalsByMonth,
+	FlightTotalsByAircraftModel,
+	FlightTotalsByAircraftFamily,
+	FlightTotalsByAirline,
+	FlightTotalsByPilotRole,
+	FlightTotalsByPilotFunction,
+	FlightTotalsByFlightType,
+	FlightTotalsByApproachType,
+}
+
+// IsValidFlightTotalsGroupBy checks if a string is a supported grouping dimension
+func IsValidFlightTotalsGroupBy(groupBy string) bool {
+	for _, g := range ValidFlightTotalsGroupBy {
+		if string(g) == groupBy {
+			return true
+		}
+	}
+	return false
+}
+
+// FlightTotalsFilter defines the employee, date range and grouping for a totals query
+type FlightTotalsFilter struct {
+	EmployeeID string
+	From       *time.Time // Inclusive, compared against flight_real_date
+	To         *time.Time // Inclusive, compared against flight_real_date
+	GroupBy    []FlightTotalsGroupBy
+
+	IncludeSimulator bool // Also report simulator session time, per device type
+}
+
+// FlightTotals holds accumulated times and counts for one group of segments
+type FlightTotals struct {
+	Keys         map[FlightTotalsGroupBy]string // Value of each grouping dimension (empty when not grouped)
+	SegmentCount int
+	BlockTime    time.Duration
+	AirTime      time.Duration
+	DutyTime     time.Duration
+	NightTime    time.Duration
+	Columns      LogbookColumnTimes // Aircraft category, function and IFR columns
+}
+
+// Add accumulates another set of totals into t (keys are not modified)
+func (t *FlightTotals) Add(other FlightTotals) {
+	t.SegmentCount += other.SegmentCount
+	t.BlockTime += other.BlockTime
+	t.AirTime += other.AirTime
+	t.DutyTime += other.DutyTime
+	t.NightTime += other.NightTime
+	t.Columns.Add(other.Columns)
+}
+
+// FlightTotalsReport is the result of a totals query: the overall totals plus one entry per group
+type FlightTotalsReport struct {
+	Filter    FlightTotalsFilter
+	Totals    FlightTotals
+	Groups    []FlightTotals
+	Simulator []SimulatorTotals // Simulator time per device type, only when requested
+	Prior     *FlightTotals     // Opening balances dated within the range; included in Totals but not in Groups
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// FTLMetric is the accumulated time a flight time limitation applies to
+type FTLMetric string
+
+const (
+	FTLMetricBlockTime FTLMetric = "block_time" // Tiempo de bloque (IN - OUT)
+	FTLMetricDutyTime  FTLMetric = "duty_time"  // Tiempo de servicio
+)
+
+// FTLLevel is the compliance level of an employee against a limit
+type FTLLevel string
+
+const (
+	FTLLevelOK       FTLLevel = "OK"
+	FTLLevelWarning  FTLLevel = "WARNING"  // Usage reached the warning ratio of the limit
+	FTLLevelExceeded FTLLevel = "EXCEEDED" // Usage is above the limit
+)
+
+// DefaultFTLWarningRatio is the fraction of a limit from which a warning is raised
+const DefaultFTLWarningRatio = 0.9
+
+// FTLLimit is a single flight time limitation over a rolling window or the calendar year
+type FTLLimit struct {
+	Code         string        `json:"code"` // Identifier shown to the user (e.g., "block_28d")
+	Metric       FTLMetric     `json:"metric"`
+	WindowDays   int           `json:"window_days,omitempty"` // Rolling window length in days (ignored for calendar year)
+	CalendarYear bool          `json:"calendar_year,omitempty"`
+	Max          time.Duration `json:"-"`
+	Blocking     bool          `json:"blocking"` // Exceeding the limit rejects the segment instead of warning
+}
+
+// Window returns the first and last day (inclusive) of the limit window that ends on the given day
+func (l FTLLimit) Window(end time.Time) (time.Time, time.Time) {
+	end = truncateToDay(end)
+	if l.CalendarYear {
+		return time.Date(end.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), end
+	}
+	return end.AddDate(0, 0, -(l.WindowDays - 1)), end
+}
+
+// DefaultFTLLimits returns the limits applied when none are configured
+func DefaultFTLLimits() []FTLLimit {
+	return []FTLLimit{
+		{Code: "duty_7d", Metric: FTLMetricDutyTime, WindowDays: 7, Max: 60 * time.Hour, Blocking: true},
+		{Code: "duty_28d", Metric: FTLMetricDutyTime, WindowDays: 28, Max: 190 * time.Hour, Blocking: true},
+		{Code: "block_28d", Metric: FTLMetricBlockTime, WindowDays: 28, Max: 100 * time.Hour, Blocking: true},
+		{Code: "block_365d", Metric: FTLMetricBlockTime, WindowDays: 365, Max: 1000 * time.Hour, Blocking: true},
+		{Code: "block_year", Metric: FTLMetricBlockTime, CalendarYear: true, Max: 900 * time.Hour, Blocking: true},
+	}
+}
+
+// DailyFlightTime holds the accumulated times of an employee for one flight date
+type DailyFlightTime struct {
+	Date      time.Time
+	BlockTime time.Duration
+	DutyTime  time.Duration
+}
+
+// Value returns the accumulated time for the metric
+func (d DailyFlightTime) Value(metric FTLMetric) time.Duration {
+	if metric == FTLMetricDutyTime {
+		return d.DutyTime
+	}
+	return d.BlockTime
+}
+
+// FTLUsage is the usage of one limit over a concrete window
+type FTLUsage struct {
+	Limit       FTLLimit
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Used        time.Duration
+	Level       FTLLevel
+}
+
+// Remaining returns the time left before the limit is reached (never negative)
+func (u FTLUsage) Remaining() time.Duration {
+	if u.Used >= u.Limit.Max {
+		return 0
+	}
+	return u.Limit.Max - u.Used
+}
+
+// FTLStatus is the usage of every configured limit for an employee as of a date
+type FTLStatus struct {
+	EmployeeID string
+	AsOf       time.Time
+	Usages     []FTLUsage
+}
+
+// FTLLimitExceededError is returned when a segment would exceed a blocking limit
+type FTLLimitExceededError struct {
+	Usage FTLUsage
+}
+
+func (e *FTLLimitExceededError) Error() string {
+	return fmt.Sprintf("%s: %s used %s of %s", ErrFTLLimitExceeded.Error(), e.Usage.Limit.Code,
+		FormatFlightDuration(e.Usage.Used), FormatFlightDuration(e.Usage.Limit.Max))
+}
+
+// Unwrap allows errors.Is(err, ErrFTLLimitExceeded)
+func (e *FTLLimitExceededError) Unwrap() error {
+	return ErrFTLLimitExceeded
+}
+
+// FTLMessageParams returns the message catalog params for a usage:
+// limit code, used, limit, window start and window end
+func FTLMessageParams(u FTLUsage) []string {
+	return []string{
+		u.Limit.Code,
+		FormatFlightDuration(u.Used),
+		FormatFlightDuration(u.Limit.Max),
+		u.WindowStart.Format("2006-01-02"),
+		u.WindowEnd.Format("2006-01-02"),
+	}
+}
+
+// ValidationWarning is a non-blocking finding raised while saving a segment.
+// Code is a message catalog code; Params fill its ${0}, ${1}... placeholders.
+type ValidationWarning struct {
+	Code     string
+	Params   []string
+	DetailID string // Related segment, when the warning points at another segment
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
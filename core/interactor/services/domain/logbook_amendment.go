@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AmendmentAction is the change an amendment makes to a signed logbook
+type AmendmentAction string
+
+const (
+	AmendmentActionCreate AmendmentAction = "CREATE" // Add a segment that was left out
+	AmendmentActionUpdate AmendmentAction = "UPDATE" // Correct a segment
+	AmendmentActionDelete AmendmentAction = "DELETE" // Remove a segment
+)
+
+// IsValidAmendmentAction checks if a string is a valid amendment action
+func IsValidAmendmentAction(action string) bool {
+	switch AmendmentAction(action) {
+	case AmendmentActionCreate, AmendmentActionUpdate, AmendmentActionDelete:
+		return true
+	}
+	return false
+}
+
+// AmendmentStatus is the state of an amendment request
+type AmendmentStatus string
+
+const (
+	AmendmentStatusPending  AmendmentStatus = "PENDING"  // Waiting for the signature
+	AmendmentStatusApplied  AmendmentStatus = "APPLIED"  // Signed and applied; the logbook was re-signed
+	AmendmentStatusRejected AmendmentStatus = "REJECTED" // Discarded without changes
+)
+
+// FieldChange is a field that differs between two versions of a record (amendments, change history)
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// LogbookAmendment is a correction of a signed daily logbook. It records who asked for it, why and
+// what changes, and it is only applied when signed, which re-signs the logbook.
+type LogbookAmendment struct {
+	ID                   string
+	DailyLogbookID       string
+	DailyLogbookDetailID string // Segment amended; for CREATE, the ID the new segment will get
+	Action               AmendmentAction
+	Reason               string
+	AuthorID             string
+	Changes              []FieldChange
+	Proposed             *DailyLogbookDetail // Segment as it will be saved (nil for DELETE)
+	OverrideConflicts    bool                // Apply even if the segment duplicates or overlaps another one
+	Status               AmendmentStatus
+	CreatedAt            time.Time
+	ResolvedBy           *string // Employee who signed or rejected it
+	ResolvedAt           *time.Time
+}
+
+// SetID generates a new UUID for the amendment
+func (a *LogbookAmendment) SetID() {
+	a.ID = uuid.New().String()
+}
+
+// IsPending reports whether the amendment can still be signed or rejected
+func (a *LogbookAmendment) IsPending() bool {
+	return a.Status == AmendmentStatusPending
+}
+
+// Resolve marks the amendment as applied or rejected
+func (a *LogbookAmendment) Resolve(status AmendmentStatus, employeeID string, at time.Time) {
+	a.Status = status
+	a.ResolvedBy = &employeeID
+	a.ResolvedAt = &at
+}
+
+// DiffDailyLogbookDetail returns the fields that differ between two versions of a segment. A nil
+// version stands for a segment that does not exist (CREATE or DELETE). Times are compared as HH:MM.
+func DiffDailyLogbookDetail(before, after *DailyLogbookDetail) []FieldChange {
+	b, a := amendableFields(before), amendableFields(after)
+	changes := []FieldChange{}
+	for i := range b {
+		if b[i][1] != a[i][1] {
+			changes = append(changes, FieldChange{Field: b[i][0], Before: b[i][1], After: a[i][1]})
+		}
+	}
+	return changes
+}
+
+// amendableFields returns the user-entered fields of a segment as (name, value) pairs in a fixed order
+func amendableFields(d *DailyLogbookDetail) [][2]string {
+	if d == nil {
+		d = &DailyLogbookDetail{}
+	}
+	approachType := ""
+	if d.ApproachType != nil {
+		approachType = string(*d.ApproachType)
+	}
+	pilotFunction := ""
+	if d.PilotFunction != nil {
+		pilotFunction = string(*d.PilotFunction)
+	}
+	wetLease := ""
+	if d.WetLease {
+		wetLease = strconv.FormatBool(d.WetLease)
+	}
+	return [][2]string{
+		{"flight_real_date", canonicalDate(d.FlightRealDate)},
+		{"flight_number", d.FlightNumber},
+		{"airline_route_id", d.AirlineRouteID},
+		{"actual_aircraft_registration_id", d.ActualAircraftRegistrationID},
+		{"passengers", intPtrValue(d.Passengers)},
+		{"out_time", clockValue(d.OutTime)},
+		{"takeoff_time", clockValue(d.TakeoffTime)},
+		{"landing_time", clockValue(d.LandingTime)},
+		{"in_time", clockValue(d.InTime)},
+		{"pilot_role", string(d.PilotRole)},
+		{"companion_name", stringPtrValue(d.CompanionName)},
+		{"companion_employee_id", stringPtrValue(d.CompanionEmployeeID)},
+		{"pilot_function", pilotFunction},
+		{"air_time", clockValue(d.AirTime)},
+		{"block_time", clockValue(d.BlockTime)},
+		{"duty_time", clockValue(stringPtrValue(d.DutyTime))},
+		{"ifr_time", clockValue(stringPtrValue(d.IFRTime))},
+		{"approach_type", approachType},
+		{"flight_type", stringPtrValue(d.FlightType)},
+		{"wet_lease", wetLease},
+	}
+}
+
+// canonicalDate returns the YYYY-MM-DD part of a flight date (MySQL DATE values arrive as RFC3339)
+func canonicalDate(value string) string {
+	if t, err := ParseFlightDate(value); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return strings.TrimSpace(value)
+}
+
+// clockValue trims the seconds of an HH:MM:SS value
+func clockValue(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) == len("15:04:05") && s[2] == ':' && s[5] == ':' {
+		return s[:5]
+	}
+	return s
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intPtrValue(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
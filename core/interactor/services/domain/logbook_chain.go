@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogbookChainGenesis is the previous hash of the first segment of every employee's chain
+var LogbookChainGenesis = strings.Repeat("0", sha256.Size*2)
+
+// LogbookChainLink is a segment of the chain whose stored hash does not match the recomputed one
+type LogbookChainLink struct {
+	Position       int // 1-based position in the chain
+	DetailID       string
+	DailyLogbookID string
+	FlightRealDate string
+	OutTime        string
+	StoredHash     string // Empty when the segment was never sealed
+	ExpectedHash   string
+}
+
+// LogbookChainReport is the result of recomputing an employee's chain of signed segments
+type LogbookChainReport struct {
+	EmployeeID  string
+	EntryCount  int
+	HeadHash    string            // Hash of the last segment, as recomputed
+	FirstBroken *LogbookChainLink // nil when every stored hash matches
+	VerifiedAt  time.Time
+}
+
+// Valid reports whether the whole chain matches the stored hashes
+func (r *LogbookChainReport) Valid() bool {
+	return r.FirstBroken == nil
+}
+
+// chainLaterFields returns the segment fields added to the chain after it was introduced, in the order
+// they were added. They are only serialized when set, so segments sealed before they existed keep their
+// hash; new fields go at the end of the list.
+func chainLaterFields(d *DailyLogbookDetail) [][2]string {
+	pilotFunction := ""
+	if d.PilotFunction != nil {
+		pilotFunction = string(*d.PilotFunction)
+	}
+	return [][2]string{
+		{"companion_employee_id", stringPtrValue(d.CompanionEmployeeID)},
+		{"pilot_function", pilotFunction},
+		{"ifr_time", clockValue(stringPtrValue(d.IFRTime))},
+	}
+}
+
+// CanonicalSerialization returns the segment data covered by the chain hash: identity, route, aircraft,
+// times and derived counters, one "field=value" line each in a fixed order. Dates are YYYY-MM-DD and
+// clock times HH:MM, so the value does not depend on how MySQL returns them.
+func (d *DailyLogbookDetail) CanonicalSerialization() string {
+	approachType := ""
+	if d.ApproachType != nil {
+		approachType = string(*d.ApproachType)
+	}
+	wetLease := ""
+	if d.WetLease {
+		wetLease = strconv.FormatBool(d.WetLease)
+	}
+	// The list is the chain's own rather than amendableFields: a segment sealed once has to hash the
+	// same for good, whatever fields are added to the segment afterwards
+	fields := [][2]string{
+		{"id", d.ID},
+		{"daily_logbook_id", d.DailyLogbookID},
+		{"flight_real_date", canonicalDate(d.FlightRealDate)},
+		{"flight_number", d.FlightNumber},
+		{"airline_route_id", d.AirlineRouteID},
+		{"actual_aircraft_registration_id", d.ActualAircraftRegistrationID},
+		{"passengers", intPtrValue(d.Passengers)},
+		{"out_time", clockValue(d.OutTime)},
+		{"takeoff_time", clockValue(d.TakeoffTime)},
+		{"landing_time", clockValue(d.LandingTime)},
+		{"in_time", clockValue(d.InTime)},
+		{"pilot_role", string(d.PilotRole)},
+		{"companion_name", stringPtrValue(d.CompanionName)},
+		{"air_time", clockValue(d.AirTime)},
+		{"block_time", clockValue(d.BlockTime)},
+		{"duty_time", clockValue(stringPtrValue(d.DutyTime))},
+		{"approach_type", approachType},
+		{"flight_type", stringPtrValue(d.FlightType)},
+		{"wet_lease", wetLease},
+		{"night_time", clockValue(stringPtrValue(d.NightTime))},
+		{"day_takeoffs", intPtrValue(d.DayTakeoffs)},
+		{"night_takeoffs", intPtrValue(d.NightTakeoffs)},
+		{"day_landings", intPtrValue(d.DayLandings)},
+		{"night_landings", intPtrValue(d.NightLandings)},
+	}
+	for _, f := range chainLaterFields(d) {
+		if f[1] != "" {
+			fields = append(fields, f)
+		}
+	}
+
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(f[0])
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(f[1]))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// LogbookChainHash returns the hash of a segment chained to the hash of the previous one:
+// hex(SHA-256(previous + "\n" + canonical serialization))
+func LogbookChainHash(previous string, d DailyLogbookDetail) string {
+	sum := sha256.Sum256([]byte(previous + "\n" + d.CanonicalSerialization()))
+	return hex.EncodeToString(sum[:])
+}
+
+// SortLogbookChain returns a copy of the segments in chain order: flight date, OUT time, then ID
+// so that segments with the same date and time keep a stable position
+func SortLogbookChain(details []DailyLogbookDetail) []DailyLogbookDetail {
+	sorted := make([]DailyLogbookDetail, len(details))
+	copy(sorted, details)
+
+	sort.SliceStable(sorted, func(a, b int) bool {
+		da, db := canonicalDate(sorted[a].FlightRealDate), canonicalDate(sorted[b].FlightRealDate)
+		if da != db {
+			return da < db
+		}
+		oa, ob := clockValue(sorted[a].OutTime), clockValue(sorted[b].OutTime)
+		if oa != ob {
+			return oa < ob
+		}
+		return sorted[a].ID < sorted[b].ID
+	})
+	return sorted
+}
+
+// VerifyLogbookChain recomputes the chain over an employee's signed segments and reports the first
+// segment whose stored hash differs. A segment edited, inserted or deleted outside the application
+// breaks the chain at that position.
+func VerifyLogbookChain(details []DailyLogbookDetail) *LogbookChainReport {
+	report := &LogbookChainReport{EntryCount: len(details), HeadHash: LogbookChainGenesis, VerifiedAt: time.Now().UTC()}
+
+	previous := LogbookChainGenesis
+	for i, d := range SortLogbookChain(details) {
+		expected := LogbookChainHash(previous, d)
+		stored := stringPtrValue(d.ChainHash)
+		if stored != expected && report.FirstBroken == nil {
+			report.FirstBroken = &LogbookChainLink{
+				Position:       i + 1,
+				DetailID:       d.ID,
+				DailyLogbookID: d.DailyLogbookID,
+				FlightRealDate: canonicalDate(d.FlightRealDate),
+				OutTime:        clockValue(d.OutTime),
+				StoredHash:     stored,
+				ExpectedHash:   expected,
+			}
+		}
+		previous = expected
+	}
+	report.HeadHash = previous
+	return report
+}
+
+// SealLogbookChain recomputes the chain over an employee's signed segments and returns, in chain order,
+// the segments whose stored hash has to change, with ChainHash set to the new value
+func SealLogbookChain(details []DailyLogbookDetail) []DailyLogbookDetail {
+	var changed []DailyLogbookDetail
+	previous := LogbookChainGenesis
+	for _, d := range SortLogbookChain(details) {
+		hash := LogbookChainHash(previous, d)
+		if stringPtrValue(d.ChainHash) != hash {
+			d.ChainHash = &hash
+			changed = append(changed, d)
+		}
+		previous = hash
+	}
+	return changed
+}
+
+// ReplaceLogbookChainEntry returns the chain segments with the segment detailID removed and, when
+// replacement is not nil, the replacement added (an amendment creating, updating or deleting a segment)
+func ReplaceLogbookChainEntry(details []DailyLogbookDetail, detailID string, replacement *DailyLogbookDetail) []DailyLogbookDetail {
+	result := make([]DailyLogbookDetail, 0, len(details)+1)
+	for _, d := range details {
+		if d.ID != detailID {
+			result = append(result, d)
+		}
+	}
+	if replacement != nil {
+		result = append(result, *replacement)
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogbookChain(t *testing.T) {
+	segment := func(id, date, out string) DailyLogbookDetail {
+		return DailyLogbookDetail{ID: id, DailyLogbookID: "lb-" + date, FlightRealDate: date, FlightNumber: "AV" + id,
+			OutTime: out, InTime: "23:00:00", BlockTime: "01:00:00", PilotRole: "PF"}
+	}
+	sealed := func(details ...DailyLogbookDetail) []DailyLogbookDetail {
+		for _, d := range SealLogbookChain(details) {
+			for i := range details {
+				if details[i].ID == d.ID {
+					details[i].ChainHash = d.ChainHash
+				}
+			}
+		}
+		return details
+	}
+
+	t.Run("sealed chain verifies", func(t *testing.T) {
+		chain := sealed(segment("2", "2026-03-02", "08:00:00"), segment("1", "2026-03-01", "10:00:00"))
+		report := VerifyLogbookChain(chain)
+		if !report.Valid() || report.EntryCount != 2 || report.HeadHash != *chain[0].ChainHash {
+			t.Fatalf("expected a valid chain headed by the latest segment, got %+v", report)
+		}
+		if len(SealLogbookChain(chain)) != 0 {
+			t.Fatalf("expected nothing to reseal")
+		}
+	})
+
+	t.Run("date format from MySQL does not change the hash", func(t *testing.T) {
+		a, b := segment("1", "2026-03-01", "10:00:00"), segment("1", "2026-03-01T00:00:00Z", "10:00")
+		b.DailyLogbookID = a.DailyLogbookID
+		if LogbookChainHash(LogbookChainGenesis, a) != LogbookChainHash(LogbookChainGenesis, b) {
+			t.Fatalf("expected equal hashes")
+		}
+	})
+
+	t.Run("edited segment breaks the chain at its position", func(t *testing.T) {
+		chain := sealed(segment("1", "2026-03-01", "10:00:00"), segment("2", "2026-03-02", "08:00:00"), segment("3", "2026-03-03", "08:00:00"))
+		chain[1].BlockTime = "02:00:00"
+		report := VerifyLogbookChain(chain)
+		if report.Valid() || report.FirstBroken.Position != 2 || report.FirstBroken.DetailID != "2" {
+			t.Fatalf("expected the chain to break at segment 2, got %+v", report.FirstBroken)
+		}
+	})
+
+	t.Run("deleted segment breaks the chain at the next one", func(t *testing.T) {
+		chain := sealed(segment("1", "2026-03-01", "10:00:00"), segment("2", "2026-03-02", "08:00:00"), segment("3", "2026-03-03", "08:00:00"))
+		report := VerifyLogbookChain(ReplaceLogbookChainEntry(chain, "2", nil))
+		if report.Valid() || report.FirstBroken.DetailID != "3" {
+			t.Fatalf("expected the chain to break at segment 3, got %+v", report.FirstBroken)
+		}
+	})
+
+	t.Run("amending a past segment reseals the links that follow", func(t *testing.T) {
+		chain := sealed(segment("1", "2026-03-01", "10:00:00"), segment("2", "2026-03-02", "08:00:00"), segment("3", "2026-03-03", "08:00:00"))
+		amended := chain[1]
+		amended.FlightNumber = "AV999"
+		changed := SealLogbookChain(ReplaceLogbookChainEntry(chain, "2", &amended))
+		if len(changed) != 2 || changed[0].ID != "2" || changed[1].ID != "3" {
+			t.Fatalf("expected segments 2 and 3 to be resealed, got %+v", changed)
+		}
+	})
+
+	t.Run("hash of a segment sealed when the chain was introduced does not change", func(t *testing.T) {
+		passengers, night := 120, "00:20:00"
+		d := DailyLogbookDetail{ID: "seg-1", DailyLogbookID: "lb-1", FlightRealDate: "2026-03-01", FlightNumber: "AV9340",
+			AirlineRouteID: "ar-1", ActualAircraftRegistrationID: "reg-1", Passengers: &passengers, OutTime: "08:00:00",
+			TakeoffTime: "08:15:00", LandingTime: "09:20:00", InTime: "09:30:00", AirTime: "01:05:00", BlockTime: "01:30:00",
+			PilotRole: "PF", NightTime: &night}
+		if hash := LogbookChainHash(LogbookChainGenesis, d); hash != "096377ea68a07c5946b61aa3fb8e0aff39283f1f0c43a1f8213e9834d71c8048" {
+			t.Fatalf("expected the hash sealed by earlier versions, got %s", hash)
+		}
+	})
+
+	t.Run("fields added later do not change existing hashes", func(t *testing.T) {
+		a := segment("1", "2026-03-01", "10:00:00")
+		if strings.Contains(a.CanonicalSerialization(), "companion_employee_id") {
+			t.Fatalf("expected unset later fields to be left out")
+		}
+		b := a
+		companion := "emp-2"
+		b.CompanionEmployeeID = &companion
+		if LogbookChainHash(LogbookChainGenesis, a) == LogbookChainHash(LogbookChainGenesis, b) {
+			t.Fatalf("expected a linked companion to be covered by the hash")
+		}
+		c := a
+		pic := PilotFunctionPIC
+		c.PilotFunction = &pic
+		if LogbookChainHash(LogbookChainGenesis, a) == LogbookChainHash(LogbookChainGenesis, c) {
+			t.Fatalf("expected a set pilot function to be covered by the hash")
+		}
+	})
+}
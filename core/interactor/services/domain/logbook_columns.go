@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+// LogbookColumnTimes breaks block time down into the standard pilot logbook columns that license
+// applications ask for. The aircraft columns follow the EASA layout: single-pilot time split by single and
+// multi-engine, and multi-pilot time; turbine time is kept apart. The function columns count the block time
+// of the segments logged in that function, and IFR time is the time entered by the pilot.
+type LogbookColumnTimes struct {
+	SinglePilotSE time.Duration // Single-pilot, single-engine aircraft
+	SinglePilotME time.Duration // Single-pilot, multi-engine aircraft
+	MultiPilot    time.Duration // Multi-pilot aircraft
+	Turbine       time.Duration
+	PIC           time.Duration
+	SIC           time.Duration
+	Dual          time.Duration
+	Instructor    time.Duration
+	PICUS         time.Duration
+	IFR           time.Duration
+}
+
+// Add accumulates another set of column times into t
+func (t *LogbookColumnTimes) Add(other LogbookColumnTimes) {
+	t.SinglePilotSE += other.SinglePilotSE
+	t.SinglePilotME += other.SinglePilotME
+	t.MultiPilot += other.MultiPilot
+	t.Turbine += other.Turbine
+	t.PIC += other.PIC
+	t.SIC += other.SIC
+	t.Dual += other.Dual
+	t.Instructor += other.Instructor
+	t.PICUS += other.PICUS
+	t.IFR += other.IFR
+}
+
+// AddDetail logs the block time of one segment under its aircraft and function columns
+func (t *LogbookColumnTimes) AddDetail(d DailyLogbookDetail) {
+	block := parseOptionalDuration(&d.BlockTime)
+	switch {
+	case d.MultiPilot:
+		t.MultiPilot += block
+	case d.MultiEngine:
+		t.SinglePilotME += block
+	default:
+		t.SinglePilotSE += block
+	}
+	if d.Turbine {
+		t.Turbine += block
+	}
+	if d.PilotFunction != nil {
+		switch *d.PilotFunction {
+		case PilotFunctionPIC:
+			t.PIC += block
+		case PilotFunctionSIC:
+			t.SIC += block
+		case PilotFunctionDual:
+			t.Dual += block
+		case PilotFunctionInstructor:
+			t.Instructor += block
+		case PilotFunctionPICUS:
+			t.PICUS += block
+		}
+	}
+	t.IFR += parseOptionalDuration(d.IFRTime)
+}
+
+// ComplementaryPilotFunction returns the function the companion usually logs the same segment in:
+// PIC↔SIC, the supervising PIC for PICUS and instructor↔dual. Returns nil when there is no function.
+func ComplementaryPilotFunction(function *PilotFunction) *PilotFunction {
+	if function == nil {
+		return nil
+	}
+	complementary := *function
+	switch *function {
+	case PilotFunctionPIC:
+		complementary = PilotFunctionSIC
+	case PilotFunctionSIC, PilotFunctionPICUS:
+		complementary = PilotFunctionPIC
+	case PilotFunctionDual:
+		complementary = PilotFunctionInstructor
+	case PilotFunctionInstructor:
+		complementary = PilotFunctionDual
+	}
+	return &complementary
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLogbookColumnTimes(t *testing.T) {
+	function := func(f PilotFunction) *PilotFunction { return &f }
+	ifr := "00:30"
+
+	var c LogbookColumnTimes
+	c.AddDetail(DailyLogbookDetail{BlockTime: "01:00:00", MultiPilot: true, MultiEngine: true, Turbine: true, PilotFunction: function(PilotFunctionSIC), IFRTime: &ifr})
+	c.AddDetail(DailyLogbookDetail{BlockTime: "02:00:00", MultiEngine: true, PilotFunction: function(PilotFunctionPIC)})
+	c.AddDetail(DailyLogbookDetail{BlockTime: "00:45:00"})
+
+	want := LogbookColumnTimes{
+		SinglePilotSE: 45 * time.Minute,
+		SinglePilotME: 2 * time.Hour,
+		MultiPilot:    time.Hour,
+		Turbine:       time.Hour,
+		PIC:           2 * time.Hour,
+		SIC:           time.Hour,
+		IFR:           30 * time.Minute,
+	}
+	if c != want {
+		t.Fatalf("expected %+v, got %+v", want, c)
+	}
+
+	t.Run("complementary functions", func(t *testing.T) {
+		cases := map[PilotFunction]PilotFunction{
+			PilotFunctionPIC: PilotFunctionSIC, PilotFunctionSIC: PilotFunctionPIC, PilotFunctionPICUS: PilotFunctionPIC,
+			PilotFunctionDual: PilotFunctionInstructor, PilotFunctionInstructor: PilotFunctionDual,
+		}
+		for f, want := range cases {
+			if got := ComplementaryPilotFunction(function(f)); got == nil || *got != want {
+				t.Fatalf("expected %s for %s, got %v", want, f, got)
+			}
+		}
+		if ComplementaryPilotFunction(nil) != nil {
+			t.Fatalf("expected no function for a segment without one")
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package domain
+
+import "time"
+
+// LogbookExportFormat is the file format of a logbook export
+type LogbookExportFormat string
+
+const (
+	LogbookExportPDF LogbookExportFormat = "pdf"
+	LogbookExportCSV LogbookExportFormat = "csv"
+)
+
+// IsValidLogbookExportFormat checks if a string is a supported export format
+func IsValidLogbookExportFormat(format string) bool {
+	return format == string(LogbookExportPDF) || format == string(LogbookExportCSV)
+}
+
+// LogbookExportFilter defines the employee and flight date range of an export
+type LogbookExportFilter struct {
+	EmployeeID string
+	From       time.Time // Inclusive, compared against flight_real_date
+	To         time.Time // Inclusive, compared against flight_real_date
+}
+
+// LogbookTotals are the column totals of a pilot logbook
+type LogbookTotals struct {
+	Segments      int
+	BlockTime     time.Duration
+	AirTime       time.Duration
+	NightTime     time.Duration
+	DutyTime      time.Duration
+	DayTakeoffs   int
+	NightTakeoffs int
+	DayLandings   int
+	NightLandings int
+	Columns       LogbookColumnTimes // Aircraft category, function and IFR columns
+}
+
+// Add accumulates another set of totals into t
+func (t *LogbookTotals) Add(other LogbookTotals) {
+	t.Segments += other.Segments
+	t.BlockTime += other.BlockTime
+	t.AirTime += other.AirTime
+	t.NightTime += other.NightTime
+	t.DutyTime += other.DutyTime
+	t.DayTakeoffs += other.DayTakeoffs
+	t.NightTakeoffs += other.NightTakeoffs
+	t.DayLandings += other.DayLandings
+	t.NightLandings += other.NightLandings
+	t.Columns.Add(other.Columns)
+}
+
+// AddDetail accumulates the times and counts of one segment into t (unparseable times count as zero)
+func (t *LogbookTotals) AddDetail(d DailyLogbookDetail) {
+	t.Segments++
+	t.BlockTime += parseOptionalDuration(&d.BlockTime)
+	t.AirTime += parseOptionalDuration(&d.AirTime)
+	t.NightTime += parseOptionalDuration(d.NightTime)
+	t.DutyTime += parseOptionalDuration(d.DutyTime)
+	t.DayTakeoffs += intValue(d.DayTakeoffs)
+	t.NightTakeoffs += intValue(d.NightTakeoffs)
+	t.DayLandings += intValue(d.DayLandings)
+	t.NightLandings += intValue(d.NightLandings)
+	t.Columns.AddDetail(d)
+}
+
+// LogbookPosition is the place of a segment in logbook page order: by BookPage with segments
+// without a page last, then flight date, OUT time and ID
+type LogbookPosition struct {
+	BookPage       *int
+	FlightRealDate string // YYYY-MM-DD
+	OutTime        string
+	DetailID       string
+}
+
+// LogbookPositionOf returns the logbook position of a segment
+func LogbookPositionOf(d DailyLogbookDetail) LogbookPosition {
+	return LogbookPosition{
+		BookPage:       d.BookPage,
+		FlightRealDate: canonicalDate(d.FlightRealDate),
+		OutTime:        clockValue(d.OutTime),
+		DetailID:       d.ID,
+	}
+}
+
+// Before reports whether p comes before other in logbook page order
+func (p LogbookPosition) Before(other LogbookPosition) bool {
+	if (p.BookPage == nil) != (other.BookPage == nil) {
+		return other.BookPage == nil
+	}
+	if p.BookPage != nil && *p.BookPage != *other.BookPage {
+		return *p.BookPage < *other.BookPage
+	}
+	if p.FlightRealDate != other.FlightRealDate {
+		return p.FlightRealDate < other.FlightRealDate
+	}
+	if p.OutTime != other.OutTime {
+		return p.OutTime < other.OutTime
+	}
+	return p.DetailID < other.DetailID
+}
+
+// LogbookPageInconsistencyKind is the way a segment's flight date disagrees with its logbook page
+type LogbookPageInconsistencyKind string
+
+const (
+	// LogbookPageOutsideRange marks a segment dated outside the exported range that is printed
+	// because its page holds segments of the range
+	LogbookPageOutsideRange LogbookPageInconsistencyKind = "OUTSIDE_RANGE"
+	// LogbookPageOutOfDateOrder marks a segment dated before a flight of an earlier page
+	LogbookPageOutOfDateOrder LogbookPageInconsistencyKind = "OUT_OF_DATE_ORDER"
+)
+
+// LogbookPageInconsistency reports a segment whose flight date disagrees with its logbook page.
+// The segment stays on its page; the report lets the pilot correct the page or the date.
+type LogbookPageInconsistency struct {
+	Kind           LogbookPageInconsistencyKind
+	DetailID       string
+	FlightRealDate string
+}
+
+// LogbookExportPage is one logbook page: the segments recorded under a BookPage with
+// the totals brought forward from previous pages, the page totals and the totals carried forward
+type LogbookExportPage struct {
+	BookPage        *int // nil for logbooks without an assigned page
+	Details         []DailyLogbookDetail
+	BroughtForward  LogbookTotals
+	PageTotals      LogbookTotals
+	CarriedForward  LogbookTotals
+	Inconsistencies []LogbookPageInconsistency
+}
+
+// Inconsistency returns the inconsistency reported for a segment of the page, if any
+func (p LogbookExportPage) Inconsistency(detailID string) (LogbookPageInconsistency, bool) {
+	for _, inc := range p.Inconsistencies {
+		if inc.DetailID == detailID {
+			return inc, true
+		}
+	}
+	return LogbookPageInconsistency{}, false
+}
+
+// LogbookExport is a printable pilot logbook for a date range
+type LogbookExport struct {
+	Filter       LogbookExportFilter
+	EmployeeName string
+	Pages        []LogbookExportPage
+}
+
+func parseOptionalDuration(value *string) time.Duration {
+	if value == nil || *value == "" {
+		return 0
+	}
+	d, err := ParseFlightDuration(*value)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
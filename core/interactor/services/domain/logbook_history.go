@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoryEntityType is the kind of record a change history entry belongs to
+type HistoryEntityType string
+
+const (
+	HistoryEntityDailyLogbook       HistoryEntityType = "DAILY_LOGBOOK"
+	HistoryEntityDailyLogbookDetail HistoryEntityType = "DAILY_LOGBOOK_DETAIL"
+)
+
+// HistoryAction is the operation recorded by a change history entry
+type HistoryAction string
+
+const (
+	HistoryActionCreate  HistoryAction = "CREATE"
+	HistoryActionUpdate  HistoryAction = "UPDATE"
+	HistoryActionDelete  HistoryAction = "DELETE"
+	HistoryActionRestore HistoryAction = "RESTORE" // A soft deleted record was brought back
+)
+
+// HistoryActor identifies who made a change: the authenticated employee and the request's trace ID
+type HistoryActor struct {
+	EmployeeID string
+	TraceID    string
+}
+
+type historyActorKey struct{}
+
+// ContextWithHistoryActor returns a context carrying the actor recorded on the changes made with it
+func ContextWithHistoryActor(ctx context.Context, actor HistoryActor) context.Context {
+	return context.WithValue(ctx, historyActorKey{}, actor)
+}
+
+// HistoryActorFromContext returns the actor set by ContextWithHistoryActor; empty for background jobs
+func HistoryActorFromContext(ctx context.Context) HistoryActor {
+	actor, _ := ctx.Value(historyActorKey{}).(HistoryActor)
+	return actor
+}
+
+// LogbookHistoryEntry is a create, update or delete of a daily logbook or one of its segments,
+// with the field-level diff. Segment entries keep their logbook ID so the day's history survives
+// the deletion of the segment.
+type LogbookHistoryEntry struct {
+	ID             string
+	EntityType     HistoryEntityType
+	EntityID       string
+	DailyLogbookID string
+	Action         HistoryAction
+	EmployeeID     string // Acting employee, empty for changes outside a request
+	TraceID        string
+	Changes        []FieldChange
+	CreatedAt      time.Time
+}
+
+// IsEmpty reports whether the entry records no change (an update that left every field as it was)
+func (e *LogbookHistoryEntry) IsEmpty() bool {
+	return e.Action == HistoryActionUpdate && len(e.Changes) == 0
+}
+
+// NewDailyLogbookHistory builds the history entry of a logbook change; before is nil on create and
+// after is nil on delete
+func NewDailyLogbookHistory(ctx context.Context, before, after *DailyLogbook) LogbookHistoryEntry {
+	current := after
+	if current == nil {
+		current = before
+	}
+	return newLogbookHistoryEntry(ctx, HistoryEntityDailyLogbook, current.ID, current.ID,
+		historyAction(before == nil, after == nil), DiffDailyLogbook(before, after))
+}
+
+// NewDailyLogbookDetailHistory builds the history entry of a segment change; before is nil on create
+// and after is nil on delete
+func NewDailyLogbookDetailHistory(ctx context.Context, before, after *DailyLogbookDetail) LogbookHistoryEntry {
+	current := after
+	if current == nil {
+		current = before
+	}
+	return newLogbookHistoryEntry(ctx, HistoryEntityDailyLogbookDetail, current.ID, current.DailyLogbookID,
+		historyAction(before == nil, after == nil), DiffDailyLogbookDetail(before, after))
+}
+
+// NewDailyLogbookRestoreHistory builds the history entry of a restored logbook; its fields reappear as on create
+func NewDailyLogbookRestoreHistory(ctx context.Context, restored *DailyLogbook) LogbookHistoryEntry {
+	entry := NewDailyLogbookHistory(ctx, nil, restored)
+	entry.Action = HistoryActionRestore
+	return entry
+}
+
+// NewDailyLogbookDetailRestoreHistory builds the history entry of a restored segment; its fields reappear as on create
+func NewDailyLogbookDetailRestoreHistory(ctx context.Context, restored *DailyLogbookDetail) LogbookHistoryEntry {
+	entry := NewDailyLogbookDetailHistory(ctx, nil, restored)
+	entry.Action = HistoryActionRestore
+	return entry
+}
+
+// NewDutyPeriodLinkHistory builds the history entry of a segment linked to or unlinked from a duty period:
+// it is unlinked when currently linked to dutyPeriodID and linked otherwise. The duty period link is not
+// one of the fields diffed on segment changes, so the entry carries it on its own.
+func NewDutyPeriodLinkHistory(ctx context.Context, dutyPeriodID string, segment DutyPeriodSegment) LogbookHistoryEntry {
+	before := &DailyLogbookDetail{ID: segment.ID, DailyLogbookID: segment.DailyLogbookID, DutyPeriodID: segment.DutyPeriodID}
+	after := *before
+	after.DutyPeriodID = &dutyPeriodID
+	if before.DutyPeriodID != nil && *before.DutyPeriodID == dutyPeriodID {
+		after.DutyPeriodID = nil
+	}
+
+	entry := NewDailyLogbookDetailHistory(ctx, before, &after)
+	entry.Changes = append(entry.Changes, FieldChange{Field: "duty_period_id",
+		Before: stringPtrValue(before.DutyPeriodID), After: stringPtrValue(after.DutyPeriodID)})
+	return entry
+}
+
+// DiffDailyLogbook returns the fields that differ between two versions of a logbook. A nil version
+// stands for a logbook that does not exist (create or delete).
+func DiffDailyLogbook(before, after *DailyLogbook) []FieldChange {
+	b, a := logbookFields(before), logbookFields(after)
+	changes := []FieldChange{}
+	for i := range b {
+		if b[i][1] != a[i][1] {
+			changes = append(changes, FieldChange{Field: b[i][0], Before: b[i][1], After: a[i][1]})
+		}
+	}
+	return changes
+}
+
+func newLogbookHistoryEntry(ctx context.Context, entityType HistoryEntityType, entityID, logbookID string, action HistoryAction, changes []FieldChange) LogbookHistoryEntry {
+	actor := HistoryActorFromContext(ctx)
+	return LogbookHistoryEntry{
+		ID:             uuid.New().String(),
+		EntityType:     entityType,
+		EntityID:       entityID,
+		DailyLogbookID: logbookID,
+		Action:         action,
+		EmployeeID:     actor.EmployeeID,
+		TraceID:        actor.TraceID,
+		Changes:        changes,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
+
+func historyAction(created, deleted bool) HistoryAction {
+	switch {
+	case created:
+		return HistoryActionCreate
+	case deleted:
+		return HistoryActionDelete
+	}
+	return HistoryActionUpdate
+}
+
+// logbookFields returns the fields of a logbook as (name, value) pairs in a fixed order; all values
+// are empty for a nil logbook
+func logbookFields(d *DailyLogbook) [][2]string {
+	fields := [][2]string{{"log_date"}, {"employee_id"}, {"book_page"}, {"status"}, {"state"},
+		{"submitted_at"}, {"signed_at"}, {"signed_by"}, {"locked_at"}}
+	if d == nil {
+		return fields
+	}
+	fields[0][1] = d.LogDate.Format("2006-01-02")
+	fields[1][1] = d.EmployeeID
+	fields[2][1] = intPtrValue(d.BookPage)
+	fields[3][1] = strconv.FormatBool(d.Status)
+	fields[4][1] = string(d.CurrentState())
+	fields[5][1] = timePtrValue(d.SubmittedAt)
+	fields[6][1] = timePtrValue(d.SignedAt)
+	fields[7][1] = stringPtrValue(d.SignedBy)
+	fields[8][1] = timePtrValue(d.LockedAt)
+	return fields
+}
+
+func timePtrValue(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
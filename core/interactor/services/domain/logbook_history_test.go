@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLogbookHistory(t *testing.T) {
+	ctx := ContextWithHistoryActor(context.Background(), HistoryActor{EmployeeID: "emp-1", TraceID: "trace-1"})
+	page := 12
+	logbook := DailyLogbook{ID: "lb-1", LogDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), EmployeeID: "emp-1", BookPage: &page, Status: true}
+
+	t.Run("create records actor and every set field", func(t *testing.T) {
+		entry := NewDailyLogbookHistory(ctx, nil, &logbook)
+		if entry.Action != HistoryActionCreate || entry.EntityType != HistoryEntityDailyLogbook {
+			t.Fatalf("unexpected entry %+v", entry)
+		}
+		if entry.EmployeeID != "emp-1" || entry.TraceID != "trace-1" || entry.DailyLogbookID != "lb-1" {
+			t.Fatalf("expected actor emp-1/trace-1 on lb-1, got %+v", entry)
+		}
+		fields := map[string]FieldChange{}
+		for _, c := range entry.Changes {
+			fields[c.Field] = c
+		}
+		if fields["log_date"].After != "2026-03-01" || fields["book_page"].After != "12" || fields["state"].After != string(LogbookStateDraft) {
+			t.Fatalf("unexpected changes %+v", entry.Changes)
+		}
+	})
+
+	t.Run("update records only the changed fields", func(t *testing.T) {
+		after := logbook
+		after.Status = false
+		entry := NewDailyLogbookHistory(ctx, &logbook, &after)
+		if entry.Action != HistoryActionUpdate || len(entry.Changes) != 1 {
+			t.Fatalf("expected one change, got %+v", entry)
+		}
+		if c := entry.Changes[0]; c.Field != "status" || c.Before != "true" || c.After != "false" {
+			t.Fatalf("unexpected change %+v", c)
+		}
+	})
+
+	t.Run("unchanged update is empty", func(t *testing.T) {
+		same := logbook
+		entry := NewDailyLogbookHistory(ctx, &logbook, &same)
+		if !entry.IsEmpty() {
+			t.Fatalf("expected empty entry, got %+v", entry.Changes)
+		}
+	})
+
+	t.Run("segment delete keeps its values and logbook", func(t *testing.T) {
+		detail := DailyLogbookDetail{ID: "d-1", DailyLogbookID: "lb-1", FlightNumber: "AV123", FlightRealDate: "2026-03-01"}
+		entry := NewDailyLogbookDetailHistory(ctx, &detail, nil)
+		if entry.Action != HistoryActionDelete || entry.EntityID != "d-1" || entry.DailyLogbookID != "lb-1" {
+			t.Fatalf("unexpected entry %+v", entry)
+		}
+		found := false
+		for _, c := range entry.Changes {
+			if c.Field == "flight_number" {
+				found = c.Before == "AV123" && c.After == ""
+			}
+		}
+		if !found || entry.IsEmpty() {
+			t.Fatalf("expected the deleted flight number in the diff, got %+v", entry.Changes)
+		}
+	})
+
+	t.Run("restore brings the segment values back", func(t *testing.T) {
+		detail := DailyLogbookDetail{ID: "d-1", DailyLogbookID: "lb-1", FlightNumber: "AV123", FlightRealDate: "2026-03-01"}
+		entry := NewDailyLogbookDetailRestoreHistory(ctx, &detail)
+		if entry.Action != HistoryActionRestore || entry.EntityType != HistoryEntityDailyLogbookDetail || entry.DailyLogbookID != "lb-1" {
+			t.Fatalf("unexpected entry %+v", entry)
+		}
+		found := false
+		for _, c := range entry.Changes {
+			if c.Field == "flight_number" {
+				found = c.Before == "" && c.After == "AV123"
+			}
+		}
+		if !found {
+			t.Fatalf("expected the restored flight number in the diff, got %+v", entry.Changes)
+		}
+	})
+
+	t.Run("duty period link and unlink", func(t *testing.T) {
+		segment := DutyPeriodSegment{ID: "d-1", DailyLogbookID: "lb-1"}
+		linked := NewDutyPeriodLinkHistory(ctx, "dp-1", segment)
+		if linked.Action != HistoryActionUpdate || linked.EntityID != "d-1" || linked.DailyLogbookID != "lb-1" ||
+			len(linked.Changes) != 1 || linked.Changes[0] != (FieldChange{Field: "duty_period_id", After: "dp-1"}) {
+			t.Fatalf("unexpected link entry %+v", linked)
+		}
+
+		own := "dp-1"
+		segment.DutyPeriodID = &own
+		unlinked := NewDutyPeriodLinkHistory(ctx, "dp-1", segment)
+		if len(unlinked.Changes) != 1 || unlinked.Changes[0] != (FieldChange{Field: "duty_period_id", Before: "dp-1"}) {
+			t.Fatalf("unexpected unlink entry %+v", unlinked.Changes)
+		}
+	})
+
+	t.Run("no actor outside a request", func(t *testing.T) {
+		entry := NewDailyLogbookHistory(context.Background(), nil, &logbook)
+		if entry.EmployeeID != "" || entry.TraceID != "" {
+			t.Fatalf("expected no actor, got %+v", entry)
+		}
+	})
+}
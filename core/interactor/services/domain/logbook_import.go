@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxLogbookImportRows is the largest number of segments accepted in one import file
+const MaxLogbookImportRows = 2000
+
+// LogbookImportRow is one segment read from an import file. Values are kept as written;
+// they are validated and resolved against the catalog before anything is saved.
+type LogbookImportRow struct {
+	Line           int // Line number in the file (header is line 1)
+	FlightNumber   string
+	FlightRealDate string // YYYY-MM-DD, also the log date of the owning daily logbook
+	RouteCode      string // e.g., "BOG-CLO"; built from Origin and Destination when empty
+	Origin         string // Departure airport as written (IATA or a mapped code), third-party formats
+	Destination    string // Arrival airport as written (IATA or a mapped code), third-party formats
+	AirlineCode    string // Optional, disambiguates routes flown by several airlines
+	LicensePlate   string
+	OutTime        string
+	TakeoffTime    string
+	LandingTime    string
+	InTime         string
+	TimeReference  string
+	PilotRole      string
+	PilotFunction  *string
+	CompanionName  *string
+	Passengers     *string
+	DutyTime       *string
+	IFRTime        *string
+	ApproachType   *string
+	FlightType     *string
+	WetLease       bool
+}
+
+// ImportFieldError is a missing or malformed value in an import row
+type ImportFieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *ImportFieldError) Error() string {
+	return e.Err.Error() + ": " + e.Field
+}
+
+// Unwrap allows errors.Is(err, ErrImportMissingField) / errors.Is(err, ErrImportInvalidField)
+func (e *ImportFieldError) Unwrap() error {
+	return e.Err
+}
+
+// LogbookImportCatalog holds the reference data used to resolve import rows
+type LogbookImportCatalog struct {
+	Routes        map[string][]AirlineRoute               // Active airline routes by upper-case route code
+	Registrations map[string]AircraftRegistration         // Registrations by upper-case license plate
+	Logbooks      map[string]DailyLogbook                 // Employee's existing daily logbooks by log date (YYYY-MM-DD)
+	Airports      map[string]Airport                      // Airports by upper-case IATA code
+	Mappings      map[ImportMappingKind]map[string]string // Employee's remembered mappings: kind -> source value -> target ID
+}
+
+// Logbook returns the existing or newly created daily logbook with an ID
+func (c *LogbookImportCatalog) Logbook(id string) (DailyLogbook, bool) {
+	for _, l := range c.Logbooks {
+		if l.ID == id {
+			return l, true
+		}
+	}
+	return DailyLogbook{}, false
+}
+
+// ResolveRoute returns the airline route for a route code, filtered by airline code when given.
+// A route code without a matching airline route falls back to the employee's ROUTE mappings.
+func (c *LogbookImportCatalog) ResolveRoute(routeCode, airlineCode string) (*AirlineRoute, error) {
+	code := strings.ToUpper(strings.TrimSpace(routeCode))
+	var matches []AirlineRoute
+	for _, r := range c.Routes[code] {
+		if airlineCode == "" || strings.EqualFold(r.AirlineCode, strings.TrimSpace(airlineCode)) {
+			matches = append(matches, r)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if id, ok := c.mapping(ImportMappingRoute, code); ok {
+			if route := c.airlineRouteByID(id); route != nil {
+				return route, nil
+			}
+		}
+		return nil, ErrFlightInvalidRoute
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, ErrImportRouteAmbiguous
+	}
+}
+
+// ResolveRegistration returns the aircraft registration for a license plate, or for its AIRCRAFT mapping
+func (c *LogbookImportCatalog) ResolveRegistration(licensePlate string) (*AircraftRegistration, error) {
+	plate := strings.ToUpper(strings.TrimSpace(licensePlate))
+	if registration, ok := c.Registrations[plate]; ok {
+		return &registration, nil
+	}
+	if id, ok := c.mapping(ImportMappingAircraft, plate); ok {
+		if registration := c.registrationByID(id); registration != nil {
+			return registration, nil
+		}
+	}
+	return nil, ErrFlightInvalidAircraft
+}
+
+// ResolveAirport returns the airport for an IATA code, or for its AIRPORT mapping (e.g., an ICAO code)
+func (c *LogbookImportCatalog) ResolveAirport(code string) (*Airport, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if airport, ok := c.Airports[code]; ok {
+		return &airport, nil
+	}
+	if id, ok := c.mapping(ImportMappingAirport, code); ok {
+		if airport := c.airportByID(id); airport != nil {
+			return airport, nil
+		}
+	}
+	return nil, &UnresolvedReferenceError{Kind: ImportMappingAirport, Value: code}
+}
+
+// HasTarget reports whether a mapping target exists in the catalog
+func (c *LogbookImportCatalog) HasTarget(kind ImportMappingKind, targetID string) bool {
+	switch kind {
+	case ImportMappingAirport:
+		return c.airportByID(targetID) != nil
+	case ImportMappingAircraft:
+		return c.registrationByID(targetID) != nil
+	case ImportMappingRoute:
+		return c.airlineRouteByID(targetID) != nil
+	}
+	return false
+}
+
+// AddMapping makes a mapping decision available to the rows resolved after it
+func (c *LogbookImportCatalog) AddMapping(m ImportMapping) {
+	if c.Mappings == nil {
+		c.Mappings = make(map[ImportMappingKind]map[string]string)
+	}
+	if c.Mappings[m.Kind] == nil {
+		c.Mappings[m.Kind] = make(map[string]string)
+	}
+	c.Mappings[m.Kind][NormalizeMappingSource(m.SourceValue)] = m.TargetID
+}
+
+func (c *LogbookImportCatalog) mapping(kind ImportMappingKind, source string) (string, bool) {
+	id, ok := c.Mappings[kind][source]
+	return id, ok
+}
+
+func (c *LogbookImportCatalog) airportByID(id string) *Airport {
+	for _, a := range c.Airports {
+		if a.ID == id {
+			return &a
+		}
+	}
+	return nil
+}
+
+func (c *LogbookImportCatalog) registrationByID(id string) *AircraftRegistration {
+	for _, r := range c.Registrations {
+		if r.ID == id {
+			return &r
+		}
+	}
+	return nil
+}
+
+func (c *LogbookImportCatalog) airlineRouteByID(id string) *AirlineRoute {
+	for _, routes := range c.Routes {
+		for _, r := range routes {
+			if r.ID == id {
+				return &r
+			}
+		}
+	}
+	return nil
+}
+
+// LogbookImportRowResult is the outcome of validating (and, unless dry run, saving) one row
+type LogbookImportRowResult struct {
+	Line     int
+	Detail   *DailyLogbookDetail // Resolved segment; nil when the row could not be resolved
+	Err      error               // First validation error of the row
+	Warnings []ValidationWarning
+}
+
+// LogbookImportResult is the outcome of an import
+type LogbookImportResult struct {
+	DryRun          bool
+	Rows            []LogbookImportRowResult
+	LogbooksCreated int // Daily logbooks created (or that would be created on dry run) for missing days
+	Saved           bool
+	Unresolved      []UnresolvedReference // References waiting for a mapping decision (third-party formats)
+	MappingsSaved   int                   // Mapping decisions remembered for the employee
+}
+
+// InvalidRows returns how many rows failed validation; rows pending a mapping are not counted
+func (r *LogbookImportResult) InvalidRows() int {
+	n := 0
+	for _, row := range r.Rows {
+		if row.Err != nil && !IsUnresolvedReference(row.Err) {
+			n++
+		}
+	}
+	return n
+}
+
+// PendingRows returns how many rows were skipped because a reference has no mapping yet
+func (r *LogbookImportResult) PendingRows() int {
+	n := 0
+	for _, row := range r.Rows {
+		if IsUnresolvedReference(row.Err) {
+			n++
+		}
+	}
+	return n
+}
+
+// NewImportLogbook creates the daily logbook for a day the employee has no logbook for yet
+func NewImportLogbook(employeeID string, logDate time.Time) DailyLogbook {
+	logbook := DailyLogbook{
+		LogDate:    logDate,
+		EmployeeID: employeeID,
+		Status:     true,
+	}
+	logbook.SetID()
+	return logbook
+}
@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// LogbookState is the sign-off state of a daily logbook.
+// DRAFT → SUBMITTED → SIGNED → LOCKED; a submitted logbook can be reopened to DRAFT.
+// Segments can only be created, edited or deleted while the logbook is a draft; once signed,
+// corrections go through a LogbookAmendment and locked logbooks cannot change at all.
+type LogbookState string
+
+const (
+	LogbookStateDraft     LogbookState = "DRAFT"     // Being filled in by the pilot
+	LogbookStateSubmitted LogbookState = "SUBMITTED" // Closed by the pilot, waiting for the signature
+	LogbookStateSigned    LogbookState = "SIGNED"    // Certified; amendments allowed and re-signed
+	LogbookStateLocked    LogbookState = "LOCKED"    // Final, immutable
+)
+
+// logbookTransitions lists the states each state can move to
+var logbookTransitions = map[LogbookState][]LogbookState{
+	LogbookStateDraft:     {LogbookStateSubmitted},
+	LogbookStateSubmitted: {LogbookStateDraft, LogbookStateSigned},
+	LogbookStateSigned:    {LogbookStateLocked},
+}
+
+// IsValidLogbookState checks if a string is a valid logbook state
+func IsValidLogbookState(state string) bool {
+	switch LogbookState(state) {
+	case LogbookStateDraft, LogbookStateSubmitted, LogbookStateSigned, LogbookStateLocked:
+		return true
+	}
+	return false
+}
+
+// CurrentState returns the logbook state; logbooks created before the workflow are drafts
+func (d *DailyLogbook) CurrentState() LogbookState {
+	if d.State == "" {
+		return LogbookStateDraft
+	}
+	return d.State
+}
+
+// IsEditable reports whether the logbook and its segments can still be changed directly
+func (d *DailyLogbook) IsEditable() bool {
+	return d.CurrentState() == LogbookStateDraft
+}
+
+// IsAmendable reports whether corrections can be requested through an amendment
+func (d *DailyLogbook) IsAmendable() bool {
+	return d.CurrentState() == LogbookStateSigned
+}
+
+// CanTransition reports whether the logbook can move to a state
+func (d *DailyLogbook) CanTransition(to LogbookState) bool {
+	for _, s := range logbookTransitions[d.CurrentState()] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition moves the logbook to a state, stamping the time of the step and, on signing, the signer
+func (d *DailyLogbook) Transition(to LogbookState, employeeID string, at time.Time) error {
+	if !d.CanTransition(to) {
+		return ErrDailyLogbookInvalidTransition
+	}
+
+	switch to {
+	case LogbookStateDraft:
+		d.SubmittedAt = nil
+	case LogbookStateSubmitted:
+		d.SubmittedAt = &at
+	case LogbookStateSigned:
+		d.Sign(employeeID, at)
+	case LogbookStateLocked:
+		d.LockedAt = &at
+	}
+	d.State = to
+	return nil
+}
+
+// Sign records the signature of the logbook; also used to re-sign it when an amendment is applied
+func (d *DailyLogbook) Sign(employeeID string, at time.Time) {
+	d.SignedAt = &at
+	d.SignedBy = &employeeID
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailyLogbookTransition(t *testing.T) {
+	at := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+
+	t.Run("full workflow", func(t *testing.T) {
+		logbook := DailyLogbook{}
+		if !logbook.IsEditable() || logbook.IsAmendable() {
+			t.Fatalf("a logbook without state should be an editable draft")
+		}
+		for _, to := range []LogbookState{LogbookStateSubmitted, LogbookStateSigned, LogbookStateLocked} {
+			if err := logbook.Transition(to, "emp-1", at); err != nil {
+				t.Fatalf("transition to %s: %v", to, err)
+			}
+			if logbook.IsEditable() {
+				t.Fatalf("%s logbook should not be editable", to)
+			}
+		}
+		if logbook.SubmittedAt == nil || logbook.SignedAt == nil || logbook.LockedAt == nil {
+			t.Fatalf("expected every step to be stamped, got %+v", logbook)
+		}
+		if logbook.SignedBy == nil || *logbook.SignedBy != "emp-1" {
+			t.Fatalf("expected signer emp-1, got %v", logbook.SignedBy)
+		}
+		if logbook.IsAmendable() {
+			t.Fatalf("locked logbook should not be amendable")
+		}
+	})
+
+	t.Run("reopen submitted logbook", func(t *testing.T) {
+		logbook := DailyLogbook{State: LogbookStateSubmitted, SubmittedAt: &at}
+		if err := logbook.Transition(LogbookStateDraft, "emp-1", at); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !logbook.IsEditable() || logbook.SubmittedAt != nil {
+			t.Fatalf("expected an editable draft without submission time, got %+v", logbook)
+		}
+	})
+
+	t.Run("invalid transitions", func(t *testing.T) {
+		cases := []struct {
+			from, to LogbookState
+		}{
+			{LogbookStateDraft, LogbookStateSigned},
+			{LogbookStateDraft, LogbookStateLocked},
+			{LogbookStateSigned, LogbookStateDraft},
+			{LogbookStateLocked, LogbookStateSigned},
+		}
+		for _, tc := range cases {
+			logbook := DailyLogbook{State: tc.from}
+			if err := logbook.Transition(tc.to, "emp-1", at); err != ErrDailyLogbookInvalidTransition {
+				t.Errorf("%s -> %s: expected ErrDailyLogbookInvalidTransition, got %v", tc.from, tc.to, err)
+			}
+			if logbook.State != tc.from {
+				t.Errorf("%s -> %s: state changed to %s", tc.from, tc.to, logbook.State)
+			}
+		}
+	})
+}
+
+func TestDiffDailyLogbookDetail(t *testing.T) {
+	passengers := 120
+	before := &DailyLogbookDetail{
+		FlightNumber: "AV9301",
+		OutTime:      "10:00:00",
+		InTime:       "11:10:00",
+		BlockTime:    "01:10:00",
+		Passengers:   &passengers,
+		PilotRole:    "PF",
+	}
+
+	t.Run("only changed fields, times as HH:MM", func(t *testing.T) {
+		after := *before
+		after.OutTime = "10:00"
+		after.InTime = "11:25"
+		after.BlockTime = "01:25"
+		changes := DiffDailyLogbookDetail(before, &after)
+		if len(changes) != 2 {
+			t.Fatalf("expected in_time and block_time changes, got %+v", changes)
+		}
+		if changes[0] != (FieldChange{Field: "in_time", Before: "11:10", After: "11:25"}) {
+			t.Fatalf("unexpected change %+v", changes[0])
+		}
+	})
+
+	t.Run("deleted segment", func(t *testing.T) {
+		changes := DiffDailyLogbookDetail(before, nil)
+		for _, c := range changes {
+			if c.After != "" {
+				t.Fatalf("expected empty values after delete, got %+v", c)
+			}
+		}
+		if len(changes) != 6 {
+			t.Fatalf("expected the 6 filled fields, got %+v", changes)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// RouteContinuityGap is a break in a day's route: a leg departs from an airport other than the
+// destination of the previous leg (e.g. CLO→BOG followed by MDE→CTG)
+type RouteContinuityGap struct {
+	PreviousDetailID string
+	DetailID         string
+	ArrivedAt        string // Destination IATA code of the previous leg
+	DepartedFrom     string // Origin IATA code of the leg
+}
+
+// ValidationSeverity tells whether a consistency finding would block the segment on create/update
+type ValidationSeverity string
+
+const (
+	ValidationSeverityError   ValidationSeverity = "ERROR"
+	ValidationSeverityWarning ValidationSeverity = "WARNING"
+)
+
+// LogbookValidationIssue is a finding of a day's consistency report
+type LogbookValidationIssue struct {
+	Severity        ValidationSeverity
+	Code            string   // Message code, same as the one raised on create/update
+	Params          []string // Message params that are not segment IDs
+	DetailID        string   // Segment the issue was found on
+	RelatedDetailID string   // Other segment involved (route gaps, duplicates, overlaps)
+}
+
+// LogbookValidationReport is the consistency report of a daily logbook
+type LogbookValidationReport struct {
+	LogbookID    string
+	LogDate      string
+	SegmentCount int
+	Route        []string // Airports visited in OUT-time order, gaps included
+	Issues       []LogbookValidationIssue
+}
+
+// Valid reports whether the day has no blocking issues (warnings are allowed)
+func (r *LogbookValidationReport) Valid() bool {
+	return r.Count(ValidationSeverityError) == 0
+}
+
+// Count returns the number of issues of a severity
+func (r *LogbookValidationReport) Count(severity ValidationSeverity) int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// SortSegmentsByOut returns a copy of the segments ordered by OUT instant (UTC). Segments whose times
+// cannot be resolved keep their relative position after the resolvable ones.
+func SortSegmentsByOut(details []DailyLogbookDetail) []DailyLogbookDetail {
+	sorted := make([]DailyLogbookDetail, len(details))
+	copy(sorted, details)
+
+	sort.SliceStable(sorted, func(a, b int) bool {
+		ta, errA := sorted[a].SegmentTimes()
+		tb, errB := sorted[b].SegmentTimes()
+		if errA != nil || errB != nil {
+			return errA == nil && errB != nil
+		}
+		return ta.Out.Before(tb.Out)
+	})
+	return sorted
+}
+
+// FindRouteContinuityGaps returns every leg, in OUT-time order, whose origin differs from the previous
+// leg's destination. Legs without airport codes are not compared.
+func FindRouteContinuityGaps(details []DailyLogbookDetail) []RouteContinuityGap {
+	var gaps []RouteContinuityGap
+	sorted := SortSegmentsByOut(details)
+	for i := 1; i < len(sorted); i++ {
+		previous, current := sorted[i-1], sorted[i]
+		if previous.DestinationIataCode == "" || current.OriginIataCode == "" {
+			continue
+		}
+		if !strings.EqualFold(previous.DestinationIataCode, current.OriginIataCode) {
+			gaps = append(gaps, RouteContinuityGap{
+				PreviousDetailID: previous.ID,
+				DetailID:         current.ID,
+				ArrivedAt:        previous.DestinationIataCode,
+				DepartedFrom:     current.OriginIataCode,
+			})
+		}
+	}
+	return gaps
+}
+
+// BuildLogbookValidationReport checks the stored segments of a day against the same rules applied on
+// create/update: time sequence, derived air/block times, duplicates and overlaps between the day's
+// segments, and route continuity. Conflicts with segments of other days are not reported.
+func BuildLogbookValidationReport(logbook DailyLogbook, details []DailyLogbookDetail) *LogbookValidationReport {
+	report := &LogbookValidationReport{
+		LogbookID:    logbook.ID,
+		LogDate:      logbook.LogDate.Format("2006-01-02"),
+		SegmentCount: len(details),
+		Issues:       []LogbookValidationIssue{},
+	}
+
+	sorted := SortSegmentsByOut(details)
+	for i, d := range sorted {
+		times, err := d.SegmentTimes()
+		if err != nil {
+			code := MsgFlightInvalidTimeSequence
+			if err == ErrFlightSegmentSpanExceeded {
+				code = MsgFlightSegmentSpanExceeded
+			}
+			report.Issues = append(report.Issues, LogbookValidationIssue{Severity: ValidationSeverityError, Code: code, DetailID: d.ID})
+		} else if !storedDurationMatches(d.AirTime, FormatFlightDuration(times.AirTime())) ||
+			!storedDurationMatches(d.BlockTime, FormatFlightDuration(times.BlockTime())) {
+			report.Issues = append(report.Issues, LogbookValidationIssue{Severity: ValidationSeverityError, Code: MsgFlightTimeMismatch, DetailID: d.ID})
+		}
+
+		// Each pair once: compare with the segments that start earlier
+		if conflict := FindSegmentConflict(d, sorted[:i]); conflict != nil {
+			code := MsgFlightOverlappingSegment
+			if conflict.Kind == SegmentConflictDuplicate {
+				code = MsgFlightDuplicateSegment
+			}
+			report.Issues = append(report.Issues, LogbookValidationIssue{
+				Severity: ValidationSeverityError, Code: code, DetailID: d.ID, RelatedDetailID: conflict.DetailID,
+			})
+		}
+
+		if i == 0 || report.Route[len(report.Route)-1] != d.OriginIataCode {
+			report.Route = append(report.Route, d.OriginIataCode)
+		}
+		report.Route = append(report.Route, d.DestinationIataCode)
+	}
+
+	for _, gap := range FindRouteContinuityGaps(sorted) {
+		report.Issues = append(report.Issues, LogbookValidationIssue{
+			Severity:        ValidationSeverityWarning,
+			Code:            MsgFlightRouteGap,
+			Params:          []string{gap.ArrivedAt, gap.DepartedFrom},
+			DetailID:        gap.DetailID,
+			RelatedDetailID: gap.PreviousDetailID,
+		})
+	}
+
+	return report
+}
+
+// storedDurationMatches compares a stored HH:MM(:SS) duration with the derived HH:MM value
+func storedDurationMatches(stored, expected string) bool {
+	d, err := ParseFlightDuration(stored)
+	if err != nil {
+		return false
+	}
+	return FormatFlightDuration(d) == expected
+}
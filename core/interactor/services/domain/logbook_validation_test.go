@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildLogbookValidationReport(t *testing.T) {
+	leg := func(id, origin, destination, out, off, on, in, air, block string) DailyLogbookDetail {
+		return DailyLogbookDetail{
+			ID: id, FlightNumber: id, ActualAircraftRegistrationID: "aircraft-1", FlightRealDate: "2024-03-10",
+			OriginIataCode: origin, DestinationIataCode: destination,
+			OutTime: out, TakeoffTime: off, LandingTime: on, InTime: in, AirTime: air, BlockTime: block,
+		}
+	}
+	logbook := DailyLogbook{ID: "logbook-1", LogDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("consistent day", func(t *testing.T) {
+		report := BuildLogbookValidationReport(logbook, []DailyLogbookDetail{
+			leg("AV2", "BOG", "MDE", "12:00:00", "12:10:00", "12:50:00", "13:00:00", "00:40:00", "01:00:00"),
+			leg("AV1", "CLO", "BOG", "08:00:00", "08:10:00", "08:50:00", "09:00:00", "00:40:00", "01:00:00"),
+		})
+		if !report.Valid() || len(report.Issues) != 0 {
+			t.Fatalf("expected no issues, got %+v", report.Issues)
+		}
+		if got := report.Route; len(got) != 3 || got[0] != "CLO" || got[1] != "BOG" || got[2] != "MDE" {
+			t.Fatalf("unexpected route %v", got)
+		}
+	})
+
+	t.Run("route gap is a warning", func(t *testing.T) {
+		report := BuildLogbookValidationReport(logbook, []DailyLogbookDetail{
+			leg("AV1", "CLO", "BOG", "08:00:00", "08:10:00", "08:50:00", "09:00:00", "00:40:00", "01:00:00"),
+			leg("AV2", "MDE", "CTG", "12:00:00", "12:10:00", "12:50:00", "13:00:00", "00:40:00", "01:00:00"),
+		})
+		if !report.Valid() || report.Count(ValidationSeverityWarning) != 1 {
+			t.Fatalf("expected a single warning, got %+v", report.Issues)
+		}
+		issue := report.Issues[0]
+		if issue.Code != MsgFlightRouteGap || issue.DetailID != "AV2" || issue.RelatedDetailID != "AV1" {
+			t.Fatalf("unexpected issue %+v", issue)
+		}
+		if len(report.Route) != 4 {
+			t.Fatalf("expected the gap in the route, got %v", report.Route)
+		}
+	})
+
+	t.Run("overlap and time mismatch are errors", func(t *testing.T) {
+		report := BuildLogbookValidationReport(logbook, []DailyLogbookDetail{
+			leg("AV1", "CLO", "BOG", "08:00:00", "08:10:00", "08:50:00", "09:00:00", "00:40:00", "01:00:00"),
+			leg("AV2", "BOG", "MDE", "08:30:00", "08:40:00", "09:20:00", "09:30:00", "00:45:00", "01:00:00"),
+		})
+		if report.Valid() || report.Count(ValidationSeverityError) != 2 {
+			t.Fatalf("expected 2 errors, got %+v", report.Issues)
+		}
+		if report.Issues[0].Code != MsgFlightTimeMismatch || report.Issues[1].Code != MsgFlightOverlappingSegment ||
+			report.Issues[1].RelatedDetailID != "AV1" {
+			t.Fatalf("unexpected issues %+v", report.Issues)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationKind is what an in-app notification is about
+type NotificationKind string
+
+const (
+	NotificationCredentialExpiry NotificationKind = "CREDENTIAL_EXPIRY" // A credential is about to expire
+)
+
+// Notification is an in-app message for an employee. Its text is the message catalog content of Code
+// with Params; DedupKey keeps a notification from being raised twice.
+type Notification struct {
+	ID          string
+	EmployeeID  string
+	Kind        NotificationKind
+	Code        string
+	Params      []string
+	ReferenceID string // What the notification is about, e.g. the credential
+	DedupKey    string
+	ReadAt      *time.Time
+	CreatedAt   time.Time
+}
+
+// SetID generates a new UUID for the notification
+func (n *Notification) SetID() {
+	n.ID = uuid.New().String()
+}
+
+// NotificationFilter selects an employee's notifications, latest first
+type NotificationFilter struct {
+	EmployeeID string
+	UnreadOnly bool
+}
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PriorExperienceDocumentType is the kind of document that supports declared prior experience
+type PriorExperienceDocumentType string
+
+const (
+	PriorExperienceDocLogbook         PriorExperienceDocumentType = "LOGBOOK"              // Previous pilot logbook
+	PriorExperienceDocCertificate     PriorExperienceDocumentType = "EMPLOYER_CERTIFICATE" // Flight time certificate issued by a previous operator
+	PriorExperienceDocAuthorityRecord PriorExperienceDocumentType = "AUTHORITY_RECORD"     // Record held by the civil aviation authority
+	PriorExperienceDocOther           PriorExperienceDocumentType = "OTHER"
+)
+
+// ValidPriorExperienceDocumentTypes contains all valid supporting document types
+var ValidPriorExperienceDocumentTypes = []PriorExperienceDocumentType{
+	PriorExperienceDocLogbook,
+	PriorExperienceDocCertificate,
+	PriorExperienceDocAuthorityRecord,
+	PriorExperienceDocOther,
+}
+
+// IsValidPriorExperienceDocumentType checks if a string is a valid supporting document type
+func IsValidPriorExperienceDocumentType(documentType string) bool {
+	for _, d := range ValidPriorExperienceDocumentTypes {
+		if string(d) == documentType {
+			return true
+		}
+	}
+	return false
+}
+
+// PriorExperience holds the opening balances of flight time an employee brings from before their segments
+// were logged here, e.g. from a previous logbook. Balances are dated AsOfDate and added to the totals,
+// export and currency computations as if flown on that date.
+type PriorExperience struct {
+	ID             string
+	EmployeeID     string
+	AsOfDate       string  // YYYY-MM-DD, date the balances are brought up to
+	AircraftFamily *string // Family the recent experience was flown on; required to credit currency
+
+	// Opening balances
+	Flights       int
+	TotalTime     time.Duration
+	NightTime     time.Duration
+	Columns       LogbookColumnTimes // Aircraft category, function and IFR columns
+	DayTakeoffs   int
+	NightTakeoffs int
+	DayLandings   int
+	NightLandings int
+
+	// Recent experience within the 90 days up to AsOfDate, credited to currency on that date
+	RecentTakeoffs      int
+	RecentLandings      int
+	RecentNightTakeoffs int
+	RecentNightLandings int
+	RecentApproaches    int
+
+	DocumentType      PriorExperienceDocumentType
+	DocumentReference string // Number, title or location of the supporting document
+	Remarks           *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// SetID generates a new UUID for the prior experience
+func (p *PriorExperience) SetID() {
+	p.ID = uuid.New().String()
+}
+
+// AsOf returns the date of the balances, zero when it cannot be parsed
+func (p *PriorExperience) AsOf() time.Time {
+	date, err := time.Parse("2006-01-02", p.AsOfDate)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+// Validate checks the date, document and counts, and that no column exceeds the total time nor the
+// aircraft category and function columns add up to more than it. today bounds the date.
+func (p *PriorExperience) Validate(today time.Time) error {
+	date, err := time.Parse("2006-01-02", p.AsOfDate)
+	if err != nil || date.After(today) {
+		return ErrPriorExperienceInvalid
+	}
+	if !IsValidPriorExperienceDocumentType(string(p.DocumentType)) || p.DocumentReference == "" {
+		return ErrPriorExperienceInvalidDocument
+	}
+
+	counts := []int{p.Flights, p.DayTakeoffs, p.NightTakeoffs, p.DayLandings, p.NightLandings,
+		p.RecentTakeoffs, p.RecentLandings, p.RecentNightTakeoffs, p.RecentNightLandings, p.RecentApproaches}
+	for _, c := range counts {
+		if c < 0 {
+			return ErrPriorExperienceInvalid
+		}
+	}
+	if p.RecentNightTakeoffs > p.RecentTakeoffs || p.RecentNightLandings > p.RecentLandings {
+		return ErrPriorExperienceInvalid
+	}
+	if p.HasRecentExperience() && (p.AircraftFamily == nil || *p.AircraftFamily == "") {
+		return ErrPriorExperienceInvalid
+	}
+
+	c := p.Columns
+	if p.TotalTime <= 0 {
+		return ErrPriorExperienceInconsistent
+	}
+	for _, d := range []time.Duration{p.NightTime, c.SinglePilotSE, c.SinglePilotME, c.MultiPilot, c.Turbine,
+		c.PIC, c.SIC, c.Dual, c.Instructor, c.PICUS, c.IFR} {
+		if d < 0 || d > p.TotalTime {
+			return ErrPriorExperienceInconsistent
+		}
+	}
+	if c.SinglePilotSE+c.SinglePilotME+c.MultiPilot > p.TotalTime ||
+		c.PIC+c.SIC+c.Dual+c.Instructor+c.PICUS > p.TotalTime {
+		return ErrPriorExperienceInconsistent
+	}
+	return nil
+}
+
+// Within reports whether the balances are dated within the optional inclusive date range
+func (p *PriorExperience) Within(from, to *time.Time) bool {
+	date := p.AsOf()
+	return (from == nil || !date.Before(*from)) && (to == nil || !date.After(*to))
+}
+
+// HasRecentExperience reports whether any recent takeoff, landing or approach was declared
+func (p *PriorExperience) HasRecentExperience() bool {
+	return p.RecentTakeoffs > 0 || p.RecentLandings > 0 || p.RecentApproaches > 0
+}
+
+// FlightTotals returns the balances as a set of flight totals
+func (p *PriorExperience) FlightTotals() FlightTotals {
+	return FlightTotals{
+		SegmentCount: p.Flights,
+		BlockTime:    p.TotalTime,
+		NightTime:    p.NightTime,
+		Columns:      p.Columns,
+	}
+}
+
+// LogbookTotals returns the balances as logbook column totals
+func (p *PriorExperience) LogbookTotals() LogbookTotals {
+	return LogbookTotals{
+		Segments:      p.Flights,
+		BlockTime:     p.TotalTime,
+		NightTime:     p.NightTime,
+		DayTakeoffs:   p.DayTakeoffs,
+		NightTakeoffs: p.NightTakeoffs,
+		DayLandings:   p.DayLandings,
+		NightLandings: p.NightLandings,
+		Columns:       p.Columns,
+	}
+}
+
+// CurrencyEvent returns the recent experience as seen by the currency rules
+func (p *PriorExperience) CurrencyEvent() CurrencyEvent {
+	event := CurrencyEvent{
+		Date:               p.AsOf(),
+		Prior:              true,
+		NightTakeoffs:      p.RecentNightTakeoffs,
+		NightLandings:      p.RecentNightLandings,
+		RecordedTakeoffs:   p.RecentTakeoffs,
+		RecordedLandings:   p.RecentLandings,
+		RecordedApproaches: p.RecentApproaches,
+	}
+	if p.AircraftFamily != nil {
+		event.AircraftFamily = *p.AircraftFamily
+	}
+	return event
+}
+
+// ToLogger returns the prior experience fields for structured logging
+func (p *PriorExperience) ToLogger() []string {
+	return []string{
+		"id:" + p.ID,
+		"employee_id:" + p.EmployeeID,
+		"as_of_date:" + p.AsOfDate,
+		"total_time:" + FormatFlightDuration(p.TotalTime),
+		"document_type:" + string(p.DocumentType),
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPriorExperience(t *testing.T) {
+	today := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	family := "A320"
+	valid := func() PriorExperience {
+		return PriorExperience{
+			AsOfDate:          "2026-01-31",
+			AircraftFamily:    &family,
+			Flights:           1200,
+			TotalTime:         3200 * time.Hour,
+			NightTime:         600 * time.Hour,
+			Columns:           LogbookColumnTimes{SinglePilotSE: 200 * time.Hour, MultiPilot: 3000 * time.Hour, PIC: 1000 * time.Hour, SIC: 2200 * time.Hour},
+			RecentTakeoffs:    4,
+			RecentLandings:    4,
+			RecentApproaches:  2,
+			DocumentType:      PriorExperienceDocLogbook,
+			DocumentReference: "Logbook #3",
+		}
+	}
+
+	t.Run("valid balances", func(t *testing.T) {
+		p := valid()
+		if err := p.Validate(today); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("rejects invalid fields", func(t *testing.T) {
+		cases := map[string]struct {
+			mutate func(*PriorExperience)
+			want   error
+		}{
+			"future date":       {func(p *PriorExperience) { p.AsOfDate = "2026-03-02" }, ErrPriorExperienceInvalid},
+			"missing document":  {func(p *PriorExperience) { p.DocumentReference = "" }, ErrPriorExperienceInvalidDocument},
+			"unknown document":  {func(p *PriorExperience) { p.DocumentType = "EMAIL" }, ErrPriorExperienceInvalidDocument},
+			"negative count":    {func(p *PriorExperience) { p.DayLandings = -1 }, ErrPriorExperienceInvalid},
+			"recent w/o family": {func(p *PriorExperience) { p.AircraftFamily = nil }, ErrPriorExperienceInvalid},
+			"no total time":     {func(p *PriorExperience) { p.TotalTime = 0 }, ErrPriorExperienceInconsistent},
+			"column over total": {func(p *PriorExperience) { p.Columns.IFR = 3300 * time.Hour }, ErrPriorExperienceInconsistent},
+			"categories over":   {func(p *PriorExperience) { p.Columns.SinglePilotME = 100 * time.Hour }, ErrPriorExperienceInconsistent},
+			"functions over":    {func(p *PriorExperience) { p.Columns.Dual = 100 * time.Hour }, ErrPriorExperienceInconsistent},
+		}
+		for name, tc := range cases {
+			p := valid()
+			tc.mutate(&p)
+			if err := p.Validate(today); err != tc.want {
+				t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+			}
+		}
+	})
+
+	t.Run("dated within range", func(t *testing.T) {
+		p := valid()
+		from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+		if !p.Within(nil, nil) || !p.Within(nil, &to) || p.Within(&from, nil) {
+			t.Fatal("unexpected range check")
+		}
+	})
+
+	t.Run("recent experience credits currency on the balance date", func(t *testing.T) {
+		p := valid()
+		event := p.CurrencyEvent()
+		if !event.Date.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)) || event.AircraftFamily != "A320" {
+			t.Fatalf("unexpected event %+v", event)
+		}
+		if event.Takeoffs(false) != 4 || event.Landings(false) != 4 || event.Approaches() != 2 || event.Landings(true) != 0 {
+			t.Fatalf("unexpected counts %+v", event)
+		}
+		if !(CurrencyRule{SimulatorDevices: []SimulatorDeviceType{SimulatorDeviceFFS}}).Credits(event) {
+			t.Fatal("expected prior experience to be credited by every rule")
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// SegmentConflictKind is the reason a segment clashes with another one of the same employee
+type SegmentConflictKind string
+
+const (
+	SegmentConflictDuplicate SegmentConflictKind = "DUPLICATE" // Same flight number, flight date and aircraft
+	SegmentConflictOverlap   SegmentConflictKind = "OVERLAP"   // OUT-IN intervals intersect
+)
+
+// SegmentConflictWindow is how many days around a segment's flight date other segments can clash with it;
+// a segment spans at most MaxSegmentBlockTime, so one day on each side is enough
+const SegmentConflictWindow = 24 * time.Hour
+
+// SegmentConflictError is returned when a segment duplicates or overlaps another segment of the employee
+type SegmentConflictError struct {
+	Kind     SegmentConflictKind
+	DetailID string // The clashing segment
+}
+
+func (e *SegmentConflictError) Error() string {
+	return e.Unwrap().Error() + ": " + e.DetailID
+}
+
+// Unwrap allows errors.Is(err, ErrFlightDuplicateSegment) / errors.Is(err, ErrFlightOverlappingSegment)
+func (e *SegmentConflictError) Unwrap() error {
+	if e.Kind == SegmentConflictDuplicate {
+		return ErrFlightDuplicateSegment
+	}
+	return ErrFlightOverlappingSegment
+}
+
+// FindSegmentConflict returns the first segment in others that the detail duplicates or, failing that,
+// overlaps in time (OUT to IN, UTC). The detail itself (same ID) is ignored. Segments whose times
+// cannot be resolved are skipped for the overlap check.
+func FindSegmentConflict(detail DailyLogbookDetail, others []DailyLogbookDetail) *SegmentConflictError {
+	for _, o := range others {
+		if o.ID == detail.ID {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(o.FlightNumber), strings.TrimSpace(detail.FlightNumber)) &&
+			sameFlightDate(o.FlightRealDate, detail.FlightRealDate) &&
+			o.ActualAircraftRegistrationID == detail.ActualAircraftRegistrationID {
+			return &SegmentConflictError{Kind: SegmentConflictDuplicate, DetailID: o.ID}
+		}
+	}
+
+	times, err := detail.SegmentTimes()
+	if err != nil {
+		return nil
+	}
+	for _, o := range others {
+		if o.ID == detail.ID {
+			continue
+		}
+		other, err := o.SegmentTimes()
+		if err != nil {
+			continue
+		}
+		if times.Out.Before(other.In) && other.Out.Before(times.In) {
+			return &SegmentConflictError{Kind: SegmentConflictOverlap, DetailID: o.ID}
+		}
+	}
+	return nil
+}
+
+func sameFlightDate(a, b string) bool {
+	da, errA := ParseFlightDate(a)
+	db, errB := ParseFlightDate(b)
+	return errA == nil && errB == nil && da.Equal(db)
+}
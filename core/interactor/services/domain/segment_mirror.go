@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SegmentMirrorStatus is the state of the link between a segment and its companion's mirrored entry
+type SegmentMirrorStatus string
+
+const (
+	SegmentMirrorStatusPending   SegmentMirrorStatus = "PENDING"   // Offered to the companion, not answered yet
+	SegmentMirrorStatusDeclined  SegmentMirrorStatus = "DECLINED"  // The companion did not want the entry
+	SegmentMirrorStatusWithdrawn SegmentMirrorStatus = "WITHDRAWN" // The segment was deleted or its companion changed before an answer
+	SegmentMirrorStatusLinked    SegmentMirrorStatus = "LINKED"    // Both pilots' entries agree on the shared flight data
+	SegmentMirrorStatusConflict  SegmentMirrorStatus = "CONFLICT"  // The entries differ and one of the pilots must resolve it
+	SegmentMirrorStatusUnlinked  SegmentMirrorStatus = "UNLINKED"  // The entries are no longer kept in sync
+)
+
+// IsValidSegmentMirrorStatus checks if a string is a valid segment mirror status
+func IsValidSegmentMirrorStatus(status string) bool {
+	switch SegmentMirrorStatus(status) {
+	case SegmentMirrorStatusPending, SegmentMirrorStatusDeclined, SegmentMirrorStatusWithdrawn,
+		SegmentMirrorStatusLinked, SegmentMirrorStatusConflict, SegmentMirrorStatusUnlinked:
+		return true
+	}
+	return false
+}
+
+// SegmentMirrorResolution is how a pilot settles a conflict between the two linked entries
+type SegmentMirrorResolution string
+
+const (
+	SegmentMirrorResolutionAdopt  SegmentMirrorResolution = "ADOPT"  // Take the companion's flight data into the own entry
+	SegmentMirrorResolutionUnlink SegmentMirrorResolution = "UNLINK" // Keep both entries as they are and stop syncing them
+)
+
+// IsValidSegmentMirrorResolution checks if a string is a valid conflict resolution
+func IsValidSegmentMirrorResolution(resolution string) bool {
+	switch SegmentMirrorResolution(resolution) {
+	case SegmentMirrorResolutionAdopt, SegmentMirrorResolutionUnlink:
+		return true
+	}
+	return false
+}
+
+// SegmentMirror links a segment to the mirrored entry offered to the companion pilot in their own
+// logbook. Edits of the shared flight data are carried to the other entry; the times are never
+// overwritten, a difference puts the link in conflict until one of the pilots resolves it.
+type SegmentMirror struct {
+	ID                  string
+	SourceDetailID      string // Segment that named the companion
+	SourceEmployeeID    string
+	CompanionEmployeeID string
+	MirrorDetailID      *string // Companion's entry, set once accepted
+	Status              SegmentMirrorStatus
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+
+	// Computed on read, not persisted
+	Proposed    *DailyLogbookDetail // Pre-filled entry offered to the companion (PENDING only)
+	Differences []SegmentMirrorDifference
+}
+
+// SegmentMirrorDifference is a shared field whose value differs between the two linked entries
+type SegmentMirrorDifference struct {
+	Field  string
+	Source string // Value in the segment that named the companion
+	Mirror string // Value in the companion's entry
+}
+
+// SetID generates a new UUID for the segment mirror
+func (m *SegmentMirror) SetID() {
+	m.ID = uuid.New().String()
+}
+
+// IsActive reports whether both entries exist and are kept in sync
+func (m *SegmentMirror) IsActive() bool {
+	return m.Status == SegmentMirrorStatusLinked || m.Status == SegmentMirrorStatusConflict
+}
+
+// IsOpen reports whether the link can still change: pending offers and active links
+func (m *SegmentMirror) IsOpen() bool {
+	return m.Status == SegmentMirrorStatusPending || m.IsActive()
+}
+
+// Involves reports whether the employee is one of the two pilots
+func (m *SegmentMirror) Involves(employeeID string) bool {
+	return m.SourceEmployeeID == employeeID || m.CompanionEmployeeID == employeeID
+}
+
+// OwnDetailID returns the entry that belongs to the employee ("" for the companion of a pending offer)
+func (m *SegmentMirror) OwnDetailID(employeeID string) string {
+	if m.SourceEmployeeID == employeeID {
+		return m.SourceDetailID
+	}
+	if m.CompanionEmployeeID == employeeID && m.MirrorDetailID != nil {
+		return *m.MirrorDetailID
+	}
+	return ""
+}
+
+// PartnerDetailID returns the other entry of the link ("" while the offer is pending)
+func (m *SegmentMirror) PartnerDetailID(detailID string) string {
+	if detailID == m.SourceDetailID {
+		if m.MirrorDetailID == nil {
+			return ""
+		}
+		return *m.MirrorDetailID
+	}
+	return m.SourceDetailID
+}
+
+// Diff returns the shared flight data that differs between the two linked entries, in either order
+func (m *SegmentMirror) Diff(a, b DailyLogbookDetail) []SegmentMirrorDifference {
+	if a.ID != m.SourceDetailID {
+		a, b = b, a
+	}
+	return DiffSharedSegmentFields(a, b)
+}
+
+// Close ends an open link: a pending offer is withdrawn, an active link is unlinked
+func (m *SegmentMirror) Close(at time.Time) {
+	if m.Status == SegmentMirrorStatusPending {
+		m.Status = SegmentMirrorStatusWithdrawn
+	} else {
+		m.Status = SegmentMirrorStatusUnlinked
+	}
+	m.UpdatedAt = at
+}
+
+// ComplementaryPilotRole returns the role the companion had: PF↔PM, and the pilot flying the landing
+// for the one who flew the takeoff (PFTO↔PFL)
+func ComplementaryPilotRole(role PilotRole) PilotRole {
+	switch role {
+	case PilotRolePF:
+		return PilotRolePM
+	case PilotRolePM:
+		return PilotRolePF
+	case PilotRolePFTO:
+		return PilotRolePFL
+	case PilotRolePFL:
+		return PilotRolePFTO
+	}
+	return role
+}
+
+// NewMirroredDetail builds the companion's pre-filled entry from a segment: same flight data, the
+// complementary role and function and the segment's pilot as companion. Derived times are computed again on save.
+func NewMirroredDetail(source DailyLogbookDetail, logbookID, sourceEmployeeID, companionEmployeeID string) DailyLogbookDetail {
+	mirrored := DailyLogbookDetail{
+		DailyLogbookID:      logbookID,
+		PilotRole:           ComplementaryPilotRole(source.PilotRole),
+		PilotFunction:       ComplementaryPilotFunction(source.PilotFunction),
+		CompanionEmployeeID: &sourceEmployeeID,
+		EmployeeLogbookID:   &companionEmployeeID,
+		TimeReference:       TimeReferenceUTC,
+	}
+	mirrored.SetID()
+	CopySharedSegmentFields(&mirrored, source, true)
+	return mirrored
+}
+
+// CopySharedSegmentFields copies the flight data both pilots share from src into dst; OUT/OFF/ON/IN
+// are only copied when withTimes is set
+func CopySharedSegmentFields(dst *DailyLogbookDetail, src DailyLogbookDetail, withTimes bool) {
+	dst.FlightRealDate = canonicalDate(src.FlightRealDate)
+	dst.FlightNumber = src.FlightNumber
+	dst.AirlineRouteID = src.AirlineRouteID
+	dst.ActualAircraftRegistrationID = src.ActualAircraftRegistrationID
+	dst.Passengers = src.Passengers
+	dst.ApproachType = src.ApproachType
+	dst.FlightType = src.FlightType
+	dst.WetLease = src.WetLease
+	if withTimes {
+		dst.OutTime = src.OutTime
+		dst.TakeoffTime = src.TakeoffTime
+		dst.LandingTime = src.LandingTime
+		dst.InTime = src.InTime
+	}
+}
+
+// DiffSharedSegmentFields returns the shared flight data that differs between a segment and its
+// companion's entry. Times are compared as HH:MM.
+func DiffSharedSegmentFields(source, mirror DailyLogbookDetail) []SegmentMirrorDifference {
+	s, m := sharedFields(&source), sharedFields(&mirror)
+	var differences []SegmentMirrorDifference
+	for i := range s {
+		if s[i][1] != m[i][1] {
+			differences = append(differences, SegmentMirrorDifference{Field: s[i][0], Source: s[i][1], Mirror: m[i][1]})
+		}
+	}
+	return differences
+}
+
+// sharedFields returns the fields of amendableFields that describe the flight rather than the pilot
+func sharedFields(d *DailyLogbookDetail) [][2]string {
+	shared := map[string]bool{
+		"flight_real_date": true, "flight_number": true, "airline_route_id": true, "actual_aircraft_registration_id": true,
+		"passengers": true, "out_time": true, "takeoff_time": true, "landing_time": true, "in_time": true,
+		"approach_type": true, "flight_type": true, "wet_lease": true,
+	}
+	var fields [][2]string
+	for _, f := range amendableFields(d) {
+		if shared[f[0]] {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "testing"
+
+func TestSegmentMirror(t *testing.T) {
+	source := DailyLogbookDetail{
+		ID:                           "det-1",
+		DailyLogbookID:               "lb-1",
+		FlightRealDate:               "2026-03-01",
+		FlightNumber:                 "AV123",
+		AirlineRouteID:               "route-1",
+		ActualAircraftRegistrationID: "ac-1",
+		OutTime:                      "10:00",
+		TakeoffTime:                  "10:15",
+		LandingTime:                  "11:30",
+		InTime:                       "11:40",
+		PilotRole:                    PilotRolePF,
+		TimeReference:                TimeReferenceUTC,
+	}
+
+	t.Run("complementary roles", func(t *testing.T) {
+		cases := map[PilotRole]PilotRole{PilotRolePF: PilotRolePM, PilotRolePM: PilotRolePF, PilotRolePFTO: PilotRolePFL, PilotRolePFL: PilotRolePFTO}
+		for role, want := range cases {
+			if got := ComplementaryPilotRole(role); got != want {
+				t.Fatalf("expected %s for %s, got %s", want, role, got)
+			}
+		}
+	})
+
+	t.Run("mirrored entry shares the flight and swaps the pilots", func(t *testing.T) {
+		mirrored := NewMirroredDetail(source, "lb-2", "emp-1", "emp-2")
+		if mirrored.ID == "" || mirrored.ID == source.ID || mirrored.DailyLogbookID != "lb-2" {
+			t.Fatalf("expected a new entry in lb-2, got %+v", mirrored)
+		}
+		if mirrored.PilotRole != PilotRolePM || *mirrored.CompanionEmployeeID != "emp-1" || *mirrored.EmployeeLogbookID != "emp-2" {
+			t.Fatalf("unexpected pilots %+v", mirrored)
+		}
+		if d := DiffSharedSegmentFields(source, mirrored); len(d) != 0 {
+			t.Fatalf("expected no differences, got %+v", d)
+		}
+	})
+
+	t.Run("sync without times leaves them to each pilot", func(t *testing.T) {
+		mirrored := NewMirroredDetail(source, "lb-2", "emp-1", "emp-2")
+		mirrored.InTime = "11:45"
+
+		edited := source
+		edited.FlightNumber = "AV124"
+		CopySharedSegmentFields(&mirrored, edited, false)
+		if mirrored.FlightNumber != "AV124" || mirrored.InTime != "11:45" {
+			t.Fatalf("expected flight number synced and in time kept, got %+v", mirrored)
+		}
+
+		mirror := SegmentMirror{SourceDetailID: source.ID, MirrorDetailID: &mirrored.ID, Status: SegmentMirrorStatusLinked}
+		d := mirror.Diff(mirrored, edited)
+		if len(d) != 1 || d[0].Field != "in_time" || d[0].Source != "11:40" || d[0].Mirror != "11:45" {
+			t.Fatalf("expected in_time to differ with the source first, got %+v", d)
+		}
+	})
+
+	t.Run("closing withdraws offers and unlinks active links", func(t *testing.T) {
+		pending := SegmentMirror{Status: SegmentMirrorStatusPending}
+		pending.Close(pending.UpdatedAt)
+		conflict := SegmentMirror{Status: SegmentMirrorStatusConflict}
+		conflict.Close(conflict.UpdatedAt)
+		if pending.Status != SegmentMirrorStatusWithdrawn || conflict.Status != SegmentMirrorStatusUnlinked {
+			t.Fatalf("unexpected statuses %s, %s", pending.Status, conflict.Status)
+		}
+	})
+}
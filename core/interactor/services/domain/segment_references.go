@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// MaxLogDateOffset is how far a segment's flight date may be from its daily logbook's log date.
+// Flight dates are stored in UTC while log dates are the pilot's local day, and overnight duties
+// are logged on the day they started, so the previous and next days are accepted.
+const MaxLogDateOffset = 24 * time.Hour
+
+// SegmentReferences are the catalog records a segment points to, loaded for cross-entity checks
+type SegmentReferences struct {
+	RouteAirlineID    string // Airline operating the airline route
+	RouteActive       bool
+	AirlineActive     bool
+	AircraftAirlineID string // Airline the aircraft registration belongs to
+}
+
+// Check enforces that the route and its airline are active and that the aircraft belongs to the
+// route's airline; a wet-leased aircraft may belong to any airline
+func (r SegmentReferences) Check(wetLease bool) error {
+	if !r.RouteActive {
+		return ErrFlightRouteInactive
+	}
+	if !r.AirlineActive {
+		return ErrFlightAirlineInactive
+	}
+	if !wetLease && r.AircraftAirlineID != r.RouteAirlineID {
+		return ErrFlightAircraftNotInAirline
+	}
+	return nil
+}
+
+// CheckFlightDateAgainstLogDate enforces that a flight date (YYYY-MM-DD) is within MaxLogDateOffset
+// of the daily logbook's log date
+func CheckFlightDateAgainstLogDate(flightDate string, logDate time.Time) error {
+	day, err := ParseFlightDate(flightDate)
+	if err != nil {
+		return ErrFlightInvalidTimeSequence
+	}
+	logDay := time.Date(logDate.Year(), logDate.Month(), logDate.Day(), 0, 0, 0, 0, time.UTC)
+	if offset := day.Sub(logDay); offset > MaxLogDateOffset || offset < -MaxLogDateOffset {
+		return ErrFlightDateOutsideLogDate
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SimulatorDeviceType is the kind of flight simulation training device (FSTD) a session was flown in
+type SimulatorDeviceType string
+
+const (
+	SimulatorDeviceFFS  SimulatorDeviceType = "FFS"  // Full flight simulator
+	SimulatorDeviceFTD  SimulatorDeviceType = "FTD"  // Flight training device
+	SimulatorDeviceFNPT SimulatorDeviceType = "FNPT" // Flight and navigation procedures trainer
+	SimulatorDeviceBITD SimulatorDeviceType = "BITD" // Basic instrument training device
+)
+
+// ValidSimulatorDeviceTypes contains all valid FSTD types
+var ValidSimulatorDeviceTypes = []SimulatorDeviceType{
+	SimulatorDeviceFFS,
+	SimulatorDeviceFTD,
+	SimulatorDeviceFNPT,
+	SimulatorDeviceBITD,
+}
+
+// IsValidSimulatorDeviceType checks if a string is a valid FSTD type
+func IsValidSimulatorDeviceType(deviceType string) bool {
+	for _, d := range ValidSimulatorDeviceTypes {
+		if string(d) == deviceType {
+			return true
+		}
+	}
+	return false
+}
+
+// SimulatorRole is the capacity the employee took part in a session in
+type SimulatorRole string
+
+const (
+	SimulatorRoleTrainee    SimulatorRole = "TRAINEE"    // Under training or being checked
+	SimulatorRolePilot      SimulatorRole = "PILOT"      // Recency or line-oriented session flown as a crew member
+	SimulatorRoleInstructor SimulatorRole = "INSTRUCTOR" // Synthetic flight instructor
+	SimulatorRoleExaminer   SimulatorRole = "EXAMINER"
+)
+
+// ValidSimulatorRoles contains all valid simulator roles
+var ValidSimulatorRoles = []SimulatorRole{
+	SimulatorRoleTrainee,
+	SimulatorRolePilot,
+	SimulatorRoleInstructor,
+	SimulatorRoleExaminer,
+}
+
+// IsValidSimulatorRole checks if a string is a valid simulator role
+func IsValidSimulatorRole(role string) bool {
+	for _, r := range ValidSimulatorRoles {
+		if string(r) == role {
+			return true
+		}
+	}
+	return false
+}
+
+// FliesSession reports whether the role flies the device, so the session's takeoffs, landings and
+// approaches are the employee's own
+func (r SimulatorRole) FliesSession() bool {
+	return r == SimulatorRoleTrainee || r == SimulatorRolePilot
+}
+
+// MaxSimulatorSessionDuration is the longest session accepted
+const MaxSimulatorSessionDuration = 8 * time.Hour
+
+// SimulatorSession is a session flown in a flight simulation training device. It has no route or
+// aircraft registration; it emulates an aircraft model and its time is logged apart from flight time.
+type SimulatorSession struct {
+	ID                 string
+	EmployeeID         string
+	SessionDate        string // YYYY-MM-DD
+	DeviceType         SimulatorDeviceType
+	QualificationLevel string  // Level of the device qualification, e.g. "D" for an FFS or "II MCC" for an FNPT
+	DeviceIdentifier   *string // Operator's designation of the device, e.g. "BOG A320 FFS 2"
+	AircraftModelID    string  // Aircraft model emulated
+	Duration           string  // HH:MM
+	Role               SimulatorRole
+	Exercises          []string // Exercises or manoeuvres flown, e.g. "V1 cut", "ILS CAT II", "Rejected takeoff"
+	Takeoffs           int
+	Landings           int
+	NightTakeoffs      int
+	NightLandings      int
+	Approaches         int // Instrument approaches
+	Remarks            *string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// Read-only fields from the aircraft model
+	ModelName      string
+	AircraftFamily string
+}
+
+// SetID generates a new UUID for the session
+func (s *SimulatorSession) SetID() {
+	s.ID = uuid.New().String()
+}
+
+// Validate checks the session date, device type, role and counts, and normalizes the duration to HH:MM
+func (s *SimulatorSession) Validate() error {
+	if _, err := time.Parse("2006-01-02", s.SessionDate); err != nil {
+		return ErrSimulatorSessionInvalid
+	}
+	if !IsValidSimulatorDeviceType(string(s.DeviceType)) || !IsValidSimulatorRole(string(s.Role)) {
+		return ErrSimulatorSessionInvalid
+	}
+	if s.Takeoffs < 0 || s.Landings < 0 || s.Approaches < 0 || s.NightTakeoffs < 0 || s.NightLandings < 0 ||
+		s.NightTakeoffs > s.Takeoffs || s.NightLandings > s.Landings {
+		return ErrSimulatorSessionInvalid
+	}
+	duration, err := ParseFlightDuration(s.Duration)
+	if err != nil || duration <= 0 || duration > MaxSimulatorSessionDuration {
+		return ErrSimulatorSessionInvalidDuration
+	}
+	s.Duration = FormatFlightDuration(duration)
+	return nil
+}
+
+// DurationValue returns the session duration, zero when it cannot be parsed
+func (s *SimulatorSession) DurationValue() time.Duration {
+	return parseOptionalDuration(&s.Duration)
+}
+
+// CurrencyEvent returns the session as seen by the currency rules; only sessions the employee flew
+// credit takeoffs, landings and approaches
+func (s *SimulatorSession) CurrencyEvent(date time.Time) CurrencyEvent {
+	event := CurrencyEvent{
+		Date:           date,
+		AircraftFamily: s.AircraftFamily,
+		Device:         s.DeviceType,
+	}
+	if s.Role.FliesSession() {
+		event.RecordedTakeoffs = s.Takeoffs
+		event.RecordedLandings = s.Landings
+		event.RecordedApproaches = s.Approaches
+		event.NightTakeoffs = s.NightTakeoffs
+		event.NightLandings = s.NightLandings
+	}
+	return event
+}
+
+// ToLogger returns the session fields for structured logging
+func (s *SimulatorSession) ToLogger() []string {
+	return []string{
+		"id:" + s.ID,
+		"employee_id:" + s.EmployeeID,
+		"session_date:" + s.SessionDate,
+		"device_type:" + string(s.DeviceType),
+		"aircraft_model_id:" + s.AircraftModelID,
+		"duration:" + s.Duration,
+		"role:" + string(s.Role),
+	}
+}
+
+// SimulatorTotals holds the accumulated session time of one device type. Simulator time is kept apart
+// from flight time, in its own logbook column.
+type SimulatorTotals struct {
+	DeviceType   SimulatorDeviceType
+	SessionCount int
+	Duration     time.Duration
+}
+
+// SimulatorSessionFilter defines the employee and optional date range of a sessions query
+type SimulatorSessionFilter struct {
+	EmployeeID string
+	From       *time.Time // Inclusive, compared against session_date
+	To         *time.Time // Inclusive, compared against session_date
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSimulatorSession(t *testing.T) {
+	valid := func() SimulatorSession {
+		return SimulatorSession{
+			SessionDate:     "2026-03-01",
+			DeviceType:      SimulatorDeviceFFS,
+			AircraftModelID: "model-1",
+			Duration:        "4:0",
+			Role:            SimulatorRoleTrainee,
+			Takeoffs:        6,
+			Landings:        6,
+			NightLandings:   2,
+			Approaches:      4,
+		}
+	}
+
+	t.Run("valid session normalizes the duration", func(t *testing.T) {
+		s := valid()
+		if err := s.Validate(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if s.Duration != "04:00" || s.DurationValue() != 4*time.Hour {
+			t.Fatalf("expected 04:00, got %s", s.Duration)
+		}
+	})
+
+	t.Run("rejects invalid fields", func(t *testing.T) {
+		cases := map[string]func(*SimulatorSession){
+			"date":         func(s *SimulatorSession) { s.SessionDate = "01/03/2026" },
+			"device":       func(s *SimulatorSession) { s.DeviceType = "CPT" },
+			"role":         func(s *SimulatorSession) { s.Role = "OBSERVER" },
+			"night counts": func(s *SimulatorSession) { s.NightLandings = 7 },
+			"negative":     func(s *SimulatorSession) { s.Approaches = -1 },
+		}
+		for name, mutate := range cases {
+			s := valid()
+			mutate(&s)
+			if err := s.Validate(); err != ErrSimulatorSessionInvalid {
+				t.Errorf("%s: expected ErrSimulatorSessionInvalid, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("rejects zero or too long durations", func(t *testing.T) {
+		for _, d := range []string{"", "00:00", "08:01", "abc"} {
+			s := valid()
+			s.Duration = d
+			if err := s.Validate(); err != ErrSimulatorSessionInvalidDuration {
+				t.Errorf("%q: expected ErrSimulatorSessionInvalidDuration, got %v", d, err)
+			}
+		}
+	})
+
+	t.Run("only sessions the employee flew credit currency", func(t *testing.T) {
+		s := valid()
+		s.AircraftFamily = "A320"
+		event := s.CurrencyEvent(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+		if event.Device != SimulatorDeviceFFS || event.Takeoffs(false) != 6 || event.Landings(true) != 2 || event.Approaches() != 4 {
+			t.Fatalf("unexpected trainee event %+v", event)
+		}
+
+		s.Role = SimulatorRoleInstructor
+		event = s.CurrencyEvent(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+		if event.Takeoffs(false) != 0 || event.Landings(false) != 0 || event.Approaches() != 0 {
+			t.Fatalf("expected no credit for an instructor, got %+v", event)
+		}
+	})
+
+	t.Run("rules credit only their devices", func(t *testing.T) {
+		rule := CurrencyRule{Code: "instrument_90d", SimulatorDevices: []SimulatorDeviceType{SimulatorDeviceFFS, SimulatorDeviceFNPT}}
+		if !rule.Credits(CurrencyEvent{}) || !rule.Credits(CurrencyEvent{Device: SimulatorDeviceFNPT}) {
+			t.Fatal("expected flights and FNPT sessions to be credited")
+		}
+		if rule.Credits(CurrencyEvent{Device: SimulatorDeviceBITD}) {
+			t.Fatal("expected BITD sessions not to be credited")
+		}
+	})
+}
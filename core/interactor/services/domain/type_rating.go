@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TypeRating is an employee's qualification to act as pilot flying on the aircraft models of a family,
+// valid from its issue date until its optional expiry date (both inclusive)
+type TypeRating struct {
+	ID             string
+	EmployeeID     string
+	AircraftFamily string  // AircraftModel.Family the rating covers
+	IssueDate      string  // YYYY-MM-DD
+	ExpiryDate     *string // YYYY-MM-DD, nil when the rating does not expire
+	Remarks        *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// SetID generates a new UUID for the type rating
+func (r *TypeRating) SetID() {
+	r.ID = uuid.New().String()
+}
+
+// Validate checks the family and that the dates are well formed, with the expiry not before the issue
+func (r *TypeRating) Validate() error {
+	if r.AircraftFamily == "" {
+		return ErrTypeRatingInvalid
+	}
+	issue, err := time.Parse("2006-01-02", r.IssueDate)
+	if err != nil {
+		return ErrTypeRatingInvalid
+	}
+	if r.ExpiryDate != nil {
+		expiry, err := time.Parse("2006-01-02", *r.ExpiryDate)
+		if err != nil || expiry.Before(issue) {
+			return ErrTypeRatingInvalid
+		}
+	}
+	return nil
+}
+
+// ValidOn reports whether the rating is in force on the day (a UTC date at midnight)
+func (r *TypeRating) ValidOn(day time.Time) bool {
+	issue, err := time.Parse("2006-01-02", r.IssueDate)
+	if err != nil || day.Before(issue) {
+		return false
+	}
+	if r.ExpiryDate == nil {
+		return true
+	}
+	expiry, err := time.Parse("2006-01-02", *r.ExpiryDate)
+	return err == nil && !day.After(expiry)
+}
+
+// ToLogger returns a slice of strings for logging type rating information
+func (r *TypeRating) ToLogger() []string {
+	return []string{
+		"id:" + r.ID,
+		"employee_id:" + r.EmployeeID,
+		"aircraft_family:" + r.AircraftFamily,
+		"issue_date:" + r.IssueDate,
+		"expiry_date:" + stringPtrValue(r.ExpiryDate),
+	}
+}
+
+// HasValidTypeRating reports whether any of the ratings covers the family on the day
+func HasValidTypeRating(ratings []TypeRating, family string, day time.Time) bool {
+	for i := range ratings {
+		if ratings[i].AircraftFamily == family && ratings[i].ValidOn(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// TypeRatingRequirement is how a missing type rating is treated on a pilot flying segment
+type TypeRatingRequirement int
+
+const (
+	TypeRatingNotRequired TypeRatingRequirement = iota // Flown under instruction or examination
+	TypeRatingFlagged                                  // Saved with a warning
+	TypeRatingEnforced                                 // Rejected
+)
+
+// TypeRatingRequirementFor returns how a missing type rating is treated for the flight type.
+// TRAINING and CHECK flights are flown under instruction or examination and need no rating, FERRY and
+// POSITIONING flights carry no passengers and are only flagged; COMMERCIAL and unspecified flights are
+// rejected.
+func TypeRatingRequirementFor(flightType *string) TypeRatingRequirement {
+	if flightType == nil {
+		return TypeRatingEnforced
+	}
+	switch *flightType {
+	case "TRAINING", "CHECK":
+		return TypeRatingNotRequired
+	case "FERRY", "POSITIONING":
+		return TypeRatingFlagged
+	default:
+		return TypeRatingEnforced
+	}
+}
+
+// TypeRatingRequiredError is returned when a pilot flying segment is logged on an aircraft family the
+// employee holds no valid type rating for
+type TypeRatingRequiredError struct {
+	AircraftFamily string
+}
+
+func (e *TypeRatingRequiredError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrTypeRatingRequired.Error(), e.AircraftFamily)
+}
+
+// Unwrap allows errors.Is(err, ErrTypeRatingRequired)
+func (e *TypeRatingRequiredError) Unwrap() error {
+	return ErrTypeRatingRequired
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTypeRating(t *testing.T) {
+	day := func(month, d int) time.Time {
+		return time.Date(2026, time.Month(month), d, 0, 0, 0, 0, time.UTC)
+	}
+	date := func(s string) *string { return &s }
+
+	t.Run("rejects invalid ratings", func(t *testing.T) {
+		cases := map[string]TypeRating{
+			"missing family":      {IssueDate: "2026-01-10"},
+			"malformed issue":     {AircraftFamily: "A320", IssueDate: "10/01/2026"},
+			"malformed expiry":    {AircraftFamily: "A320", IssueDate: "2026-01-10", ExpiryDate: date("2027-13-01")},
+			"expiry before issue": {AircraftFamily: "A320", IssueDate: "2026-01-10", ExpiryDate: date("2026-01-09")},
+		}
+		for name, r := range cases {
+			if err := r.Validate(); err != ErrTypeRatingInvalid {
+				t.Errorf("%s: expected %v, got %v", name, ErrTypeRatingInvalid, err)
+			}
+		}
+		r := TypeRating{AircraftFamily: "A320", IssueDate: "2026-01-10", ExpiryDate: date("2026-01-10")}
+		if err := r.Validate(); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("valid between issue and expiry inclusive", func(t *testing.T) {
+		r := TypeRating{AircraftFamily: "A320", IssueDate: "2026-01-10", ExpiryDate: date("2026-07-31")}
+		cases := map[time.Time]bool{
+			day(1, 9):  false,
+			day(1, 10): true,
+			day(7, 31): true,
+			day(8, 1):  false,
+		}
+		for d, want := range cases {
+			if got := r.ValidOn(d); got != want {
+				t.Errorf("%s: expected %v, got %v", d.Format("2006-01-02"), want, got)
+			}
+		}
+
+		open := TypeRating{AircraftFamily: "A320", IssueDate: "2026-01-10"}
+		if !open.ValidOn(day(12, 31)) {
+			t.Error("expected a rating without expiry to stay valid")
+		}
+	})
+
+	t.Run("matches the family", func(t *testing.T) {
+		ratings := []TypeRating{
+			{AircraftFamily: "A320", IssueDate: "2025-01-01", ExpiryDate: date("2025-12-31")},
+			{AircraftFamily: "ATR72", IssueDate: "2026-01-01"},
+		}
+		if HasValidTypeRating(ratings, "A320", day(3, 1)) {
+			t.Error("expected the expired A320 rating not to count")
+		}
+		if !HasValidTypeRating(ratings, "ATR72", day(3, 1)) {
+			t.Error("expected the ATR72 rating to count")
+		}
+		if HasValidTypeRating(ratings, "E190", day(3, 1)) {
+			t.Error("expected no rating on E190")
+		}
+	})
+
+	t.Run("requirement by flight type", func(t *testing.T) {
+		cases := map[string]TypeRatingRequirement{
+			"TRAINING":    TypeRatingNotRequired,
+			"CHECK":       TypeRatingNotRequired,
+			"FERRY":       TypeRatingFlagged,
+			"POSITIONING": TypeRatingFlagged,
+			"COMMERCIAL":  TypeRatingEnforced,
+		}
+		for flightType, want := range cases {
+			if got := TypeRatingRequirementFor(&flightType); got != want {
+				t.Errorf("%s: expected %v, got %v", flightType, want, got)
+			}
+		}
+		if got := TypeRatingRequirementFor(nil); got != TypeRatingEnforced {
+			t.Errorf("unspecified: expected %v, got %v", TypeRatingEnforced, got)
+		}
+	})
+
+	t.Run("pilot flying roles", func(t *testing.T) {
+		for _, role := range []PilotRole{PilotRolePF, PilotRolePFTO, PilotRolePFL} {
+			if !role.IsPilotFlying() {
+				t.Errorf("expected %s to be pilot flying", role)
+			}
+		}
+		if PilotRolePM.IsPilotFlying() {
+			t.Error("expected PM not to be pilot flying")
+		}
+	})
+
+	t.Run("required error unwraps", func(t *testing.T) {
+		var err error = &TypeRatingRequiredError{AircraftFamily: "A320"}
+		if !errors.Is(err, ErrTypeRatingRequired) {
+			t.Errorf("expected %v to wrap %v", err, ErrTypeRatingRequired)
+		}
+	})
+}
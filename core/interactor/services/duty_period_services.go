@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// DutyPeriodService stores the duty periods that group an employee's segments and loads them with their
+// segments and the rest before each of them. Linking or unlinking a segment is recorded in its history.
+type DutyPeriodService struct {
+	repo        output.DutyPeriodRepository
+	historyRepo output.LogbookHistoryRepository
+	logger      logger.Logger
+}
+
+// NewDutyPeriodService creates a new duty period service
+func NewDutyPeriodService(repo output.DutyPeriodRepository, historyRepo output.LogbookHistoryRepository, log logger.Logger) *DutyPeriodService {
+	return &DutyPeriodService{
+		repo:        repo,
+		historyRepo: historyRepo,
+		logger:      log,
+	}
+}
+
+// GetDutyPeriod retrieves a duty period by its ID, with its segments and the rest before it
+func (s *DutyPeriodService) GetDutyPeriod(ctx context.Context, id string) (*domain.DutyPeriod, error) {
+	period, err := s.repo.GetDutyPeriodByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := []domain.DutyPeriod{*period}
+	if err := s.complete(ctx, period.EmployeeID, periods); err != nil {
+		return nil, err
+	}
+	return &periods[0], nil
+}
+
+// ListDutyPeriods retrieves an employee's duty periods in chronological order, with their segments and
+// the rest before each of them
+func (s *DutyPeriodService) ListDutyPeriods(ctx context.Context, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error) {
+	periods, err := s.repo.ListDutyPeriods(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.complete(ctx, filter.EmployeeID, periods); err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+// HasOverlappingDutyPeriod reports whether another duty period of the employee overlaps the period
+func (s *DutyPeriodService) HasOverlappingDutyPeriod(ctx context.Context, period domain.DutyPeriod) (bool, error) {
+	return s.repo.HasOverlappingDutyPeriod(ctx, period.EmployeeID, period.ReportTime, period.ReleaseTime, period.ID)
+}
+
+// ListSegmentsByID retrieves the live segments with the given IDs
+func (s *DutyPeriodService) ListSegmentsByID(ctx context.Context, segmentIDs []string) ([]domain.DutyPeriodSegment, error) {
+	return s.repo.ListSegmentsByID(ctx, segmentIDs)
+}
+
+// CreateDutyPeriod saves a new duty period and links its segments
+func (s *DutyPeriodService) CreateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SaveDutyPeriod(ctx, tx, period); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", period.ID, "error", err)
+		return err
+	}
+
+	if err := s.linkSegments(ctx, tx, period.ID, nil, period.Segments); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", period.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateDutyPeriod stores the editable fields of a duty period and replaces its segments
+func (s *DutyPeriodService) UpdateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error {
+	linked, err := s.repo.ListDutyPeriodSegments(ctx, []string{period.ID})
+	if err != nil {
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", period.ID, "error", err)
+		return err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.UpdateDutyPeriod(ctx, tx, period); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", period.ID, "error", err)
+		return err
+	}
+
+	if err := s.linkSegments(ctx, tx, period.ID, linked, period.Segments); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", period.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteDutyPeriod unlinks the segments of a duty period and removes it
+func (s *DutyPeriodService) DeleteDutyPeriod(ctx context.Context, id string) error {
+	linked, err := s.repo.ListDutyPeriodSegments(ctx, []string{id})
+	if err != nil {
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", id, "error", err)
+		return err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.linkSegments(ctx, tx, id, linked, nil); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", id, "error", err)
+		return err
+	}
+
+	if err := s.repo.DeleteDutyPeriod(ctx, tx, id); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogDutyPeriodError, "duty_period_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// linkSegments replaces the segments linked to the duty period within the transaction, recording each
+// segment linked or unlinked in its history
+func (s *DutyPeriodService) linkSegments(ctx context.Context, tx output.Tx, dutyPeriodID string, linked, segments []domain.DutyPeriodSegment) error {
+	if err := s.repo.LinkDutyPeriodSegments(ctx, tx, dutyPeriodID, segmentIDs(segments)); err != nil {
+		return err
+	}
+	for _, seg := range domain.RelinkedDutyPeriodSegments(linked, segments) {
+		if err := recordHistory(ctx, s.historyRepo, tx, domain.NewDutyPeriodLinkHistory(ctx, dutyPeriodID, seg)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// complete loads the segments of the chronologically ordered periods and computes the rest before each
+// of them, looking up the employee's duty period preceding the first one
+func (s *DutyPeriodService) complete(ctx context.Context, employeeID string, periods []domain.DutyPeriod) error {
+	if len(periods) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(periods))
+	for i := range periods {
+		ids[i] = periods[i].ID
+	}
+	segments, err := s.repo.ListDutyPeriodSegments(ctx, ids)
+	if err != nil {
+		s.logger.Error(logger.LogDutyPeriodError, "employee_id", employeeID, "error", err)
+		return err
+	}
+	byPeriod := make(map[string][]domain.DutyPeriodSegment, len(periods))
+	for _, seg := range segments {
+		if seg.DutyPeriodID != nil {
+			byPeriod[*seg.DutyPeriodID] = append(byPeriod[*seg.DutyPeriodID], seg)
+		}
+	}
+	for i := range periods {
+		periods[i].Segments = byPeriod[periods[i].ID]
+	}
+
+	previous, err := s.repo.GetPreviousDutyPeriod(ctx, employeeID, periods[0].ReportTime)
+	if err != nil {
+		s.logger.Error(logger.LogDutyPeriodError, "employee_id", employeeID, "error", err)
+		return err
+	}
+	domain.ComputeDutyPeriodRest(previous, periods)
+	return nil
+}
+
+// segmentIDs returns the IDs of the segments
+func segmentIDs(segments []domain.DutyPeriodSegment) []string {
+	ids := make([]string, len(segments))
+	for i, s := range segments {
+		ids[i] = s.ID
+	}
+	return ids
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// FlightAnomalyService flags block and air times that deviate from the route's estimated flight time
+type FlightAnomalyService struct {
+	repo      output.DailyLogbookDetailRepository
+	tolerance domain.AnomalyTolerance
+	logger    logger.Logger
+}
+
+// NewFlightAnomalyService creates a new flight anomaly service
+func NewFlightAnomalyService(repo output.DailyLogbookDetailRepository, tolerance domain.AnomalyTolerance, log logger.Logger) *FlightAnomalyService {
+	return &FlightAnomalyService{
+		repo:      repo,
+		tolerance: tolerance,
+		logger:    log,
+	}
+}
+
+// AnnotateAnomalies sets the Anomalies of each detail
+func (s *FlightAnomalyService) AnnotateAnomalies(details []domain.DailyLogbookDetail) {
+	for i := range details {
+		details[i].Anomalies = domain.DetectFlightTimeAnomalies(details[i], s.tolerance)
+	}
+}
+
+// GetAnomalyReport returns the employee's segments with anomalies between two flight dates (inclusive)
+func (s *FlightAnomalyService) GetAnomalyReport(ctx context.Context, employeeID string, from, to time.Time) (*domain.FlightAnomalyReport, error) {
+	details, err := s.repo.ListDailyLogbookDetailsByEmployee(ctx, employeeID, from, to)
+	if err != nil {
+		s.logger.Error(logger.LogFlightAnomalyReportError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	report := &domain.FlightAnomalyReport{
+		EmployeeID:   employeeID,
+		From:         from,
+		To:           to,
+		Tolerance:    s.tolerance,
+		SegmentCount: len(details),
+		Segments:     []domain.DailyLogbookDetail{},
+	}
+	s.AnnotateAnomalies(details)
+	for _, d := range details {
+		if len(d.Anomalies) > 0 {
+			report.Segments = append(report.Segments, d)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// FTLEngine evaluates accumulated block and duty time against flight time limitations.
+// Days are flight dates (UTC); rolling windows include both the first and the last day.
+type FTLEngine struct {
+	limits       []domain.FTLLimit
+	warningRatio float64
+}
+
+// NewFTLEngine creates an engine for the given limits.
+// Falls back to domain.DefaultFTLLimits and domain.DefaultFTLWarningRatio when not configured.
+func NewFTLEngine(limits []domain.FTLLimit, warningRatio float64) *FTLEngine {
+	if len(limits) == 0 {
+		limits = domain.DefaultFTLLimits()
+	}
+	if warningRatio <= 0 || warningRatio > 1 {
+		warningRatio = domain.DefaultFTLWarningRatio
+	}
+	return &FTLEngine{
+		limits:       limits,
+		warningRatio: warningRatio,
+	}
+}
+
+// Limits returns the configured limits
+func (e *FTLEngine) Limits() []domain.FTLLimit {
+	return e.limits
+}
+
+// LookBack returns how many days before a date must be loaded to evaluate every window ending on it
+func (e *FTLEngine) LookBack() int {
+	days := 0
+	for _, l := range e.limits {
+		n := l.WindowDays
+		if l.CalendarYear {
+			n = 366
+		}
+		if n > days {
+			days = n
+		}
+	}
+	return days
+}
+
+// Status returns the usage of each limit over the window ending on asOf
+func (e *FTLEngine) Status(series []domain.DailyFlightTime, asOf time.Time) []domain.FTLUsage {
+	usages := make([]domain.FTLUsage, 0, len(e.limits))
+	for _, l := range e.limits {
+		start, end := l.Window(asOf)
+		usages = append(usages, e.usage(l, series, start, end))
+	}
+	return usages
+}
+
+// Check returns, for each limit, the most used window that contains the given day.
+// A segment on that day counts towards every window covering it, including windows that end later
+// (e.g. an entry back-dated into a full 28 day period), so all of them are evaluated.
+// Only usages at WARNING or EXCEEDED level are returned.
+func (e *FTLEngine) Check(series []domain.DailyFlightTime, day time.Time) []domain.FTLUsage {
+	var findings []domain.FTLUsage
+	for _, l := range e.limits {
+		var worst *domain.FTLUsage
+		for _, end := range windowEnds(l, day) {
+			start, end := l.Window(end)
+			u := e.usage(l, series, start, end)
+			if worst == nil || u.Used > worst.Used {
+				worst = &u
+			}
+		}
+		if worst != nil && worst.Level != domain.FTLLevelOK {
+			findings = append(findings, *worst)
+		}
+	}
+	return findings
+}
+
+// usage sums the metric of the series between start and end (inclusive) and classifies it
+func (e *FTLEngine) usage(l domain.FTLLimit, series []domain.DailyFlightTime, start, end time.Time) domain.FTLUsage {
+	var used time.Duration
+	for _, d := range series {
+		if d.Date.Before(start) || d.Date.After(end) {
+			continue
+		}
+		used += d.Value(l.Metric)
+	}
+
+	level := domain.FTLLevelOK
+	switch {
+	case used > l.Max:
+		level = domain.FTLLevelExceeded
+	case float64(used) >= float64(l.Max)*e.warningRatio:
+		level = domain.FTLLevelWarning
+	}
+
+	return domain.FTLUsage{
+		Limit:       l,
+		WindowStart: start,
+		WindowEnd:   end,
+		Used:        used,
+		Level:       level,
+	}
+}
+
+// windowEnds lists the last days of every window of the limit that contains day
+func windowEnds(l domain.FTLLimit, day time.Time) []time.Time {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	if l.CalendarYear {
+		return []time.Time{time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)}
+	}
+	ends := make([]time.Time, 0, l.WindowDays)
+	for i := 0; i < l.WindowDays; i++ {
+		ends = append(ends, day.AddDate(0, 0, i))
+	}
+	return ends
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+func ftlDay(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestFTLEngine_Status(t *testing.T) {
+	engine := NewFTLEngine([]domain.FTLLimit{
+		{Code: "block_7d", Metric: domain.FTLMetricBlockTime, WindowDays: 7, Max: 10 * time.Hour, Blocking: true},
+		{Code: "block_year", Metric: domain.FTLMetricBlockTime, CalendarYear: true, Max: 20 * time.Hour},
+	}, 0.9)
+
+	series := []domain.DailyFlightTime{
+		{Date: ftlDay("2025-12-30"), BlockTime: 5 * time.Hour},
+		{Date: ftlDay("2026-01-02"), BlockTime: 4 * time.Hour},
+		{Date: ftlDay("2026-01-05"), BlockTime: 5 * time.Hour},
+	}
+
+	usages := engine.Status(series, ftlDay("2026-01-05"))
+	if len(usages) != 2 {
+		t.Fatalf("expected 2 usages, got %d", len(usages))
+	}
+
+	// 7 day window 2025-12-30..2026-01-05 includes all three days
+	if usages[0].Used != 14*time.Hour || usages[0].Level != domain.FTLLevelExceeded {
+		t.Errorf("block_7d: got %v %s", usages[0].Used, usages[0].Level)
+	}
+	if !usages[0].WindowStart.Equal(ftlDay("2025-12-30")) {
+		t.Errorf("block_7d window start: got %v", usages[0].WindowStart)
+	}
+
+	// Calendar year only counts 2026
+	if usages[1].Used != 9*time.Hour || usages[1].Level != domain.FTLLevelOK {
+		t.Errorf("block_year: got %v %s", usages[1].Used, usages[1].Level)
+	}
+	if usages[1].Remaining() != 11*time.Hour {
+		t.Errorf("block_year remaining: got %v", usages[1].Remaining())
+	}
+}
+
+func TestFTLEngine_Check_Warning(t *testing.T) {
+	engine := NewFTLEngine([]domain.FTLLimit{
+		{Code: "block_7d", Metric: domain.FTLMetricBlockTime, WindowDays: 7, Max: 10 * time.Hour, Blocking: true},
+	}, 0.9)
+
+	series := []domain.DailyFlightTime{
+		{Date: ftlDay("2026-03-01"), BlockTime: 6 * time.Hour},
+		{Date: ftlDay("2026-03-03"), BlockTime: 3 * time.Hour},
+	}
+
+	findings := engine.Check(series, ftlDay("2026-03-03"))
+	if len(findings) != 1 || findings[0].Level != domain.FTLLevelWarning {
+		t.Fatalf("expected one warning, got %+v", findings)
+	}
+}
+
+func TestFTLEngine_Check_BackDatedEntry(t *testing.T) {
+	engine := NewFTLEngine([]domain.FTLLimit{
+		{Code: "block_7d", Metric: domain.FTLMetricBlockTime, WindowDays: 7, Max: 10 * time.Hour, Blocking: true},
+	}, 0.9)
+
+	// The entry on 03-01 only exceeds the limit in windows ending after it (03-01..03-07)
+	series := []domain.DailyFlightTime{
+		{Date: ftlDay("2026-03-01"), BlockTime: 4 * time.Hour},
+		{Date: ftlDay("2026-03-06"), BlockTime: 7 * time.Hour},
+	}
+
+	findings := engine.Check(series, ftlDay("2026-03-01"))
+	if len(findings) != 1 || findings[0].Level != domain.FTLLevelExceeded {
+		t.Fatalf("expected one exceeded finding, got %+v", findings)
+	}
+	if findings[0].Used != 11*time.Hour {
+		t.Errorf("expected 11h used, got %v", findings[0].Used)
+	}
+}
+
+func TestFTLEngine_Check_WithinLimits(t *testing.T) {
+	engine := NewFTLEngine(nil, 0)
+
+	series := []domain.DailyFlightTime{
+		{Date: ftlDay("2026-03-01"), BlockTime: 4 * time.Hour, DutyTime: 6 * time.Hour},
+	}
+
+	if findings := engine.Check(series, ftlDay("2026-03-01")); len(findings) != 0 {
+		t.Errorf("expected no findings, got %+v", findings)
+	}
+	if engine.LookBack() != 366 {
+		t.Errorf("expected 366 look back days with the default limits, got %d", engine.LookBack())
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// FTLService evaluates flight time limitations for an employee's segments. Duty time comes from the
+// duty periods, counted on their report date, and from the segments not linked to any of them.
+type FTLService struct {
+	repo       output.DailyLogbookDetailRepository
+	dutyPeriod output.DutyPeriodRepository
+	engine     *FTLEngine
+	logger     logger.Logger
+}
+
+// NewFTLService creates a new FTL service
+func NewFTLService(repo output.DailyLogbookDetailRepository, dutyPeriod output.DutyPeriodRepository, engine *FTLEngine, log logger.Logger) *FTLService {
+	return &FTLService{
+		repo:       repo,
+		dutyPeriod: dutyPeriod,
+		engine:     engine,
+		logger:     log,
+	}
+}
+
+// GetFTLStatus returns the usage of every limit over the windows ending on asOf
+func (s *FTLService) GetFTLStatus(ctx context.Context, employeeID string, asOf time.Time) (*domain.FTLStatus, error) {
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	from := asOf.AddDate(0, 0, -s.engine.LookBack())
+
+	series, err := s.dailyFlightTimes(ctx, employeeID, from, asOf, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.FTLStatus{
+		EmployeeID: employeeID,
+		AsOf:       asOf,
+		Usages:     s.engine.Status(series, asOf),
+	}, nil
+}
+
+// CheckSegment evaluates the limits as if the segment and the batch saved with it were saved. The
+// segment's previously stored values (when updating) are excluded and its new block and duty time are
+// added on its flight date, as are those of each segment of the batch; the duty time of a segment linked
+// to a duty period is ignored, its duty period counts instead. Returns the limits at WARNING or EXCEEDED level.
+func (s *FTLService) CheckSegment(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.FTLUsage, error) {
+	day, err := domain.ParseFlightDate(detail.FlightRealDate)
+	if err != nil {
+		return nil, domain.ErrFlightInvalidTimeSequence
+	}
+
+	lookBack := s.engine.LookBack()
+	series, err := s.dailyFlightTimes(ctx, employeeID, day.AddDate(0, 0, -lookBack), day.AddDate(0, 0, lookBack), detail.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := segmentFlightTime(detail)
+	if err != nil {
+		return nil, err
+	}
+	series = append(series, candidate)
+	for _, b := range batch {
+		if b.ID == detail.ID {
+			continue
+		}
+		// Batch segments were already checked, so their times parse
+		if t, err := segmentFlightTime(b); err == nil {
+			series = append(series, t)
+		}
+	}
+
+	findings := s.engine.Check(series, day)
+	if len(findings) > 0 {
+		s.logger.Warn(logger.LogFTLCheckWarning, "employee_id", employeeID, "flight_real_date", detail.FlightRealDate, "findings", len(findings))
+	}
+	return findings, nil
+}
+
+// segmentFlightTime returns the block time of a segment, and its duty time unless it is linked to a duty
+// period, on its flight date
+func segmentFlightTime(detail domain.DailyLogbookDetail) (domain.DailyFlightTime, error) {
+	day, err := domain.ParseFlightDate(detail.FlightRealDate)
+	if err != nil {
+		return domain.DailyFlightTime{}, domain.ErrFlightInvalidTimeSequence
+	}
+	t := domain.DailyFlightTime{Date: day}
+	if t.BlockTime, err = domain.ParseFlightDuration(detail.BlockTime); err != nil {
+		return domain.DailyFlightTime{}, domain.ErrFlightTimeMismatch
+	}
+	if detail.DutyPeriodID == nil && detail.DutyTime != nil && *detail.DutyTime != "" {
+		if t.DutyTime, err = domain.ParseFlightDuration(*detail.DutyTime); err != nil {
+			return domain.DailyFlightTime{}, domain.ErrFlightTimeMismatch
+		}
+	}
+	return t, nil
+}
+
+// dailyFlightTimes returns the employee's block and duty time per day between from and to (inclusive),
+// with the duty of every duty period reported in the range added on its report date
+func (s *FTLService) dailyFlightTimes(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyFlightTime, error) {
+	series, err := s.repo.GetDailyFlightTimes(ctx, employeeID, from, to, excludeDetailID)
+	if err != nil {
+		s.logger.Error(logger.LogFTLStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	periods, err := s.dutyPeriod.ListDutyPeriods(ctx, domain.DutyPeriodFilter{EmployeeID: employeeID, From: &from, To: &to})
+	if err != nil {
+		s.logger.Error(logger.LogFTLStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	for i := range periods {
+		series = append(series, domain.DailyFlightTime{Date: periods[i].ReportDate(), DutyTime: periods[i].DutyTime()})
+	}
+	return series, nil
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+)
+
+// stubFlightTimeRepository is a minimal output.DailyLogbookDetailRepository returning stored daily times
+type stubFlightTimeRepository struct {
+	output.DailyLogbookDetailRepository
+	series []domain.DailyFlightTime
+}
+
+func (r *stubFlightTimeRepository) GetDailyFlightTimes(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyFlightTime, error) {
+	return r.series, nil
+}
+
+// stubDutyPeriodRepository is a minimal output.DutyPeriodRepository without duty periods
+type stubDutyPeriodRepository struct {
+	output.DutyPeriodRepository
+}
+
+func (r *stubDutyPeriodRepository) ListDutyPeriods(ctx context.Context, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error) {
+	return nil, nil
+}
+
+func TestFTLService_CheckSegment(t *testing.T) {
+	engine := NewFTLEngine([]domain.FTLLimit{
+		{Code: "block_7d", Metric: domain.FTLMetricBlockTime, WindowDays: 7, Max: 10 * time.Hour, Blocking: true},
+	}, 0.9)
+	svc := NewFTLService(&stubFlightTimeRepository{
+		series: []domain.DailyFlightTime{{Date: ftlDay("2026-03-01"), BlockTime: 4 * time.Hour}},
+	}, &stubDutyPeriodRepository{}, engine, noopLogger{})
+
+	segment := func(id, date, block string) domain.DailyLogbookDetail {
+		return domain.DailyLogbookDetail{ID: id, FlightRealDate: date, BlockTime: block}
+	}
+	detail := segment("row-4", "2026-03-04", "03:00")
+
+	t.Run("stored history only", func(t *testing.T) {
+		findings, err := svc.CheckSegment(context.Background(), "employee-1", detail, nil)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if len(findings) != 0 {
+			t.Fatalf("expected no findings, got %v", findings)
+		}
+	})
+
+	t.Run("batch rows count towards the limits", func(t *testing.T) {
+		batch := []domain.DailyLogbookDetail{
+			segment("row-2", "2026-03-02", "02:30"),
+			segment("row-3", "2026-03-03", "02:30"),
+		}
+		findings, err := svc.CheckSegment(context.Background(), "employee-1", detail, batch)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if len(findings) != 1 || findings[0].Level != domain.FTLLevelExceeded || findings[0].Used != 12*time.Hour {
+			t.Fatalf("expected block_7d exceeded with 12h, got %v", findings)
+		}
+	})
+
+	t.Run("invalid block time", func(t *testing.T) {
+		_, err := svc.CheckSegment(context.Background(), "employee-1", segment("row-5", "2026-03-04", "x"), nil)
+		if !errors.Is(err, domain.ErrFlightTimeMismatch) {
+			t.Fatalf("expected ErrFlightTimeMismatch, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// LogbookAmendmentService stores amendment requests of signed logbooks and applies them once signed
+type LogbookAmendmentService struct {
+	amendmentRepo output.LogbookAmendmentRepository
+	logbookRepo   output.DailyLogbookRepository
+	detailRepo    output.DailyLogbookDetailRepository
+	historyRepo   output.LogbookHistoryRepository
+	logger        logger.Logger
+}
+
+// NewLogbookAmendmentService creates a new logbook amendment service
+func NewLogbookAmendmentService(amendmentRepo output.LogbookAmendmentRepository, logbookRepo output.DailyLogbookRepository,
+	detailRepo output.DailyLogbookDetailRepository, historyRepo output.LogbookHistoryRepository, log logger.Logger) *LogbookAmendmentService {
+	return &LogbookAmendmentService{
+		amendmentRepo: amendmentRepo,
+		logbookRepo:   logbookRepo,
+		detailRepo:    detailRepo,
+		historyRepo:   historyRepo,
+		logger:        log,
+	}
+}
+
+// GetAmendment retrieves an amendment by its ID
+func (s *LogbookAmendmentService) GetAmendment(ctx context.Context, id string) (*domain.LogbookAmendment, error) {
+	return s.amendmentRepo.GetAmendmentByID(ctx, id)
+}
+
+// ListAmendments retrieves the amendments of a daily logbook
+func (s *LogbookAmendmentService) ListAmendments(ctx context.Context, logbookID string) ([]domain.LogbookAmendment, error) {
+	return s.amendmentRepo.ListAmendmentsByLogbook(ctx, logbookID)
+}
+
+// RequestAmendment saves a pending amendment
+func (s *LogbookAmendmentService) RequestAmendment(ctx context.Context, amendment domain.LogbookAmendment) error {
+	tx, err := s.amendmentRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.amendmentRepo.SaveAmendment(ctx, tx, amendment); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogAmendmentError, "amendment_id", amendment.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ApplyAmendment applies the segment change, stores the resealed hash chain links, marks the amendment
+// as applied and re-signs the logbook in one transaction, recording both changes in the history.
+// The amendment and logbook must already carry the resolution and new signature.
+func (s *LogbookAmendmentService) ApplyAmendment(ctx context.Context, amendment domain.LogbookAmendment, logbook domain.DailyLogbook, chain []domain.DailyLogbookDetail) error {
+	var before *domain.DailyLogbookDetail
+	if amendment.Action != domain.AmendmentActionCreate {
+		current, err := s.detailRepo.GetDailyLogbookDetailByID(ctx, amendment.DailyLogbookDetailID)
+		if err != nil {
+			return err
+		}
+		before = current
+	}
+	beforeLogbook, err := s.logbookRepo.GetDailyLogbookByID(ctx, logbook.ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.amendmentRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	switch amendment.Action {
+	case domain.AmendmentActionCreate:
+		err = s.detailRepo.SaveDailyLogbookDetail(ctx, tx, *amendment.Proposed)
+	case domain.AmendmentActionUpdate:
+		err = s.detailRepo.UpdateDailyLogbookDetail(ctx, tx, *amendment.Proposed)
+	case domain.AmendmentActionDelete:
+		err = s.detailRepo.DeleteDailyLogbookDetail(ctx, tx, amendment.DailyLogbookDetailID, time.Now().UTC())
+	default:
+		err = domain.ErrAmendmentInvalid
+	}
+	if err == nil {
+		err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookDetailHistory(ctx, before, amendment.Proposed))
+	}
+	if err == nil {
+		err = storeChainHashes(ctx, s.detailRepo, tx, chain)
+	}
+	if err == nil {
+		err = s.amendmentRepo.ResolveAmendment(ctx, tx, amendment)
+	}
+	if err == nil {
+		err = s.logbookRepo.UpdateDailyLogbookState(ctx, tx, logbook)
+	}
+	if err == nil {
+		err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookHistory(ctx, beforeLogbook, &logbook))
+	}
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogAmendmentError, "amendment_id", amendment.ID, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+	return nil
+}
+
+// RejectAmendment marks a pending amendment as rejected
+func (s *LogbookAmendmentService) RejectAmendment(ctx context.Context, amendment domain.LogbookAmendment) error {
+	tx, err := s.amendmentRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.amendmentRepo.ResolveAmendment(ctx, tx, amendment); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogAmendmentError, "amendment_id", amendment.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// LogbookChainService keeps a SHA-256 hash chain over each employee's signed segments so that
+// changes made directly in the database can be detected
+type LogbookChainService struct {
+	logbookRepo output.DailyLogbookRepository
+	detailRepo  output.DailyLogbookDetailRepository
+	historyRepo output.LogbookHistoryRepository
+	logger      logger.Logger
+}
+
+// NewLogbookChainService creates a new logbook chain service
+func NewLogbookChainService(logbookRepo output.DailyLogbookRepository, detailRepo output.DailyLogbookDetailRepository,
+	historyRepo output.LogbookHistoryRepository, log logger.Logger) *LogbookChainService {
+	return &LogbookChainService{
+		logbookRepo: logbookRepo,
+		detailRepo:  detailRepo,
+		historyRepo: historyRepo,
+		logger:      log,
+	}
+}
+
+// VerifyChain recomputes the employee's chain and reports the first broken link
+func (s *LogbookChainService) VerifyChain(ctx context.Context, employeeID string) (*domain.LogbookChainReport, error) {
+	entries, err := s.detailRepo.ListChainEntries(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.VerifyLogbookChain(entries)
+	report.EmployeeID = employeeID
+	return report, nil
+}
+
+// SealDailyLogbook stores the signed state of a logbook and chains its segments in one transaction.
+// Segments are ordered by flight date, so signing a past day rehashes the links that follow it.
+// Nothing is sealed on top of a chain that no longer verifies.
+func (s *LogbookChainService) SealDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error {
+	entries, err := s.verifiedEntries(ctx, logbook.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	details, err := s.detailRepo.ListDailyLogbookDetailsByLogbook(ctx, logbook.ID)
+	if err != nil {
+		return err
+	}
+	before, err := s.logbookRepo.GetDailyLogbookByID(ctx, logbook.ID)
+	if err != nil {
+		return err
+	}
+	for _, d := range details {
+		entries = domain.ReplaceLogbookChainEntry(entries, d.ID, &d)
+	}
+	chain := domain.SealLogbookChain(entries)
+
+	s.logger.Info(logger.LogLogbookChainSeal, "logbook_id", logbook.ID, "segments", len(details), "links", len(chain))
+
+	tx, err := s.logbookRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err = s.logbookRepo.UpdateDailyLogbookState(ctx, tx, logbook); err == nil {
+		err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookHistory(ctx, before, &logbook))
+	}
+	if err == nil {
+		err = storeChainHashes(ctx, s.detailRepo, tx, chain)
+	}
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogLogbookChainError, "logbook_id", logbook.ID, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+
+	s.logger.Info(logger.LogLogbookChainSealOK, "logbook_id", logbook.ID)
+	return nil
+}
+
+// ChainAfterAmendment returns the chain links that change when the segment detailID is replaced by
+// proposed (nil when the amendment deletes it), after checking the current chain still verifies
+func (s *LogbookChainService) ChainAfterAmendment(ctx context.Context, employeeID, detailID string, proposed *domain.DailyLogbookDetail) ([]domain.DailyLogbookDetail, error) {
+	entries, err := s.verifiedEntries(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.SealLogbookChain(domain.ReplaceLogbookChainEntry(entries, detailID, proposed)), nil
+}
+
+// verifiedEntries returns the employee's chain, or ErrLogbookChainBroken when a stored hash was altered
+func (s *LogbookChainService) verifiedEntries(ctx context.Context, employeeID string) ([]domain.DailyLogbookDetail, error) {
+	entries, err := s.detailRepo.ListChainEntries(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if report := domain.VerifyLogbookChain(entries); !report.Valid() {
+		s.logger.Warn(logger.LogLogbookChainBroken, "employee_id", employeeID,
+			"position", report.FirstBroken.Position, "detail_id", report.FirstBroken.DetailID)
+		return nil, domain.ErrLogbookChainBroken
+	}
+	return entries, nil
+}
+
+// storeChainHashes writes the hash chain links of the given segments
+func storeChainHashes(ctx context.Context, repo output.DailyLogbookDetailRepository, tx output.Tx, chain []domain.DailyLogbookDetail) error {
+	for _, d := range chain {
+		if err := repo.UpdateChainHash(ctx, tx, d.ID, *d.ChainHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
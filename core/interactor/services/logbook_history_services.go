@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// LogbookHistoryService reads the change history of daily logbooks and segments. Entries are written by
+// the services that make the changes, in the same transaction (see recordHistory).
+type LogbookHistoryService struct {
+	repo   output.LogbookHistoryRepository
+	logger logger.Logger
+}
+
+// NewLogbookHistoryService creates a new logbook history service
+func NewLogbookHistoryService(repo output.LogbookHistoryRepository, log logger.Logger) *LogbookHistoryService {
+	return &LogbookHistoryService{
+		repo:   repo,
+		logger: log,
+	}
+}
+
+// ListLogbookHistory returns the changes of a daily logbook and of its segments, oldest first
+func (s *LogbookHistoryService) ListLogbookHistory(ctx context.Context, logbookID string) ([]domain.LogbookHistoryEntry, error) {
+	return s.repo.ListHistoryByLogbook(ctx, logbookID)
+}
+
+// ListDetailHistory returns the changes of a segment, oldest first; also available once it was deleted
+func (s *LogbookHistoryService) ListDetailHistory(ctx context.Context, detailID string) ([]domain.LogbookHistoryEntry, error) {
+	return s.repo.ListHistoryByEntity(ctx, domain.HistoryEntityDailyLogbookDetail, detailID)
+}
+
+// recordHistory stores a history entry in the transaction of the change; updates that change no field
+// are not recorded
+func recordHistory(ctx context.Context, repo output.LogbookHistoryRepository, tx output.Tx, entry domain.LogbookHistoryEntry) error {
+	if entry.IsEmpty() {
+		return nil
+	}
+	return repo.SaveHistoryEntry(ctx, tx, entry)
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// LogbookImportService resolves bulk-imported segments against the reference data
+// and saves them, together with any missing daily logbooks, in a single transaction
+type LogbookImportService struct {
+	logbookRepo      output.DailyLogbookRepository
+	detailRepo       output.DailyLogbookDetailRepository
+	airlineRouteRepo output.AirlineRouteRepository
+	registrationRepo output.AircraftRegistrationRepository
+	airportRepo      output.AirportRepository
+	mappingRepo      output.ImportMappingRepository
+	historyRepo      output.LogbookHistoryRepository
+	logger           logger.Logger
+}
+
+// NewLogbookImportService creates a new logbook import service
+func NewLogbookImportService(
+	logbookRepo output.DailyLogbookRepository,
+	detailRepo output.DailyLogbookDetailRepository,
+	airlineRouteRepo output.AirlineRouteRepository,
+	registrationRepo output.AircraftRegistrationRepository,
+	airportRepo output.AirportRepository,
+	mappingRepo output.ImportMappingRepository,
+	historyRepo output.LogbookHistoryRepository,
+	log logger.Logger,
+) *LogbookImportService {
+	return &LogbookImportService{
+		logbookRepo:      logbookRepo,
+		detailRepo:       detailRepo,
+		airlineRouteRepo: airlineRouteRepo,
+		registrationRepo: registrationRepo,
+		airportRepo:      airportRepo,
+		mappingRepo:      mappingRepo,
+		historyRepo:      historyRepo,
+		logger:           log,
+	}
+}
+
+// LoadImportCatalog loads the active airline routes, the aircraft registrations, the airports and the employee's
+// existing daily logbooks and remembered mappings once, so rows are resolved without a query per row
+func (s *LogbookImportService) LoadImportCatalog(ctx context.Context, employeeID string) (*domain.LogbookImportCatalog, error) {
+	routes, err := s.airlineRouteRepo.ListAirlineRoutes(ctx, map[string]interface{}{"status": true})
+	if err != nil {
+		s.logger.Error(logger.LogLogbookImportError, "error", err)
+		return nil, err
+	}
+
+	registrations, err := s.registrationRepo.ListAircraftRegistrations(ctx, map[string]interface{}{})
+	if err != nil {
+		s.logger.Error(logger.LogLogbookImportError, "error", err)
+		return nil, err
+	}
+
+	logbooks, err := s.logbookRepo.ListDailyLogbooksByEmployee(ctx, employeeID, map[string]interface{}{})
+	if err != nil {
+		s.logger.Error(logger.LogLogbookImportError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	airports, err := s.airportRepo.ListAirports(ctx, map[string]interface{}{})
+	if err != nil {
+		s.logger.Error(logger.LogLogbookImportError, "error", err)
+		return nil, err
+	}
+
+	mappings, err := s.mappingRepo.ListImportMappingsByEmployee(ctx, employeeID)
+	if err != nil {
+		s.logger.Error(logger.LogImportMappingError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	catalog := &domain.LogbookImportCatalog{
+		Routes:        make(map[string][]domain.AirlineRoute),
+		Registrations: make(map[string]domain.AircraftRegistration, len(registrations)),
+		Logbooks:      make(map[string]domain.DailyLogbook, len(logbooks)),
+		Airports:      make(map[string]domain.Airport, len(airports)),
+	}
+	for _, r := range routes {
+		code := strings.ToUpper(r.RouteCode)
+		catalog.Routes[code] = append(catalog.Routes[code], r)
+	}
+	for _, r := range registrations {
+		catalog.Registrations[strings.ToUpper(r.LicensePlate)] = r
+	}
+	for _, l := range logbooks {
+		catalog.Logbooks[l.LogDate.Format("2006-01-02")] = l
+	}
+	for _, a := range airports {
+		catalog.Airports[strings.ToUpper(a.IATACode)] = a
+	}
+	for _, m := range mappings {
+		catalog.AddMapping(m)
+	}
+
+	return catalog, nil
+}
+
+// ResolveImportRow validates the row's values and resolves route, aircraft and daily logbook.
+// A missing daily logbook is created in memory and added to the catalog so later rows of the
+// same day share it; the returned logbook is non-nil only when it is new.
+func (s *LogbookImportService) ResolveImportRow(catalog *domain.LogbookImportCatalog, employeeID string, row domain.LogbookImportRow) (*domain.DailyLogbookDetail, *domain.DailyLogbook, error) {
+	required := []struct{ field, value string }{
+		{"flight_number", row.FlightNumber},
+		{"flight_real_date", row.FlightRealDate},
+		{"route_code", row.RouteCode},
+		{"license_plate", row.LicensePlate},
+		{"out_time", row.OutTime},
+		{"takeoff_time", row.TakeoffTime},
+		{"landing_time", row.LandingTime},
+		{"in_time", row.InTime},
+		{"pilot_role", row.PilotRole},
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			return nil, nil, &domain.ImportFieldError{Field: r.field, Err: domain.ErrImportMissingField}
+		}
+	}
+
+	logDate, err := time.Parse("2006-01-02", row.FlightRealDate)
+	if err != nil {
+		return nil, nil, &domain.ImportFieldError{Field: "flight_real_date", Err: domain.ErrImportInvalidField}
+	}
+	if !domain.IsValidPilotRole(row.PilotRole) {
+		return nil, nil, &domain.ImportFieldError{Field: "pilot_role", Err: domain.ErrImportInvalidField}
+	}
+	if row.PilotFunction != nil && !domain.IsValidPilotFunction(*row.PilotFunction) {
+		return nil, nil, &domain.ImportFieldError{Field: "pilot_function", Err: domain.ErrImportInvalidField}
+	}
+	if row.ApproachType != nil && !domain.IsValidApproachType(*row.ApproachType) {
+		return nil, nil, &domain.ImportFieldError{Field: "approach_type", Err: domain.ErrImportInvalidField}
+	}
+	if !domain.IsValidTimeReference(row.TimeReference) {
+		return nil, nil, domain.ErrFlightInvalidTimeRef
+	}
+	var passengers *int
+	if row.Passengers != nil {
+		n, err := strconv.Atoi(*row.Passengers)
+		if err != nil || n < 0 {
+			return nil, nil, &domain.ImportFieldError{Field: "passengers", Err: domain.ErrImportInvalidField}
+		}
+		passengers = &n
+	}
+	if row.DutyTime != nil {
+		if _, err := domain.ParseFlightDuration(*row.DutyTime); err != nil {
+			return nil, nil, &domain.ImportFieldError{Field: "duty_time", Err: domain.ErrImportInvalidField}
+		}
+	}
+	if row.IFRTime != nil {
+		if _, err := domain.ParseFlightDuration(*row.IFRTime); err != nil {
+			return nil, nil, &domain.ImportFieldError{Field: "ifr_time", Err: domain.ErrImportInvalidField}
+		}
+	}
+
+	route, err := catalog.ResolveRoute(row.RouteCode, row.AirlineCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	registration, err := catalog.ResolveRegistration(row.LicensePlate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created *domain.DailyLogbook
+	logbook, ok := catalog.Logbooks[row.FlightRealDate]
+	if !ok {
+		logbook = domain.NewImportLogbook(employeeID, logDate)
+		catalog.Logbooks[row.FlightRealDate] = logbook
+		created = &logbook
+	}
+
+	var approachType *domain.ApproachType
+	if row.ApproachType != nil {
+		at := domain.ApproachType(*row.ApproachType)
+		approachType = &at
+	}
+	var pilotFunction *domain.PilotFunction
+	if row.PilotFunction != nil {
+		pf := domain.PilotFunction(*row.PilotFunction)
+		pilotFunction = &pf
+	}
+
+	detail := &domain.DailyLogbookDetail{
+		DailyLogbookID:               logbook.ID,
+		FlightRealDate:               row.FlightRealDate,
+		FlightNumber:                 strings.TrimSpace(row.FlightNumber),
+		AirlineRouteID:               route.ID,
+		ActualAircraftRegistrationID: registration.ID,
+		Passengers:                   passengers,
+		OutTime:                      row.OutTime,
+		TakeoffTime:                  row.TakeoffTime,
+		LandingTime:                  row.LandingTime,
+		InTime:                       row.InTime,
+		TimeReference:                domain.TimeReference(row.TimeReference),
+		PilotRole:                    domain.PilotRole(row.PilotRole),
+		PilotFunction:                pilotFunction,
+		CompanionName:                row.CompanionName,
+		DutyTime:                     row.DutyTime,
+		IFRTime:                      row.IFRTime,
+		ApproachType:                 approachType,
+		FlightType:                   row.FlightType,
+		WetLease:                     row.WetLease,
+		EmployeeLogbookID:            &employeeID,
+		OriginIataCode:               route.OriginIataCode,
+		DestinationIataCode:          route.DestinationIataCode,
+		LicensePlate:                 registration.LicensePlate,
+	}
+	detail.SetID()
+
+	return detail, created, nil
+}
+
+// ResolveElogbookRow resolves a row translated from a third-party format. The route code is built from the
+// origin and destination airports when the format has none; airports, aircraft and routes that neither the
+// catalog nor the employee's mappings resolve are returned as an UnresolvedReferenceError.
+func (s *LogbookImportService) ResolveElogbookRow(catalog *domain.LogbookImportCatalog, employeeID string, row domain.LogbookImportRow) (*domain.DailyLogbookDetail, *domain.DailyLogbook, error) {
+	if strings.TrimSpace(row.RouteCode) == "" {
+		if strings.TrimSpace(row.Origin) == "" {
+			return nil, nil, &domain.ImportFieldError{Field: "origin", Err: domain.ErrImportMissingField}
+		}
+		if strings.TrimSpace(row.Destination) == "" {
+			return nil, nil, &domain.ImportFieldError{Field: "destination", Err: domain.ErrImportMissingField}
+		}
+		origin, err := catalog.ResolveAirport(row.Origin)
+		if err != nil {
+			return nil, nil, err
+		}
+		destination, err := catalog.ResolveAirport(row.Destination)
+		if err != nil {
+			return nil, nil, err
+		}
+		row.RouteCode = strings.ToUpper(origin.IATACode + "-" + destination.IATACode)
+	}
+
+	detail, created, err := s.ResolveImportRow(catalog, employeeID, row)
+	switch err {
+	case domain.ErrFlightInvalidRoute:
+		return nil, nil, &domain.UnresolvedReferenceError{Kind: domain.ImportMappingRoute, Value: domain.NormalizeMappingSource(row.RouteCode)}
+	case domain.ErrFlightInvalidAircraft:
+		return nil, nil, &domain.UnresolvedReferenceError{Kind: domain.ImportMappingAircraft, Value: domain.NormalizeMappingSource(row.LicensePlate)}
+	}
+	return detail, created, err
+}
+
+// ListImportMappings returns the mapping decisions remembered for the employee
+func (s *LogbookImportService) ListImportMappings(ctx context.Context, employeeID string) ([]domain.ImportMapping, error) {
+	mappings, err := s.mappingRepo.ListImportMappingsByEmployee(ctx, employeeID)
+	if err != nil {
+		s.logger.Error(logger.LogImportMappingError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// DeleteImportMapping forgets one of the employee's mapping decisions
+func (s *LogbookImportService) DeleteImportMapping(ctx context.Context, id, employeeID string) error {
+	tx, err := s.mappingRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.mappingRepo.DeleteImportMapping(ctx, tx, id, employeeID); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogImportMappingError, "mapping_id", id, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// SaveImport creates the new daily logbooks and the details, with their history entries, and remembers the
+// mapping decisions in one transaction; nothing is saved on error
+func (s *LogbookImportService) SaveImport(ctx context.Context, logbooks []domain.DailyLogbook, details []domain.DailyLogbookDetail, mappings []domain.ImportMapping) error {
+	tx, err := s.detailRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	for _, l := range logbooks {
+		err := s.logbookRepo.SaveDailyLogbook(ctx, tx, l)
+		if err == nil {
+			err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookHistory(ctx, nil, &l))
+		}
+		if err != nil {
+			tx.Rollback()
+			s.logger.Error(logger.LogLogbookImportError, "logbook_id", l.ID, "error", err)
+			return err
+		}
+	}
+
+	for _, d := range details {
+		err := s.detailRepo.SaveDailyLogbookDetail(ctx, tx, d)
+		if err == nil {
+			err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookDetailHistory(ctx, nil, &d))
+		}
+		if err != nil {
+			tx.Rollback()
+			s.logger.Error(logger.LogLogbookImportError, "detail_id", d.ID, "error", err)
+			return err
+		}
+	}
+
+	for _, m := range mappings {
+		if err := s.mappingRepo.SaveImportMapping(ctx, tx, m); err != nil {
+			tx.Rollback()
+			s.logger.Error(logger.LogImportMappingError, "kind", m.Kind, "source", m.SourceValue, "error", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+
+	return nil
+}
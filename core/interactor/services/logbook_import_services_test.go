@@ -0,0 +1,185 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+func importCatalog() *domain.LogbookImportCatalog {
+	return &domain.LogbookImportCatalog{
+		Routes: map[string][]domain.AirlineRoute{
+			"BOG-CLO": {{ID: "ar-1", RouteCode: "BOG-CLO", AirlineCode: "AV"}},
+			"BOG-MDE": {
+				{ID: "ar-2", RouteCode: "BOG-MDE", AirlineCode: "AV"},
+				{ID: "ar-3", RouteCode: "BOG-MDE", AirlineCode: "LA"},
+			},
+		},
+		Registrations: map[string]domain.AircraftRegistration{
+			"HK-5000": {ID: "reg-1", LicensePlate: "HK-5000"},
+		},
+		Logbooks: map[string]domain.DailyLogbook{
+			"2024-03-10": {ID: "lb-1", LogDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), EmployeeID: "emp-1"},
+		},
+	}
+}
+
+func importRow(date, route string) domain.LogbookImportRow {
+	return domain.LogbookImportRow{
+		Line:           2,
+		FlightNumber:   "AV9340",
+		FlightRealDate: date,
+		RouteCode:      route,
+		LicensePlate:   "hk-5000",
+		OutTime:        "08:00",
+		TakeoffTime:    "08:15",
+		LandingTime:    "09:20",
+		InTime:         "09:30",
+		PilotRole:      "PF",
+	}
+}
+
+func TestLogbookImportService_ResolveImportRow(t *testing.T) {
+	svc := NewLogbookImportService(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("resolves row into existing logbook", func(t *testing.T) {
+		detail, created, err := svc.ResolveImportRow(importCatalog(), "emp-1", importRow("2024-03-10", "bog-clo"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created != nil {
+			t.Fatal("expected no new logbook")
+		}
+		if detail.DailyLogbookID != "lb-1" || detail.AirlineRouteID != "ar-1" || detail.ActualAircraftRegistrationID != "reg-1" {
+			t.Errorf("unexpected references %s %s %s", detail.DailyLogbookID, detail.AirlineRouteID, detail.ActualAircraftRegistrationID)
+		}
+	})
+
+	t.Run("rows of a new day share one new logbook", func(t *testing.T) {
+		catalog := importCatalog()
+		first, created, err := svc.ResolveImportRow(catalog, "emp-1", importRow("2024-03-11", "BOG-CLO"))
+		if err != nil || created == nil {
+			t.Fatalf("expected a new logbook, got %v %v", created, err)
+		}
+		second, again, err := svc.ResolveImportRow(catalog, "emp-1", importRow("2024-03-11", "BOG-CLO"))
+		if err != nil || again != nil {
+			t.Fatalf("expected the logbook to be reused, got %v %v", again, err)
+		}
+		if first.DailyLogbookID != created.ID || second.DailyLogbookID != created.ID {
+			t.Error("expected both rows in the new logbook")
+		}
+	})
+
+	t.Run("rejects missing field", func(t *testing.T) {
+		row := importRow("2024-03-10", "BOG-CLO")
+		row.InTime = ""
+		_, _, err := svc.ResolveImportRow(importCatalog(), "emp-1", row)
+		var fieldErr *domain.ImportFieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Field != "in_time" || !errors.Is(err, domain.ErrImportMissingField) {
+			t.Fatalf("expected missing in_time, got %v", err)
+		}
+	})
+
+	t.Run("rejects invalid passengers", func(t *testing.T) {
+		row := importRow("2024-03-10", "BOG-CLO")
+		passengers := "many"
+		row.Passengers = &passengers
+		_, _, err := svc.ResolveImportRow(importCatalog(), "emp-1", row)
+		if !errors.Is(err, domain.ErrImportInvalidField) {
+			t.Fatalf("expected %v, got %v", domain.ErrImportInvalidField, err)
+		}
+	})
+
+	t.Run("ambiguous route needs airline code", func(t *testing.T) {
+		row := importRow("2024-03-10", "BOG-MDE")
+		if _, _, err := svc.ResolveImportRow(importCatalog(), "emp-1", row); !errors.Is(err, domain.ErrImportRouteAmbiguous) {
+			t.Fatalf("expected %v, got %v", domain.ErrImportRouteAmbiguous, err)
+		}
+		row.AirlineCode = "la"
+		detail, _, err := svc.ResolveImportRow(importCatalog(), "emp-1", row)
+		if err != nil || detail.AirlineRouteID != "ar-3" {
+			t.Fatalf("expected route ar-3, got %v", err)
+		}
+	})
+
+	t.Run("rejects unknown registration", func(t *testing.T) {
+		row := importRow("2024-03-10", "BOG-CLO")
+		row.LicensePlate = "HK-0000"
+		if _, _, err := svc.ResolveImportRow(importCatalog(), "emp-1", row); !errors.Is(err, domain.ErrFlightInvalidAircraft) {
+			t.Fatalf("expected %v, got %v", domain.ErrFlightInvalidAircraft, err)
+		}
+	})
+}
+
+func TestLogbookImportService_ResolveElogbookRow(t *testing.T) {
+	svc := NewLogbookImportService(nil, nil, nil, nil, nil, nil, nil, nil)
+
+	elogbookCatalog := func() *domain.LogbookImportCatalog {
+		catalog := importCatalog()
+		catalog.Airports = map[string]domain.Airport{
+			"BOG": {ID: "apt-bog", IATACode: "BOG"},
+			"CLO": {ID: "apt-clo", IATACode: "CLO"},
+			"MDE": {ID: "apt-mde", IATACode: "MDE"},
+		}
+		catalog.Routes["BOG-CLO"][0].OriginIataCode = "BOG"
+		catalog.Routes["BOG-CLO"][0].DestinationIataCode = "CLO"
+		return catalog
+	}
+	elogbookRow := func(origin, destination string) domain.LogbookImportRow {
+		row := importRow("2024-03-10", "")
+		row.Origin, row.Destination = origin, destination
+		return row
+	}
+
+	t.Run("builds route from airports", func(t *testing.T) {
+		detail, _, err := svc.ResolveElogbookRow(elogbookCatalog(), "emp-1", elogbookRow("bog", "CLO"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if detail.AirlineRouteID != "ar-1" || detail.OriginIataCode != "BOG" {
+			t.Errorf("unexpected route %s from %s", detail.AirlineRouteID, detail.OriginIataCode)
+		}
+	})
+
+	t.Run("reports unknown airport for mapping", func(t *testing.T) {
+		_, _, err := svc.ResolveElogbookRow(elogbookCatalog(), "emp-1", elogbookRow("SKBO", "CLO"))
+		var refErr *domain.UnresolvedReferenceError
+		if !errors.As(err, &refErr) || refErr.Kind != domain.ImportMappingAirport || refErr.Value != "SKBO" {
+			t.Fatalf("expected unresolved airport SKBO, got %v", err)
+		}
+	})
+
+	t.Run("applies airport mapping", func(t *testing.T) {
+		catalog := elogbookCatalog()
+		catalog.AddMapping(domain.ImportMapping{Kind: domain.ImportMappingAirport, SourceValue: " skbo", TargetID: "apt-bog"})
+		if _, _, err := svc.ResolveElogbookRow(catalog, "emp-1", elogbookRow("SKBO", "CLO")); err != nil {
+			t.Fatalf("expected mapped airport, got %v", err)
+		}
+	})
+
+	t.Run("reports route and aircraft for mapping", func(t *testing.T) {
+		_, _, err := svc.ResolveElogbookRow(elogbookCatalog(), "emp-1", elogbookRow("CLO", "MDE"))
+		var refErr *domain.UnresolvedReferenceError
+		if !errors.As(err, &refErr) || refErr.Kind != domain.ImportMappingRoute || refErr.Value != "CLO-MDE" {
+			t.Fatalf("expected unresolved route CLO-MDE, got %v", err)
+		}
+
+		row := elogbookRow("BOG", "CLO")
+		row.LicensePlate = "N123AB"
+		_, _, err = svc.ResolveElogbookRow(elogbookCatalog(), "emp-1", row)
+		if !errors.As(err, &refErr) || refErr.Kind != domain.ImportMappingAircraft {
+			t.Fatalf("expected unresolved aircraft, got %v", err)
+		}
+	})
+
+	t.Run("applies route mapping", func(t *testing.T) {
+		catalog := elogbookCatalog()
+		catalog.AddMapping(domain.ImportMapping{Kind: domain.ImportMappingRoute, SourceValue: "CLO-MDE", TargetID: "ar-2"})
+		detail, _, err := svc.ResolveElogbookRow(catalog, "emp-1", elogbookRow("CLO", "MDE"))
+		if err != nil || detail.AirlineRouteID != "ar-2" {
+			t.Fatalf("expected mapped route ar-2, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// LogbookPurgeService permanently removes the daily logbooks and segments that were soft deleted longer
+// ago than the retention period; until then they can be restored
+type LogbookPurgeService struct {
+	logbookRepo output.DailyLogbookRepository
+	detailRepo  output.DailyLogbookDetailRepository
+	retention   time.Duration
+	interval    time.Duration
+	stopPurge   chan struct{}
+	logger      logger.Logger
+}
+
+// NewLogbookPurgeService creates a new logbook purge service; a zero interval disables the periodic job
+func NewLogbookPurgeService(logbookRepo output.DailyLogbookRepository, detailRepo output.DailyLogbookDetailRepository,
+	retention, interval time.Duration, log logger.Logger) *LogbookPurgeService {
+	return &LogbookPurgeService{
+		logbookRepo: logbookRepo,
+		detailRepo:  detailRepo,
+		retention:   retention,
+		interval:    interval,
+		stopPurge:   make(chan struct{}),
+		logger:      log,
+	}
+}
+
+// PurgeDeleted hard deletes, in one transaction, the segments and then the logbooks deleted before
+// now minus the retention period. Returns how many of each were removed.
+func (s *LogbookPurgeService) PurgeDeleted(ctx context.Context, now time.Time) (int64, int64, error) {
+	cutoff := now.Add(-s.retention)
+
+	tx, err := s.logbookRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return 0, 0, err
+	}
+
+	details, err := s.detailRepo.PurgeDeletedDailyLogbookDetails(ctx, tx, cutoff)
+	var logbooks int64
+	if err == nil {
+		logbooks, err = s.logbookRepo.PurgeDeletedDailyLogbooks(ctx, tx, cutoff)
+	}
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogLogbookPurgeError, "error", err)
+		return 0, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return 0, 0, err
+	}
+
+	s.logger.Info(logger.LogLogbookPurgeOK, "deleted_before", cutoff.Format(time.RFC3339), "details", details, "logbooks", logbooks)
+	return details, logbooks, nil
+}
+
+// StartPurgeJob purges once and then on every interval until StopPurgeJob is called
+func (s *LogbookPurgeService) StartPurgeJob(ctx context.Context) {
+	if s.interval <= 0 {
+		s.logger.Info(logger.LogLogbookPurgeDisabled)
+		return
+	}
+
+	s.logger.Info(logger.LogLogbookPurgeStart, "interval", s.interval.String(), "retention", s.retention.String())
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			// Errors are logged by PurgeDeleted; the next run retries
+			s.PurgeDeleted(ctx, time.Now().UTC())
+
+			select {
+			case <-ticker.C:
+			case <-s.stopPurge:
+				s.logger.Info(logger.LogLogbookPurgeStop)
+				return
+			}
+		}
+	}()
+}
+
+// StopPurgeJob stops the periodic purge
+func (s *LogbookPurgeService) StopPurgeJob() {
+	if s.interval > 0 {
+		close(s.stopPurge)
+	}
+}
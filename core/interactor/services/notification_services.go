@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// NotificationService reads and acknowledges the in-app notifications raised for employees
+type NotificationService struct {
+	repo   output.NotificationRepository
+	logger logger.Logger
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(repo output.NotificationRepository, log logger.Logger) *NotificationService {
+	return &NotificationService{
+		repo:   repo,
+		logger: log,
+	}
+}
+
+// GetNotification retrieves a notification by its ID
+func (s *NotificationService) GetNotification(ctx context.Context, id string) (*domain.Notification, error) {
+	return s.repo.GetNotificationByID(ctx, id)
+}
+
+// ListNotifications retrieves an employee's notifications, latest first
+func (s *NotificationService) ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error) {
+	return s.repo.ListNotifications(ctx, filter)
+}
+
+// MarkNotificationRead records when a notification was read
+func (s *NotificationService) MarkNotificationRead(ctx context.Context, id string, readAt time.Time) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.MarkNotificationRead(ctx, tx, id, readAt); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogNotificationError, "notification_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
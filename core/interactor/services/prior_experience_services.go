@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// PriorExperienceService stores the opening balances employees bring from before their logged segments
+type PriorExperienceService struct {
+	repo   output.PriorExperienceRepository
+	logger logger.Logger
+}
+
+// NewPriorExperienceService creates a new prior experience service
+func NewPriorExperienceService(repo output.PriorExperienceRepository, log logger.Logger) *PriorExperienceService {
+	return &PriorExperienceService{
+		repo:   repo,
+		logger: log,
+	}
+}
+
+// GetPriorExperience retrieves an opening balance by its ID
+func (s *PriorExperienceService) GetPriorExperience(ctx context.Context, id string) (*domain.PriorExperience, error) {
+	return s.repo.GetPriorExperienceByID(ctx, id)
+}
+
+// ListPriorExperience retrieves an employee's opening balances, oldest first
+func (s *PriorExperienceService) ListPriorExperience(ctx context.Context, employeeID string) ([]domain.PriorExperience, error) {
+	return s.repo.ListPriorExperienceByEmployee(ctx, employeeID)
+}
+
+// CreatePriorExperience saves a new opening balance
+func (s *PriorExperienceService) CreatePriorExperience(ctx context.Context, prior domain.PriorExperience) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SavePriorExperience(ctx, tx, prior); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogPriorExperienceError, "prior_experience_id", prior.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdatePriorExperience stores the editable fields of an opening balance
+func (s *PriorExperienceService) UpdatePriorExperience(ctx context.Context, prior domain.PriorExperience) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.UpdatePriorExperience(ctx, tx, prior); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogPriorExperienceError, "prior_experience_id", prior.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeletePriorExperience removes an opening balance
+func (s *PriorExperienceService) DeletePriorExperience(ctx context.Context, id string) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.DeletePriorExperience(ctx, tx, id); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogPriorExperienceError, "prior_experience_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
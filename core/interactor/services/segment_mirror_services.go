@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// SegmentMirrorService stores the offers of mirrored entries to companion pilots and keeps the two
+// linked entries in sync
+type SegmentMirrorService struct {
+	mirrorRepo   output.SegmentMirrorRepository
+	logbookRepo  output.DailyLogbookRepository
+	detailRepo   output.DailyLogbookDetailRepository
+	employeeRepo output.Repository
+	historyRepo  output.LogbookHistoryRepository
+	logger       logger.Logger
+}
+
+// NewSegmentMirrorService creates a new segment mirror service
+func NewSegmentMirrorService(mirrorRepo output.SegmentMirrorRepository, logbookRepo output.DailyLogbookRepository,
+	detailRepo output.DailyLogbookDetailRepository, employeeRepo output.Repository, historyRepo output.LogbookHistoryRepository,
+	log logger.Logger) *SegmentMirrorService {
+	return &SegmentMirrorService{
+		mirrorRepo:   mirrorRepo,
+		logbookRepo:  logbookRepo,
+		detailRepo:   detailRepo,
+		employeeRepo: employeeRepo,
+		historyRepo:  historyRepo,
+		logger:       log,
+	}
+}
+
+// GetSegmentMirror retrieves a segment mirror by its ID
+func (s *SegmentMirrorService) GetSegmentMirror(ctx context.Context, id string) (*domain.SegmentMirror, error) {
+	return s.mirrorRepo.GetSegmentMirrorByID(ctx, id)
+}
+
+// GetOpenSegmentMirror retrieves the pending or active link of a segment (nil if it has none)
+func (s *SegmentMirrorService) GetOpenSegmentMirror(ctx context.Context, detailID string) (*domain.SegmentMirror, error) {
+	return s.mirrorRepo.GetOpenSegmentMirrorByDetail(ctx, detailID)
+}
+
+// ListSegmentMirrors retrieves the links the employee takes part in, as pilot or companion
+func (s *SegmentMirrorService) ListSegmentMirrors(ctx context.Context, employeeID string) ([]domain.SegmentMirror, error) {
+	return s.mirrorRepo.ListSegmentMirrorsByEmployee(ctx, employeeID)
+}
+
+// ValidateCompanion checks that the companion is an existing employee other than the pilot
+func (s *SegmentMirrorService) ValidateCompanion(ctx context.Context, employeeID, companionID string) error {
+	if companionID == "" || companionID == employeeID {
+		return domain.ErrSegmentMirrorInvalidCompanion
+	}
+	companion, err := s.employeeRepo.GetEmployeeByID(ctx, companionID)
+	if err != nil || companion == nil {
+		return domain.ErrSegmentMirrorInvalidCompanion
+	}
+	return nil
+}
+
+// FindDailyLogbookByDate returns the employee's daily logbook of a day (nil if there is none)
+func (s *SegmentMirrorService) FindDailyLogbookByDate(ctx context.Context, employeeID string, logDate time.Time) (*domain.DailyLogbook, error) {
+	logbooks, err := s.logbookRepo.ListDailyLogbooksByEmployee(ctx, employeeID, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	day := logDate.Format("2006-01-02")
+	for _, l := range logbooks {
+		if l.LogDate.Format("2006-01-02") == day {
+			return &l, nil
+		}
+	}
+	return nil, nil
+}
+
+// OfferSegmentMirror saves a pending offer of a mirrored entry
+func (s *SegmentMirrorService) OfferSegmentMirror(ctx context.Context, mirror domain.SegmentMirror) error {
+	tx, err := s.mirrorRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.mirrorRepo.SaveSegmentMirror(ctx, tx, mirror); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSegmentMirrorError, "mirror_id", mirror.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateSegmentMirror stores a new status of a link without touching the entries (decline, withdraw, unlink)
+func (s *SegmentMirrorService) UpdateSegmentMirror(ctx context.Context, mirror domain.SegmentMirror) error {
+	return s.SyncSegmentMirror(ctx, mirror, nil)
+}
+
+// AcceptSegmentMirror saves the companion's entry, with its daily logbook when the companion had none
+// for the day, and links it in one transaction
+func (s *SegmentMirrorService) AcceptSegmentMirror(ctx context.Context, mirror domain.SegmentMirror, newLogbook *domain.DailyLogbook, detail domain.DailyLogbookDetail) error {
+	tx, err := s.mirrorRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if newLogbook != nil {
+		err = s.logbookRepo.SaveDailyLogbook(ctx, tx, *newLogbook)
+		if err == nil {
+			err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookHistory(ctx, nil, newLogbook))
+		}
+	}
+	if err == nil {
+		err = s.detailRepo.SaveDailyLogbookDetail(ctx, tx, detail)
+	}
+	if err == nil {
+		err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookDetailHistory(ctx, nil, &detail))
+	}
+	if err == nil {
+		err = s.mirrorRepo.UpdateSegmentMirror(ctx, tx, mirror)
+	}
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSegmentMirrorError, "mirror_id", mirror.ID, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+	return nil
+}
+
+// SyncSegmentMirror stores the new status of a link together with the entry changed to follow the
+// other one, if any, in one transaction
+func (s *SegmentMirrorService) SyncSegmentMirror(ctx context.Context, mirror domain.SegmentMirror, changed *domain.DailyLogbookDetail) error {
+	var before *domain.DailyLogbookDetail
+	if changed != nil {
+		current, err := s.detailRepo.GetDailyLogbookDetailByID(ctx, changed.ID)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return domain.ErrFlightNotFound
+		}
+		before = current
+	}
+
+	tx, err := s.mirrorRepo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if changed != nil {
+		err = s.detailRepo.UpdateDailyLogbookDetail(ctx, tx, *changed)
+		if err == nil {
+			err = recordHistory(ctx, s.historyRepo, tx, domain.NewDailyLogbookDetailHistory(ctx, before, changed))
+		}
+	}
+	if err == nil {
+		err = s.mirrorRepo.UpdateSegmentMirror(ctx, tx, mirror)
+	}
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSegmentMirrorError, "mirror_id", mirror.ID, "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		s.logger.Error(logger.LogDBTransactionCommitErr, "error", err)
+		return err
+	}
+	return nil
+}
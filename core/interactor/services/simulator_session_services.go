@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// SimulatorSessionService stores the sessions flown in flight simulation training devices
+type SimulatorSessionService struct {
+	repo      output.SimulatorSessionRepository
+	modelRepo output.AircraftModelRepository
+	logger    logger.Logger
+}
+
+// NewSimulatorSessionService creates a new simulator session service
+func NewSimulatorSessionService(repo output.SimulatorSessionRepository, modelRepo output.AircraftModelRepository, log logger.Logger) *SimulatorSessionService {
+	return &SimulatorSessionService{
+		repo:      repo,
+		modelRepo: modelRepo,
+		logger:    log,
+	}
+}
+
+// GetSimulatorSession retrieves a simulator session by its ID
+func (s *SimulatorSessionService) GetSimulatorSession(ctx context.Context, id string) (*domain.SimulatorSession, error) {
+	return s.repo.GetSimulatorSessionByID(ctx, id)
+}
+
+// ListSimulatorSessions retrieves an employee's sessions for the filter, latest first
+func (s *SimulatorSessionService) ListSimulatorSessions(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorSession, error) {
+	return s.repo.ListSimulatorSessions(ctx, filter)
+}
+
+// GetSimulatorTotals adds up an employee's session time per device type for the filter
+func (s *SimulatorSessionService) GetSimulatorTotals(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorTotals, error) {
+	return s.repo.GetSimulatorTotals(ctx, filter)
+}
+
+// ValidateSimulatorSession checks the session and that the emulated aircraft model exists,
+// normalizing the duration to HH:MM
+func (s *SimulatorSessionService) ValidateSimulatorSession(ctx context.Context, session *domain.SimulatorSession) error {
+	if err := session.Validate(); err != nil {
+		return err
+	}
+	model, err := s.modelRepo.GetAircraftModelByID(ctx, session.AircraftModelID)
+	if err != nil || model == nil {
+		return domain.ErrSimulatorSessionInvalidModel
+	}
+	session.ModelName = model.ModelName
+	session.AircraftFamily = model.Family
+	return nil
+}
+
+// CreateSimulatorSession saves a new simulator session
+func (s *SimulatorSessionService) CreateSimulatorSession(ctx context.Context, session domain.SimulatorSession) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SaveSimulatorSession(ctx, tx, session); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSimulatorSessionError, "session_id", session.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateSimulatorSession stores the editable fields of a simulator session
+func (s *SimulatorSessionService) UpdateSimulatorSession(ctx context.Context, session domain.SimulatorSession) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.UpdateSimulatorSession(ctx, tx, session); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSimulatorSessionError, "session_id", session.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteSimulatorSession removes a simulator session
+func (s *SimulatorSessionService) DeleteSimulatorSession(ctx context.Context, id string) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.DeleteSimulatorSession(ctx, tx, id); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogSimulatorSessionError, "session_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
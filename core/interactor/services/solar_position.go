@@ -0,0 +1,125 @@
+package services
+
+import (
+	"math"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// CivilTwilightElevation is the solar elevation (degrees) below which it is night for logbook purposes:
+// night is the period between the end of evening civil twilight and the beginning of morning civil twilight
+const CivilTwilightElevation = -6.0
+
+// nightSampleStep is the resolution used when integrating night time along a segment
+const nightSampleStep = time.Minute
+
+// SolarElevation returns the elevation of the sun's centre in degrees above the horizon
+// for a position (decimal degrees, north/east positive) at the given instant.
+// Uses the low-precision almanac formulas (accurate to ~0.01 degrees), enough for twilight boundaries.
+func SolarElevation(t time.Time, latitude, longitude float64) float64 {
+	// Days since J2000.0
+	n := float64(t.UTC().UnixNano())/float64(24*time.Hour) + 2440587.5 - 2451545.0
+
+	meanLongitude := normalizeDegrees(280.460 + 0.9856474*n)
+	meanAnomaly := radians(normalizeDegrees(357.528 + 0.9856003*n))
+	eclipticLongitude := radians(meanLongitude + 1.915*math.Sin(meanAnomaly) + 0.020*math.Sin(2*meanAnomaly))
+	obliquity := radians(23.439 - 0.0000004*n)
+
+	rightAscension := math.Atan2(math.Cos(obliquity)*math.Sin(eclipticLongitude), math.Cos(eclipticLongitude))
+	declination := math.Asin(math.Sin(obliquity) * math.Sin(eclipticLongitude))
+
+	siderealTime := radians(normalizeDegrees(280.46061837 + 360.98564736629*n + longitude))
+	hourAngle := siderealTime - rightAscension
+
+	lat := radians(latitude)
+	elevation := math.Asin(math.Sin(lat)*math.Sin(declination) + math.Cos(lat)*math.Cos(declination)*math.Cos(hourAngle))
+	return degrees(elevation)
+}
+
+// IsNight reports whether the sun is below civil twilight at the given position and instant
+func IsNight(t time.Time, latitude, longitude float64) bool {
+	return SolarElevation(t, latitude, longitude) < CivilTwilightElevation
+}
+
+// GreatCirclePosition returns the point at the given fraction (0..1) of the great-circle track
+// between two positions, all in decimal degrees
+func GreatCirclePosition(lat1, lon1, lat2, lon2, fraction float64) (float64, float64) {
+	phi1, lambda1 := radians(lat1), radians(lon1)
+	phi2, lambda2 := radians(lat2), radians(lon2)
+
+	// Angular distance (haversine)
+	delta := 2 * math.Asin(math.Sqrt(math.Pow(math.Sin((phi2-phi1)/2), 2)+
+		math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin((lambda2-lambda1)/2), 2)))
+	if delta == 0 {
+		return lat1, lon1
+	}
+
+	a := math.Sin((1-fraction)*delta) / math.Sin(delta)
+	b := math.Sin(fraction*delta) / math.Sin(delta)
+	x := a*math.Cos(phi1)*math.Cos(lambda1) + b*math.Cos(phi2)*math.Cos(lambda2)
+	y := a*math.Cos(phi1)*math.Sin(lambda1) + b*math.Cos(phi2)*math.Sin(lambda2)
+	z := a*math.Sin(phi1) + b*math.Sin(phi2)
+
+	return degrees(math.Atan2(z, math.Sqrt(x*x+y*y))), degrees(math.Atan2(y, x))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+func normalizeDegrees(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// NightFlight summarizes the night portion of a segment
+type NightFlight struct {
+	NightTime    time.Duration
+	NightTakeoff bool
+	NightLanding bool
+}
+
+// CalculateNightFlight integrates the time spent in night conditions between OUT and IN.
+// While taxiing the aircraft is assumed to be at the origin/destination airport; between OFF and ON
+// its position is interpolated along the great-circle track in proportion to elapsed air time.
+// Takeoff and landing are classified by the sun elevation at the departure and arrival airports.
+func CalculateNightFlight(times *domain.SegmentTimes, originLat, originLon, destinationLat, destinationLon float64) NightFlight {
+	airTime := times.AirTime()
+	position := func(t time.Time) (float64, float64) {
+		switch {
+		case !t.After(times.Takeoff) || airTime <= 0:
+			return originLat, originLon
+		case !t.Before(times.Landing):
+			return destinationLat, destinationLon
+		default:
+			fraction := float64(t.Sub(times.Takeoff)) / float64(airTime)
+			return GreatCirclePosition(originLat, originLon, destinationLat, destinationLon, fraction)
+		}
+	}
+
+	var night time.Duration
+	for t := times.Out; t.Before(times.In); t = t.Add(nightSampleStep) {
+		step := nightSampleStep
+		if remaining := times.In.Sub(t); remaining < step {
+			step = remaining
+		}
+		lat, lon := position(t.Add(step / 2))
+		if IsNight(t.Add(step/2), lat, lon) {
+			night += step
+		}
+	}
+
+	return NightFlight{
+		NightTime:    night,
+		NightTakeoff: IsNight(times.Takeoff, originLat, originLon),
+		NightLanding: IsNight(times.Landing, destinationLat, destinationLon),
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+func TestSolarElevation(t *testing.T) {
+	// Bogotá (El Dorado)
+	lat, lon := 4.70, -74.15
+
+	noon := time.Date(2024, 3, 10, 17, 10, 0, 0, time.UTC) // ~12:10 local
+	if elev := SolarElevation(noon, lat, lon); elev < 75 {
+		t.Fatalf("expected sun high at local noon, got %.2f", elev)
+	}
+
+	midnight := time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC) // 00:00 local
+	if !IsNight(midnight, lat, lon) {
+		t.Fatalf("expected night at local midnight, elevation %.2f", SolarElevation(midnight, lat, lon))
+	}
+}
+
+func TestGreatCirclePosition(t *testing.T) {
+	lat, lon := GreatCirclePosition(0, 0, 0, 90, 0.5)
+	if math.Abs(lat) > 1e-9 || math.Abs(lon-45) > 1e-9 {
+		t.Fatalf("expected (0, 45), got (%.6f, %.6f)", lat, lon)
+	}
+}
+
+func TestCalculateNightFlight(t *testing.T) {
+	// BOG -> MDE
+	bogLat, bogLon := 4.70, -74.15
+	mdeLat, mdeLon := 6.16, -75.42
+
+	t.Run("day segment has no night time", func(t *testing.T) {
+		times, _ := domain.ResolveSegmentTimes("2024-03-10", "15:00", "15:15", "15:55", "16:05")
+		night := CalculateNightFlight(times, bogLat, bogLon, mdeLat, mdeLon)
+		if night.NightTime != 0 || night.NightTakeoff || night.NightLanding {
+			t.Fatalf("expected day segment, got %+v", night)
+		}
+	})
+
+	t.Run("night segment is entirely night", func(t *testing.T) {
+		times, _ := domain.ResolveSegmentTimes("2024-03-10", "04:00", "04:15", "04:55", "05:05")
+		night := CalculateNightFlight(times, bogLat, bogLon, mdeLat, mdeLon)
+		if night.NightTime != times.BlockTime() || !night.NightTakeoff || !night.NightLanding {
+			t.Fatalf("expected full night segment, got %+v", night)
+		}
+	})
+
+	t.Run("dusk segment is partially night", func(t *testing.T) {
+		// Civil twilight ends around 23:30Z in Bogotá in March
+		times, _ := domain.ResolveSegmentTimes("2024-03-10", "23:00", "23:15", "00:00", "00:10")
+		night := CalculateNightFlight(times, bogLat, bogLon, mdeLat, mdeLon)
+		if night.NightTime <= 0 || night.NightTime >= times.BlockTime() {
+			t.Fatalf("expected partial night time, got %v of %v", night.NightTime, times.BlockTime())
+		}
+		if night.NightTakeoff || !night.NightLanding {
+			t.Fatalf("expected day takeoff and night landing, got %+v", night)
+		}
+	})
+}
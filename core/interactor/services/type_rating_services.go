@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// TypeRatingService stores the type ratings that qualify an employee to log segments as pilot flying and
+// checks them against the aircraft family of each segment
+type TypeRatingService struct {
+	repo             output.TypeRatingRepository
+	registrationRepo output.AircraftRegistrationRepository
+	modelRepo        output.AircraftModelRepository
+	logger           logger.Logger
+}
+
+// NewTypeRatingService creates a new type rating service
+func NewTypeRatingService(repo output.TypeRatingRepository, registrationRepo output.AircraftRegistrationRepository,
+	modelRepo output.AircraftModelRepository, log logger.Logger) *TypeRatingService {
+	return &TypeRatingService{
+		repo:             repo,
+		registrationRepo: registrationRepo,
+		modelRepo:        modelRepo,
+		logger:           log,
+	}
+}
+
+// GetTypeRating retrieves a type rating by its ID
+func (s *TypeRatingService) GetTypeRating(ctx context.Context, id string) (*domain.TypeRating, error) {
+	return s.repo.GetTypeRatingByID(ctx, id)
+}
+
+// ListTypeRatings retrieves an employee's type ratings ordered by family and issue date
+func (s *TypeRatingService) ListTypeRatings(ctx context.Context, employeeID string) ([]domain.TypeRating, error) {
+	return s.repo.ListTypeRatingsByEmployee(ctx, employeeID)
+}
+
+// CheckQualification checks that an employee logging the segment as pilot flying (PF, PFTO or PFL) holds
+// a type rating on the aircraft's family valid on the flight date. Depending on the flight type a
+// missing rating is ignored, returned as a warning or rejected with TypeRatingRequiredError.
+func (s *TypeRatingService) CheckQualification(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail) (*domain.ValidationWarning, error) {
+	if !detail.PilotRole.IsPilotFlying() {
+		return nil, nil
+	}
+	requirement := domain.TypeRatingRequirementFor(detail.FlightType)
+	if requirement == domain.TypeRatingNotRequired {
+		return nil, nil
+	}
+
+	day, err := domain.ParseFlightDate(detail.FlightRealDate)
+	if err != nil {
+		return nil, domain.ErrFlightInvalidTimeSequence
+	}
+
+	registration, err := s.registrationRepo.GetAircraftRegistrationByID(ctx, detail.ActualAircraftRegistrationID)
+	if err != nil || registration == nil {
+		return nil, domain.ErrFlightInvalidAircraft
+	}
+	model, err := s.modelRepo.GetAircraftModelByID(ctx, registration.AircraftModelID)
+	if err != nil || model == nil {
+		return nil, domain.ErrFlightInvalidAircraft
+	}
+
+	ratings, err := s.repo.ListTypeRatingsByEmployee(ctx, employeeID)
+	if err != nil {
+		s.logger.Error(logger.LogTypeRatingError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	if domain.HasValidTypeRating(ratings, model.Family, day) {
+		return nil, nil
+	}
+
+	if requirement == domain.TypeRatingEnforced {
+		return nil, &domain.TypeRatingRequiredError{AircraftFamily: model.Family}
+	}
+	return &domain.ValidationWarning{Code: domain.MsgTypeRatingMissing, Params: []string{model.Family}}, nil
+}
+
+// CreateTypeRating saves a new type rating
+func (s *TypeRatingService) CreateTypeRating(ctx context.Context, rating domain.TypeRating) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.SaveTypeRating(ctx, tx, rating); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogTypeRatingError, "type_rating_id", rating.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateTypeRating stores the editable fields of a type rating
+func (s *TypeRatingService) UpdateTypeRating(ctx context.Context, rating domain.TypeRating) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.UpdateTypeRating(ctx, tx, rating); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogTypeRatingError, "type_rating_id", rating.ID, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteTypeRating removes a type rating
+func (s *TypeRatingService) DeleteTypeRating(ctx context.Context, id string) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		s.logger.Error(logger.LogDBTransactionBeginErr, "error", err)
+		return err
+	}
+
+	if err := s.repo.DeleteTypeRating(ctx, tx, id); err != nil {
+		tx.Rollback()
+		s.logger.Error(logger.LogTypeRatingError, "type_rating_id", id, "error", err)
+		return err
+	}
+
+	return tx.Commit()
+}
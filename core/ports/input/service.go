@@ -2,6 +2,7 @@ package input
 
 import (
 	"context"
+	"time"
 
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/champion19/flighthours-api/core/interactor/dto"
@@ -108,13 +109,107 @@ type DailyLogbookService interface {
 	// DailyLogbook - queries
 	GetDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error)
 	ListDailyLogbooksByEmployee(ctx context.Context, employeeID string, filters map[string]interface{}) ([]domain.DailyLogbook, error)
+	GetDeletedDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error)
+	ListDeletedDailyLogbooks(ctx context.Context, employeeID string) ([]domain.DailyLogbook, error)
 
 	// DailyLogbook - operations
 	CreateDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error
 	UpdateDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error
-	DeleteDailyLogbook(ctx context.Context, id string) error
+	DeleteDailyLogbook(ctx context.Context, id string) error // Soft delete, restorable until purged
+	RestoreDailyLogbook(ctx context.Context, id string) error
 	ActivateDailyLogbook(ctx context.Context, id string) error
 	DeactivateDailyLogbook(ctx context.Context, id string) error
+	UpdateDailyLogbookState(ctx context.Context, logbook domain.DailyLogbook) error
+}
+
+// LogbookAmendmentService defines the interface for corrections of signed daily logbooks
+type LogbookAmendmentService interface {
+	GetAmendment(ctx context.Context, id string) (*domain.LogbookAmendment, error)
+	ListAmendments(ctx context.Context, logbookID string) ([]domain.LogbookAmendment, error)
+	RequestAmendment(ctx context.Context, amendment domain.LogbookAmendment) error
+	ApplyAmendment(ctx context.Context, amendment domain.LogbookAmendment, logbook domain.DailyLogbook, chain []domain.DailyLogbookDetail) error
+	RejectAmendment(ctx context.Context, amendment domain.LogbookAmendment) error
+}
+
+// SegmentMirrorService defines the offers of mirrored entries to companion pilots and the sync of linked entries
+type SegmentMirrorService interface {
+	GetSegmentMirror(ctx context.Context, id string) (*domain.SegmentMirror, error)
+	GetOpenSegmentMirror(ctx context.Context, detailID string) (*domain.SegmentMirror, error)
+	ListSegmentMirrors(ctx context.Context, employeeID string) ([]domain.SegmentMirror, error)
+	ValidateCompanion(ctx context.Context, employeeID, companionID string) error
+	FindDailyLogbookByDate(ctx context.Context, employeeID string, logDate time.Time) (*domain.DailyLogbook, error)
+	OfferSegmentMirror(ctx context.Context, mirror domain.SegmentMirror) error
+	UpdateSegmentMirror(ctx context.Context, mirror domain.SegmentMirror) error
+	AcceptSegmentMirror(ctx context.Context, mirror domain.SegmentMirror, newLogbook *domain.DailyLogbook, detail domain.DailyLogbookDetail) error
+	SyncSegmentMirror(ctx context.Context, mirror domain.SegmentMirror, changed *domain.DailyLogbookDetail) error
+}
+
+// SimulatorSessionService defines the interface for the sessions flown in flight simulation training devices
+type SimulatorSessionService interface {
+	GetSimulatorSession(ctx context.Context, id string) (*domain.SimulatorSession, error)
+	ListSimulatorSessions(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorSession, error)
+	GetSimulatorTotals(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorTotals, error)
+	ValidateSimulatorSession(ctx context.Context, session *domain.SimulatorSession) error
+	CreateSimulatorSession(ctx context.Context, session domain.SimulatorSession) error
+	UpdateSimulatorSession(ctx context.Context, session domain.SimulatorSession) error
+	DeleteSimulatorSession(ctx context.Context, id string) error
+}
+
+// PriorExperienceService defines the interface for the opening balances employees bring from before their
+// logged segments
+type PriorExperienceService interface {
+	GetPriorExperience(ctx context.Context, id string) (*domain.PriorExperience, error)
+	ListPriorExperience(ctx context.Context, employeeID string) ([]domain.PriorExperience, error)
+	CreatePriorExperience(ctx context.Context, prior domain.PriorExperience) error
+	UpdatePriorExperience(ctx context.Context, prior domain.PriorExperience) error
+	DeletePriorExperience(ctx context.Context, id string) error
+}
+
+// DutyPeriodService defines the interface for the duty periods that group an employee's segments
+type DutyPeriodService interface {
+	GetDutyPeriod(ctx context.Context, id string) (*domain.DutyPeriod, error)
+	ListDutyPeriods(ctx context.Context, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error)
+	HasOverlappingDutyPeriod(ctx context.Context, period domain.DutyPeriod) (bool, error)
+	ListSegmentsByID(ctx context.Context, segmentIDs []string) ([]domain.DutyPeriodSegment, error)
+	CreateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error
+	UpdateDutyPeriod(ctx context.Context, period domain.DutyPeriod) error
+	DeleteDutyPeriod(ctx context.Context, id string) error
+}
+
+// TypeRatingService defines the interface for the type ratings that qualify an employee to log segments
+// as pilot flying
+type TypeRatingService interface {
+	GetTypeRating(ctx context.Context, id string) (*domain.TypeRating, error)
+	ListTypeRatings(ctx context.Context, employeeID string) ([]domain.TypeRating, error)
+	CreateTypeRating(ctx context.Context, rating domain.TypeRating) error
+	UpdateTypeRating(ctx context.Context, rating domain.TypeRating) error
+	DeleteTypeRating(ctx context.Context, id string) error
+	// CheckQualification checks the employee's type rating on the segment's aircraft family when they fly
+	// it; returns a warning when a missing rating is only flagged, and TypeRatingRequiredError when it
+	// rejects the segment
+	CheckQualification(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail) (*domain.ValidationWarning, error)
+}
+
+// CredentialService defines the interface for employees' licences, medical certificates, language
+// proficiency and recurrent training, and the notices raised before they expire
+type CredentialService interface {
+	GetCredential(ctx context.Context, id string) (*domain.Credential, error)
+	ListCredentials(ctx context.Context, employeeID string) ([]domain.Credential, error)
+	CreateCredential(ctx context.Context, credential domain.Credential) error
+	UpdateCredential(ctx context.Context, credential domain.Credential) error
+	DeleteCredential(ctx context.Context, id string) error
+	SaveCredentialScan(ctx context.Context, id string, scan domain.CredentialScan) error
+	GetCredentialScan(ctx context.Context, id string) (*domain.CredentialScan, error)
+	GetExpiryReport(ctx context.Context, airlineID string, asOf time.Time, withinDays int) (*domain.CredentialExpiryReport, error)
+	// WarningDays is how many days before expiry a credential is reported as EXPIRING
+	WarningDays() int
+}
+
+// NotificationService defines the interface for employees' in-app notifications
+type NotificationService interface {
+	GetNotification(ctx context.Context, id string) (*domain.Notification, error)
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error)
+	MarkNotificationRead(ctx context.Context, id string, readAt time.Time) error
 }
 
 // AircraftRegistrationService defines the interface for aircraft registration business operations
@@ -166,15 +261,77 @@ type DailyLogbookDetailService interface {
 
 	// DailyLogbookDetail - queries
 	GetDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error)
+	GetDeletedDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error)
 	ListDailyLogbookDetailsByLogbook(ctx context.Context, logbookID string) ([]domain.DailyLogbookDetail, error)
+	GetFlightTotals(ctx context.Context, filter domain.FlightTotalsFilter) (*domain.FlightTotalsReport, error)
+	GetLogbookExport(ctx context.Context, filter domain.LogbookExportFilter) (*domain.LogbookExport, error)
 
 	// DailyLogbookDetail - operations
 	CreateDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error
 	UpdateDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error
-	DeleteDailyLogbookDetail(ctx context.Context, id string) error
+	DeleteDailyLogbookDetail(ctx context.Context, id string) error // Soft delete, restorable until purged
+	RestoreDailyLogbookDetail(ctx context.Context, detail domain.DailyLogbookDetail) error
 
 	// DailyLogbookDetail - validations
-	ValidateTimeSequence(outTime, takeoffTime, landingTime, inTime string) error
+	// CheckSegmentReferences enforces active route and airline, aircraft of the route's airline and flight date vs log date
+	CheckSegmentReferences(ctx context.Context, detail domain.DailyLogbookDetail, logbook domain.DailyLogbook) error
+	// NormalizeSegmentTimes converts times entered in airport local time to UTC
+	NormalizeSegmentTimes(ctx context.Context, detail *domain.DailyLogbookDetail) error
+	// ValidateTimeSequence anchors times to the flight date and allows rollover past midnight
+	ValidateTimeSequence(flightDate, outTime, takeoffTime, landingTime, inTime string) error
+	// CalculateFlightTimes derives air_time and block_time from OUT/OFF/ON/IN
+	CalculateFlightTimes(detail *domain.DailyLogbookDetail) error
+	// CalculateNightTime derives night_time and day/night takeoff and landing counts
+	CalculateNightTime(ctx context.Context, detail *domain.DailyLogbookDetail) error
+	// CheckSegmentConflicts rejects duplicates and time overlaps with the employee's other segments, stored
+	// or in the batch not yet saved
+	CheckSegmentConflicts(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) error
+	// CheckRouteContinuity warns when the segment does not connect with the previous or next leg of the day,
+	// stored or in the batch not yet saved
+	CheckRouteContinuity(ctx context.Context, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.ValidationWarning, error)
+	// ValidateLogbook builds the consistency report of a daily logbook
+	ValidateLogbook(ctx context.Context, logbook domain.DailyLogbook) (*domain.LogbookValidationReport, error)
+}
+
+// FTLService defines the interface for flight time limitation checks
+type FTLService interface {
+	GetFTLStatus(ctx context.Context, employeeID string, asOf time.Time) (*domain.FTLStatus, error)
+	// CheckSegment evaluates the segment with the stored history and the batch of segments saved with it
+	CheckSegment(ctx context.Context, employeeID string, detail domain.DailyLogbookDetail, batch []domain.DailyLogbookDetail) ([]domain.FTLUsage, error)
+}
+
+// LogbookImportService resolves and saves bulk-imported flight segments
+type LogbookImportService interface {
+	LoadImportCatalog(ctx context.Context, employeeID string) (*domain.LogbookImportCatalog, error)
+	ResolveImportRow(catalog *domain.LogbookImportCatalog, employeeID string, row domain.LogbookImportRow) (*domain.DailyLogbookDetail, *domain.DailyLogbook, error)
+	ResolveElogbookRow(catalog *domain.LogbookImportCatalog, employeeID string, row domain.LogbookImportRow) (*domain.DailyLogbookDetail, *domain.DailyLogbook, error)
+	SaveImport(ctx context.Context, logbooks []domain.DailyLogbook, details []domain.DailyLogbookDetail, mappings []domain.ImportMapping) error
+	ListImportMappings(ctx context.Context, employeeID string) ([]domain.ImportMapping, error)
+	DeleteImportMapping(ctx context.Context, id, employeeID string) error
+}
+
+// CurrencyService evaluates pilot recency (currency) rules per aircraft family
+type CurrencyService interface {
+	GetCurrencyStatus(ctx context.Context, employeeID string, asOf time.Time) (*domain.CurrencyStatus, error)
+}
+
+// LogbookChainService keeps the tamper-evident hash chain over an employee's signed segments
+type LogbookChainService interface {
+	VerifyChain(ctx context.Context, employeeID string) (*domain.LogbookChainReport, error)
+	SealDailyLogbook(ctx context.Context, logbook domain.DailyLogbook) error
+	ChainAfterAmendment(ctx context.Context, employeeID, detailID string, proposed *domain.DailyLogbookDetail) ([]domain.DailyLogbookDetail, error)
+}
+
+// LogbookHistoryService reads the change history of daily logbooks and their segments
+type LogbookHistoryService interface {
+	ListLogbookHistory(ctx context.Context, logbookID string) ([]domain.LogbookHistoryEntry, error)
+	ListDetailHistory(ctx context.Context, detailID string) ([]domain.LogbookHistoryEntry, error)
+}
+
+// FlightAnomalyService flags block/air times that deviate from the route's estimated flight time
+type FlightAnomalyService interface {
+	AnnotateAnomalies(details []domain.DailyLogbookDetail)
+	GetAnomalyReport(ctx context.Context, employeeID string, from, to time.Time) (*domain.FlightAnomalyReport, error)
 }
 
 // EngineService defines the interface for engine business operations
@@ -2,6 +2,7 @@ package output
 
 import (
 	"context"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 )
@@ -81,12 +82,17 @@ type DailyLogbookRepository interface {
 	// DailyLogbook operations - read
 	GetDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error)
 	ListDailyLogbooksByEmployee(ctx context.Context, employeeID string, filters map[string]interface{}) ([]domain.DailyLogbook, error)
+	GetDeletedDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error)
+	ListDeletedDailyLogbooksByEmployee(ctx context.Context, employeeID string) ([]domain.DailyLogbook, error)
 
 	// DailyLogbook operations - transactional
 	SaveDailyLogbook(ctx context.Context, tx Tx, logbook domain.DailyLogbook) error
 	UpdateDailyLogbook(ctx context.Context, tx Tx, logbook domain.DailyLogbook) error
-	DeleteDailyLogbook(ctx context.Context, tx Tx, id string) error
+	DeleteDailyLogbook(ctx context.Context, tx Tx, id string, deletedAt time.Time) error // Soft delete, segments included
+	RestoreDailyLogbook(ctx context.Context, tx Tx, id string) error
+	PurgeDeletedDailyLogbooks(ctx context.Context, tx Tx, deletedBefore time.Time) (int64, error)
 	UpdateDailyLogbookStatus(ctx context.Context, tx Tx, id string, status bool) error
+	UpdateDailyLogbookState(ctx context.Context, tx Tx, logbook domain.DailyLogbook) error
 }
 
 // AircraftRegistrationRepository defines the interface for aircraft registration data persistence
@@ -137,12 +143,26 @@ type DailyLogbookDetailRepository interface {
 
 	// DailyLogbookDetail operations - read
 	GetDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error)
+	GetDeletedDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error)
 	ListDailyLogbookDetailsByLogbook(ctx context.Context, logbookID string) ([]domain.DailyLogbookDetail, error)
+	GetRouteAirports(ctx context.Context, airlineRouteID string) (origin *domain.Airport, destination *domain.Airport, err error)
+	GetSegmentReferences(ctx context.Context, airlineRouteID, aircraftRegistrationID string) (*domain.SegmentReferences, error)
+	GetFlightTotals(ctx context.Context, filter domain.FlightTotalsFilter) ([]domain.FlightTotals, error)
+	GetDailyFlightTimes(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyFlightTime, error)
+	GetCurrencyEvents(ctx context.Context, employeeID string, from, to time.Time) ([]domain.CurrencyEvent, error)
+	ListDailyLogbookDetailsByEmployee(ctx context.Context, employeeID string, from, to time.Time) ([]domain.DailyLogbookDetail, error)
+	ListDailyLogbookDetailsBetween(ctx context.Context, employeeID string, first, last domain.LogbookPosition) ([]domain.DailyLogbookDetail, error)
+	GetLogbookTotalsBefore(ctx context.Context, employeeID string, before domain.LogbookPosition) (domain.LogbookTotals, error)
+	ListConflictCandidates(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyLogbookDetail, error)
+	ListChainEntries(ctx context.Context, employeeID string) ([]domain.DailyLogbookDetail, error)
 
 	// DailyLogbookDetail operations - transactional
 	SaveDailyLogbookDetail(ctx context.Context, tx Tx, detail domain.DailyLogbookDetail) error
 	UpdateDailyLogbookDetail(ctx context.Context, tx Tx, detail domain.DailyLogbookDetail) error
-	DeleteDailyLogbookDetail(ctx context.Context, tx Tx, id string) error
+	DeleteDailyLogbookDetail(ctx context.Context, tx Tx, id string, deletedAt time.Time) error // Soft delete
+	RestoreDailyLogbookDetail(ctx context.Context, tx Tx, id string) error
+	PurgeDeletedDailyLogbookDetails(ctx context.Context, tx Tx, deletedBefore time.Time) (int64, error)
+	UpdateChainHash(ctx context.Context, tx Tx, detailID, hash string) error
 }
 
 // EngineRepository defines the interface for engine type data persistence
@@ -152,6 +172,162 @@ type EngineRepository interface {
 	ListEngines(ctx context.Context) ([]domain.Engine, error)
 }
 
+// ImportMappingRepository defines the interface for the per-employee import mapping decisions
+type ImportMappingRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// ImportMapping operations - read
+	ListImportMappingsByEmployee(ctx context.Context, employeeID string) ([]domain.ImportMapping, error)
+
+	// ImportMapping operations - transactional
+	SaveImportMapping(ctx context.Context, tx Tx, mapping domain.ImportMapping) error
+	DeleteImportMapping(ctx context.Context, tx Tx, id, employeeID string) error
+}
+
+// LogbookHistoryRepository defines the interface for the change history of daily logbooks and segments
+type LogbookHistoryRepository interface {
+	// LogbookHistory operations - read
+	ListHistoryByLogbook(ctx context.Context, logbookID string) ([]domain.LogbookHistoryEntry, error)
+	ListHistoryByEntity(ctx context.Context, entityType domain.HistoryEntityType, entityID string) ([]domain.LogbookHistoryEntry, error)
+
+	// LogbookHistory operations - transactional (written in the transaction of the change)
+	SaveHistoryEntry(ctx context.Context, tx Tx, entry domain.LogbookHistoryEntry) error
+}
+
+// LogbookAmendmentRepository defines the interface for the amendments of signed daily logbooks
+type LogbookAmendmentRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// LogbookAmendment operations - read
+	GetAmendmentByID(ctx context.Context, id string) (*domain.LogbookAmendment, error)
+	ListAmendmentsByLogbook(ctx context.Context, logbookID string) ([]domain.LogbookAmendment, error)
+
+	// LogbookAmendment operations - transactional
+	SaveAmendment(ctx context.Context, tx Tx, amendment domain.LogbookAmendment) error
+	ResolveAmendment(ctx context.Context, tx Tx, amendment domain.LogbookAmendment) error
+}
+
+// SegmentMirrorRepository defines the interface for the links between a segment and the companion's mirrored entry
+type SegmentMirrorRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// SegmentMirror operations - read
+	GetSegmentMirrorByID(ctx context.Context, id string) (*domain.SegmentMirror, error)
+	GetOpenSegmentMirrorByDetail(ctx context.Context, detailID string) (*domain.SegmentMirror, error)
+	ListSegmentMirrorsByEmployee(ctx context.Context, employeeID string) ([]domain.SegmentMirror, error)
+
+	// SegmentMirror operations - transactional
+	SaveSegmentMirror(ctx context.Context, tx Tx, mirror domain.SegmentMirror) error
+	UpdateSegmentMirror(ctx context.Context, tx Tx, mirror domain.SegmentMirror) error
+}
+
+// SimulatorSessionRepository defines the interface for the sessions flown in flight simulation training devices
+type SimulatorSessionRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// SimulatorSession operations - read
+	GetSimulatorSessionByID(ctx context.Context, id string) (*domain.SimulatorSession, error)
+	ListSimulatorSessions(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorSession, error)
+	GetSimulatorTotals(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorTotals, error)
+
+	// SimulatorSession operations - transactional
+	SaveSimulatorSession(ctx context.Context, tx Tx, session domain.SimulatorSession) error
+	UpdateSimulatorSession(ctx context.Context, tx Tx, session domain.SimulatorSession) error
+	DeleteSimulatorSession(ctx context.Context, tx Tx, id string) error
+}
+
+// PriorExperienceRepository defines the interface for the opening balances employees bring from before
+// their logged segments
+type PriorExperienceRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// PriorExperience operations - read
+	GetPriorExperienceByID(ctx context.Context, id string) (*domain.PriorExperience, error)
+	ListPriorExperienceByEmployee(ctx context.Context, employeeID string) ([]domain.PriorExperience, error)
+
+	// PriorExperience operations - transactional
+	SavePriorExperience(ctx context.Context, tx Tx, prior domain.PriorExperience) error
+	UpdatePriorExperience(ctx context.Context, tx Tx, prior domain.PriorExperience) error
+	DeletePriorExperience(ctx context.Context, tx Tx, id string) error
+}
+
+// DutyPeriodRepository defines the interface for the duty periods that group an employee's segments
+type DutyPeriodRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// DutyPeriod operations - read
+	GetDutyPeriodByID(ctx context.Context, id string) (*domain.DutyPeriod, error)
+	ListDutyPeriods(ctx context.Context, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error)
+	// GetPreviousDutyPeriod returns the employee's last duty period reported before the instant, nil when there is none
+	GetPreviousDutyPeriod(ctx context.Context, employeeID string, before time.Time) (*domain.DutyPeriod, error)
+	// HasOverlappingDutyPeriod reports whether another duty period of the employee, other than excludeID
+	// (may be empty), overlaps the report-to-release span
+	HasOverlappingDutyPeriod(ctx context.Context, employeeID string, report, release time.Time, excludeID string) (bool, error)
+
+	// Linked segments - read
+	ListSegmentsByID(ctx context.Context, segmentIDs []string) ([]domain.DutyPeriodSegment, error)
+	ListDutyPeriodSegments(ctx context.Context, dutyPeriodIDs []string) ([]domain.DutyPeriodSegment, error)
+
+	// DutyPeriod operations - transactional
+	SaveDutyPeriod(ctx context.Context, tx Tx, period domain.DutyPeriod) error
+	UpdateDutyPeriod(ctx context.Context, tx Tx, period domain.DutyPeriod) error
+	DeleteDutyPeriod(ctx context.Context, tx Tx, id string) error
+	// LinkDutyPeriodSegments replaces the segments linked to the duty period
+	LinkDutyPeriodSegments(ctx context.Context, tx Tx, dutyPeriodID string, segmentIDs []string) error
+}
+
+// TypeRatingRepository defines the interface for the type ratings that qualify an employee to log
+// segments as pilot flying
+type TypeRatingRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// TypeRating operations - read
+	GetTypeRatingByID(ctx context.Context, id string) (*domain.TypeRating, error)
+	ListTypeRatingsByEmployee(ctx context.Context, employeeID string) ([]domain.TypeRating, error)
+
+	// TypeRating operations - transactional
+	SaveTypeRating(ctx context.Context, tx Tx, rating domain.TypeRating) error
+	UpdateTypeRating(ctx context.Context, tx Tx, rating domain.TypeRating) error
+	DeleteTypeRating(ctx context.Context, tx Tx, id string) error
+}
+
+// CredentialRepository defines the interface for employees' licences, medical certificates, language
+// proficiency and recurrent training
+type CredentialRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// Credential operations - read
+	GetCredentialByID(ctx context.Context, id string) (*domain.Credential, error)
+	ListCredentialsByEmployee(ctx context.Context, employeeID string) ([]domain.Credential, error)
+	// ListCredentialsExpiringBetween returns every employee's credentials with an expiry date in [from, to]
+	ListCredentialsExpiringBetween(ctx context.Context, from, to time.Time) ([]domain.Credential, error)
+	// ListAirlineCredentialsExpiringBy returns the credentials of the airline's employees with an expiry date
+	// up to the date, already expired ones included, with the employee name
+	ListAirlineCredentialsExpiringBy(ctx context.Context, airlineID string, until time.Time) ([]domain.Credential, error)
+	// GetCredentialScan returns the attached scan with its content, ErrCredentialScanNotFound when there is none
+	GetCredentialScan(ctx context.Context, id string) (*domain.CredentialScan, error)
+
+	// Credential operations - transactional
+	SaveCredential(ctx context.Context, tx Tx, credential domain.Credential) error
+	UpdateCredential(ctx context.Context, tx Tx, credential domain.Credential) error
+	DeleteCredential(ctx context.Context, tx Tx, id string) error
+	SaveCredentialScan(ctx context.Context, tx Tx, id string, scan domain.CredentialScan) error
+}
+
+// NotificationRepository defines the interface for employees' in-app notifications
+type NotificationRepository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// Notification operations - read
+	GetNotificationByID(ctx context.Context, id string) (*domain.Notification, error)
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error)
+
+	// Notification operations - transactional
+	// SaveNotification stores the notification unless one with its dedup key exists; reports whether it was stored
+	SaveNotification(ctx context.Context, tx Tx, notification domain.Notification) (bool, error)
+	MarkNotificationRead(ctx context.Context, tx Tx, id string, readAt time.Time) error
+}
+
 // ManufacturerRepository defines the interface for manufacturer data persistence
 type ManufacturerRepository interface {
 	// Manufacturer operations - read only (catalog table)
@@ -10,6 +10,9 @@ type AircraftModelResponse struct {
 	EngineTypeName   string `json:"engine_type_name,omitempty"`
 	Family           string `json:"family"`
 	Manufacturer     string `json:"manufacturer,omitempty"`
+	MultiEngine      bool   `json:"multi_engine"`
+	Turbine          bool   `json:"turbine"`
+	MultiPilot       bool   `json:"multi_pilot"`
 	Links            []Link `json:"_links,omitempty"`
 }
 
@@ -22,6 +25,9 @@ func FromDomainAircraftModel(model *domain.AircraftModel, encodedID string) Airc
 		EngineTypeName:   model.EngineTypeName,
 		Family:           model.Family,
 		Manufacturer:     model.Manufacturer,
+		MultiEngine:      model.MultiEngine,
+		Turbine:          model.Turbine,
+		MultiPilot:       model.MultiPilot,
 	}
 }
 
@@ -53,6 +59,9 @@ func ToAircraftModelListResponse(models []domain.AircraftModel, encodeFunc func(
 			EngineTypeName:   model.EngineTypeName,
 			Family:           model.Family,
 			Manufacturer:     model.Manufacturer,
+			MultiEngine:      model.MultiEngine,
+			Turbine:          model.Turbine,
+			MultiPilot:       model.MultiPilot,
 		}
 		// Add HATEOAS links to each model
 		if baseURL != "" {
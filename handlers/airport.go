@@ -4,14 +4,17 @@ import domain "github.com/champion19/flighthours-api/core/interactor/services/do
 
 // AirportResponse - Response DTO for airport data
 type AirportResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	City        string `json:"city,omitempty"`
-	Country     string `json:"country,omitempty"`
-	IATACode    string `json:"iata_code,omitempty"`
-	Status      string `json:"status"`
-	AirportType string `json:"airport_type,omitempty"`
-	Links       []Link `json:"_links,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	City        string   `json:"city,omitempty"`
+	Country     string   `json:"country,omitempty"`
+	IATACode    string   `json:"iata_code,omitempty"`
+	Status      string   `json:"status"`
+	AirportType string   `json:"airport_type,omitempty"`
+	TimeZone    string   `json:"time_zone,omitempty"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+	Links       []Link   `json:"_links,omitempty"`
 }
 
 // FromDomainAirport converts domain.Airport to AirportResponse with encoded ID
@@ -28,6 +31,9 @@ func FromDomainAirport(airport *domain.Airport, encodedID string) AirportRespons
 		IATACode:    airport.IATACode,
 		Status:      status,
 		AirportType: airport.AirportType,
+		TimeZone:    airport.TimeZone,
+		Latitude:    airport.Latitude,
+		Longitude:   airport.Longitude,
 	}
 }
 
@@ -72,6 +78,9 @@ func ToAirportListResponse(airports []domain.Airport, encodeFunc func(string) (s
 			IATACode:    airport.IATACode,
 			Status:      status,
 			AirportType: airport.AirportType,
+			TimeZone:    airport.TimeZone,
+			Latitude:    airport.Latitude,
+			Longitude:   airport.Longitude,
 		}
 		// Add HATEOAS links to each airport
 		if baseURL != "" {
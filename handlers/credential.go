@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// CredentialRequest represents the request body for recording or updating a credential; the scan is
+// uploaded separately
+type CredentialRequest struct {
+	Type             string  `json:"type" binding:"required"`  // LICENCE, MEDICAL, LANGUAGE_PROFICIENCY or RECURRENT_TRAINING
+	Title            string  `json:"title" binding:"required"` // e.g. ATPL(A), Class 1, ICAO English level 4, CRM
+	Number           *string `json:"number,omitempty"`
+	IssuingAuthority *string `json:"issuing_authority,omitempty"`
+	IssueDate        string  `json:"issue_date" binding:"required"` // YYYY-MM-DD
+	ExpiryDate       *string `json:"expiry_date,omitempty"`         // YYYY-MM-DD, omitted when the credential does not expire
+	Remarks          *string `json:"remarks,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields
+func (r *CredentialRequest) Sanitize() {
+	r.Type = TrimString(r.Type)
+	r.Title = TrimString(r.Title)
+	r.Number = TrimStringPtr(r.Number)
+	r.IssuingAuthority = TrimStringPtr(r.IssuingAuthority)
+	r.IssueDate = TrimString(r.IssueDate)
+	r.ExpiryDate = TrimStringPtr(r.ExpiryDate)
+	r.Remarks = TrimStringPtr(r.Remarks)
+}
+
+// ToDomain converts the request to a domain credential of the employee
+func (r *CredentialRequest) ToDomain(employeeID string) domain.Credential {
+	return domain.Credential{
+		EmployeeID:       employeeID,
+		Type:             domain.CredentialType(r.Type),
+		Title:            r.Title,
+		Number:           r.Number,
+		IssuingAuthority: r.IssuingAuthority,
+		IssueDate:        r.IssueDate,
+		ExpiryDate:       r.ExpiryDate,
+		Remarks:          r.Remarks,
+	}
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// CredentialScanResponse describes the scan attached to a credential; the content is downloaded from
+// /credentials/{id}/scan
+type CredentialScanResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	UploadedAt  string `json:"uploaded_at"`
+}
+
+// CredentialResponse represents a credential with its validity today (UTC)
+type CredentialResponse struct {
+	ID               string                  `json:"id"`
+	Type             string                  `json:"type"`
+	Title            string                  `json:"title"`
+	Number           *string                 `json:"number,omitempty"`
+	IssuingAuthority *string                 `json:"issuing_authority,omitempty"`
+	IssueDate        string                  `json:"issue_date"`
+	ExpiryDate       *string                 `json:"expiry_date,omitempty"`
+	Status           string                  `json:"status"`                   // VALID, EXPIRING or EXPIRED
+	DaysToExpiry     *int                    `json:"days_to_expiry,omitempty"` // Negative once expired, omitted when it does not expire
+	Scan             *CredentialScanResponse `json:"scan,omitempty"`
+	Remarks          *string                 `json:"remarks,omitempty"`
+	CreatedAt        string                  `json:"created_at"`
+	UpdatedAt        string                  `json:"updated_at"`
+}
+
+// CredentialExpiryResponse represents a credential on the airline expiry report
+type CredentialExpiryResponse struct {
+	EmployeeID   string `json:"employee_id"`
+	EmployeeName string `json:"employee_name"`
+	CredentialResponse
+}
+
+// CredentialExpiryReportResponse represents an airline's credentials expired or expiring within
+// within_days of as_of, soonest expiry first
+type CredentialExpiryReportResponse struct {
+	AirlineID   string                     `json:"airline_id"`
+	AsOf        string                     `json:"as_of"`
+	WithinDays  int                        `json:"within_days"`
+	Credentials []CredentialExpiryResponse `json:"credentials"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toCredentialResponse maps a credential, encoding its ID; the status is computed for the day with the
+// warning days
+func (h *handler) toCredentialResponse(cr *domain.Credential, day time.Time, warningDays int) CredentialResponse {
+	id, _ := h.EncodeID(cr.ID)
+
+	response := CredentialResponse{
+		ID:               id,
+		Type:             string(cr.Type),
+		Title:            cr.Title,
+		Number:           cr.Number,
+		IssuingAuthority: cr.IssuingAuthority,
+		IssueDate:        cr.IssueDate,
+		ExpiryDate:       cr.ExpiryDate,
+		Status:           string(cr.Status(day, warningDays)),
+		Remarks:          cr.Remarks,
+		CreatedAt:        cr.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:        cr.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if days, expires := cr.DaysToExpiry(day); expires {
+		response.DaysToExpiry = &days
+	}
+	if cr.Scan != nil {
+		response.Scan = &CredentialScanResponse{
+			FileName:    cr.Scan.FileName,
+			ContentType: cr.Scan.ContentType,
+			Size:        cr.Scan.Size,
+			UploadedAt:  cr.Scan.UploadedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+// toCredentialExpiryReportResponse maps the airline expiry report, encoding the IDs
+func (h *handler) toCredentialExpiryReportResponse(report *domain.CredentialExpiryReport, warningDays int) CredentialExpiryReportResponse {
+	airlineID, _ := h.EncodeID(report.AirlineID)
+
+	credentials := make([]CredentialExpiryResponse, 0, len(report.Credentials))
+	for i := range report.Credentials {
+		cr := &report.Credentials[i]
+		employeeID, _ := h.EncodeID(cr.EmployeeID)
+		credentials = append(credentials, CredentialExpiryResponse{
+			EmployeeID:         employeeID,
+			EmployeeName:       cr.EmployeeName,
+			CredentialResponse: h.toCredentialResponse(cr, report.AsOf, warningDays),
+		})
+	}
+
+	return CredentialExpiryReportResponse{
+		AirlineID:   airlineID,
+		AsOf:        report.AsOf.Format("2006-01-02"),
+		WithinDays:  report.WithinDays,
+		Credentials: credentials,
+	}
+}
+
+// utcToday returns the current UTC date at midnight
+func utcToday() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
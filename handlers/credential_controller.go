@@ -0,0 +1,465 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/credentials
+// Listar credenciales del empleado
+// ============================================
+
+// GetMyCredentials lists the credentials of the authenticated employee
+// @Summary List my credentials
+// @Description Licences, medical certificates, language proficiency and recurrent training grouped by type, soonest expiry first, with their status today (UTC). A credential is EXPIRING within the earliest notice days of its expiry date.
+// @Tags Credentials
+// @Produce json
+// @Success 200 {object} middleware.APIResponse{data=[]CredentialResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/credentials [get]
+// @Security BearerAuth
+func (h *handler) GetMyCredentials() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogCredentialError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		credentials, err := h.CredentialInteractor.ListCredentials(c.Request.Context(), traceID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, domain.MsgCredentialErr)
+			return
+		}
+
+		today, warningDays := utcToday(), h.CredentialInteractor.WarningDays()
+		response := make([]CredentialResponse, 0, len(credentials))
+		for i := range credentials {
+			response = append(response, h.toCredentialResponse(&credentials[i], today, warningDays))
+		}
+		h.Response.SuccessWithData(c, domain.MsgCredentialListOK, response)
+	}
+}
+
+// ============================================
+// GET /credentials/:id
+// Consultar credencial
+// ============================================
+
+// GetCredential returns a credential of the authenticated employee
+// @Summary Get credential
+// @Tags Credentials
+// @Produce json
+// @Param id path string true "Credential ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=CredentialResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetCredential() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, credentialUUID, ok := h.authorizeCredential(c)
+		if !ok {
+			return
+		}
+
+		credential, err := h.CredentialInteractor.GetCredential(c.Request.Context(), traceID, credentialUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgCredentialGetOK,
+			h.toCredentialResponse(credential, utcToday(), h.CredentialInteractor.WarningDays()))
+	}
+}
+
+// ============================================
+// POST /credentials
+// Registrar credencial
+// ============================================
+
+// CreateCredential records a credential for the authenticated employee
+// @Summary Create credential
+// @Description The scan is attached afterwards with PUT /credentials/{id}/scan. The holder is notified in-app when the expiry date comes within each of the configured notice days.
+// @Tags Credentials
+// @Accept json
+// @Produce json
+// @Param body body CredentialRequest true "Credential"
+// @Success 201 {object} middleware.APIResponse{data=CredentialResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials [post]
+// @Security BearerAuth
+func (h *handler) CreateCredential() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogCredentialError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		credential, ok := h.bindCredentialRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		created, err := h.CredentialInteractor.CreateCredential(c.Request.Context(), traceID, credential)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgCredentialCreated,
+			h.toCredentialResponse(created, utcToday(), h.CredentialInteractor.WarningDays()))
+	}
+}
+
+// ============================================
+// PUT /credentials/:id
+// Actualizar credencial
+// ============================================
+
+// UpdateCredential replaces the values of a credential of the authenticated employee
+// @Summary Update credential
+// @Description The attached scan is kept. Renewing a credential with a new expiry date restarts its expiry notices.
+// @Tags Credentials
+// @Accept json
+// @Produce json
+// @Param id path string true "Credential ID (obfuscated or UUID)"
+// @Param body body CredentialRequest true "Credential"
+// @Success 200 {object} middleware.APIResponse{data=CredentialResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials/{id} [put]
+// @Security BearerAuth
+func (h *handler) UpdateCredential() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, credentialUUID, ok := h.authorizeCredential(c)
+		if !ok {
+			return
+		}
+
+		credential, ok := h.bindCredentialRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		updated, err := h.CredentialInteractor.UpdateCredential(c.Request.Context(), traceID, credentialUUID, employee.ID, credential)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgCredentialUpdated,
+			h.toCredentialResponse(updated, utcToday(), h.CredentialInteractor.WarningDays()))
+	}
+}
+
+// ============================================
+// DELETE /credentials/:id
+// Eliminar credencial
+// ============================================
+
+// DeleteCredential removes a credential of the authenticated employee with its scan
+// @Summary Delete credential
+// @Tags Credentials
+// @Produce json
+// @Param id path string true "Credential ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeleteCredential() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, credentialUUID, ok := h.authorizeCredential(c)
+		if !ok {
+			return
+		}
+
+		if err := h.CredentialInteractor.DeleteCredential(c.Request.Context(), traceID, credentialUUID, employee.ID); err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.Success(c, domain.MsgCredentialDeleted)
+	}
+}
+
+// ============================================
+// PUT /credentials/:id/scan
+// Adjuntar documento escaneado
+// ============================================
+
+// UploadCredentialScan attaches a scan to a credential of the authenticated employee
+// @Summary Upload credential scan
+// @Description Multipart upload of a PDF, JPEG or PNG of up to 10 MB; replaces the previous scan.
+// @Tags Credentials
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Credential ID (obfuscated or UUID)"
+// @Param file formData file true "Scanned document"
+// @Success 200 {object} middleware.APIResponse{data=CredentialResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials/{id}/scan [put]
+// @Security BearerAuth
+func (h *handler) UploadCredentialScan() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, credentialUUID, ok := h.authorizeCredential(c)
+		if !ok {
+			return
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil || file.Size > domain.MaxCredentialScanSize {
+			log.Warn(logger.LogCredentialError, "error", "missing or too large scan")
+			h.Response.Error(c, domain.MsgCredentialInvalidScan)
+			return
+		}
+		f, err := file.Open()
+		if err != nil {
+			log.Warn(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, domain.MsgCredentialInvalidScan)
+			return
+		}
+		defer f.Close()
+
+		content, err := io.ReadAll(io.LimitReader(f, domain.MaxCredentialScanSize+1))
+		if err != nil {
+			log.Warn(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, domain.MsgCredentialInvalidScan)
+			return
+		}
+
+		// The format is sniffed from the content; the client's declared type is not trusted
+		contentType := http.DetectContentType(content)
+		if i := strings.Index(contentType, ";"); i >= 0 {
+			contentType = contentType[:i]
+		}
+		scan := domain.CredentialScan{
+			FileName:    file.Filename,
+			ContentType: contentType,
+			Size:        int64(len(content)),
+			Content:     content,
+		}
+
+		credential, err := h.CredentialInteractor.UploadCredentialScan(c.Request.Context(), traceID, credentialUUID, employee.ID, scan)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgCredentialScanUploaded,
+			h.toCredentialResponse(credential, utcToday(), h.CredentialInteractor.WarningDays()))
+	}
+}
+
+// ============================================
+// GET /credentials/:id/scan
+// Descargar documento escaneado
+// ============================================
+
+// DownloadCredentialScan returns the scan attached to a credential of the authenticated employee
+// @Summary Download credential scan
+// @Tags Credentials
+// @Produce application/pdf,image/jpeg,image/png
+// @Param id path string true "Credential ID (obfuscated or UUID)"
+// @Success 200 {file} file
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /credentials/{id}/scan [get]
+// @Security BearerAuth
+func (h *handler) DownloadCredentialScan() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, credentialUUID, ok := h.authorizeCredential(c)
+		if !ok {
+			return
+		}
+
+		scan, err := h.CredentialInteractor.GetCredentialScan(c.Request.Context(), traceID, credentialUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename=%q`, scan.FileName))
+		c.Data(http.StatusOK, scan.ContentType, scan.Content)
+	}
+}
+
+// ============================================
+// GET /airlines/:id/credential-expiries
+// Reporte de vencimientos de credenciales de la aerolínea
+// ============================================
+
+// GetAirlineCredentialExpiries reports the airline's credentials expired or about to expire
+// @Summary Airline credential expiry report
+// @Description Credentials of the airline's active employees that expired or expire within within_days of as_of, soonest expiry first. Only crew managers of the airline can see it.
+// @Tags Credentials
+// @Produce json
+// @Param id path string true "Airline ID (obfuscated or UUID)"
+// @Param as_of query string false "Report date (YYYY-MM-DD), defaults to today (UTC)"
+// @Param within_days query int false "Days ahead of as_of, defaults to the earliest notice days"
+// @Success 200 {object} middleware.APIResponse{data=CredentialExpiryReportResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /airlines/{id}/credential-expiries [get]
+// @Security BearerAuth
+func (h *handler) GetAirlineCredentialExpiries() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogCredentialError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		airlineUUID, _ := h.resolveID(c.Param("id"))
+		if airlineUUID == "" {
+			log.Warn(logger.LogCredentialError, "error", "invalid airline ID")
+			h.Response.Error(c, domain.MsgValIDInvalid)
+			return
+		}
+
+		asOf, ok := parseDateQuery(c, "as_of")
+		if !ok {
+			log.Warn(logger.LogCredentialError, "error", "invalid as_of date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if asOf == nil {
+			today := utcToday()
+			asOf = &today
+		}
+
+		withinDays := 0
+		if value := strings.TrimSpace(c.Query("within_days")); value != "" {
+			days, err := strconv.Atoi(value)
+			if err != nil || days < 1 {
+				log.Warn(logger.LogCredentialError, "error", "invalid within_days")
+				h.Response.Error(c, domain.MsgValFieldFormat)
+				return
+			}
+			withinDays = days
+		}
+
+		report, err := h.CredentialInteractor.GetExpiryReport(c.Request.Context(), traceID, airlineUUID, employee.Airline, *asOf, withinDays)
+		if err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			h.Response.Error(c, credentialErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgCredentialExpiryReportOK,
+			h.toCredentialExpiryReportResponse(report, h.CredentialInteractor.WarningDays()))
+	}
+}
+
+// bindCredentialRequest binds and sanitizes the request body, writing the error response when it cannot
+func (h *handler) bindCredentialRequest(c *gin.Context, employeeID string) (domain.Credential, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	var req CredentialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(logger.LogCredentialError, "error", err)
+		h.Response.Error(c, domain.MsgValJSONInvalid)
+		return domain.Credential{}, false
+	}
+	req.Sanitize()
+
+	return req.ToDomain(employeeID), true
+}
+
+// authorizeCredential resolves the :id credential of the authenticated employee, writing the error
+// response when it cannot; ownership is checked by the interactor
+func (h *handler) authorizeCredential(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogCredentialError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	credentialUUID, _ := h.resolveID(c.Param("id"))
+	if credentialUUID == "" {
+		log.Warn(logger.LogCredentialError, "error", "invalid credential ID")
+		h.Response.Error(c, domain.MsgCredentialNotFound)
+		return nil, "", false
+	}
+	return employee, credentialUUID, true
+}
+
+// credentialErrorMessage maps a credential error to its message code
+func credentialErrorMessage(err error) string {
+	switch err {
+	case domain.ErrCredentialNotFound:
+		return domain.MsgCredentialNotFound
+	case domain.ErrCredentialUnauthorized:
+		return domain.MsgCredentialUnauthorized
+	case domain.ErrCredentialInvalid:
+		return domain.MsgCredentialInvalid
+	case domain.ErrCredentialInvalidScan:
+		return domain.MsgCredentialInvalidScan
+	case domain.ErrCredentialScanNotFound:
+		return domain.MsgCredentialScanNotFound
+	default:
+		return domain.MsgCredentialErr
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// CurrencyRuleResponse represents the status of one recency rule for an aircraft family
+type CurrencyRuleResponse struct {
+	Code               string  `json:"code"`
+	AircraftFamily     string  `json:"aircraft_family"`
+	WindowDays         int     `json:"window_days"`
+	Night              bool    `json:"night,omitempty"`
+	RequiredTakeoffs   int     `json:"required_takeoffs,omitempty"`
+	RequiredLandings   int     `json:"required_landings,omitempty"`
+	RequiredApproaches int     `json:"required_approaches,omitempty"`
+	Takeoffs           int     `json:"takeoffs"`
+	Landings           int     `json:"landings"`
+	Approaches         int     `json:"approaches"`
+	Status             string  `json:"status"`               // CURRENT, EXPIRING, EXPIRED
+	ExpiresOn          *string `json:"expires_on,omitempty"` // YYYY-MM-DD, last day the rule is met
+
+	SimulatorDevices []string `json:"simulator_devices,omitempty"` // FSTD types whose sessions the rule credits
+}
+
+// CurrencyStatusResponse represents the response for GET /employees/me/currency
+type CurrencyStatusResponse struct {
+	AsOf  string                 `json:"as_of"`
+	Rules []CurrencyRuleResponse `json:"rules"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// FromDomainCurrencyRuleStatus converts a rule status to its response DTO
+func FromDomainCurrencyRuleStatus(s domain.CurrencyRuleStatus) CurrencyRuleResponse {
+	response := CurrencyRuleResponse{
+		Code:               s.Rule.Code,
+		AircraftFamily:     s.AircraftFamily,
+		WindowDays:         s.Rule.WindowDays,
+		Night:              s.Rule.Night,
+		RequiredTakeoffs:   s.Rule.Takeoffs,
+		RequiredLandings:   s.Rule.Landings,
+		RequiredApproaches: s.Rule.Approaches,
+		Takeoffs:           s.Takeoffs,
+		Landings:           s.Landings,
+		Approaches:         s.Approaches,
+		Status:             string(s.Level),
+	}
+	if s.ExpiresOn != nil {
+		expiresOn := s.ExpiresOn.Format("2006-01-02")
+		response.ExpiresOn = &expiresOn
+	}
+	for _, d := range s.Rule.SimulatorDevices {
+		response.SimulatorDevices = append(response.SimulatorDevices, string(d))
+	}
+	return response
+}
+
+// FromDomainCurrencyStatus converts a currency status to its response DTO
+func FromDomainCurrencyStatus(s *domain.CurrencyStatus) CurrencyStatusResponse {
+	response := CurrencyStatusResponse{
+		AsOf:  s.AsOf.Format("2006-01-02"),
+		Rules: make([]CurrencyRuleResponse, 0, len(s.Rules)),
+	}
+	for _, r := range s.Rules {
+		response.Rules = append(response.Rules, FromDomainCurrencyRuleStatus(r))
+	}
+	return response
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/currency
+// Experiencia reciente del empleado autenticado
+// ============================================
+
+// GetMyCurrency returns the recency status of every currency rule per aircraft family for the authenticated employee
+// @Summary Get pilot currency
+// @Description Returns takeoff, landing and instrument approach recency per aircraft family with status and expiry date per rule
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param as_of query string false "Evaluation date (YYYY-MM-DD), defaults to today (UTC)"
+// @Success 200 {object} middleware.APIResponse{data=CurrencyStatusResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/currency [get]
+// @Security BearerAuth
+func (h *handler) GetMyCurrency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogCurrencyStatusError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		asOf, ok := parseDateQuery(c, "as_of")
+		if !ok {
+			log.Warn(logger.LogCurrencyStatusError, "error", "invalid as_of date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if asOf == nil {
+			today := time.Now().UTC()
+			asOf = &today
+		}
+
+		status, err := h.DailyLogbookDetailInteractor.GetCurrencyStatus(c.Request.Context(), traceID, employee.ID, *asOf)
+		if err != nil {
+			log.Error(logger.LogCurrencyStatusError, "error", err)
+			h.Response.Error(c, domain.MsgCurrencyGetErr)
+			return
+		}
+
+		log.Info(logger.LogCurrencyStatusOK, "employee_id", employee.ID)
+		h.Response.SuccessWithData(c, domain.MsgCurrencyGetOK, FromDomainCurrencyStatus(status))
+	}
+}
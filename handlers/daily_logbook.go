@@ -8,12 +8,17 @@ import (
 
 // DailyLogbookResponse - Response DTO for daily logbook data
 type DailyLogbookResponse struct {
-	ID         string `json:"id"`
-	LogDate    string `json:"log_date"`
-	EmployeeID string `json:"employee_id"`
-	BookPage   *int   `json:"book_page,omitempty"`
-	Status     string `json:"status"`
-	Links      []Link `json:"_links,omitempty"`
+	ID          string  `json:"id"`
+	LogDate     string  `json:"log_date"`
+	EmployeeID  string  `json:"employee_id"`
+	BookPage    *int    `json:"book_page,omitempty"`
+	Status      string  `json:"status"`
+	State       string  `json:"state"`                  // DRAFT, SUBMITTED, SIGNED or LOCKED
+	SubmittedAt *string `json:"submitted_at,omitempty"` // RFC3339
+	SignedAt    *string `json:"signed_at,omitempty"`    // RFC3339, last signature (re-signed after amendments)
+	LockedAt    *string `json:"locked_at,omitempty"`    // RFC3339
+	DeletedAt   *string `json:"deleted_at,omitempty"`   // RFC3339, only on deleted logbooks
+	Links       []Link  `json:"_links,omitempty"`
 }
 
 // FromDomainDailyLogbook converts domain.DailyLogbook to DailyLogbookResponse with encoded IDs
@@ -23,12 +28,26 @@ func FromDomainDailyLogbook(logbook *domain.DailyLogbook, encodedID, encodedEmpl
 		status = "active"
 	}
 	return DailyLogbookResponse{
-		ID:         encodedID,
-		LogDate:    logbook.LogDate.Format("2006-01-02"),
-		EmployeeID: encodedEmployeeID,
-		BookPage:   logbook.BookPage,
-		Status:     status,
+		ID:          encodedID,
+		LogDate:     logbook.LogDate.Format("2006-01-02"),
+		EmployeeID:  encodedEmployeeID,
+		BookPage:    logbook.BookPage,
+		Status:      status,
+		State:       string(logbook.CurrentState()),
+		SubmittedAt: formatTimestamp(logbook.SubmittedAt),
+		SignedAt:    formatTimestamp(logbook.SignedAt),
+		LockedAt:    formatTimestamp(logbook.LockedAt),
+		DeletedAt:   formatTimestamp(logbook.DeletedAt),
+	}
+}
+
+// formatTimestamp formats an optional timestamp as RFC3339
+func formatTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
 	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
 }
 
 // CreateDailyLogbookRequest - Request DTO for creating a daily logbook
@@ -140,6 +159,19 @@ func ToDailyLogbookListResponse(logbooks []domain.DailyLogbook, encodeFunc func(
 	return response
 }
 
+// ToDeletedDailyLogbookListResponse converts soft deleted logbooks to DailyLogbookListResponse, with a
+// restore link on each logbook
+func ToDeletedDailyLogbookListResponse(logbooks []domain.DailyLogbook, encodeFunc func(string) (string, error), baseURL string) DailyLogbookListResponse {
+	response := ToDailyLogbookListResponse(logbooks, encodeFunc, "")
+	if baseURL != "" {
+		for i := range response.Logbooks {
+			response.Logbooks[i].Links = BuildDailyLogbookDeletedLinks(baseURL, response.Logbooks[i].ID)
+		}
+		response.Links = BuildDailyLogbookListLinks(baseURL)
+	}
+	return response
+}
+
 // DailyLogbookDeleteResponse - Response DTO for delete operation
 type DailyLogbookDeleteResponse struct {
 	ID      string `json:"id"`
@@ -322,7 +322,7 @@ func (h *handler) DeleteDailyLogbook() gin.HandlerFunc {
 		response := DailyLogbookDeleteResponse{
 			ID:      responseID,
 			Deleted: true,
-			Links:   BuildDailyLogbookDeletedLinks(baseURL),
+			Links:   BuildDailyLogbookDeletedLinks(baseURL, responseID),
 		}
 
 		c.JSON(http.StatusOK, response)
@@ -464,3 +464,107 @@ func (h *handler) DeactivateDailyLogbook() gin.HandlerFunc {
 		c.JSON(http.StatusOK, response)
 	}
 }
+
+// ListDeletedDailyLogbooks godoc
+// @Summary      List deleted daily logbooks
+// @Description  Returns the authenticated employee's deleted daily logbooks that can still be restored, most recently deleted first
+// @Tags         DailyLogbooks
+// @Produce      json
+// @Success      200  {object}  DailyLogbookListResponse
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/deleted [get]
+// @Security     BearerAuth
+func (h *handler) ListDeletedDailyLogbooks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Get authenticated employee from context
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			c.Error(domain.ErrUserNotFound)
+			return
+		}
+
+		logbooks, err := h.DailyLogbookInteractor.ListDeletedDailyLogbooks(c.Request.Context(), employee.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		baseURL := GetBaseURL(c)
+		response := ToDeletedDailyLogbookListResponse(logbooks, h.EncodeID, baseURL)
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// RestoreDailyLogbook godoc
+// @Summary      Restore a deleted daily logbook
+// @Description  Brings back a deleted daily logbook together with the segments deleted along with it (accepts both UUID and obfuscated ID). Logbooks can be restored until they are purged.
+// @Tags         DailyLogbooks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Daily Logbook ID (obfuscated ID)"
+// @Success      200  {object}  DailyLogbookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/{id}/restore [patch]
+// @Security     BearerAuth
+func (h *handler) RestoreDailyLogbook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Get authenticated employee from context
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			c.Error(domain.ErrUserNotFound)
+			return
+		}
+
+		inputID := c.Param("id")
+		if inputID == "" {
+			c.Error(domain.ErrInvalidID)
+			return
+		}
+
+		// Resolve ID (accepts both UUID and obfuscated ID)
+		logbookUUID, responseID := h.resolveID(inputID)
+		if logbookUUID == "" {
+			h.HandleIDDecodingError(c, inputID, domain.ErrInvalidID)
+			return
+		}
+
+		// Verify the deleted logbook exists and belongs to employee
+		deletedLogbook, err := h.DailyLogbookInteractor.GetDeletedDailyLogbookByID(c.Request.Context(), logbookUUID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		if deletedLogbook.EmployeeID != employee.ID {
+			c.Error(domain.ErrDailyLogbookUnauthorized)
+			return
+		}
+
+		if err := h.DailyLogbookInteractor.RestoreDailyLogbook(c.Request.Context(), logbookUUID); err != nil {
+			c.Error(err)
+			return
+		}
+
+		logbook, err := h.DailyLogbookInteractor.GetDailyLogbookByID(c.Request.Context(), logbookUUID)
+		if err != nil {
+			Logger.Error(logger.LogDailyLogbookGetError, "logbook_id", logbookUUID, "error", err)
+			c.Error(err)
+			return
+		}
+
+		// Encode employee ID for response
+		encodedEmployeeID, _ := h.EncodeID(employee.ID)
+
+		baseURL := GetBaseURL(c)
+		response := FromDomainDailyLogbook(logbook, responseID, encodedEmployeeID)
+		response.Links = BuildDailyLogbookLinks(baseURL, responseID)
+
+		c.JSON(http.StatusOK, response)
+	}
+}
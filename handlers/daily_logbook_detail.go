@@ -15,17 +15,23 @@ type CreateDailyLogbookDetailRequest struct {
 	AirlineRouteID               string  `json:"airline_route_id"`
 	ActualAircraftRegistrationID string  `json:"actual_aircraft_registration_id"`
 	Passengers                   *int    `json:"passengers,omitempty"`
-	OutTime                      string  `json:"out_time"`     // TIME format HH:MM
-	TakeoffTime                  string  `json:"takeoff_time"` // TIME format HH:MM
-	LandingTime                  string  `json:"landing_time"` // TIME format HH:MM
-	InTime                       string  `json:"in_time"`      // TIME format HH:MM
+	OutTime                      string  `json:"out_time"`                 // TIME format HH:MM
+	TakeoffTime                  string  `json:"takeoff_time"`             // TIME format HH:MM
+	LandingTime                  string  `json:"landing_time"`             // TIME format HH:MM
+	InTime                       string  `json:"in_time"`                  // TIME format HH:MM
+	TimeReference                string  `json:"time_reference,omitempty"` // 'UTC' (default) or 'LOCAL' (origin/destination airport time)
 	PilotRole                    string  `json:"pilot_role"`
 	CompanionName                *string `json:"companion_name,omitempty"`
-	AirTime                      string  `json:"air_time"`            // TIME format HH:MM
-	BlockTime                    string  `json:"block_time"`          // TIME format HH:MM
-	DutyTime                     *string `json:"duty_time,omitempty"` // TIME format HH:MM (nullable)
+	CompanionEmployeeID          *string `json:"companion_employee_id,omitempty"` // Obfuscated employee ID; the companion is offered a mirrored entry
+	PilotFunction                *string `json:"pilot_function,omitempty"`        // PIC, SIC, DUAL, INSTRUCTOR or PICUS
+	AirTime                      string  `json:"air_time,omitempty"`              // Optional - derived server-side (ON - OFF)
+	BlockTime                    string  `json:"block_time,omitempty"`            // Optional - derived server-side (IN - OUT)
+	DutyTime                     *string `json:"duty_time,omitempty"`             // TIME format HH:MM (nullable)
+	IFRTime                      *string `json:"ifr_time,omitempty"`              // TIME format HH:MM, at most the block time (nullable)
 	ApproachType                 *string `json:"approach_type,omitempty"`
 	FlightType                   *string `json:"flight_type,omitempty"`
+	WetLease                     bool    `json:"wet_lease,omitempty"`          // Aircraft leased with crew from another airline
+	OverrideConflicts            bool    `json:"override_conflicts,omitempty"` // Save even if it duplicates or overlaps another segment
 }
 
 // Sanitize trims whitespace from string fields
@@ -38,11 +44,15 @@ func (r *CreateDailyLogbookDetailRequest) Sanitize() {
 	r.TakeoffTime = TrimString(r.TakeoffTime)
 	r.LandingTime = TrimString(r.LandingTime)
 	r.InTime = TrimString(r.InTime)
+	r.TimeReference = TrimString(r.TimeReference)
 	r.PilotRole = TrimString(r.PilotRole)
 	r.CompanionName = TrimStringPtr(r.CompanionName)
+	r.CompanionEmployeeID = TrimStringPtr(r.CompanionEmployeeID)
+	r.PilotFunction = TrimStringPtr(r.PilotFunction)
 	r.AirTime = TrimString(r.AirTime)
 	r.BlockTime = TrimString(r.BlockTime)
 	r.DutyTime = TrimStringPtr(r.DutyTime)
+	r.IFRTime = TrimStringPtr(r.IFRTime)
 	r.ApproachType = TrimStringPtr(r.ApproachType)
 	r.FlightType = TrimStringPtr(r.FlightType)
 }
@@ -54,17 +64,23 @@ type UpdateDailyLogbookDetailRequest struct {
 	AirlineRouteID               string  `json:"airline_route_id"`
 	ActualAircraftRegistrationID string  `json:"actual_aircraft_registration_id"`
 	Passengers                   *int    `json:"passengers,omitempty"`
-	OutTime                      string  `json:"out_time"`     // TIME format HH:MM
-	TakeoffTime                  string  `json:"takeoff_time"` // TIME format HH:MM
-	LandingTime                  string  `json:"landing_time"` // TIME format HH:MM
-	InTime                       string  `json:"in_time"`      // TIME format HH:MM
+	OutTime                      string  `json:"out_time"`                 // TIME format HH:MM
+	TakeoffTime                  string  `json:"takeoff_time"`             // TIME format HH:MM
+	LandingTime                  string  `json:"landing_time"`             // TIME format HH:MM
+	InTime                       string  `json:"in_time"`                  // TIME format HH:MM
+	TimeReference                string  `json:"time_reference,omitempty"` // 'UTC' (default) or 'LOCAL' (origin/destination airport time)
 	PilotRole                    string  `json:"pilot_role"`
 	CompanionName                *string `json:"companion_name,omitempty"`
-	AirTime                      string  `json:"air_time"`            // TIME format HH:MM
-	BlockTime                    string  `json:"block_time"`          // TIME format HH:MM
-	DutyTime                     *string `json:"duty_time,omitempty"` // TIME format HH:MM (nullable)
+	CompanionEmployeeID          *string `json:"companion_employee_id,omitempty"` // Obfuscated employee ID; the companion is offered a mirrored entry
+	PilotFunction                *string `json:"pilot_function,omitempty"`        // PIC, SIC, DUAL, INSTRUCTOR or PICUS
+	AirTime                      string  `json:"air_time,omitempty"`              // Optional - derived server-side (ON - OFF)
+	BlockTime                    string  `json:"block_time,omitempty"`            // Optional - derived server-side (IN - OUT)
+	DutyTime                     *string `json:"duty_time,omitempty"`             // TIME format HH:MM (nullable)
+	IFRTime                      *string `json:"ifr_time,omitempty"`              // TIME format HH:MM, at most the block time (nullable)
 	ApproachType                 *string `json:"approach_type,omitempty"`
 	FlightType                   *string `json:"flight_type,omitempty"`
+	WetLease                     bool    `json:"wet_lease,omitempty"`          // Aircraft leased with crew from another airline
+	OverrideConflicts            bool    `json:"override_conflicts,omitempty"` // Save even if it duplicates or overlaps another segment
 }
 
 // Sanitize trims whitespace from string fields
@@ -77,11 +93,15 @@ func (r *UpdateDailyLogbookDetailRequest) Sanitize() {
 	r.TakeoffTime = TrimString(r.TakeoffTime)
 	r.LandingTime = TrimString(r.LandingTime)
 	r.InTime = TrimString(r.InTime)
+	r.TimeReference = TrimString(r.TimeReference)
 	r.PilotRole = TrimString(r.PilotRole)
 	r.CompanionName = TrimStringPtr(r.CompanionName)
+	r.CompanionEmployeeID = TrimStringPtr(r.CompanionEmployeeID)
+	r.PilotFunction = TrimStringPtr(r.PilotFunction)
 	r.AirTime = TrimString(r.AirTime)
 	r.BlockTime = TrimString(r.BlockTime)
 	r.DutyTime = TrimStringPtr(r.DutyTime)
+	r.IFRTime = TrimStringPtr(r.IFRTime)
 	r.ApproachType = TrimStringPtr(r.ApproachType)
 	r.FlightType = TrimStringPtr(r.FlightType)
 }
@@ -92,32 +112,64 @@ func (r *UpdateDailyLogbookDetailRequest) Sanitize() {
 
 // DailyLogbookDetailResponse represents the response for a detail
 type DailyLogbookDetailResponse struct {
-	ID                           string            `json:"id"`
-	DailyLogbookID               string            `json:"daily_logbook_id"`
-	FlightRealDate               string            `json:"flight_real_date"`
-	FlightNumber                 string            `json:"flight_number"`
-	AirlineRouteID               string            `json:"airline_route_id"`
-	ActualAircraftRegistrationID string            `json:"actual_aircraft_registration_id"`
-	Passengers                   *int              `json:"passengers,omitempty"`
-	OutTime                      string            `json:"out_time"`
-	TakeoffTime                  string            `json:"takeoff_time"`
-	LandingTime                  string            `json:"landing_time"`
-	InTime                       string            `json:"in_time"`
-	PilotRole                    string            `json:"pilot_role"`
-	CompanionName                *string           `json:"companion_name,omitempty"`
-	AirTime                      string            `json:"air_time"`
-	BlockTime                    string            `json:"block_time"`
-	DutyTime                     *string           `json:"duty_time,omitempty"`
-	ApproachType                 *string           `json:"approach_type,omitempty"`
-	FlightType                   *string           `json:"flight_type,omitempty"`
-	LogDate                      string            `json:"log_date,omitempty"`
-	RouteCode                    string            `json:"route_code,omitempty"`
-	OriginIataCode               string            `json:"origin_iata_code,omitempty"`
-	DestinationIataCode          string            `json:"destination_iata_code,omitempty"`
-	AirlineCode                  string            `json:"airline_code,omitempty"`
-	LicensePlate                 string            `json:"license_plate,omitempty"`
-	ModelName                    string            `json:"model_name,omitempty"`
-	Links                        map[string]string `json:"_links,omitempty"`
+	ID                           string                      `json:"id"`
+	DailyLogbookID               string                      `json:"daily_logbook_id"`
+	FlightRealDate               string                      `json:"flight_real_date"`
+	FlightNumber                 string                      `json:"flight_number"`
+	AirlineRouteID               string                      `json:"airline_route_id"`
+	ActualAircraftRegistrationID string                      `json:"actual_aircraft_registration_id"`
+	Passengers                   *int                        `json:"passengers,omitempty"`
+	OutTime                      string                      `json:"out_time"`
+	TakeoffTime                  string                      `json:"takeoff_time"`
+	LandingTime                  string                      `json:"landing_time"`
+	InTime                       string                      `json:"in_time"`
+	PilotRole                    string                      `json:"pilot_role"`
+	CompanionName                *string                     `json:"companion_name,omitempty"`
+	CompanionEmployeeID          string                      `json:"companion_employee_id,omitempty"`
+	PilotFunction                *string                     `json:"pilot_function,omitempty"`
+	AirTime                      string                      `json:"air_time"`
+	BlockTime                    string                      `json:"block_time"`
+	DutyTime                     *string                     `json:"duty_time,omitempty"`
+	DutyPeriodID                 string                      `json:"duty_period_id,omitempty"` // Duty period the segment was flown in
+	IFRTime                      *string                     `json:"ifr_time,omitempty"`
+	ApproachType                 *string                     `json:"approach_type,omitempty"`
+	FlightType                   *string                     `json:"flight_type,omitempty"`
+	WetLease                     bool                        `json:"wet_lease"`
+	NightTime                    *string                     `json:"night_time,omitempty"`
+	DayTakeoffs                  *int                        `json:"day_takeoffs,omitempty"`
+	NightTakeoffs                *int                        `json:"night_takeoffs,omitempty"`
+	DayLandings                  *int                        `json:"day_landings,omitempty"`
+	NightLandings                *int                        `json:"night_landings,omitempty"`
+	LogDate                      string                      `json:"log_date,omitempty"`
+	RouteCode                    string                      `json:"route_code,omitempty"`
+	OriginIataCode               string                      `json:"origin_iata_code,omitempty"`
+	DestinationIataCode          string                      `json:"destination_iata_code,omitempty"`
+	AirlineCode                  string                      `json:"airline_code,omitempty"`
+	OriginTimeZone               string                      `json:"origin_time_zone,omitempty"`
+	DestinationTimeZone          string                      `json:"destination_time_zone,omitempty"`
+	LicensePlate                 string                      `json:"license_plate,omitempty"`
+	ModelName                    string                      `json:"model_name,omitempty"`
+	MultiEngine                  bool                        `json:"multi_engine"`
+	Turbine                      bool                        `json:"turbine"`
+	MultiPilot                   bool                        `json:"multi_pilot"`
+	EstimatedFlightTime          string                      `json:"estimated_flight_time,omitempty"`
+	ChainHash                    string                      `json:"chain_hash,omitempty"` // Hash chain link, set once the logbook is signed
+	Anomalies                    []FlightTimeAnomalyResponse `json:"anomalies,omitempty"`  // Block/air times outside the tolerance around the route estimate
+	Warnings                     []WarningResponse           `json:"warnings,omitempty"`   // Non-blocking findings raised on create/update
+	Links                        map[string]string           `json:"_links,omitempty"`
+}
+
+// WarningResponse represents a non-blocking validation finding
+type WarningResponse struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	DetailID string `json:"detail_id,omitempty"` // Related segment, e.g. the one an overridden conflict clashed with
+}
+
+// SegmentConflictResponse identifies the segment a create/update clashed with
+type SegmentConflictResponse struct {
+	Kind                string `json:"kind"` // DUPLICATE or OVERLAP
+	ConflictingDetailID string `json:"conflicting_detail_id"`
 }
 
 // ============================================
@@ -137,18 +189,27 @@ func ToDomainDailyLogbookDetail(logbookID string, req CreateDailyLogbookDetailRe
 		TakeoffTime:                  req.TakeoffTime,
 		LandingTime:                  req.LandingTime,
 		InTime:                       req.InTime,
+		TimeReference:                domain.TimeReference(req.TimeReference),
 		PilotRole:                    domain.PilotRole(req.PilotRole),
 		CompanionName:                req.CompanionName,
+		CompanionEmployeeID:          req.CompanionEmployeeID,
 		AirTime:                      req.AirTime,
 		BlockTime:                    req.BlockTime,
 		DutyTime:                     req.DutyTime,
+		IFRTime:                      req.IFRTime,
 		FlightType:                   req.FlightType,
+		WetLease:                     req.WetLease,
+		OverrideConflicts:            req.OverrideConflicts,
 	}
 
 	if req.ApproachType != nil {
 		approachType := domain.ApproachType(*req.ApproachType)
 		detail.ApproachType = &approachType
 	}
+	if req.PilotFunction != nil {
+		pilotFunction := domain.PilotFunction(*req.PilotFunction)
+		detail.PilotFunction = &pilotFunction
+	}
 
 	return detail
 }
@@ -166,18 +227,27 @@ func ToDomainDailyLogbookDetailUpdate(id string, req UpdateDailyLogbookDetailReq
 		TakeoffTime:                  req.TakeoffTime,
 		LandingTime:                  req.LandingTime,
 		InTime:                       req.InTime,
+		TimeReference:                domain.TimeReference(req.TimeReference),
 		PilotRole:                    domain.PilotRole(req.PilotRole),
 		CompanionName:                req.CompanionName,
+		CompanionEmployeeID:          req.CompanionEmployeeID,
 		AirTime:                      req.AirTime,
 		BlockTime:                    req.BlockTime,
 		DutyTime:                     req.DutyTime,
+		IFRTime:                      req.IFRTime,
 		FlightType:                   req.FlightType,
+		WetLease:                     req.WetLease,
+		OverrideConflicts:            req.OverrideConflicts,
 	}
 
 	if req.ApproachType != nil {
 		approachType := domain.ApproachType(*req.ApproachType)
 		detail.ApproachType = &approachType
 	}
+	if req.PilotFunction != nil {
+		pilotFunction := domain.PilotFunction(*req.PilotFunction)
+		detail.PilotFunction = &pilotFunction
+	}
 
 	return detail
 }
@@ -201,21 +271,62 @@ func FromDomainDailyLogbookDetail(d *domain.DailyLogbookDetail, encodedID, encod
 		AirTime:                      d.AirTime,
 		BlockTime:                    d.BlockTime,
 		DutyTime:                     d.DutyTime,
+		IFRTime:                      d.IFRTime,
+		NightTime:                    d.NightTime,
+		DayTakeoffs:                  d.DayTakeoffs,
+		NightTakeoffs:                d.NightTakeoffs,
+		DayLandings:                  d.DayLandings,
+		NightLandings:                d.NightLandings,
 		LogDate:                      d.LogDate,
 		RouteCode:                    d.RouteCode,
 		OriginIataCode:               d.OriginIataCode,
 		DestinationIataCode:          d.DestinationIataCode,
 		AirlineCode:                  d.AirlineCode,
+		OriginTimeZone:               d.OriginTimeZone,
+		DestinationTimeZone:          d.DestinationTimeZone,
 		LicensePlate:                 d.LicensePlate,
 		ModelName:                    d.ModelName,
+		MultiEngine:                  d.MultiEngine,
+		Turbine:                      d.Turbine,
+		MultiPilot:                   d.MultiPilot,
 	}
 
 	if d.ApproachType != nil {
 		approachTypeStr := string(*d.ApproachType)
 		response.ApproachType = &approachTypeStr
 	}
+	if d.PilotFunction != nil {
+		pilotFunctionStr := string(*d.PilotFunction)
+		response.PilotFunction = &pilotFunctionStr
+	}
 
 	response.FlightType = d.FlightType
+	response.WetLease = d.WetLease
+	response.EstimatedFlightTime = d.EstimatedFlightTime
+	if d.ChainHash != nil {
+		response.ChainHash = *d.ChainHash
+	}
+	for _, a := range d.Anomalies {
+		response.Anomalies = append(response.Anomalies, FromDomainFlightTimeAnomaly(a))
+	}
 
 	return response
 }
+
+// encodeDutyPeriodID returns the obfuscated ID of the segment's duty period ("" when it is not linked)
+func (h *handler) encodeDutyPeriodID(d *domain.DailyLogbookDetail) string {
+	if d.DutyPeriodID == nil {
+		return ""
+	}
+	encoded, _ := h.EncodeID(*d.DutyPeriodID)
+	return encoded
+}
+
+// encodeCompanionEmployeeID returns the obfuscated ID of the segment's companion ("" when there is none)
+func (h *handler) encodeCompanionEmployeeID(d *domain.DailyLogbookDetail) string {
+	if d.CompanionEmployeeID == nil {
+		return ""
+	}
+	encoded, _ := h.EncodeID(*d.CompanionEmployeeID)
+	return encoded
+}
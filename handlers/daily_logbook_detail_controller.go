@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/middleware"
 	"github.com/champion19/flighthours-api/platform/logger"
@@ -57,6 +59,8 @@ func (h *handler) GetDailyLogbookDetail() gin.HandlerFunc {
 
 		// Build response
 		response := FromDomainDailyLogbookDetail(detail, responseID, encodedLogbookID, encodedRouteID, encodedAircraftID)
+		response.CompanionEmployeeID = h.encodeCompanionEmployeeID(detail)
+		response.DutyPeriodID = h.encodeDutyPeriodID(detail)
 		response.Links = BuildDailyLogbookDetailLinks(c, responseID)
 
 		log.Info(logger.LogDailyLogbookDetailGetOK, "id", detailUUID)
@@ -80,6 +84,7 @@ func (h *handler) GetDailyLogbookDetail() gin.HandlerFunc {
 // @Success 201 {object} DailyLogbookDetailResponse
 // @Failure 400 {object} middleware.APIResponse
 // @Failure 403 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse{data=SegmentConflictResponse} "Duplicate or overlapping segment (retry with override_conflicts), or logbook no longer in draft"
 // @Router /daily-logbooks/{id}/details [post]
 func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -143,6 +148,17 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 		}
 		req.ActualAircraftRegistrationID = aircraftUUID
 
+		// Resolve companion_employee_id if provided
+		if req.CompanionEmployeeID != nil {
+			companionUUID, _ := h.resolveID(*req.CompanionEmployeeID)
+			if companionUUID == "" {
+				log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "invalid companion ID")
+				h.Response.Error(c, domain.MsgSegmentMirrorInvalidCompanion)
+				return
+			}
+			req.CompanionEmployeeID = &companionUUID
+		}
+
 		// Validate pilot role
 		if !domain.IsValidPilotRole(req.PilotRole) {
 			log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "invalid pilot role")
@@ -150,6 +166,13 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 			return
 		}
 
+		// Validate pilot function if provided
+		if req.PilotFunction != nil && !domain.IsValidPilotFunction(*req.PilotFunction) {
+			log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "invalid pilot function")
+			h.Response.Error(c, domain.MsgValFieldFormat)
+			return
+		}
+
 		// Validate approach type if provided
 		if req.ApproachType != nil && !domain.IsValidApproachType(*req.ApproachType) {
 			log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "invalid approach type")
@@ -157,6 +180,13 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 			return
 		}
 
+		// Validate time reference
+		if !domain.IsValidTimeReference(req.TimeReference) {
+			log.Warn(logger.LogDailyLogbookDetailCreateError, "error", "invalid time reference")
+			h.Response.Error(c, domain.MsgFlightInvalidTimeRef)
+			return
+		}
+
 		// Convert to domain
 		detail := ToDomainDailyLogbookDetail(logbookUUID, req)
 		detail.SetID()
@@ -165,8 +195,33 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 		detail.EmployeeLogbookID = &employee.ID
 
 		// Create detail
-		if err := h.DailyLogbookDetailInteractor.CreateDailyLogbookDetail(c.Request.Context(), traceID, detail); err != nil {
+		warnings, err := h.DailyLogbookDetailInteractor.CreateDailyLogbookDetail(c.Request.Context(), traceID, detail)
+		if err != nil {
 			log.Error(logger.LogDailyLogbookDetailCreateError, "error", err)
+			var ftlErr *domain.FTLLimitExceededError
+			if errors.As(err, &ftlErr) {
+				h.Response.Error(c, domain.MsgFTLLimitExceeded, domain.FTLMessageParams(ftlErr.Usage)...)
+				return
+			}
+			var ratingErr *domain.TypeRatingRequiredError
+			if errors.As(err, &ratingErr) {
+				h.Response.Error(c, domain.MsgTypeRatingRequired, ratingErr.AircraftFamily)
+				return
+			}
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			if err == domain.ErrDailyLogbookSigned {
+				h.Response.Error(c, domain.MsgDailyLogbookSigned)
+				return
+			}
+			if err == domain.ErrSegmentMirrorInvalidCompanion {
+				h.Response.Error(c, domain.MsgSegmentMirrorInvalidCompanion)
+				return
+			}
 			if err == domain.ErrFlightInvalidLogbook {
 				h.Response.Error(c, domain.MsgFlightInvalidLogbook)
 				return
@@ -183,6 +238,42 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 				h.Response.Error(c, domain.MsgFlightInvalidTimeSequence)
 				return
 			}
+			if err == domain.ErrFlightTimeMismatch {
+				h.Response.Error(c, domain.MsgFlightTimeMismatch)
+				return
+			}
+			if err == domain.ErrFlightInvalidIFRTime {
+				h.Response.Error(c, domain.MsgFlightInvalidIFRTime)
+				return
+			}
+			if err == domain.ErrFlightSegmentSpanExceeded {
+				h.Response.Error(c, domain.MsgFlightSegmentSpanExceeded)
+				return
+			}
+			if err == domain.ErrFlightTimeZoneUnavailable {
+				h.Response.Error(c, domain.MsgFlightTimeZoneUnavailable)
+				return
+			}
+			if err == domain.ErrFlightInvalidTimeRef {
+				h.Response.Error(c, domain.MsgFlightInvalidTimeRef)
+				return
+			}
+			if err == domain.ErrFlightAircraftNotInAirline {
+				h.Response.Error(c, domain.MsgFlightAircraftNotInAirline)
+				return
+			}
+			if err == domain.ErrFlightRouteInactive {
+				h.Response.Error(c, domain.MsgFlightRouteInactive)
+				return
+			}
+			if err == domain.ErrFlightAirlineInactive {
+				h.Response.Error(c, domain.MsgFlightAirlineInactive)
+				return
+			}
+			if err == domain.ErrFlightDateOutsideLogDate {
+				h.Response.Error(c, domain.MsgFlightDateOutsideLogDate)
+				return
+			}
 			h.Response.Error(c, domain.MsgFlightSaveError)
 			return
 		}
@@ -203,6 +294,8 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 
 		// Build response
 		response := FromDomainDailyLogbookDetail(createdDetail, encodedID, encodedLogbookID, encodedRouteID, encodedAircraftID)
+		response.CompanionEmployeeID = h.encodeCompanionEmployeeID(createdDetail)
+		response.Warnings = h.toWarningResponses(warnings)
 		response.Links = BuildDailyLogbookDetailLinks(c, encodedID)
 
 		log.Info(logger.LogDailyLogbookDetailCreateOK, "id", detail.ID)
@@ -227,6 +320,7 @@ func (h *handler) CreateDailyLogbookDetail() gin.HandlerFunc {
 // @Failure 400 {object} middleware.APIResponse
 // @Failure 403 {object} middleware.APIResponse
 // @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse{data=SegmentConflictResponse} "Duplicate or overlapping segment (retry with override_conflicts), or logbook no longer in draft"
 // @Router /daily-logbook-details/{id} [put]
 func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -296,6 +390,17 @@ func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 		}
 		req.ActualAircraftRegistrationID = aircraftUUID
 
+		// Resolve companion_employee_id if provided
+		if req.CompanionEmployeeID != nil {
+			companionUUID, _ := h.resolveID(*req.CompanionEmployeeID)
+			if companionUUID == "" {
+				log.Warn(logger.LogDailyLogbookDetailUpdateError, "error", "invalid companion ID")
+				h.Response.Error(c, domain.MsgSegmentMirrorInvalidCompanion)
+				return
+			}
+			req.CompanionEmployeeID = &companionUUID
+		}
+
 		// Validate pilot role
 		if !domain.IsValidPilotRole(req.PilotRole) {
 			log.Warn(logger.LogDailyLogbookDetailUpdateError, "error", "invalid pilot role")
@@ -303,6 +408,13 @@ func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 			return
 		}
 
+		// Validate pilot function if provided
+		if req.PilotFunction != nil && !domain.IsValidPilotFunction(*req.PilotFunction) {
+			log.Warn(logger.LogDailyLogbookDetailUpdateError, "error", "invalid pilot function")
+			h.Response.Error(c, domain.MsgValFieldFormat)
+			return
+		}
+
 		// Validate approach type if provided
 		if req.ApproachType != nil && !domain.IsValidApproachType(*req.ApproachType) {
 			log.Warn(logger.LogDailyLogbookDetailUpdateError, "error", "invalid approach type")
@@ -310,20 +422,92 @@ func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 			return
 		}
 
+		// Validate time reference
+		if !domain.IsValidTimeReference(req.TimeReference) {
+			log.Warn(logger.LogDailyLogbookDetailUpdateError, "error", "invalid time reference")
+			h.Response.Error(c, domain.MsgFlightInvalidTimeRef)
+			return
+		}
+
 		// Convert to domain
 		detail := ToDomainDailyLogbookDetailUpdate(detailUUID, req)
 
 		// Update detail
-		if err := h.DailyLogbookDetailInteractor.UpdateDailyLogbookDetail(c.Request.Context(), traceID, detail); err != nil {
+		warnings, err := h.DailyLogbookDetailInteractor.UpdateDailyLogbookDetail(c.Request.Context(), traceID, detail)
+		if err != nil {
 			log.Error(logger.LogDailyLogbookDetailUpdateError, "error", err)
+			var ftlErr *domain.FTLLimitExceededError
+			if errors.As(err, &ftlErr) {
+				h.Response.Error(c, domain.MsgFTLLimitExceeded, domain.FTLMessageParams(ftlErr.Usage)...)
+				return
+			}
+			var ratingErr *domain.TypeRatingRequiredError
+			if errors.As(err, &ratingErr) {
+				h.Response.Error(c, domain.MsgTypeRatingRequired, ratingErr.AircraftFamily)
+				return
+			}
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			if err == domain.ErrDailyLogbookSigned {
+				h.Response.Error(c, domain.MsgDailyLogbookSigned)
+				return
+			}
+			if err == domain.ErrSegmentMirrorInvalidCompanion {
+				h.Response.Error(c, domain.MsgSegmentMirrorInvalidCompanion)
+				return
+			}
 			if err == domain.ErrFlightNotFound {
 				h.Response.Error(c, domain.MsgFlightNotFound)
 				return
 			}
+			if err == domain.ErrFlightInvalidRoute {
+				h.Response.Error(c, domain.MsgFlightInvalidRoute)
+				return
+			}
 			if err == domain.ErrFlightInvalidTimeSequence {
 				h.Response.Error(c, domain.MsgFlightInvalidTimeSequence)
 				return
 			}
+			if err == domain.ErrFlightTimeMismatch {
+				h.Response.Error(c, domain.MsgFlightTimeMismatch)
+				return
+			}
+			if err == domain.ErrFlightInvalidIFRTime {
+				h.Response.Error(c, domain.MsgFlightInvalidIFRTime)
+				return
+			}
+			if err == domain.ErrFlightSegmentSpanExceeded {
+				h.Response.Error(c, domain.MsgFlightSegmentSpanExceeded)
+				return
+			}
+			if err == domain.ErrFlightTimeZoneUnavailable {
+				h.Response.Error(c, domain.MsgFlightTimeZoneUnavailable)
+				return
+			}
+			if err == domain.ErrFlightInvalidTimeRef {
+				h.Response.Error(c, domain.MsgFlightInvalidTimeRef)
+				return
+			}
+			if err == domain.ErrFlightAircraftNotInAirline {
+				h.Response.Error(c, domain.MsgFlightAircraftNotInAirline)
+				return
+			}
+			if err == domain.ErrFlightRouteInactive {
+				h.Response.Error(c, domain.MsgFlightRouteInactive)
+				return
+			}
+			if err == domain.ErrFlightAirlineInactive {
+				h.Response.Error(c, domain.MsgFlightAirlineInactive)
+				return
+			}
+			if err == domain.ErrFlightDateOutsideLogDate {
+				h.Response.Error(c, domain.MsgFlightDateOutsideLogDate)
+				return
+			}
 			h.Response.Error(c, domain.MsgFlightUpdateError)
 			return
 		}
@@ -343,6 +527,9 @@ func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 
 		// Build response
 		response := FromDomainDailyLogbookDetail(updatedDetail, responseID, encodedLogbookID, encodedRouteID, encodedAircraftID)
+		response.CompanionEmployeeID = h.encodeCompanionEmployeeID(updatedDetail)
+		response.DutyPeriodID = h.encodeDutyPeriodID(updatedDetail)
+		response.Warnings = h.toWarningResponses(warnings)
 		response.Links = BuildDailyLogbookDetailLinks(c, responseID)
 
 		log.Info(logger.LogDailyLogbookDetailUpdateOK, "id", detailUUID)
@@ -365,6 +552,7 @@ func (h *handler) UpdateDailyLogbookDetail() gin.HandlerFunc {
 // @Success 200 {object} middleware.APIResponse
 // @Failure 403 {object} middleware.APIResponse
 // @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse "Logbook no longer in draft"
 // @Router /daily-logbook-details/{id} [delete]
 func (h *handler) DeleteDailyLogbookDetail() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -410,6 +598,10 @@ func (h *handler) DeleteDailyLogbookDetail() gin.HandlerFunc {
 		// Delete detail
 		if err := h.DailyLogbookDetailInteractor.DeleteDailyLogbookDetail(c.Request.Context(), traceID, detailUUID); err != nil {
 			log.Error(logger.LogDailyLogbookDetailDeleteError, "error", err)
+			if err == domain.ErrDailyLogbookSigned {
+				h.Response.Error(c, domain.MsgDailyLogbookSigned)
+				return
+			}
 			if err == domain.ErrFlightNotFound {
 				h.Response.Error(c, domain.MsgFlightNotFound)
 				return
@@ -423,6 +615,83 @@ func (h *handler) DeleteDailyLogbookDetail() gin.HandlerFunc {
 	}
 }
 
+// ============================================
+// PATCH /daily-logbook-details/:id/restore
+// Restaurar Detalle de Bitácora Diaria eliminado
+// ============================================
+
+// RestoreDailyLogbookDetail restores a deleted detail
+// @Summary Restore daily logbook detail
+// @Description Brings back a deleted flight segment. Its logbook must not be deleted or signed and the segment must not clash with the employee's current segments.
+// @Tags DailyLogbookDetails
+// @Accept json
+// @Produce json
+// @Param id path string true "Detail ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=DailyLogbookDetailResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse "Logbook deleted or no longer in draft, or the segment clashes with another one"
+// @Router /daily-logbook-details/{id}/restore [patch]
+func (h *handler) RestoreDailyLogbookDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+		inputID := c.Param("id")
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok {
+			log.Error(logger.LogDailyLogbookDetailRestoreErr, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgFlightUnauthorized)
+			return
+		}
+
+		// Resolve detail ID
+		detailUUID, responseID := h.resolveID(inputID)
+		if detailUUID == "" {
+			log.Warn(logger.LogDailyLogbookDetailRestoreErr, "error", "invalid ID")
+			h.Response.Error(c, domain.MsgValIDInvalid)
+			return
+		}
+
+		restored, err := h.DailyLogbookDetailInteractor.RestoreDailyLogbookDetail(c.Request.Context(), traceID, detailUUID, employee.ID)
+		if err != nil {
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			switch err {
+			case domain.ErrFlightNotFound:
+				h.Response.Error(c, domain.MsgFlightNotFound)
+			case domain.ErrFlightUnauthorized:
+				h.Response.Error(c, domain.MsgFlightUnauthorized)
+			case domain.ErrFlightLogbookDeleted:
+				h.Response.Error(c, domain.MsgFlightLogbookDeleted)
+			case domain.ErrDailyLogbookSigned:
+				h.Response.Error(c, domain.MsgDailyLogbookSigned)
+			default:
+				log.Error(logger.LogDailyLogbookDetailRestoreErr, "error", err)
+				h.Response.Error(c, domain.MsgFlightRestoreErr)
+			}
+			return
+		}
+
+		// Encode IDs for response
+		encodedLogbookID, _ := h.EncodeID(restored.DailyLogbookID)
+		encodedRouteID, _ := h.EncodeID(restored.AirlineRouteID)
+		encodedAircraftID, _ := h.EncodeID(restored.ActualAircraftRegistrationID)
+
+		response := FromDomainDailyLogbookDetail(restored, responseID, encodedLogbookID, encodedRouteID, encodedAircraftID)
+		response.CompanionEmployeeID = h.encodeCompanionEmployeeID(restored)
+		response.DutyPeriodID = h.encodeDutyPeriodID(restored)
+		response.Links = BuildDailyLogbookDetailLinks(c, responseID)
+
+		h.Response.SuccessWithData(c, domain.MsgFlightRestored, response)
+	}
+}
+
 // ============================================
 // ADDITIONAL: GET /daily-logbooks/:id/details
 // Listar Detalles por Bitácora
@@ -490,6 +759,8 @@ func (h *handler) ListDailyLogbookDetails() gin.HandlerFunc {
 			encodedAircraftID, _ := h.EncodeID(d.ActualAircraftRegistrationID)
 
 			response := FromDomainDailyLogbookDetail(&d, encodedID, encodedLogbookID, encodedRouteID, encodedAircraftID)
+			response.CompanionEmployeeID = h.encodeCompanionEmployeeID(&d)
+			response.DutyPeriodID = h.encodeDutyPeriodID(&d)
 			response.Links = BuildDailyLogbookDetailLinks(c, encodedID)
 			responses = append(responses, response)
 		}
@@ -498,3 +769,34 @@ func (h *handler) ListDailyLogbookDetails() gin.HandlerFunc {
 		h.Response.SuccessWithData(c, domain.MsgFlightListOK, responses)
 	}
 }
+
+// toWarningResponses resolves the message catalog content of each validation warning
+func (h *handler) toWarningResponses(warnings []domain.ValidationWarning) []WarningResponse {
+	if len(warnings) == 0 {
+		return nil
+	}
+	responses := make([]WarningResponse, 0, len(warnings))
+	for _, w := range warnings {
+		response := WarningResponse{Code: w.Code}
+		if w.DetailID != "" {
+			response.DetailID, _ = h.EncodeID(w.DetailID)
+		}
+		if h.MessagingCache != nil {
+			if msg := h.MessagingCache.GetMessageResponse(w.Code, w.Params...); msg != nil {
+				response.Message = msg.Content
+			}
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// toSegmentConflictResponse returns the message code for a duplicate or overlap and the clashing segment
+func (h *handler) toSegmentConflictResponse(err *domain.SegmentConflictError) (string, SegmentConflictResponse) {
+	code := domain.MsgFlightOverlappingSegment
+	if err.Kind == domain.SegmentConflictDuplicate {
+		code = domain.MsgFlightDuplicateSegment
+	}
+	encodedID, _ := h.EncodeID(err.DetailID)
+	return code, SegmentConflictResponse{Kind: string(err.Kind), ConflictingDetailID: encodedID}
+}
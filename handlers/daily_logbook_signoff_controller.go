@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// SubmitDailyLogbook godoc
+// @Summary      Submit a daily logbook for signature
+// @Description  Moves a draft logbook to SUBMITTED; its segments can no longer be edited unless it is reopened
+// @Tags         DailyLogbooks
+// @Produce      json
+// @Param        id   path      string  true  "Daily Logbook ID (obfuscated ID)"
+// @Success      200  {object}  DailyLogbookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/{id}/submit [patch]
+// @Security     BearerAuth
+func (h *handler) SubmitDailyLogbook() gin.HandlerFunc {
+	return h.transitionDailyLogbook(domain.LogbookStateSubmitted)
+}
+
+// ReopenDailyLogbook godoc
+// @Summary      Reopen a submitted daily logbook
+// @Description  Moves a submitted logbook back to DRAFT so its segments can be edited again
+// @Tags         DailyLogbooks
+// @Produce      json
+// @Param        id   path      string  true  "Daily Logbook ID (obfuscated ID)"
+// @Success      200  {object}  DailyLogbookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/{id}/reopen [patch]
+// @Security     BearerAuth
+func (h *handler) ReopenDailyLogbook() gin.HandlerFunc {
+	return h.transitionDailyLogbook(domain.LogbookStateDraft)
+}
+
+// SignDailyLogbook godoc
+// @Summary      Sign a daily logbook
+// @Description  Certifies a submitted logbook. Signed logbooks are immutable: corrections go through amendments, which must be signed again
+// @Tags         DailyLogbooks
+// @Produce      json
+// @Param        id   path      string  true  "Daily Logbook ID (obfuscated ID)"
+// @Success      200  {object}  DailyLogbookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/{id}/sign [patch]
+// @Security     BearerAuth
+func (h *handler) SignDailyLogbook() gin.HandlerFunc {
+	return h.transitionDailyLogbook(domain.LogbookStateSigned)
+}
+
+// LockDailyLogbook godoc
+// @Summary      Lock a signed daily logbook
+// @Description  Makes a signed logbook final; locked logbooks accept no amendments
+// @Tags         DailyLogbooks
+// @Produce      json
+// @Param        id   path      string  true  "Daily Logbook ID (obfuscated ID)"
+// @Success      200  {object}  DailyLogbookResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /daily-logbooks/{id}/lock [patch]
+// @Security     BearerAuth
+func (h *handler) LockDailyLogbook() gin.HandlerFunc {
+	return h.transitionDailyLogbook(domain.LogbookStateLocked)
+}
+
+// transitionDailyLogbook moves one of the authenticated employee's logbooks to a sign-off state
+func (h *handler) transitionDailyLogbook(to domain.LogbookState) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Get authenticated employee from context
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			c.Error(domain.ErrUserNotFound)
+			return
+		}
+
+		inputID := c.Param("id")
+		if inputID == "" {
+			c.Error(domain.ErrInvalidID)
+			return
+		}
+
+		// Resolve ID (accepts both UUID and obfuscated ID)
+		logbookUUID, responseID := h.resolveID(inputID)
+		if logbookUUID == "" {
+			h.HandleIDDecodingError(c, inputID, domain.ErrInvalidID)
+			return
+		}
+
+		// Verify logbook exists and belongs to employee
+		existingLogbook, err := h.DailyLogbookInteractor.GetDailyLogbookByID(c.Request.Context(), logbookUUID)
+		if err != nil {
+			Logger.Error(logger.LogDailyLogbookGetError, "logbook_id", logbookUUID, "error", err)
+			c.Error(err)
+			return
+		}
+
+		if existingLogbook.EmployeeID != employee.ID {
+			c.Error(domain.ErrDailyLogbookUnauthorized)
+			return
+		}
+
+		logbook, err := h.DailyLogbookInteractor.TransitionDailyLogbook(c.Request.Context(), logbookUUID, employee.ID, to)
+		if err != nil {
+			Logger.Error(logger.LogDailyLogbookTransitionError, "logbook_id", logbookUUID, "to", to, "error", err)
+			c.Error(err)
+			return
+		}
+
+		// Encode employee ID for response
+		encodedEmployeeID, _ := h.EncodeID(employee.ID)
+
+		baseURL := GetBaseURL(c)
+		response := FromDomainDailyLogbook(logbook, responseID, encodedEmployeeID)
+		response.Links = BuildDailyLogbookStateLinks(baseURL, responseID, logbook.CurrentState())
+
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// DutyPeriodRequest represents the request body for recording or updating a duty period
+type DutyPeriodRequest struct {
+	ReportTime  string   `json:"report_time" binding:"required"`  // RFC3339, e.g. 2026-03-01T05:30:00Z
+	ReleaseTime string   `json:"release_time" binding:"required"` // RFC3339
+	SplitDuty   bool     `json:"split_duty"`                      // Duty interrupted by a break on the ground
+	Standby     bool     `json:"standby"`                         // Airport or home standby counted as duty
+	SegmentIDs  []string `json:"segment_ids,omitempty"`           // Segments flown in the duty period (obfuscated or UUID)
+	Remarks     *string  `json:"remarks,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields and drops empty and repeated segment IDs
+func (r *DutyPeriodRequest) Sanitize() {
+	r.ReportTime = TrimString(r.ReportTime)
+	r.ReleaseTime = TrimString(r.ReleaseTime)
+	r.Remarks = TrimStringPtr(r.Remarks)
+
+	seen := make(map[string]bool, len(r.SegmentIDs))
+	segmentIDs := make([]string, 0, len(r.SegmentIDs))
+	for _, id := range r.SegmentIDs {
+		if id = TrimString(id); id != "" && !seen[id] {
+			seen[id] = true
+			segmentIDs = append(segmentIDs, id)
+		}
+	}
+	r.SegmentIDs = segmentIDs
+}
+
+// ToDomain converts the request to a domain duty period of the employee; segment IDs must already be
+// resolved. Returns ErrDutyPeriodInvalid when a time is not in RFC3339 format.
+func (r *DutyPeriodRequest) ToDomain(employeeID string) (domain.DutyPeriod, error) {
+	report, err := time.Parse(time.RFC3339, r.ReportTime)
+	if err != nil {
+		return domain.DutyPeriod{}, domain.ErrDutyPeriodInvalid
+	}
+	release, err := time.Parse(time.RFC3339, r.ReleaseTime)
+	if err != nil {
+		return domain.DutyPeriod{}, domain.ErrDutyPeriodInvalid
+	}
+
+	return domain.DutyPeriod{
+		EmployeeID:  employeeID,
+		ReportTime:  report.UTC(),
+		ReleaseTime: release.UTC(),
+		SplitDuty:   r.SplitDuty,
+		Standby:     r.Standby,
+		SegmentIDs:  r.SegmentIDs,
+		Remarks:     r.Remarks,
+	}, nil
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// DutyPeriodSegmentResponse represents a segment linked to a duty period
+type DutyPeriodSegmentResponse struct {
+	ID             string `json:"id"`
+	FlightNumber   string `json:"flight_number"`
+	FlightRealDate string `json:"flight_real_date"`
+	RouteCode      string `json:"route_code,omitempty"`
+	OutTime        string `json:"out_time"`   // RFC3339, UTC
+	InTime         string `json:"in_time"`    // RFC3339, UTC
+	BlockTime      string `json:"block_time"` // HH:MM
+}
+
+// DutyPeriodResponse represents a duty period with its computed lengths
+type DutyPeriodResponse struct {
+	ID               string                      `json:"id"`
+	ReportTime       string                      `json:"report_time"`  // RFC3339, UTC
+	ReleaseTime      string                      `json:"release_time"` // RFC3339, UTC
+	SplitDuty        bool                        `json:"split_duty"`
+	Standby          bool                        `json:"standby"`
+	DutyTime         string                      `json:"duty_time"`             // HH:MM, report to release
+	FlightDutyPeriod string                      `json:"flight_duty_period"`    // HH:MM, report to the IN of the last segment
+	BlockTime        string                      `json:"block_time"`            // HH:MM, block time of the segments
+	RestBefore       *string                     `json:"rest_before,omitempty"` // HH:MM since the release of the previous duty period
+	Segments         []DutyPeriodSegmentResponse `json:"segments"`
+	Remarks          *string                     `json:"remarks,omitempty"`
+	CreatedAt        string                      `json:"created_at"`
+	UpdatedAt        string                      `json:"updated_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toDutyPeriodResponse maps a duty period, encoding its IDs and those of its segments
+func (h *handler) toDutyPeriodResponse(p *domain.DutyPeriod) DutyPeriodResponse {
+	id, _ := h.EncodeID(p.ID)
+
+	segments := make([]DutyPeriodSegmentResponse, 0, len(p.Segments))
+	for _, s := range p.Segments {
+		segmentID, _ := h.EncodeID(s.ID)
+		segments = append(segments, DutyPeriodSegmentResponse{
+			ID:             segmentID,
+			FlightNumber:   s.FlightNumber,
+			FlightRealDate: s.FlightRealDate,
+			RouteCode:      s.RouteCode,
+			OutTime:        s.Out.UTC().Format(time.RFC3339),
+			InTime:         s.In.UTC().Format(time.RFC3339),
+			BlockTime:      domain.FormatFlightDuration(s.BlockTime()),
+		})
+	}
+
+	response := DutyPeriodResponse{
+		ID:               id,
+		ReportTime:       p.ReportTime.UTC().Format(time.RFC3339),
+		ReleaseTime:      p.ReleaseTime.UTC().Format(time.RFC3339),
+		SplitDuty:        p.SplitDuty,
+		Standby:          p.Standby,
+		DutyTime:         domain.FormatFlightDuration(p.DutyTime()),
+		FlightDutyPeriod: domain.FormatFlightDuration(p.FlightDutyPeriod()),
+		BlockTime:        domain.FormatFlightDuration(p.BlockTime()),
+		Segments:         segments,
+		Remarks:          p.Remarks,
+		CreatedAt:        p.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:        p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if p.RestBefore != nil {
+		rest := domain.FormatFlightDuration(*p.RestBefore)
+		response.RestBefore = &rest
+	}
+	return response
+}
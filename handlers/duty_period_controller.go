@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /duty-periods
+// Listar periodos de servicio del empleado
+// ============================================
+
+// ListDutyPeriods lists the duty periods of the authenticated employee
+// @Summary List duty periods
+// @Description Duty periods in chronological order, optionally filtered by UTC report date, with their segments, duty time, flight duty period and the rest since the previous duty period
+// @Tags DutyPeriods
+// @Produce json
+// @Param from query string false "Start report date (YYYY-MM-DD, inclusive)"
+// @Param to query string false "End report date (YYYY-MM-DD, inclusive)"
+// @Success 200 {object} middleware.APIResponse{data=[]DutyPeriodResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /duty-periods [get]
+// @Security BearerAuth
+func (h *handler) ListDutyPeriods() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogDutyPeriodError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		from, ok := parseDateQuery(c, "from")
+		if !ok {
+			log.Warn(logger.LogDutyPeriodError, "error", "invalid from date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		to, ok := parseDateQuery(c, "to")
+		if !ok {
+			log.Warn(logger.LogDutyPeriodError, "error", "invalid to date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if from != nil && to != nil && from.After(*to) {
+			log.Warn(logger.LogDutyPeriodError, "error", "from date after to date")
+			h.Response.Error(c, domain.MsgValStartDateAfterEndDate)
+			return
+		}
+
+		filter := domain.DutyPeriodFilter{EmployeeID: employee.ID, From: from, To: to}
+		periods, err := h.DutyPeriodInteractor.ListDutyPeriods(c.Request.Context(), traceID, filter)
+		if err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			h.Response.Error(c, domain.MsgDutyPeriodErr)
+			return
+		}
+
+		response := make([]DutyPeriodResponse, 0, len(periods))
+		for i := range periods {
+			response = append(response, h.toDutyPeriodResponse(&periods[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgDutyPeriodListOK, response)
+	}
+}
+
+// ============================================
+// GET /duty-periods/:id
+// Consultar periodo de servicio
+// ============================================
+
+// GetDutyPeriod returns a duty period of the authenticated employee
+// @Summary Get duty period
+// @Tags DutyPeriods
+// @Produce json
+// @Param id path string true "Duty period ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=DutyPeriodResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /duty-periods/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetDutyPeriod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, periodUUID, ok := h.authorizeDutyPeriod(c)
+		if !ok {
+			return
+		}
+
+		period, err := h.DutyPeriodInteractor.GetDutyPeriod(c.Request.Context(), traceID, periodUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			h.Response.Error(c, dutyPeriodErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgDutyPeriodGetOK, h.toDutyPeriodResponse(period))
+	}
+}
+
+// ============================================
+// POST /duty-periods
+// Registrar periodo de servicio
+// ============================================
+
+// CreateDutyPeriod records a duty period for the authenticated employee
+// @Summary Create duty period
+// @Description Release must follow report within 24 hours and the period may not overlap another one. Linked segments must be the employee's, not linked to another duty period and flown between report and release, and their logbooks must be drafts. The duty period replaces the duty time of its segments in the FTL duty limits.
+// @Tags DutyPeriods
+// @Accept json
+// @Produce json
+// @Param body body DutyPeriodRequest true "Duty period"
+// @Success 201 {object} middleware.APIResponse{data=DutyPeriodResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /duty-periods [post]
+// @Security BearerAuth
+func (h *handler) CreateDutyPeriod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogDutyPeriodError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		period, ok := h.bindDutyPeriodRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		created, err := h.DutyPeriodInteractor.CreateDutyPeriod(c.Request.Context(), traceID, period)
+		if err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			h.Response.Error(c, dutyPeriodErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgDutyPeriodCreated, h.toDutyPeriodResponse(created))
+	}
+}
+
+// ============================================
+// PUT /duty-periods/:id
+// Actualizar periodo de servicio
+// ============================================
+
+// UpdateDutyPeriod replaces the values and segments of a duty period of the authenticated employee
+// @Summary Update duty period
+// @Description Segments left out of segment_ids are unlinked from the duty period. Segments are only linked or unlinked while their logbook is a draft.
+// @Tags DutyPeriods
+// @Accept json
+// @Produce json
+// @Param id path string true "Duty period ID (obfuscated or UUID)"
+// @Param body body DutyPeriodRequest true "Duty period"
+// @Success 200 {object} middleware.APIResponse{data=DutyPeriodResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /duty-periods/{id} [put]
+// @Security BearerAuth
+func (h *handler) UpdateDutyPeriod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, periodUUID, ok := h.authorizeDutyPeriod(c)
+		if !ok {
+			return
+		}
+
+		period, ok := h.bindDutyPeriodRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		updated, err := h.DutyPeriodInteractor.UpdateDutyPeriod(c.Request.Context(), traceID, periodUUID, employee.ID, period)
+		if err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			h.Response.Error(c, dutyPeriodErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgDutyPeriodUpdated, h.toDutyPeriodResponse(updated))
+	}
+}
+
+// ============================================
+// DELETE /duty-periods/:id
+// Eliminar periodo de servicio
+// ============================================
+
+// DeleteDutyPeriod removes a duty period of the authenticated employee
+// @Summary Delete duty period
+// @Description The segments of the duty period are unlinked, not deleted; rejected when one of them belongs to a logbook that is no longer a draft.
+// @Tags DutyPeriods
+// @Produce json
+// @Param id path string true "Duty period ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /duty-periods/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeleteDutyPeriod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, periodUUID, ok := h.authorizeDutyPeriod(c)
+		if !ok {
+			return
+		}
+
+		if err := h.DutyPeriodInteractor.DeleteDutyPeriod(c.Request.Context(), traceID, periodUUID, employee.ID); err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			h.Response.Error(c, dutyPeriodErrorMessage(err))
+			return
+		}
+
+		h.Response.Success(c, domain.MsgDutyPeriodDeleted)
+	}
+}
+
+// bindDutyPeriodRequest binds and sanitizes the request body, resolves the segment IDs and parses the
+// report and release times, writing the error response when it cannot
+func (h *handler) bindDutyPeriodRequest(c *gin.Context, employeeID string) (domain.DutyPeriod, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	var req DutyPeriodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(logger.LogDutyPeriodError, "error", err)
+		h.Response.Error(c, domain.MsgValJSONInvalid)
+		return domain.DutyPeriod{}, false
+	}
+	req.Sanitize()
+
+	for i, id := range req.SegmentIDs {
+		segmentUUID, _ := h.resolveID(id)
+		if segmentUUID == "" {
+			log.Warn(logger.LogDutyPeriodError, "error", "invalid segment_ids", "id", id)
+			h.Response.Error(c, domain.MsgDutyPeriodInvalidSegment)
+			return domain.DutyPeriod{}, false
+		}
+		req.SegmentIDs[i] = segmentUUID
+	}
+
+	period, err := req.ToDomain(employeeID)
+	if err != nil {
+		log.Warn(logger.LogDutyPeriodError, "error", err)
+		h.Response.Error(c, dutyPeriodErrorMessage(err))
+		return domain.DutyPeriod{}, false
+	}
+	return period, true
+}
+
+// authorizeDutyPeriod resolves the :id duty period of the authenticated employee, writing the error
+// response when it cannot; ownership is checked by the interactor
+func (h *handler) authorizeDutyPeriod(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogDutyPeriodError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	periodUUID, _ := h.resolveID(c.Param("id"))
+	if periodUUID == "" {
+		log.Warn(logger.LogDutyPeriodError, "error", "invalid duty period ID")
+		h.Response.Error(c, domain.MsgDutyPeriodNotFound)
+		return nil, "", false
+	}
+	return employee, periodUUID, true
+}
+
+// dutyPeriodErrorMessage maps a duty period error to its message code
+func dutyPeriodErrorMessage(err error) string {
+	switch err {
+	case domain.ErrDutyPeriodNotFound:
+		return domain.MsgDutyPeriodNotFound
+	case domain.ErrDutyPeriodUnauthorized:
+		return domain.MsgDutyPeriodUnauthorized
+	case domain.ErrDutyPeriodInvalid:
+		return domain.MsgDutyPeriodInvalid
+	case domain.ErrDutyPeriodOverlap:
+		return domain.MsgDutyPeriodOverlap
+	case domain.ErrDutyPeriodInvalidSegment:
+		return domain.MsgDutyPeriodInvalidSegment
+	case domain.ErrDutyPeriodSegmentOutside:
+		return domain.MsgDutyPeriodSegmentOutside
+	case domain.ErrDailyLogbookSigned:
+		return domain.MsgDailyLogbookSigned
+	default:
+		return domain.MsgDutyPeriodErr
+	}
+}
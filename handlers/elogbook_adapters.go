@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// THIRD-PARTY ELECTRONIC LOGBOOK ADAPTERS
+// ============================================
+
+// elogbookRequiredColumns must be found (through the adapter or the request's field map) in third-party files.
+// Role and time reference may come from the request defaults instead.
+var elogbookRequiredColumns = []string{
+	"flight_number", "flight_real_date", "origin", "destination", "license_plate",
+	"out_time", "takeoff_time", "landing_time", "in_time",
+}
+
+// elogbookFields are the canonical columns a field map may target
+var elogbookFields = map[string]bool{
+	"flight_number": true, "flight_real_date": true, "origin": true, "destination": true, "route_code": true,
+	"airline_code": true, "license_plate": true, "out_time": true, "takeoff_time": true, "landing_time": true,
+	"in_time": true, "time_reference": true, "pilot_role": true, "pilot_flying": true, "companion_name": true,
+	"passengers": true, "duty_time": true, "approach_type": true, "flight_type": true, "wet_lease": true,
+}
+
+// elogbookAdapters maps each supported format to its column layout
+var elogbookAdapters = map[domain.ElogbookFormat]importAdapter{
+	// ForeFlight: "Flights Table" section of the logbook CSV export, preceded by the "Aircraft Table"
+	domain.ElogbookFormatForeFlight: {
+		Comma: ',',
+		Columns: map[string]string{
+			"flightnumber": "flight_number",
+			"date":         "flight_real_date",
+			"from":         "origin",
+			"to":           "destination",
+			"aircraftid":   "license_plate",
+			"timeout":      "out_time",
+			"timeoff":      "takeoff_time",
+			"timeon":       "landing_time",
+			"timein":       "in_time",
+			"person1":      "companion_name",
+		},
+		Required:      elogbookRequiredColumns,
+		DateLayouts:   []string{"2006-01-02", "01/02/2006"},
+		NormalizeTime: true,
+		ScanForHeader: true,
+		TimeReference: string(domain.TimeReferenceUTC),
+	},
+	// LogTen Pro: tab separated export with display column names
+	domain.ElogbookFormatLogTen: {
+		Comma: '\t',
+		Columns: map[string]string{
+			"flight #":      "flight_number",
+			"flight number": "flight_number",
+			"date":          "flight_real_date",
+			"flight date":   "flight_real_date",
+			"from":          "origin",
+			"to":            "destination",
+			"aircraft id":   "license_plate",
+			"out":           "out_time",
+			"off":           "takeoff_time",
+			"on":            "landing_time",
+			"in":            "in_time",
+			"pilot flying":  "pilot_flying",
+			"pax":           "passengers",
+		},
+		Required:      elogbookRequiredColumns,
+		DateLayouts:   []string{"2006-01-02", "01/02/2006", "1/2/2006", "02 Jan 2006"},
+		NormalizeTime: true,
+		TimeReference: string(domain.TimeReferenceUTC),
+	},
+	// mccPILOTLOG: CSV export, times in UTC
+	domain.ElogbookFormatMccPilotLog: {
+		Comma: ',',
+		Columns: map[string]string{
+			"flightnumber": "flight_number",
+			"mcc_date":     "flight_real_date",
+			"af_dep":       "origin",
+			"af_arr":       "destination",
+			"ac_reg":       "license_plate",
+			"time_dep":     "out_time",
+			"time_to":      "takeoff_time",
+			"time_ldg":     "landing_time",
+			"time_arr":     "in_time",
+			"pf":           "pilot_flying",
+			"pax":          "passengers",
+			"pilot2_name":  "companion_name",
+		},
+		Required:      elogbookRequiredColumns,
+		DateLayouts:   []string{"2006-01-02", "02/01/2006"},
+		NormalizeTime: true,
+		TimeReference: string(domain.TimeReferenceUTC),
+	},
+	// Custom: every column comes from the request's field map
+	domain.ElogbookFormatCustom: {
+		Comma:         ',',
+		Columns:       map[string]string{},
+		Required:      elogbookRequiredColumns,
+		DateLayouts:   []string{"2006-01-02"},
+		NormalizeTime: true,
+	},
+}
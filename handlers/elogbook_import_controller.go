@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportMappingRequest is a mapping decision for an unresolved reference of a previous preview
+type ImportMappingRequest struct {
+	Kind        string `json:"kind"`         // AIRPORT, AIRCRAFT or ROUTE
+	SourceValue string `json:"source_value"` // Value as reported in "unresolved"
+	TargetID    string `json:"target_id"`    // Obfuscated airport, aircraft registration or airline route ID
+}
+
+// ImportMappingResponse represents a remembered mapping decision
+type ImportMappingResponse struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	SourceValue string `json:"source_value"`
+	TargetID    string `json:"target_id"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// ============================================
+// POST /daily-logbooks/import/elogbook
+// Importación desde bitácoras electrónicas de terceros (ForeFlight, LogTen Pro, mccPILOTLOG)
+// ============================================
+
+// ImportElogbook imports flight segments from a third-party electronic logbook export
+// @Summary Import third-party electronic logbook
+// @Description Imports an export of ForeFlight, LogTen Pro or mccPILOTLOG (or any CSV described by field_map). Airports, aircraft and routes that cannot be resolved are returned in "unresolved" and their rows are skipped; send the decisions in "mappings" on the next request and they are remembered for future imports. With dry_run=true the file is only previewed.
+// @Tags DailyLogbookDetails
+// @Accept multipart/form-data
+// @Produce json
+// @Param format query string true "foreflight, logten, mccpilotlog or custom"
+// @Param dry_run query bool false "Preview only, do not save"
+// @Param file formData file true "Export file"
+// @Param field_map formData string false "JSON object: canonical column -> header in the file (e.g. {\"flight_number\":\"Route\"})"
+// @Param mappings formData string false "JSON array of ImportMappingRequest"
+// @Param pilot_role formData string false "Pilot role for rows without one (PF, PM, PFTO, PFL)"
+// @Param time_reference formData string false "UTC or LOCAL, defaults to the format's reference"
+// @Success 200 {object} middleware.APIResponse{data=ImportResponse} "Preview"
+// @Success 201 {object} middleware.APIResponse{data=ImportResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 422 {object} middleware.APIResponse{data=ImportResponse}
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/import/elogbook [post]
+// @Security BearerAuth
+func (h *handler) ImportElogbook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookImportError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		format := strings.ToLower(strings.TrimSpace(c.Query("format")))
+		adapter, ok := elogbookAdapters[domain.ElogbookFormat(format)]
+		if !ok {
+			log.Warn(logger.LogLogbookImportError, "error", "unsupported format", "format", format)
+			h.Response.Error(c, domain.MsgElogbookInvalidFormat, format)
+			return
+		}
+		dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+
+		// Field map: canonical column -> header in the file
+		var fieldMap map[string]string
+		if raw := c.PostForm("field_map"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &fieldMap); err != nil {
+				h.Response.Error(c, domain.MsgElogbookInvalidFieldMap, "field_map")
+				return
+			}
+			for column := range fieldMap {
+				if !elogbookFields[column] {
+					h.Response.Error(c, domain.MsgElogbookInvalidFieldMap, column)
+					return
+				}
+			}
+		}
+
+		// Mapping decisions for references reported as unresolved
+		var mappingRequests []ImportMappingRequest
+		if raw := c.PostForm("mappings"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &mappingRequests); err != nil {
+				h.Response.Error(c, domain.MsgElogbookInvalidMapping, "mappings")
+				return
+			}
+		}
+		mappings := make([]domain.ImportMapping, 0, len(mappingRequests))
+		for _, m := range mappingRequests {
+			kind := strings.ToUpper(strings.TrimSpace(m.Kind))
+			targetID, _ := h.resolveID(m.TargetID)
+			if !domain.IsValidImportMappingKind(kind) || targetID == "" || strings.TrimSpace(m.SourceValue) == "" {
+				h.Response.Error(c, domain.MsgElogbookInvalidMapping, m.SourceValue)
+				return
+			}
+			mappings = append(mappings, domain.ImportMapping{Kind: domain.ImportMappingKind(kind), SourceValue: m.SourceValue, TargetID: targetID})
+		}
+
+		defaults := importDefaults{
+			PilotRole:     strings.ToUpper(strings.TrimSpace(c.PostForm("pilot_role"))),
+			TimeReference: strings.ToUpper(strings.TrimSpace(c.PostForm("time_reference"))),
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil {
+			h.Response.Error(c, domain.MsgImportInvalidFile, "file")
+			return
+		}
+		if file.Size > maxImportFileSize {
+			h.Response.Error(c, domain.MsgImportInvalidFile, "file too large")
+			return
+		}
+		f, err := file.Open()
+		if err != nil {
+			log.Warn(logger.LogLogbookImportError, "error", err)
+			h.Response.Error(c, domain.MsgImportInvalidFile, "file could not be read")
+			return
+		}
+		defer f.Close()
+
+		rows, err := parseImportFile(f, adapter, fieldMap, defaults)
+		if err != nil {
+			log.Warn(logger.LogLogbookImportError, "error", err)
+			if err == domain.ErrImportTooManyRows {
+				h.Response.Error(c, domain.MsgImportTooManyRows, strconv.Itoa(domain.MaxLogbookImportRows))
+				return
+			}
+			var fileErr *ImportFileError
+			if errors.As(err, &fileErr) {
+				h.Response.Error(c, domain.MsgImportInvalidFile, fileErr.Detail)
+				return
+			}
+			h.Response.Error(c, domain.MsgImportInvalidFile, err.Error())
+			return
+		}
+
+		result, err := h.DailyLogbookDetailInteractor.ImportElogbook(c.Request.Context(), traceID, employee.ID, domain.ElogbookImport{
+			Format:   domain.ElogbookFormat(format),
+			Rows:     rows,
+			Mappings: mappings,
+			DryRun:   dryRun,
+		})
+		if err != nil {
+			var fieldErr *domain.ImportFieldError
+			if errors.As(err, &fieldErr) && errors.Is(err, domain.ErrImportInvalidMapping) {
+				h.Response.Error(c, domain.MsgElogbookInvalidMapping, fieldErr.Field)
+				return
+			}
+			if err == domain.ErrImportTooManyRows {
+				h.Response.Error(c, domain.MsgImportTooManyRows, strconv.Itoa(domain.MaxLogbookImportRows))
+				return
+			}
+			log.Error(logger.LogLogbookImportError, "error", err)
+			h.Response.Error(c, domain.MsgImportErr)
+			return
+		}
+
+		response := h.toImportResponse(result)
+		if response.InvalidRows > 0 {
+			h.Response.ErrorWithData(c, domain.MsgImportValidationErr, response, strconv.Itoa(response.InvalidRows))
+			return
+		}
+		if result.DryRun {
+			log.Info(logger.LogElogbookImportPreviewOK, "format", format, "rows", response.TotalRows, "pending_rows", response.PendingRows)
+			h.Response.SuccessWithData(c, domain.MsgElogbookPreviewOK, response, strconv.Itoa(response.PendingRows))
+			return
+		}
+
+		log.Info(logger.LogElogbookImportOK, "employee_id", employee.ID, "format", format, "rows", response.ValidRows, "pending_rows", response.PendingRows)
+		h.Response.SuccessWithData(c, domain.MsgElogbookImportOK, response, strconv.Itoa(response.PendingRows))
+	}
+}
+
+// ============================================
+// GET /employees/me/import-mappings
+// ============================================
+
+// ListMyImportMappings lists the mapping decisions remembered for the authenticated employee
+// @Summary List remembered import mappings
+// @Description Returns the airport, aircraft and route mapping decisions applied automatically on electronic logbook imports
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Success 200 {object} middleware.APIResponse{data=[]ImportMappingResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/import-mappings [get]
+// @Security BearerAuth
+func (h *handler) ListMyImportMappings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogImportMappingError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		mappings, err := h.DailyLogbookDetailInteractor.ListImportMappings(c.Request.Context(), traceID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogImportMappingError, "error", err)
+			h.Response.Error(c, domain.MsgImportMappingErr)
+			return
+		}
+
+		response := make([]ImportMappingResponse, 0, len(mappings))
+		for _, m := range mappings {
+			id, _ := h.EncodeID(m.ID)
+			targetID, _ := h.EncodeID(m.TargetID)
+			response = append(response, ImportMappingResponse{
+				ID:          id,
+				Kind:        string(m.Kind),
+				SourceValue: m.SourceValue,
+				TargetID:    targetID,
+				UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+
+		log.Info(logger.LogImportMappingListOK, "employee_id", employee.ID, "count", len(response))
+		h.Response.SuccessWithData(c, domain.MsgImportMappingListOK, response)
+	}
+}
+
+// ============================================
+// DELETE /employees/me/import-mappings/:id
+// ============================================
+
+// DeleteMyImportMapping forgets one of the authenticated employee's mapping decisions
+// @Summary Delete remembered import mapping
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Mapping ID (obfuscated)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/import-mappings/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeleteMyImportMapping() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogImportMappingError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		id, _ := h.resolveID(c.Param("id"))
+		if id == "" {
+			h.Response.Error(c, domain.MsgImportMappingNotFound)
+			return
+		}
+
+		err := h.DailyLogbookDetailInteractor.DeleteImportMapping(c.Request.Context(), traceID, id, employee.ID)
+		if err != nil {
+			if err == domain.ErrImportMappingNotFound {
+				h.Response.Error(c, domain.MsgImportMappingNotFound)
+				return
+			}
+			log.Error(logger.LogImportMappingError, "error", err)
+			h.Response.Error(c, domain.MsgImportMappingErr)
+			return
+		}
+
+		log.Info(logger.LogImportMappingDeleteOK, "mapping_id", id)
+		h.Response.Success(c, domain.MsgImportMappingDeleted)
+	}
+}
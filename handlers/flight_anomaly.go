@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// FlightTimeAnomalyResponse represents a logged time outside the tolerance around the route estimate
+type FlightTimeAnomalyResponse struct {
+	Metric    string `json:"metric"`    // BLOCK_TIME or AIR_TIME
+	Logged    string `json:"logged"`    // HH:MM
+	Estimated string `json:"estimated"` // HH:MM
+	Deviation string `json:"deviation"` // +HH:MM or -HH:MM
+}
+
+// FlightAnomalyReportResponse represents the response for GET /employees/me/flight-anomalies
+type FlightAnomalyReportResponse struct {
+	From               string                       `json:"from"` // YYYY-MM-DD
+	To                 string                       `json:"to"`   // YYYY-MM-DD
+	ToleranceRatio     float64                      `json:"tolerance_ratio"`
+	MinDeviation       string                       `json:"min_deviation"` // HH:MM
+	SegmentCount       int                          `json:"segment_count"`
+	SuspiciousSegments int                          `json:"suspicious_segments"`
+	Segments           []DailyLogbookDetailResponse `json:"segments"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// FromDomainFlightTimeAnomaly converts an anomaly to its response DTO
+func FromDomainFlightTimeAnomaly(a domain.FlightTimeAnomaly) FlightTimeAnomalyResponse {
+	deviation := a.Deviation()
+	sign := "+"
+	if deviation < 0 {
+		sign = "-"
+		deviation = -deviation
+	}
+	return FlightTimeAnomalyResponse{
+		Metric:    string(a.Metric),
+		Logged:    domain.FormatFlightDuration(a.Logged),
+		Estimated: domain.FormatFlightDuration(a.Estimated),
+		Deviation: sign + domain.FormatFlightDuration(deviation),
+	}
+}
+
+// toFlightAnomalyReportResponse converts the report, encoding the IDs of its segments
+func (h *handler) toFlightAnomalyReportResponse(report *domain.FlightAnomalyReport) FlightAnomalyReportResponse {
+	response := FlightAnomalyReportResponse{
+		From:               report.From.Format("2006-01-02"),
+		To:                 report.To.Format("2006-01-02"),
+		ToleranceRatio:     report.Tolerance.Ratio,
+		MinDeviation:       domain.FormatFlightDuration(report.Tolerance.Min),
+		SegmentCount:       report.SegmentCount,
+		SuspiciousSegments: len(report.Segments),
+		Segments:           make([]DailyLogbookDetailResponse, 0, len(report.Segments)),
+	}
+	for i := range report.Segments {
+		d := &report.Segments[i]
+		encodedID, _ := h.EncodeID(d.ID)
+		encodedLogbookID, _ := h.EncodeID(d.DailyLogbookID)
+		encodedRouteID, _ := h.EncodeID(d.AirlineRouteID)
+		encodedAircraftID, _ := h.EncodeID(d.ActualAircraftRegistrationID)
+		response.Segments = append(response.Segments, FromDomainDailyLogbookDetail(d, encodedID, encodedLogbookID, encodedRouteID, encodedAircraftID))
+	}
+	return response
+}
+
+// defaultAnomalyReportWindow is the range reported when no from date is given
+const defaultAnomalyReportWindow = 365 * 24 * time.Hour
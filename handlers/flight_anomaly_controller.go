@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/flight-anomalies
+// Segmentos con tiempos de bloque/vuelo fuera del estimado de la ruta
+// ============================================
+
+// GetMyFlightAnomalies lists the authenticated employee's segments whose block or air time deviates from the route estimate
+// @Summary List suspicious flight times
+// @Description Compares each segment's block and air time with the route's estimated flight time and returns the ones outside the configured tolerance (the larger of tolerance_ratio × estimate and min_deviation). Routes without an estimate are not checked.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param from query string false "First flight date (YYYY-MM-DD), defaults to one year before to"
+// @Param to query string false "Last flight date (YYYY-MM-DD), defaults to today (UTC)"
+// @Success 200 {object} middleware.APIResponse{data=FlightAnomalyReportResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/flight-anomalies [get]
+// @Security BearerAuth
+func (h *handler) GetMyFlightAnomalies() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogFlightAnomalyReportError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		from, okFrom := parseDateQuery(c, "from")
+		to, okTo := parseDateQuery(c, "to")
+		if !okFrom || !okTo {
+			log.Warn(logger.LogFlightAnomalyReportError, "error", "invalid date range")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if to == nil {
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			to = &today
+		}
+		if from == nil {
+			start := to.Add(-defaultAnomalyReportWindow)
+			from = &start
+		}
+		if from.After(*to) {
+			log.Warn(logger.LogFlightAnomalyReportError, "error", "from after to")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+
+		report, err := h.DailyLogbookDetailInteractor.GetFlightAnomalyReport(c.Request.Context(), traceID, employee.ID, *from, *to)
+		if err != nil {
+			log.Error(logger.LogFlightAnomalyReportError, "error", err)
+			h.Response.Error(c, domain.MsgFlightAnomalyReportErr)
+			return
+		}
+
+		log.Info(logger.LogFlightAnomalyReportOK, "employee_id", employee.ID, "suspicious", len(report.Segments))
+		h.Response.SuccessWithData(c, domain.MsgFlightAnomalyReportOK, h.toFlightAnomalyReportResponse(report))
+	}
+}
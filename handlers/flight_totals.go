@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// FlightTotalsGroupResponse represents the totals of one group of segments
+type FlightTotalsGroupResponse struct {
+	Month          *string `json:"month,omitempty"`
+	AircraftModel  *string `json:"aircraft_model,omitempty"`
+	AircraftFamily *string `json:"aircraft_family,omitempty"`
+	Airline        *string `json:"airline,omitempty"`
+	PilotRole      *string `json:"pilot_role,omitempty"`
+	PilotFunction  *string `json:"pilot_function,omitempty"`
+	FlightType     *string `json:"flight_type,omitempty"`
+	ApproachType   *string `json:"approach_type,omitempty"`
+	SegmentCount   int     `json:"segment_count"`
+	BlockTime      string  `json:"block_time"` // HH:MM
+	AirTime        string  `json:"air_time"`   // HH:MM
+	DutyTime       string  `json:"duty_time"`  // HH:MM
+	NightTime      string  `json:"night_time"` // HH:MM
+
+	LogbookColumns LogbookColumnsResponse `json:"logbook_columns"`
+}
+
+// LogbookColumnsResponse breaks block time down into the standard pilot logbook columns (HH:MM each)
+type LogbookColumnsResponse struct {
+	SinglePilotSE string `json:"single_pilot_se"` // Single-pilot, single-engine aircraft
+	SinglePilotME string `json:"single_pilot_me"` // Single-pilot, multi-engine aircraft
+	MultiPilot    string `json:"multi_pilot"`
+	Turbine       string `json:"turbine"`
+	PIC           string `json:"pic"`
+	SIC           string `json:"sic"`
+	Dual          string `json:"dual"`
+	Instructor    string `json:"instructor"`
+	PICUS         string `json:"picus"`
+	IFR           string `json:"ifr"`
+}
+
+// FlightTotalsResponse represents the response for GET /employees/me/flight-totals
+type FlightTotalsResponse struct {
+	From    string                      `json:"from,omitempty"`
+	To      string                      `json:"to,omitempty"`
+	GroupBy []string                    `json:"group_by,omitempty"`
+	Totals  FlightTotalsGroupResponse   `json:"totals"`
+	Groups  []FlightTotalsGroupResponse `json:"groups,omitempty"`
+
+	Simulator []SimulatorTotalsResponse `json:"simulator,omitempty"` // Only with include_simulator=true; never added to flight time
+
+	PriorExperience *FlightTotalsGroupResponse `json:"prior_experience,omitempty"` // Opening balances dated within the range, included in totals
+}
+
+// SimulatorTotalsResponse represents the accumulated session time of one FSTD type
+type SimulatorTotalsResponse struct {
+	DeviceType   string `json:"device_type"`
+	SessionCount int    `json:"session_count"`
+	Duration     string `json:"duration"` // HH:MM
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// FromDomainFlightTotals converts one set of totals to its response DTO
+func FromDomainFlightTotals(t domain.FlightTotals) FlightTotalsGroupResponse {
+	response := FlightTotalsGroupResponse{
+		SegmentCount: t.SegmentCount,
+		BlockTime:    domain.FormatFlightDuration(t.BlockTime),
+		AirTime:      domain.FormatFlightDuration(t.AirTime),
+		DutyTime:     domain.FormatFlightDuration(t.DutyTime),
+		NightTime:    domain.FormatFlightDuration(t.NightTime),
+
+		LogbookColumns: FromDomainLogbookColumns(t.Columns),
+	}
+
+	for groupBy, value := range t.Keys {
+		value := value
+		switch groupBy {
+		case domain.FlightTotalsByMonth:
+			response.Month = &value
+		case domain.FlightTotalsByAircraftModel:
+			response.AircraftModel = &value
+		case domain.FlightTotalsByAircraftFamily:
+			response.AircraftFamily = &value
+		case domain.FlightTotalsByAirline:
+			response.Airline = &value
+		case domain.FlightTotalsByPilotRole:
+			response.PilotRole = &value
+		case domain.FlightTotalsByPilotFunction:
+			response.PilotFunction = &value
+		case domain.FlightTotalsByFlightType:
+			response.FlightType = &value
+		case domain.FlightTotalsByApproachType:
+			response.ApproachType = &value
+		}
+	}
+
+	return response
+}
+
+// FromDomainLogbookColumns converts the logbook column times to their response DTO
+func FromDomainLogbookColumns(c domain.LogbookColumnTimes) LogbookColumnsResponse {
+	return LogbookColumnsResponse{
+		SinglePilotSE: domain.FormatFlightDuration(c.SinglePilotSE),
+		SinglePilotME: domain.FormatFlightDuration(c.SinglePilotME),
+		MultiPilot:    domain.FormatFlightDuration(c.MultiPilot),
+		Turbine:       domain.FormatFlightDuration(c.Turbine),
+		PIC:           domain.FormatFlightDuration(c.PIC),
+		SIC:           domain.FormatFlightDuration(c.SIC),
+		Dual:          domain.FormatFlightDuration(c.Dual),
+		Instructor:    domain.FormatFlightDuration(c.Instructor),
+		PICUS:         domain.FormatFlightDuration(c.PICUS),
+		IFR:           domain.FormatFlightDuration(c.IFR),
+	}
+}
+
+// FromDomainFlightTotalsReport converts a totals report to the response DTO
+func FromDomainFlightTotalsReport(r *domain.FlightTotalsReport) FlightTotalsResponse {
+	response := FlightTotalsResponse{
+		Totals: FromDomainFlightTotals(r.Totals),
+	}
+
+	if r.Filter.From != nil {
+		response.From = r.Filter.From.Format("2006-01-02")
+	}
+	if r.Filter.To != nil {
+		response.To = r.Filter.To.Format("2006-01-02")
+	}
+	for _, g := range r.Filter.GroupBy {
+		response.GroupBy = append(response.GroupBy, string(g))
+	}
+	for _, g := range r.Groups {
+		response.Groups = append(response.Groups, FromDomainFlightTotals(g))
+	}
+	if r.Prior != nil {
+		prior := FromDomainFlightTotals(*r.Prior)
+		response.PriorExperience = &prior
+	}
+	for _, s := range r.Simulator {
+		response.Simulator = append(response.Simulator, SimulatorTotalsResponse{
+			DeviceType:   string(s.DeviceType),
+			SessionCount: s.SessionCount,
+			Duration:     domain.FormatFlightDuration(s.Duration),
+		})
+	}
+
+	return response
+}
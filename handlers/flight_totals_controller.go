@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/flight-totals
+// Totales de tiempo de vuelo del empleado autenticado
+// ============================================
+
+// GetMyFlightTotals returns the accumulated flight times of the authenticated employee
+// @Summary Get flight time totals
+// @Description Returns total block, air and duty time and segment counts for the authenticated employee, optionally filtered by flight date and grouped. Opening balances of prior experience dated within the range are included in the totals and shown apart from the groups. Simulator session time is never added to flight time; include_simulator lists it per device type.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param from query string false "Start flight date (YYYY-MM-DD, inclusive)"
+// @Param to query string false "End flight date (YYYY-MM-DD, inclusive)"
+// @Param group_by query string false "Comma separated: month, aircraft_model, aircraft_family, airline, pilot_role, pilot_function, flight_type, approach_type"
+// @Param include_simulator query bool false "Also return simulator session totals per device type"
+// @Success 200 {object} middleware.APIResponse{data=FlightTotalsResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/flight-totals [get]
+// @Security BearerAuth
+func (h *handler) GetMyFlightTotals() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogFlightTotalsGetError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		filter := domain.FlightTotalsFilter{EmployeeID: employee.ID}
+
+		// Parse date range
+		from, ok := parseDateQuery(c, "from")
+		if !ok {
+			log.Warn(logger.LogFlightTotalsGetError, "error", "invalid from date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		to, ok := parseDateQuery(c, "to")
+		if !ok {
+			log.Warn(logger.LogFlightTotalsGetError, "error", "invalid to date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if from != nil && to != nil && from.After(*to) {
+			log.Warn(logger.LogFlightTotalsGetError, "error", "from date after to date")
+			h.Response.Error(c, domain.MsgValStartDateAfterEndDate)
+			return
+		}
+		filter.From, filter.To = from, to
+
+		// Parse grouping dimensions (duplicates are ignored)
+		seen := make(map[string]bool)
+		for _, g := range strings.Split(c.Query("group_by"), ",") {
+			g = strings.TrimSpace(g)
+			if g == "" || seen[g] {
+				continue
+			}
+			if !domain.IsValidFlightTotalsGroupBy(g) {
+				log.Warn(logger.LogFlightTotalsGetError, "error", "invalid group_by", "group_by", g)
+				h.Response.Error(c, domain.MsgFlightTotalsInvalidGroupBy)
+				return
+			}
+			seen[g] = true
+			filter.GroupBy = append(filter.GroupBy, domain.FlightTotalsGroupBy(g))
+		}
+
+		filter.IncludeSimulator = c.Query("include_simulator") == "true"
+
+		report, err := h.DailyLogbookDetailInteractor.GetFlightTotals(c.Request.Context(), traceID, filter)
+		if err != nil {
+			log.Error(logger.LogFlightTotalsGetError, "error", err)
+			if err == domain.ErrFlightTotalsInvalidGroupBy {
+				h.Response.Error(c, domain.MsgFlightTotalsInvalidGroupBy)
+				return
+			}
+			h.Response.Error(c, domain.MsgFlightTotalsGetErr)
+			return
+		}
+
+		log.Info(logger.LogFlightTotalsGetOK, "employee_id", employee.ID, "groups", len(report.Groups))
+		h.Response.SuccessWithData(c, domain.MsgFlightTotalsGetOK, FromDomainFlightTotalsReport(report))
+	}
+}
+
+// parseDateQuery parses an optional YYYY-MM-DD query parameter.
+// Returns (nil, true) when the parameter is absent and (nil, false) when it is malformed.
+func parseDateQuery(c *gin.Context, name string) (*time.Time, bool) {
+	value := strings.TrimSpace(c.Query(name))
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, false
+	}
+	return &t, true
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// FTLUsageResponse represents the usage of one flight time limitation
+type FTLUsageResponse struct {
+	Code         string `json:"code"`
+	Metric       string `json:"metric"`
+	WindowDays   int    `json:"window_days,omitempty"`
+	CalendarYear bool   `json:"calendar_year,omitempty"`
+	WindowStart  string `json:"window_start"` // YYYY-MM-DD
+	WindowEnd    string `json:"window_end"`   // YYYY-MM-DD
+	Used         string `json:"used"`         // HH:MM
+	Limit        string `json:"limit"`        // HH:MM
+	Remaining    string `json:"remaining"`    // HH:MM
+	Level        string `json:"level"`        // OK, WARNING, EXCEEDED
+	Blocking     bool   `json:"blocking"`
+}
+
+// FTLStatusResponse represents the response for GET /employees/me/ftl-status
+type FTLStatusResponse struct {
+	AsOf   string             `json:"as_of"`
+	Limits []FTLUsageResponse `json:"limits"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// FromDomainFTLUsage converts a limit usage to its response DTO
+func FromDomainFTLUsage(u domain.FTLUsage) FTLUsageResponse {
+	return FTLUsageResponse{
+		Code:         u.Limit.Code,
+		Metric:       string(u.Limit.Metric),
+		WindowDays:   u.Limit.WindowDays,
+		CalendarYear: u.Limit.CalendarYear,
+		WindowStart:  u.WindowStart.Format("2006-01-02"),
+		WindowEnd:    u.WindowEnd.Format("2006-01-02"),
+		Used:         domain.FormatFlightDuration(u.Used),
+		Limit:        domain.FormatFlightDuration(u.Limit.Max),
+		Remaining:    domain.FormatFlightDuration(u.Remaining()),
+		Level:        string(u.Level),
+		Blocking:     u.Limit.Blocking,
+	}
+}
+
+// FromDomainFTLStatus converts an FTL status to its response DTO
+func FromDomainFTLStatus(s *domain.FTLStatus) FTLStatusResponse {
+	response := FTLStatusResponse{
+		AsOf:   s.AsOf.Format("2006-01-02"),
+		Limits: make([]FTLUsageResponse, 0, len(s.Usages)),
+	}
+	for _, u := range s.Usages {
+		response.Limits = append(response.Limits, FromDomainFTLUsage(u))
+	}
+	return response
+}
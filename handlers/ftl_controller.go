@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/ftl-status
+// Estado de limitaciones de tiempo de vuelo del empleado autenticado
+// ============================================
+
+// GetMyFTLStatus returns the usage of every flight time limitation for the authenticated employee
+// @Summary Get flight time limitations status
+// @Description Returns used and remaining block/duty time for each configured limit (rolling 7/28/365 days and calendar year)
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param as_of query string false "Evaluation date (YYYY-MM-DD), defaults to today (UTC)"
+// @Success 200 {object} middleware.APIResponse{data=FTLStatusResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/ftl-status [get]
+// @Security BearerAuth
+func (h *handler) GetMyFTLStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogFTLStatusError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		asOf, ok := parseDateQuery(c, "as_of")
+		if !ok {
+			log.Warn(logger.LogFTLStatusError, "error", "invalid as_of date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if asOf == nil {
+			today := time.Now().UTC()
+			asOf = &today
+		}
+
+		status, err := h.DailyLogbookDetailInteractor.GetFTLStatus(c.Request.Context(), traceID, employee.ID, *asOf)
+		if err != nil {
+			log.Error(logger.LogFTLStatusError, "error", err)
+			h.Response.Error(c, domain.MsgFTLStatusGetErr)
+			return
+		}
+
+		log.Info(logger.LogFTLStatusOK, "employee_id", employee.ID)
+		h.Response.SuccessWithData(c, domain.MsgFTLStatusGetOK, FromDomainFTLStatus(status))
+	}
+}
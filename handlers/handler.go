@@ -29,6 +29,12 @@ type handler struct {
 	EngineInteractor               *interactor.EngineInteractor
 	ManufacturerInteractor         *interactor.ManufacturerInteractor
 	AirlineEmployeeInteractor      *interactor.AirlineEmployeeInteractor // Release 15
+	SimulatorSessionInteractor     *interactor.SimulatorSessionInteractor
+	PriorExperienceInteractor      *interactor.PriorExperienceInteractor
+	DutyPeriodInteractor           *interactor.DutyPeriodInteractor
+	TypeRatingInteractor           *interactor.TypeRatingInteractor
+	CredentialInteractor           *interactor.CredentialInteractor
+	NotificationInteractor         *interactor.NotificationInteractor
 }
 
 func New(
@@ -48,7 +54,13 @@ func New(
 	dailyLogbookDetailInteractor *interactor.DailyLogbookDetailInteractor,
 	engineInteractor *interactor.EngineInteractor,
 	manufacturerInteractor *interactor.ManufacturerInteractor,
-	airlineEmployeeInteractor *interactor.AirlineEmployeeInteractor) *handler {
+	airlineEmployeeInteractor *interactor.AirlineEmployeeInteractor,
+	simulatorSessionInteractor *interactor.SimulatorSessionInteractor,
+	priorExperienceInteractor *interactor.PriorExperienceInteractor,
+	dutyPeriodInteractor *interactor.DutyPeriodInteractor,
+	typeRatingInteractor *interactor.TypeRatingInteractor,
+	credentialInteractor *interactor.CredentialInteractor,
+	notificationInteractor *interactor.NotificationInteractor) *handler {
 	return &handler{
 		EmployeeService:                service,
 		Interactor:                     interactor,
@@ -67,6 +79,12 @@ func New(
 		EngineInteractor:               engineInteractor,
 		ManufacturerInteractor:         manufacturerInteractor,
 		AirlineEmployeeInteractor:      airlineEmployeeInteractor,
+		SimulatorSessionInteractor:     simulatorSessionInteractor,
+		PriorExperienceInteractor:      priorExperienceInteractor,
+		DutyPeriodInteractor:           dutyPeriodInteractor,
+		TypeRatingInteractor:           typeRatingInteractor,
+		CredentialInteractor:           credentialInteractor,
+		NotificationInteractor:         notificationInteractor,
 	}
 }
 
@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/gin-gonic/gin"
 )
 
@@ -475,6 +476,44 @@ func BuildDailyLogbookStatusLinks(baseURL string, logbookID string, isActive boo
 	return links
 }
 
+// BuildDailyLogbookStateLinks construye links para respuesta de cambio de estado (firma y bloqueo),
+// con las transiciones disponibles desde el estado actual
+func BuildDailyLogbookStateLinks(baseURL string, logbookID string, state domain.LogbookState) []Link {
+	resourceURL := BuildResourceURL(baseURL, "daily-logbooks", logbookID)
+	collectionURL := BuildCollectionURL(baseURL, "daily-logbooks")
+
+	links := []Link{
+		{
+			Href:   resourceURL,
+			Rel:    "self",
+			Method: "GET",
+		},
+	}
+
+	switch state {
+	case domain.LogbookStateDraft:
+		links = append(links, Link{Href: resourceURL + "/submit", Rel: "submit", Method: "PATCH"})
+	case domain.LogbookStateSubmitted:
+		links = append(links,
+			Link{Href: resourceURL + "/sign", Rel: "sign", Method: "PATCH"},
+			Link{Href: resourceURL + "/reopen", Rel: "reopen", Method: "PATCH"},
+		)
+	case domain.LogbookStateSigned:
+		links = append(links,
+			Link{Href: resourceURL + "/lock", Rel: "lock", Method: "PATCH"},
+			Link{Href: resourceURL + "/amendments", Rel: "amend", Method: "POST"},
+		)
+	}
+
+	links = append(links, Link{
+		Href:   collectionURL,
+		Rel:    "collection",
+		Method: "GET",
+	})
+
+	return links
+}
+
 // BuildDailyLogbookCreatedLinks construye links para una bitácora recién creada
 func BuildDailyLogbookCreatedLinks(baseURL string, logbookID string) []Link {
 	resourceURL := BuildResourceURL(baseURL, "daily-logbooks", logbookID)
@@ -504,11 +543,17 @@ func BuildDailyLogbookCreatedLinks(baseURL string, logbookID string) []Link {
 	}
 }
 
-// BuildDailyLogbookDeletedLinks construye links para respuesta de eliminación
-func BuildDailyLogbookDeletedLinks(baseURL string) []Link {
+// BuildDailyLogbookDeletedLinks construye links para respuesta de eliminación, incluyendo la restauración
+func BuildDailyLogbookDeletedLinks(baseURL string, logbookID string) []Link {
+	resourceURL := BuildResourceURL(baseURL, "daily-logbooks", logbookID)
 	collectionURL := BuildCollectionURL(baseURL, "daily-logbooks")
 
 	return []Link{
+		{
+			Href:   resourceURL + "/restore",
+			Rel:    "restore",
+			Method: "PATCH",
+		},
 		{
 			Href:   collectionURL,
 			Rel:    "list",
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// LogbookAmendmentRequest represents the request body for amending a signed logbook
+type LogbookAmendmentRequest struct {
+	Action               string                           `json:"action"`                            // CREATE, UPDATE or DELETE
+	DailyLogbookDetailID string                           `json:"daily_logbook_detail_id,omitempty"` // Segment to correct or remove (UPDATE, DELETE)
+	Reason               string                           `json:"reason"`                            // Why the signed entry is corrected
+	Detail               *UpdateDailyLogbookDetailRequest `json:"detail,omitempty"`                  // Segment as it should be (CREATE, UPDATE)
+}
+
+// Sanitize trims whitespace from string fields
+func (r *LogbookAmendmentRequest) Sanitize() {
+	r.Action = strings.ToUpper(TrimString(r.Action))
+	r.DailyLogbookDetailID = TrimString(r.DailyLogbookDetailID)
+	r.Reason = TrimString(r.Reason)
+	if r.Detail != nil {
+		r.Detail.Sanitize()
+	}
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// FieldChangeResponse represents a field that differs between two versions of a record
+type FieldChangeResponse struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// LogbookAmendmentResponse represents an amendment of a signed logbook
+type LogbookAmendmentResponse struct {
+	ID                   string                `json:"id"`
+	DailyLogbookID       string                `json:"daily_logbook_id"`
+	DailyLogbookDetailID string                `json:"daily_logbook_detail_id"`
+	Action               string                `json:"action"`
+	Reason               string                `json:"reason"`
+	AuthorID             string                `json:"author_id"`
+	Status               string                `json:"status"` // PENDING, APPLIED or REJECTED
+	Changes              []FieldChangeResponse `json:"changes"`
+	CreatedAt            string                `json:"created_at"`
+	ResolvedBy           string                `json:"resolved_by,omitempty"`
+	ResolvedAt           *string               `json:"resolved_at,omitempty"`
+	Warnings             []WarningResponse     `json:"warnings,omitempty"` // Non-blocking findings on the proposed segment
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toLogbookAmendmentResponse maps an amendment, encoding its IDs and the ID values of the diff
+func (h *handler) toLogbookAmendmentResponse(a *domain.LogbookAmendment) LogbookAmendmentResponse {
+	id, _ := h.EncodeID(a.ID)
+	logbookID, _ := h.EncodeID(a.DailyLogbookID)
+	detailID, _ := h.EncodeID(a.DailyLogbookDetailID)
+	authorID, _ := h.EncodeID(a.AuthorID)
+
+	response := LogbookAmendmentResponse{
+		ID:                   id,
+		DailyLogbookID:       logbookID,
+		DailyLogbookDetailID: detailID,
+		Action:               string(a.Action),
+		Reason:               a.Reason,
+		AuthorID:             authorID,
+		Status:               string(a.Status),
+		Changes:              h.toFieldChangeResponses(a.Changes),
+		CreatedAt:            a.CreatedAt.UTC().Format(time.RFC3339),
+		ResolvedAt:           formatTimestamp(a.ResolvedAt),
+	}
+	if a.ResolvedBy != nil {
+		response.ResolvedBy, _ = h.EncodeID(*a.ResolvedBy)
+	}
+	return response
+}
+
+// toFieldChangeResponses maps a field-level diff, encoding the values of ID fields
+func (h *handler) toFieldChangeResponses(changes []domain.FieldChange) []FieldChangeResponse {
+	responses := make([]FieldChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		c := FieldChangeResponse{Field: change.Field, Before: change.Before, After: change.After}
+		if strings.HasSuffix(change.Field, "_id") || strings.HasSuffix(change.Field, "_by") {
+			c.Before = h.encodeOptionalID(change.Before)
+			c.After = h.encodeOptionalID(change.After)
+		}
+		responses = append(responses, c)
+	}
+	return responses
+}
+
+// encodeOptionalID encodes an ID, keeping empty values empty
+func (h *handler) encodeOptionalID(id string) string {
+	if id == "" {
+		return ""
+	}
+	encoded, _ := h.EncodeID(id)
+	return encoded
+}
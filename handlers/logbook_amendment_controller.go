@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// POST /daily-logbooks/:id/amendments
+// Solicitar enmienda de una bitácora firmada
+// ============================================
+
+// RequestLogbookAmendment registers a correction of a signed logbook
+// @Summary Request amendment of a signed logbook
+// @Description Signed logbooks cannot be edited. An amendment records the reason, the author and the field-by-field diff of the segment to create, correct or remove; the proposed segment is validated like a direct create/update and applied only when the amendment is signed.
+// @Tags DailyLogbookDetails
+// @Accept json
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Param body body LogbookAmendmentRequest true "Amendment"
+// @Success 201 {object} middleware.APIResponse{data=LogbookAmendmentResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse "Logbook not signed, or the segment duplicates/overlaps another one"
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/amendments [post]
+// @Security BearerAuth
+func (h *handler) RequestLogbookAmendment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, logbookUUID, ok := h.authorizeLogbook(c, logger.LogAmendmentError)
+		if !ok {
+			return
+		}
+
+		var req LogbookAmendmentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Error(logger.LogAmendmentError, "error", err)
+			h.Response.Error(c, domain.MsgValJSONInvalid)
+			return
+		}
+		req.Sanitize()
+
+		if !domain.IsValidAmendmentAction(req.Action) || req.Reason == "" {
+			log.Warn(logger.LogAmendmentError, "error", "invalid action or missing reason")
+			h.Response.Error(c, domain.MsgAmendmentInvalid)
+			return
+		}
+		amendment := domain.LogbookAmendment{
+			DailyLogbookID: logbookUUID,
+			Action:         domain.AmendmentAction(req.Action),
+			Reason:         req.Reason,
+			AuthorID:       employee.ID,
+		}
+
+		// Segment to correct or remove
+		if amendment.Action != domain.AmendmentActionCreate {
+			detailUUID, _ := h.resolveID(req.DailyLogbookDetailID)
+			if detailUUID == "" {
+				log.Warn(logger.LogAmendmentError, "error", "invalid detail ID")
+				h.Response.Error(c, domain.MsgAmendmentInvalid)
+				return
+			}
+			amendment.DailyLogbookDetailID = detailUUID
+		}
+
+		// Segment as it should be
+		if amendment.Action != domain.AmendmentActionDelete {
+			if req.Detail == nil {
+				log.Warn(logger.LogAmendmentError, "error", "missing detail")
+				h.Response.Error(c, domain.MsgAmendmentInvalid)
+				return
+			}
+			if code := h.resolveSegmentRequest(req.Detail); code != "" {
+				log.Warn(logger.LogAmendmentError, "error", "invalid detail", "code", code)
+				h.Response.Error(c, code)
+				return
+			}
+			proposed := ToDomainDailyLogbookDetailUpdate(amendment.DailyLogbookDetailID, *req.Detail)
+			amendment.Proposed = &proposed
+		}
+
+		created, warnings, err := h.DailyLogbookDetailInteractor.RequestAmendment(c.Request.Context(), traceID, amendment)
+		if err != nil {
+			log.Error(logger.LogAmendmentError, "error", err)
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			code, params := amendmentErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		response := h.toLogbookAmendmentResponse(created)
+		response.Warnings = h.toWarningResponses(warnings)
+
+		log.Info(logger.LogAmendmentCreateOK, "amendment_id", created.ID)
+		h.Response.SuccessWithData(c, domain.MsgAmendmentCreated, response)
+	}
+}
+
+// ============================================
+// GET /daily-logbooks/:id/amendments
+// Listar enmiendas de la bitácora
+// ============================================
+
+// ListLogbookAmendments lists the amendments of a logbook
+// @Summary List logbook amendments
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=[]LogbookAmendmentResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/amendments [get]
+// @Security BearerAuth
+func (h *handler) ListLogbookAmendments() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		_, logbookUUID, ok := h.authorizeLogbook(c, logger.LogAmendmentError)
+		if !ok {
+			return
+		}
+
+		amendments, err := h.DailyLogbookDetailInteractor.ListAmendments(c.Request.Context(), traceID, logbookUUID)
+		if err != nil {
+			log.Error(logger.LogAmendmentError, "error", err)
+			h.Response.Error(c, domain.MsgAmendmentErr)
+			return
+		}
+
+		response := make([]LogbookAmendmentResponse, 0, len(amendments))
+		for i := range amendments {
+			response = append(response, h.toLogbookAmendmentResponse(&amendments[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgAmendmentListOK, response)
+	}
+}
+
+// ============================================
+// POST /daily-logbooks/:id/amendments/:amendment_id/sign
+// Firmar y aplicar enmienda
+// ============================================
+
+// SignLogbookAmendment signs a pending amendment, applying it and re-signing the logbook
+// @Summary Sign logbook amendment
+// @Description Validates the proposed segment again, applies the change and re-signs the logbook in one transaction
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Param amendment_id path string true "Amendment ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=LogbookAmendmentResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/amendments/{amendment_id}/sign [post]
+// @Security BearerAuth
+func (h *handler) SignLogbookAmendment() gin.HandlerFunc {
+	return h.resolveLogbookAmendment(true)
+}
+
+// ============================================
+// POST /daily-logbooks/:id/amendments/:amendment_id/reject
+// Rechazar enmienda
+// ============================================
+
+// RejectLogbookAmendment discards a pending amendment
+// @Summary Reject logbook amendment
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Param amendment_id path string true "Amendment ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=LogbookAmendmentResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/amendments/{amendment_id}/reject [post]
+// @Security BearerAuth
+func (h *handler) RejectLogbookAmendment() gin.HandlerFunc {
+	return h.resolveLogbookAmendment(false)
+}
+
+// resolveLogbookAmendment signs (and applies) or rejects a pending amendment
+func (h *handler) resolveLogbookAmendment(sign bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, logbookUUID, ok := h.authorizeLogbook(c, logger.LogAmendmentError)
+		if !ok {
+			return
+		}
+
+		amendmentUUID, _ := h.resolveID(c.Param("amendment_id"))
+		if amendmentUUID == "" {
+			log.Warn(logger.LogAmendmentError, "error", "invalid amendment ID")
+			h.Response.Error(c, domain.MsgAmendmentNotFound)
+			return
+		}
+
+		var amendment *domain.LogbookAmendment
+		var err error
+		code := domain.MsgAmendmentApplied
+		if sign {
+			amendment, err = h.DailyLogbookDetailInteractor.SignAmendment(c.Request.Context(), traceID, logbookUUID, amendmentUUID, employee.ID)
+		} else {
+			code = domain.MsgAmendmentRejected
+			amendment, err = h.DailyLogbookDetailInteractor.RejectAmendment(c.Request.Context(), traceID, logbookUUID, amendmentUUID, employee.ID)
+		}
+		if err != nil {
+			log.Error(logger.LogAmendmentError, "error", err)
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			code, params := amendmentErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		h.Response.SuccessWithData(c, code, h.toLogbookAmendmentResponse(amendment))
+	}
+}
+
+// authorizeLogbook resolves the :id logbook and checks it belongs to the authenticated employee,
+// writing the error response when it does not
+func (h *handler) authorizeLogbook(c *gin.Context, logMessage string) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logMessage, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgFlightUnauthorized)
+		return nil, "", false
+	}
+
+	logbookUUID, _ := h.resolveID(c.Param("id"))
+	if logbookUUID == "" {
+		log.Warn(logMessage, "error", "invalid logbook ID")
+		h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+		return nil, "", false
+	}
+
+	if err := h.DailyLogbookDetailInteractor.VerifyLogbookOwnership(c.Request.Context(), logbookUUID, employee.ID); err != nil {
+		log.Warn(logMessage, "error", err)
+		if err == domain.ErrFlightUnauthorized {
+			h.Response.Error(c, domain.MsgFlightUnauthorized)
+			return nil, "", false
+		}
+		h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+		return nil, "", false
+	}
+	return employee, logbookUUID, true
+}
+
+// resolveSegmentRequest resolves the obfuscated route, aircraft and companion IDs of a segment request and checks its
+// enumerated fields; returns the message code of the first problem or "" when the request is usable
+func (h *handler) resolveSegmentRequest(req *UpdateDailyLogbookDetailRequest) string {
+	routeUUID, _ := h.resolveID(req.AirlineRouteID)
+	if routeUUID == "" {
+		return domain.MsgFlightInvalidRoute
+	}
+	req.AirlineRouteID = routeUUID
+
+	aircraftUUID, _ := h.resolveID(req.ActualAircraftRegistrationID)
+	if aircraftUUID == "" {
+		return domain.MsgFlightInvalidAircraft
+	}
+	req.ActualAircraftRegistrationID = aircraftUUID
+
+	if req.CompanionEmployeeID != nil {
+		companionUUID, _ := h.resolveID(*req.CompanionEmployeeID)
+		if companionUUID == "" {
+			return domain.MsgSegmentMirrorInvalidCompanion
+		}
+		req.CompanionEmployeeID = &companionUUID
+	}
+
+	if !domain.IsValidPilotRole(req.PilotRole) {
+		return domain.MsgValFieldFormat
+	}
+	if req.PilotFunction != nil && !domain.IsValidPilotFunction(*req.PilotFunction) {
+		return domain.MsgValFieldFormat
+	}
+	if req.ApproachType != nil && !domain.IsValidApproachType(*req.ApproachType) {
+		return domain.MsgValFieldFormat
+	}
+	if !domain.IsValidTimeReference(req.TimeReference) {
+		return domain.MsgFlightInvalidTimeRef
+	}
+	return ""
+}
+
+// amendmentErrorMessage maps an amendment error to its message code and params; segment validation
+// errors use the same messages as a direct create/update
+func amendmentErrorMessage(err error) (string, []string) {
+	switch err {
+	case domain.ErrAmendmentNotFound:
+		return domain.MsgAmendmentNotFound, nil
+	case domain.ErrAmendmentNotPending:
+		return domain.MsgAmendmentNotPending, nil
+	case domain.ErrAmendmentNotAllowed:
+		return domain.MsgAmendmentNotAllowed, nil
+	case domain.ErrAmendmentInvalid:
+		return domain.MsgAmendmentInvalid, nil
+	case domain.ErrFlightNotFound:
+		return domain.MsgFlightNotFound, nil
+	case domain.ErrLogbookChainBroken:
+		return domain.MsgLogbookChainSeal, nil
+	}
+	code, params := segmentErrorMessage(err)
+	if code == domain.MsgImportErr {
+		return domain.MsgAmendmentErr, nil
+	}
+	return code, params
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// LogbookChainLinkResponse represents the first segment whose stored hash does not match the chain
+type LogbookChainLinkResponse struct {
+	Position             int    `json:"position"` // 1-based, in flight date and OUT time order
+	DailyLogbookDetailID string `json:"daily_logbook_detail_id"`
+	DailyLogbookID       string `json:"daily_logbook_id"`
+	FlightRealDate       string `json:"flight_real_date"` // YYYY-MM-DD
+	OutTime              string `json:"out_time"`         // HH:MM (UTC)
+	StoredHash           string `json:"stored_hash"`      // Empty when the segment was never sealed
+	ExpectedHash         string `json:"expected_hash"`
+}
+
+// LogbookChainResponse represents the response for GET /employees/me/logbook/verify
+type LogbookChainResponse struct {
+	Valid       bool                      `json:"valid"`
+	EntryCount  int                       `json:"entry_count"`
+	HeadHash    string                    `json:"head_hash"` // Recomputed hash of the last signed segment
+	VerifiedAt  string                    `json:"verified_at"`
+	FirstBroken *LogbookChainLinkResponse `json:"first_broken_link,omitempty"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toLogbookChainResponse converts the verification report, encoding the IDs of the broken link
+func (h *handler) toLogbookChainResponse(report *domain.LogbookChainReport) LogbookChainResponse {
+	response := LogbookChainResponse{
+		Valid:      report.Valid(),
+		EntryCount: report.EntryCount,
+		HeadHash:   report.HeadHash,
+		VerifiedAt: report.VerifiedAt.Format(time.RFC3339),
+	}
+
+	if link := report.FirstBroken; link != nil {
+		detailID, _ := h.EncodeID(link.DetailID)
+		logbookID, _ := h.EncodeID(link.DailyLogbookID)
+		response.FirstBroken = &LogbookChainLinkResponse{
+			Position:             link.Position,
+			DailyLogbookDetailID: detailID,
+			DailyLogbookID:       logbookID,
+			FlightRealDate:       link.FlightRealDate,
+			OutTime:              link.OutTime,
+			StoredHash:           link.StoredHash,
+			ExpectedHash:         link.ExpectedHash,
+		}
+	}
+	return response
+}
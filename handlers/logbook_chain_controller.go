@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/logbook/verify
+// Verificación de la cadena de hashes de los segmentos firmados
+// ============================================
+
+// VerifyMyLogbook recomputes the hash chain over the authenticated employee's signed segments
+// @Summary Verify logbook hash chain
+// @Description Every segment of a signed logbook stores SHA-256(previous hash + canonical segment data), chained in flight date and OUT time order. The chain is recomputed and the first segment whose stored hash differs is reported, which reveals rows edited, inserted or deleted directly in the database.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Success 200 {object} middleware.APIResponse{data=LogbookChainResponse} "Chain verified; valid=false and first_broken_link when it was altered"
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/logbook/verify [get]
+// @Security BearerAuth
+func (h *handler) VerifyMyLogbook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookChainError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		report, err := h.DailyLogbookDetailInteractor.VerifyLogbookChain(c.Request.Context(), traceID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogLogbookChainError, "error", err)
+			h.Response.Error(c, domain.MsgLogbookChainErr)
+			return
+		}
+
+		response := h.toLogbookChainResponse(report)
+		if !report.Valid() {
+			h.Response.SuccessWithData(c, domain.MsgLogbookChainBroken, response, strconv.Itoa(report.FirstBroken.Position))
+			return
+		}
+		h.Response.SuccessWithData(c, domain.MsgLogbookChainValid, response, strconv.Itoa(report.EntryCount))
+	}
+}
@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/tools/pdf"
+)
+
+// ============================================
+// LOGBOOK LAYOUT
+// ============================================
+
+// logbookColumn is one column of the printed pilot logbook
+type logbookColumn struct {
+	Title   string
+	Width   float64 // PDF width in points
+	Right   bool    // Right aligned (times and counts)
+	CSVOnly bool    // Left out of the PDF, which has no room for it on an A4 landscape sheet
+	Value   func(d domain.DailyLogbookDetail) string
+	Totals  func(t domain.LogbookTotals) string // nil when the column has no total
+}
+
+// logbookColumns is the standard pilot logbook layout shared by the PDF and CSV exports. Block time is
+// repeated under the aircraft category (single-pilot SE/ME, multi-pilot) and function columns that
+// license applications ask for.
+var logbookColumns = []logbookColumn{
+	{Title: "Date", Width: 46, Value: func(d domain.DailyLogbookDetail) string { return d.FlightRealDate }},
+	{Title: "Flight", Width: 38, Value: func(d domain.DailyLogbookDetail) string { return d.FlightNumber }},
+	{Title: "From", Width: 26, Value: func(d domain.DailyLogbookDetail) string { return d.OriginIataCode }},
+	{Title: "To", Width: 26, Value: func(d domain.DailyLogbookDetail) string { return d.DestinationIataCode }},
+	{Title: "Aircraft", Width: 54, Value: func(d domain.DailyLogbookDetail) string { return d.ModelName }},
+	{Title: "Registration", Width: 44, Value: func(d domain.DailyLogbookDetail) string { return d.LicensePlate }},
+	{Title: "OUT", Width: 28, Right: true, Value: func(d domain.DailyLogbookDetail) string { return clockHHMM(d.OutTime) }},
+	{Title: "OFF", CSVOnly: true, Right: true, Value: func(d domain.DailyLogbookDetail) string { return clockHHMM(d.TakeoffTime) }},
+	{Title: "ON", CSVOnly: true, Right: true, Value: func(d domain.DailyLogbookDetail) string { return clockHHMM(d.LandingTime) }},
+	{Title: "IN", Width: 28, Right: true, Value: func(d domain.DailyLogbookDetail) string { return clockHHMM(d.InTime) }},
+	{Title: "Block", Width: 30, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return clockHHMM(d.BlockTime) },
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(t.BlockTime) }},
+	{Title: "Air", CSVOnly: true, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return clockHHMM(d.AirTime) },
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(t.AirTime) }},
+	columnTimeColumn("SP SE", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.SinglePilotSE }),
+	columnTimeColumn("SP ME", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.SinglePilotME }),
+	columnTimeColumn("MP", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.MultiPilot }),
+	{Title: "Turbine", CSVOnly: true, Right: true,
+		Value: func(d domain.DailyLogbookDetail) string {
+			return columnTime(d, func(c domain.LogbookColumnTimes) time.Duration { return c.Turbine })
+		},
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(t.Columns.Turbine) }},
+	{Title: "Function", CSVOnly: true, Value: func(d domain.DailyLogbookDetail) string {
+		if d.PilotFunction == nil {
+			return ""
+		}
+		return string(*d.PilotFunction)
+	}},
+	columnTimeColumn("PIC", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.PIC }),
+	columnTimeColumn("SIC", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.SIC }),
+	columnTimeColumn("Dual", 28, func(c domain.LogbookColumnTimes) time.Duration { return c.Dual }),
+	columnTimeColumn("Instructor", 36, func(c domain.LogbookColumnTimes) time.Duration { return c.Instructor }),
+	columnTimeColumn("PICUS", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.PICUS }),
+	{Title: "Night", Width: 30, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalClockHHMM(d.NightTime) },
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(t.NightTime) }},
+	columnTimeColumn("IFR", 30, func(c domain.LogbookColumnTimes) time.Duration { return c.IFR }),
+	{Title: "Duty", CSVOnly: true, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalClockHHMM(d.DutyTime) },
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(t.DutyTime) }},
+	{Title: "Role", CSVOnly: true, Value: func(d domain.DailyLogbookDetail) string { return string(d.PilotRole) }},
+	{Title: "TO Day", Width: 30, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalCount(d.DayTakeoffs) },
+		Totals: func(t domain.LogbookTotals) string { return strconv.Itoa(t.DayTakeoffs) }},
+	{Title: "TO Night", Width: 34, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalCount(d.NightTakeoffs) },
+		Totals: func(t domain.LogbookTotals) string { return strconv.Itoa(t.NightTakeoffs) }},
+	{Title: "LDG Day", Width: 34, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalCount(d.DayLandings) },
+		Totals: func(t domain.LogbookTotals) string { return strconv.Itoa(t.DayLandings) }},
+	{Title: "LDG Night", Width: 38, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return optionalCount(d.NightLandings) },
+		Totals: func(t domain.LogbookTotals) string { return strconv.Itoa(t.NightLandings) }},
+	{Title: "Approach", Width: 34, Value: func(d domain.DailyLogbookDetail) string {
+		if d.ApproachType == nil {
+			return ""
+		}
+		return string(*d.ApproachType)
+	}},
+}
+
+// pdfLogbookColumns are the columns printed on the PDF sheets
+var pdfLogbookColumns = func() []logbookColumn {
+	columns := make([]logbookColumn, 0, len(logbookColumns))
+	for _, col := range logbookColumns {
+		if !col.CSVOnly {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}()
+
+// columnTimeColumn builds a right-aligned column showing one of the logbook column times of a segment
+func columnTimeColumn(title string, width float64, pick func(c domain.LogbookColumnTimes) time.Duration) logbookColumn {
+	return logbookColumn{Title: title, Width: width, Right: true,
+		Value:  func(d domain.DailyLogbookDetail) string { return columnTime(d, pick) },
+		Totals: func(t domain.LogbookTotals) string { return domain.FormatFlightDuration(pick(t.Columns)) }}
+}
+
+// logbookTotalRows are the totals printed at the foot of every logbook page
+var logbookTotalRows = []struct {
+	Label string
+	Value func(p domain.LogbookExportPage) domain.LogbookTotals
+}{
+	{"BROUGHT FORWARD", func(p domain.LogbookExportPage) domain.LogbookTotals { return p.BroughtForward }},
+	{"PAGE TOTAL", func(p domain.LogbookExportPage) domain.LogbookTotals { return p.PageTotals }},
+	{"CARRIED FORWARD", func(p domain.LogbookExportPage) domain.LogbookTotals { return p.CarriedForward }},
+}
+
+// logbookInconsistencyNotes mark the segments whose flight date disagrees with their logbook page
+var logbookInconsistencyNotes = []struct {
+	Kind   domain.LogbookPageInconsistencyKind
+	Mark   string // Appended to the date on the PDF
+	Legend string
+}{
+	{domain.LogbookPageOutsideRange, "*", "* Dated outside the exported period, printed with the rest of its logbook page"},
+	{domain.LogbookPageOutOfDateOrder, "+", "+ Dated before a flight of an earlier logbook page"},
+}
+
+// ============================================
+// CSV
+// ============================================
+
+// WriteLogbookCSV writes the logbook as CSV: one row per segment, followed on each page by
+// the brought forward, page and carried forward totals. The trailing note column holds the
+// inconsistency kind of segments whose flight date disagrees with their page.
+func WriteLogbookCSV(w io.Writer, export *domain.LogbookExport) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"book_page", "row_type"}
+	for _, col := range logbookColumns {
+		header = append(header, col.Title)
+	}
+	header = append(header, "note")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, page := range export.Pages {
+		bookPage := bookPageLabel(page.BookPage)
+		for _, d := range page.Details {
+			record := []string{bookPage, "SEGMENT"}
+			for _, col := range logbookColumns {
+				record = append(record, col.Value(d))
+			}
+			note := ""
+			if inc, ok := page.Inconsistency(d.ID); ok {
+				note = string(inc.Kind)
+			}
+			record = append(record, note)
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		for _, row := range logbookTotalRows {
+			totals := row.Value(page)
+			record := []string{bookPage, row.Label}
+			for _, col := range logbookColumns {
+				value := ""
+				if col.Totals != nil {
+					value = col.Totals(totals)
+				}
+				record = append(record, value)
+			}
+			record = append(record, "")
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ============================================
+// PDF
+// ============================================
+
+const (
+	logbookMargin     = 24.0
+	logbookRowHeight  = 13.0
+	logbookFontSize   = 7.0
+	logbookHeaderTop  = 64.0
+	logbookRowsOnPage = 32 // Segment rows per sheet, leaving room for the totals block
+)
+
+// RenderLogbookPDF renders the logbook as an A4 landscape PDF. Each book page starts on a new sheet;
+// a book page with more segments than fit continues on the following sheets and its totals are printed
+// after its last segment, followed by a legend for the marked segments whose date disagrees with the page.
+func RenderLogbookPDF(export *domain.LogbookExport) ([]byte, error) {
+	doc := pdf.NewDocument(pdf.A4Height, pdf.A4Width)
+	doc.SetTitle("Pilot logbook " + export.EmployeeName)
+
+	period := export.Filter.From.Format("2006-01-02") + " to " + export.Filter.To.Format("2006-01-02")
+	if len(export.Pages) == 0 {
+		renderLogbookSheet(doc, export, period, "", false)
+		doc.Text(logbookMargin, logbookHeaderTop+2*logbookRowHeight, pdf.Helvetica, 9, "No flights recorded in this period.")
+	}
+
+	for _, page := range export.Pages {
+		label := "Page " + bookPageLabel(page.BookPage)
+		for start := 0; start < len(page.Details); start += logbookRowsOnPage {
+			end := start + logbookRowsOnPage
+			if end > len(page.Details) {
+				end = len(page.Details)
+			}
+			y := renderLogbookSheet(doc, export, period, label, start > 0)
+			for _, d := range page.Details[start:end] {
+				values := make([]string, len(pdfLogbookColumns))
+				for i, col := range pdfLogbookColumns {
+					values[i] = col.Value(d)
+				}
+				if inc, ok := page.Inconsistency(d.ID); ok {
+					values[0] += logbookInconsistencyMark(inc.Kind)
+				}
+				renderLogbookRow(doc, y, pdf.Helvetica, values)
+				doc.Line(logbookMargin, y+3, logbookTableRight(), y+3, 0.2)
+				y += logbookRowHeight
+			}
+			if end < len(page.Details) {
+				doc.Text(logbookMargin, y+logbookRowHeight, pdf.Helvetica, logbookFontSize, "Continued on next sheet")
+				continue
+			}
+
+			// Totals block
+			y += logbookRowHeight / 2
+			for _, row := range logbookTotalRows {
+				totals := row.Value(page)
+				values := make([]string, len(pdfLogbookColumns))
+				values[0] = row.Label
+				for i, col := range pdfLogbookColumns {
+					if col.Totals != nil {
+						values[i] = col.Totals(totals)
+					}
+				}
+				doc.FillRect(logbookMargin, y-logbookRowHeight+3, logbookTableRight()-logbookMargin, logbookRowHeight, 0.92)
+				renderLogbookRow(doc, y, pdf.HelveticaBold, values)
+				y += logbookRowHeight
+			}
+			for _, note := range logbookInconsistencyNotes {
+				for _, inc := range page.Inconsistencies {
+					if inc.Kind == note.Kind {
+						doc.Text(logbookMargin, y, pdf.Helvetica, logbookFontSize, note.Legend)
+						y += logbookRowHeight
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return doc.Bytes()
+}
+
+// renderLogbookSheet starts a new sheet with the title and column headers and returns the first row baseline
+func renderLogbookSheet(doc *pdf.Document, export *domain.LogbookExport, period, label string, continued bool) float64 {
+	doc.AddPage()
+	doc.Text(logbookMargin, 30, pdf.HelveticaBold, 13, "PILOT LOGBOOK")
+	doc.Text(logbookMargin, 44, pdf.Helvetica, 9, export.EmployeeName+"   "+period)
+	if continued {
+		label += " (continued)"
+	}
+	doc.TextRight(logbookTableRight(), 30, pdf.HelveticaBold, 11, label)
+	doc.TextRight(logbookTableRight(), 44, pdf.Helvetica, 8, fmt.Sprintf("Sheet %d", doc.PageCount()))
+
+	titles := make([]string, len(pdfLogbookColumns))
+	for i, col := range pdfLogbookColumns {
+		titles[i] = col.Title
+	}
+	doc.FillRect(logbookMargin, logbookHeaderTop-logbookRowHeight+3, logbookTableRight()-logbookMargin, logbookRowHeight, 0.8)
+	renderLogbookRow(doc, logbookHeaderTop, pdf.HelveticaBold, titles)
+	return logbookHeaderTop + logbookRowHeight
+}
+
+// renderLogbookRow writes one value per column on the given baseline
+func renderLogbookRow(doc *pdf.Document, y float64, font pdf.Font, values []string) {
+	x := logbookMargin
+	for i, col := range pdfLogbookColumns {
+		switch {
+		case values[i] == "":
+		case col.Right:
+			doc.TextRight(x+col.Width-3, y, font, logbookFontSize, values[i])
+		default:
+			doc.Text(x+2, y, font, logbookFontSize, values[i])
+		}
+		x += col.Width
+	}
+}
+
+func logbookTableRight() float64 {
+	x := logbookMargin
+	for _, col := range pdfLogbookColumns {
+		x += col.Width
+	}
+	return x
+}
+
+// ============================================
+// HELPERS
+// ============================================
+
+// columnTime returns one of the logbook column times of a segment, empty when the segment logs none in it
+func columnTime(d domain.DailyLogbookDetail, pick func(c domain.LogbookColumnTimes) time.Duration) string {
+	var c domain.LogbookColumnTimes
+	c.AddDetail(d)
+	if v := pick(c); v > 0 {
+		return domain.FormatFlightDuration(v)
+	}
+	return ""
+}
+
+func logbookInconsistencyMark(kind domain.LogbookPageInconsistencyKind) string {
+	for _, note := range logbookInconsistencyNotes {
+		if note.Kind == kind {
+			return note.Mark
+		}
+	}
+	return ""
+}
+
+func bookPageLabel(page *int) string {
+	if page == nil {
+		return "-"
+	}
+	return strconv.Itoa(*page)
+}
+
+// clockHHMM trims a stored HH:MM:SS value to HH:MM
+func clockHHMM(value string) string {
+	if len(value) > 5 {
+		return value[:5]
+	}
+	return value
+}
+
+func optionalClockHHMM(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return clockHHMM(*value)
+}
+
+func optionalCount(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/logbook/export
+// Exportación imprimible de la bitácora del empleado autenticado
+// ============================================
+
+// ExportMyLogbook renders the authenticated employee's logbook for a date range as PDF or CSV
+// @Summary Export pilot logbook
+// @Description Returns a printable pilot logbook (PDF) or CSV for a flight date range, grouped by book page with brought and carried forward totals. Pages are never split: segments whose flight date disagrees with their page are printed on it and marked
+// @Tags DailyLogbookDetails
+// @Produce application/pdf
+// @Produce text/csv
+// @Param from query string false "Start flight date (YYYY-MM-DD, inclusive), defaults to the first flight"
+// @Param to query string false "End flight date (YYYY-MM-DD, inclusive), defaults to today (UTC)"
+// @Param format query string false "pdf (default) or csv"
+// @Success 200 {file} file
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/logbook/export [get]
+// @Security BearerAuth
+func (h *handler) ExportMyLogbook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookExportError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", string(domain.LogbookExportPDF))))
+		if !domain.IsValidLogbookExportFormat(format) {
+			log.Warn(logger.LogLogbookExportError, "error", "invalid format", "format", format)
+			h.Response.Error(c, domain.MsgLogbookExportInvalidFormat)
+			return
+		}
+
+		// Parse date range
+		from, ok := parseDateQuery(c, "from")
+		if !ok {
+			log.Warn(logger.LogLogbookExportError, "error", "invalid from date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		to, ok := parseDateQuery(c, "to")
+		if !ok {
+			log.Warn(logger.LogLogbookExportError, "error", "invalid to date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+
+		filter := domain.LogbookExportFilter{
+			EmployeeID: employee.ID,
+			From:       time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+			To:         time.Now().UTC(),
+		}
+		if from != nil {
+			filter.From = *from
+		}
+		if to != nil {
+			filter.To = *to
+		}
+		if filter.From.After(filter.To) {
+			log.Warn(logger.LogLogbookExportError, "error", "from date after to date")
+			h.Response.Error(c, domain.MsgValStartDateAfterEndDate)
+			return
+		}
+
+		export, err := h.DailyLogbookDetailInteractor.GetLogbookExport(c.Request.Context(), traceID, filter)
+		if err != nil {
+			log.Error(logger.LogLogbookExportError, "error", err)
+			h.Response.Error(c, domain.MsgLogbookExportErr)
+			return
+		}
+		export.EmployeeName = employee.Name
+
+		var body []byte
+		contentType := "application/pdf"
+		if format == string(domain.LogbookExportCSV) {
+			var buf bytes.Buffer
+			err = WriteLogbookCSV(&buf, export)
+			body = buf.Bytes()
+			contentType = "text/csv; charset=utf-8"
+		} else {
+			body, err = RenderLogbookPDF(export)
+		}
+		if err != nil {
+			log.Error(logger.LogLogbookExportError, "error", err)
+			h.Response.Error(c, domain.MsgLogbookExportErr)
+			return
+		}
+
+		filename := fmt.Sprintf("logbook_%s_%s.%s", filter.From.Format("20060102"), filter.To.Format("20060102"), format)
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+		log.Info(logger.LogLogbookExportOK, "employee_id", employee.ID, "format", format, "pages", len(export.Pages))
+		c.Data(http.StatusOK, contentType, body)
+	}
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// LogbookHistoryEntryResponse represents a recorded change of a daily logbook or one of its segments
+type LogbookHistoryEntryResponse struct {
+	ID             string                `json:"id"`
+	EntityType     string                `json:"entity_type"` // DAILY_LOGBOOK or DAILY_LOGBOOK_DETAIL
+	EntityID       string                `json:"entity_id"`
+	DailyLogbookID string                `json:"daily_logbook_id"`
+	Action         string                `json:"action"` // CREATE, UPDATE or DELETE
+	EmployeeID     string                `json:"employee_id,omitempty"`
+	TraceID        string                `json:"trace_id,omitempty"`
+	Changes        []FieldChangeResponse `json:"changes"`
+	CreatedAt      string                `json:"created_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toLogbookHistoryResponse maps history entries, encoding their IDs and the ID values of the diffs
+func (h *handler) toLogbookHistoryResponse(entries []domain.LogbookHistoryEntry) []LogbookHistoryEntryResponse {
+	response := make([]LogbookHistoryEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		id, _ := h.EncodeID(e.ID)
+		entityID, _ := h.EncodeID(e.EntityID)
+		logbookID, _ := h.EncodeID(e.DailyLogbookID)
+		entry := LogbookHistoryEntryResponse{
+			ID:             id,
+			EntityType:     string(e.EntityType),
+			EntityID:       entityID,
+			DailyLogbookID: logbookID,
+			Action:         string(e.Action),
+			TraceID:        e.TraceID,
+			Changes:        h.toFieldChangeResponses(e.Changes),
+			CreatedAt:      e.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		if e.EmployeeID != "" {
+			entry.EmployeeID, _ = h.EncodeID(e.EmployeeID)
+		}
+		response = append(response, entry)
+	}
+	return response
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /daily-logbooks/:id/history
+// Historial de cambios de la bitácora y sus segmentos
+// ============================================
+
+// GetDailyLogbookHistory lists the recorded changes of a logbook and its segments
+// @Summary Daily logbook change history
+// @Description Returns every create, update and delete of the logbook and of its segments, deleted segments included, oldest first. Each entry carries the acting employee, the request trace ID and the field-level diff.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=[]LogbookHistoryEntryResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/history [get]
+// @Security BearerAuth
+func (h *handler) GetDailyLogbookHistory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		_, logbookUUID, ok := h.authorizeLogbook(c, logger.LogLogbookHistoryError)
+		if !ok {
+			return
+		}
+
+		entries, err := h.DailyLogbookDetailInteractor.ListLogbookHistory(c.Request.Context(), traceID, logbookUUID)
+		if err != nil {
+			log.Error(logger.LogLogbookHistoryError, "error", err)
+			h.Response.Error(c, domain.MsgLogbookHistoryErr)
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgLogbookHistoryOK, h.toLogbookHistoryResponse(entries))
+	}
+}
+
+// ============================================
+// GET /daily-logbook-details/:id/history
+// Historial de cambios de un segmento (también eliminado)
+// ============================================
+
+// GetDailyLogbookDetailHistory lists the recorded changes of a segment
+// @Summary Daily logbook detail change history
+// @Description Returns every create, update and delete of the segment, oldest first. Still available after the segment was deleted.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Detail ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=[]LogbookHistoryEntryResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbook-details/{id}/history [get]
+// @Security BearerAuth
+func (h *handler) GetDailyLogbookDetailHistory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookHistoryError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgFlightUnauthorized)
+			return
+		}
+
+		detailUUID, _ := h.resolveID(c.Param("id"))
+		if detailUUID == "" {
+			h.Response.Error(c, domain.MsgLogbookHistoryNotFound)
+			return
+		}
+
+		entries, err := h.DailyLogbookDetailInteractor.ListDetailHistory(c.Request.Context(), traceID, detailUUID)
+		if err != nil {
+			if err == domain.ErrLogbookHistoryNotFound {
+				h.Response.Error(c, domain.MsgLogbookHistoryNotFound)
+				return
+			}
+			log.Error(logger.LogLogbookHistoryError, "error", err)
+			h.Response.Error(c, domain.MsgLogbookHistoryErr)
+			return
+		}
+
+		// Ownership is checked on the segment's logbook, which outlives the segment
+		if err := h.DailyLogbookDetailInteractor.VerifyLogbookOwnership(c.Request.Context(), entries[0].DailyLogbookID, employee.ID); err != nil {
+			log.Warn(logger.LogLogbookHistoryError, "error", err)
+			if err == domain.ErrFlightUnauthorized {
+				h.Response.Error(c, domain.MsgFlightUnauthorized)
+				return
+			}
+			h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgLogbookHistoryOK, h.toLogbookHistoryResponse(entries))
+	}
+}
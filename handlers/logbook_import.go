@@ -0,0 +1,490 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// CSV FORMAT
+// ============================================
+
+// importColumnAliases maps accepted header names (lower case) to the canonical column name
+var importColumnAliases = map[string]string{
+	"flight_number":    "flight_number",
+	"flight":           "flight_number",
+	"flight_real_date": "flight_real_date",
+	"date":             "flight_real_date",
+	"route_code":       "route_code",
+	"route":            "route_code",
+	"airline_code":     "airline_code",
+	"airline":          "airline_code",
+	"license_plate":    "license_plate",
+	"registration":     "license_plate",
+	"out_time":         "out_time",
+	"out":              "out_time",
+	"takeoff_time":     "takeoff_time",
+	"off":              "takeoff_time",
+	"landing_time":     "landing_time",
+	"on":               "landing_time",
+	"in_time":          "in_time",
+	"in":               "in_time",
+	"time_reference":   "time_reference",
+	"pilot_role":       "pilot_role",
+	"role":             "pilot_role",
+	"pilot_function":   "pilot_function",
+	"function":         "pilot_function",
+	"companion_name":   "companion_name",
+	"passengers":       "passengers",
+	"duty_time":        "duty_time",
+	"ifr_time":         "ifr_time",
+	"ifr":              "ifr_time",
+	"approach_type":    "approach_type",
+	"flight_type":      "flight_type",
+	"wet_lease":        "wet_lease",
+}
+
+// importRequiredColumns must be present in the header
+var importRequiredColumns = []string{
+	"flight_number", "flight_real_date", "route_code", "license_plate",
+	"out_time", "takeoff_time", "landing_time", "in_time", "pilot_role",
+}
+
+// ImportFileError describes why an import file could not be read
+type ImportFileError struct {
+	Err    error
+	Detail string // Missing columns or the line that could not be parsed
+}
+
+func (e *ImportFileError) Error() string {
+	return e.Err.Error() + ": " + e.Detail
+}
+
+func (e *ImportFileError) Unwrap() error {
+	return e.Err
+}
+
+// importAdapter describes how one file format maps onto the import fields
+type importAdapter struct {
+	Comma         rune
+	Columns       map[string]string // Accepted header (lower case) -> canonical column
+	Required      []string          // Canonical columns that must be present in the header
+	DateLayouts   []string          // Flight date layouts, normalized to YYYY-MM-DD; empty keeps the value as written
+	NormalizeTime bool              // Accept HHMM, H:MM and date-time values for OUT/OFF/ON/IN
+	ScanForHeader bool              // The header row may come after a preamble (e.g., ForeFlight's Aircraft Table)
+	TimeReference string            // Default time reference when the file has no column for it
+}
+
+// importDefaults are values the request supplies for columns the file does not have
+type importDefaults struct {
+	PilotRole     string
+	TimeReference string
+}
+
+// nativeImportAdapter is the flighthours CSV format
+var nativeImportAdapter = importAdapter{
+	Comma:    ',',
+	Columns:  importColumnAliases,
+	Required: importRequiredColumns,
+}
+
+// headerScanLimit bounds how many rows are read looking for the header row
+const headerScanLimit = 50
+
+// ParseLogbookImportCSV reads import rows from a CSV file with a header row.
+// Columns may appear in any order; unknown columns are ignored and empty optional values are left nil.
+func ParseLogbookImportCSV(r io.Reader) ([]domain.LogbookImportRow, error) {
+	return parseImportFile(r, nativeImportAdapter, nil, importDefaults{})
+}
+
+// parseImportFile reads import rows with the adapter's column mapping. fieldMap overrides the adapter:
+// canonical column -> header as written in the file.
+func parseImportFile(r io.Reader, adapter importAdapter, fieldMap map[string]string, defaults importDefaults) ([]domain.LogbookImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = adapter.Comma
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = adapter.ScanForHeader
+
+	var index map[string]int
+	var missing []string
+	line := 0
+	for {
+		header, err := reader.Read()
+		line++
+		if err != nil {
+			if index == nil {
+				return nil, &ImportFileError{Err: domain.ErrImportInvalidFile, Detail: "missing header"}
+			}
+			return nil, &ImportFileError{Err: domain.ErrImportInvalidFile, Detail: strings.Join(missing, ", ")}
+		}
+		index, missing = importHeaderIndex(header, adapter, fieldMap)
+		if len(missing) == 0 || !adapter.ScanForHeader || line >= headerScanLimit {
+			break
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &ImportFileError{Err: domain.ErrImportInvalidFile, Detail: strings.Join(missing, ", ")}
+	}
+
+	var rows []domain.LogbookImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, &ImportFileError{Err: domain.ErrImportInvalidFile, Detail: "line " + strconv.Itoa(line)}
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) == domain.MaxLogbookImportRows {
+			return nil, domain.ErrImportTooManyRows
+		}
+
+		value := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		optional := func(column string) *string {
+			v := value(column)
+			if v == "" {
+				return nil
+			}
+			return &v
+		}
+		clock := func(column string) string {
+			if adapter.NormalizeTime {
+				return normalizeImportClock(value(column))
+			}
+			return value(column)
+		}
+
+		row := domain.LogbookImportRow{
+			Line:           line,
+			FlightNumber:   value("flight_number"),
+			FlightRealDate: normalizeImportDate(value("flight_real_date"), adapter.DateLayouts),
+			RouteCode:      value("route_code"),
+			Origin:         value("origin"),
+			Destination:    value("destination"),
+			AirlineCode:    value("airline_code"),
+			LicensePlate:   value("license_plate"),
+			OutTime:        clock("out_time"),
+			TakeoffTime:    clock("takeoff_time"),
+			LandingTime:    clock("landing_time"),
+			InTime:         clock("in_time"),
+			TimeReference:  strings.ToUpper(value("time_reference")),
+			PilotRole:      strings.ToUpper(value("pilot_role")),
+			PilotFunction:  optional("pilot_function"),
+			CompanionName:  optional("companion_name"),
+			Passengers:     optional("passengers"),
+			DutyTime:       optional("duty_time"),
+			IFRTime:        optional("ifr_time"),
+			ApproachType:   optional("approach_type"),
+			FlightType:     optional("flight_type"),
+			WetLease:       isTruthy(value("wet_lease")),
+		}
+		if row.ApproachType != nil {
+			upper := strings.ToUpper(*row.ApproachType)
+			row.ApproachType = &upper
+		}
+		if row.PilotFunction != nil {
+			upper := strings.ToUpper(*row.PilotFunction)
+			row.PilotFunction = &upper
+		}
+		if row.PilotRole == "" {
+			if _, ok := index["pilot_flying"]; ok {
+				row.PilotRole = string(domain.PilotRolePM)
+				if isTruthy(value("pilot_flying")) {
+					row.PilotRole = string(domain.PilotRolePF)
+				}
+			} else {
+				row.PilotRole = defaults.PilotRole
+			}
+		}
+		if row.TimeReference == "" {
+			row.TimeReference = defaults.TimeReference
+			if row.TimeReference == "" {
+				row.TimeReference = adapter.TimeReference
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, &ImportFileError{Err: domain.ErrImportInvalidFile, Detail: "no rows"}
+	}
+	return rows, nil
+}
+
+// importHeaderIndex maps canonical columns to their position and lists the required ones not found
+func importHeaderIndex(header []string, adapter importAdapter, fieldMap map[string]string) (map[string]int, []string) {
+	positions := make(map[string]int, len(header))
+	index := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		positions[name] = i
+		if column, ok := adapter.Columns[name]; ok {
+			if _, seen := index[column]; !seen {
+				index[column] = i
+			}
+		}
+	}
+
+	var missing []string
+	for column, source := range fieldMap {
+		if i, ok := positions[strings.ToLower(strings.TrimSpace(source))]; ok {
+			index[column] = i
+		} else {
+			missing = append(missing, source)
+		}
+	}
+	for _, column := range adapter.Required {
+		if _, ok := index[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	return index, missing
+}
+
+// normalizeImportDate rewrites the date as YYYY-MM-DD using the first matching layout; otherwise it is kept
+// as written so the row is reported with an invalid flight_real_date
+func normalizeImportDate(value string, layouts []string) string {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return value
+}
+
+// normalizeImportClock rewrites HHMM, H:MM and "date time" values as HH:MM
+func normalizeImportClock(value string) string {
+	if i := strings.LastIndex(value, " "); i >= 0 {
+		value = value[i+1:]
+	}
+	if len(value) == 4 && !strings.Contains(value, ":") {
+		value = value[:2] + ":" + value[2:]
+	}
+	if i := strings.Index(value, ":"); i == 1 {
+		value = "0" + value
+	}
+	return value
+}
+
+func isTruthy(value string) bool {
+	switch strings.ToLower(value) {
+	case "1", "true", "yes", "y", "x", "pf":
+		return true
+	}
+	return false
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// ImportRowErrorResponse represents the validation error of one row
+type ImportRowErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+	Field   string `json:"field,omitempty"`
+	// Segment the row duplicates or overlaps
+	ConflictingDetailID string `json:"conflicting_detail_id,omitempty"`
+	// Earlier row of the same file the row duplicates or overlaps
+	ConflictingLine int `json:"conflicting_line,omitempty"`
+}
+
+// ImportRowResponse represents the outcome of one import row
+type ImportRowResponse struct {
+	Line           int                     `json:"line"`
+	Valid          bool                    `json:"valid"`
+	Pending        bool                    `json:"pending,omitempty"`   // Waiting for a mapping decision, skipped
+	DetailID       string                  `json:"detail_id,omitempty"` // Only when saved
+	LogbookID      string                  `json:"daily_logbook_id,omitempty"`
+	FlightRealDate string                  `json:"flight_real_date,omitempty"`
+	FlightNumber   string                  `json:"flight_number,omitempty"`
+	Origin         string                  `json:"origin_iata_code,omitempty"`
+	Destination    string                  `json:"destination_iata_code,omitempty"`
+	LicensePlate   string                  `json:"license_plate,omitempty"`
+	BlockTime      string                  `json:"block_time,omitempty"`
+	AirTime        string                  `json:"air_time,omitempty"`
+	Error          *ImportRowErrorResponse `json:"error,omitempty"`
+	Warnings       []WarningResponse       `json:"warnings,omitempty"`
+}
+
+// UnresolvedReferenceResponse is a source value waiting for a mapping decision
+type UnresolvedReferenceResponse struct {
+	Kind  string `json:"kind"`  // AIRPORT, AIRCRAFT or ROUTE
+	Value string `json:"value"` // As written in the file (normalized)
+	Lines []int  `json:"lines"`
+}
+
+// ImportResponse represents the response for POST /daily-logbooks/import
+type ImportResponse struct {
+	DryRun          bool                `json:"dry_run"`
+	Saved           bool                `json:"saved"`
+	TotalRows       int                 `json:"total_rows"`
+	ValidRows       int                 `json:"valid_rows"`
+	InvalidRows     int                 `json:"invalid_rows"`
+	PendingRows     int                 `json:"pending_rows,omitempty"`
+	LogbooksCreated int                 `json:"logbooks_created"`
+	MappingsSaved   int                 `json:"mappings_saved,omitempty"`
+	Rows            []ImportRowResponse `json:"rows"`
+
+	Unresolved []UnresolvedReferenceResponse `json:"unresolved,omitempty"`
+}
+
+// segmentErrorMessage maps a segment validation error to its message code and params
+func segmentErrorMessage(err error) (string, []string) {
+	var fieldErr *domain.ImportFieldError
+	if errors.As(err, &fieldErr) {
+		if errors.Is(fieldErr.Err, domain.ErrImportMissingField) {
+			return domain.MsgValFieldRequired, []string{fieldErr.Field}
+		}
+		return domain.MsgValFieldFormat, []string{fieldErr.Field}
+	}
+	var ftlErr *domain.FTLLimitExceededError
+	if errors.As(err, &ftlErr) {
+		return domain.MsgFTLLimitExceeded, domain.FTLMessageParams(ftlErr.Usage)
+	}
+	var ratingErr *domain.TypeRatingRequiredError
+	if errors.As(err, &ratingErr) {
+		return domain.MsgTypeRatingRequired, []string{ratingErr.AircraftFamily}
+	}
+	var refErr *domain.UnresolvedReferenceError
+	if errors.As(err, &refErr) {
+		return domain.MsgElogbookUnresolved, []string{string(refErr.Kind), refErr.Value}
+	}
+
+	switch err {
+	case domain.ErrImportRouteAmbiguous:
+		return domain.MsgImportRouteAmbiguous, nil
+	case domain.ErrFlightInvalidRoute:
+		return domain.MsgFlightInvalidRoute, nil
+	case domain.ErrFlightInvalidAircraft:
+		return domain.MsgFlightInvalidAircraft, nil
+	case domain.ErrFlightInvalidLogbook:
+		return domain.MsgFlightInvalidLogbook, nil
+	case domain.ErrFlightInvalidTimeSequence:
+		return domain.MsgFlightInvalidTimeSequence, nil
+	case domain.ErrFlightInvalidIFRTime:
+		return domain.MsgFlightInvalidIFRTime, nil
+	case domain.ErrFlightTimeMismatch:
+		return domain.MsgFlightTimeMismatch, nil
+	case domain.ErrFlightSegmentSpanExceeded:
+		return domain.MsgFlightSegmentSpanExceeded, nil
+	case domain.ErrFlightTimeZoneUnavailable:
+		return domain.MsgFlightTimeZoneUnavailable, nil
+	case domain.ErrFlightInvalidTimeRef:
+		return domain.MsgFlightInvalidTimeRef, nil
+	case domain.ErrFlightAircraftNotInAirline:
+		return domain.MsgFlightAircraftNotInAirline, nil
+	case domain.ErrFlightRouteInactive:
+		return domain.MsgFlightRouteInactive, nil
+	case domain.ErrFlightAirlineInactive:
+		return domain.MsgFlightAirlineInactive, nil
+	case domain.ErrFlightDateOutsideLogDate:
+		return domain.MsgFlightDateOutsideLogDate, nil
+	case domain.ErrDailyLogbookSigned:
+		return domain.MsgDailyLogbookSigned, nil
+	}
+	return domain.MsgImportErr, nil
+}
+
+// toImportResponse maps the import result; IDs are only returned once the rows are saved
+func (h *handler) toImportResponse(result *domain.LogbookImportResult) ImportResponse {
+	response := ImportResponse{
+		DryRun:          result.DryRun,
+		Saved:           result.Saved,
+		TotalRows:       len(result.Rows),
+		InvalidRows:     result.InvalidRows(),
+		PendingRows:     result.PendingRows(),
+		LogbooksCreated: result.LogbooksCreated,
+		MappingsSaved:   result.MappingsSaved,
+		Rows:            make([]ImportRowResponse, 0, len(result.Rows)),
+	}
+	response.ValidRows = response.TotalRows - response.InvalidRows - response.PendingRows
+	for _, u := range result.Unresolved {
+		response.Unresolved = append(response.Unresolved, UnresolvedReferenceResponse{Kind: string(u.Kind), Value: u.Value, Lines: u.Lines})
+	}
+
+	// Segments of the file are not saved yet, so a clash with one of them is reported by its line
+	lines := make(map[string]int, len(result.Rows))
+	for _, row := range result.Rows {
+		if row.Detail != nil {
+			lines[row.Detail.ID] = row.Line
+		}
+	}
+
+	for _, row := range result.Rows {
+		rowResponse := ImportRowResponse{
+			Line:     row.Line,
+			Valid:    row.Err == nil,
+			Pending:  domain.IsUnresolvedReference(row.Err),
+			Warnings: h.toWarningResponses(row.Warnings),
+		}
+		if row.Err != nil {
+			code, params := segmentErrorMessage(row.Err)
+			rowError := &ImportRowErrorResponse{Code: code}
+			var fieldErr *domain.ImportFieldError
+			if errors.As(row.Err, &fieldErr) {
+				rowError.Field = fieldErr.Field
+			}
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(row.Err, &conflictErr) {
+				var conflict SegmentConflictResponse
+				code, conflict = h.toSegmentConflictResponse(conflictErr)
+				rowError.Code = code
+				rowError.ConflictingDetailID = conflict.ConflictingDetailID
+				params = []string{conflict.ConflictingDetailID}
+				if line, ok := lines[conflictErr.DetailID]; ok {
+					rowError.ConflictingDetailID = ""
+					rowError.ConflictingLine = line
+					params = []string{"line " + strconv.Itoa(line)}
+				}
+			}
+			if h.MessagingCache != nil {
+				if msg := h.MessagingCache.GetMessageResponse(code, params...); msg != nil {
+					rowError.Message = msg.Content
+				}
+			}
+			rowResponse.Error = rowError
+		}
+		if row.Detail != nil {
+			rowResponse.FlightRealDate = row.Detail.FlightRealDate
+			rowResponse.FlightNumber = row.Detail.FlightNumber
+			rowResponse.Origin = row.Detail.OriginIataCode
+			rowResponse.Destination = row.Detail.DestinationIataCode
+			rowResponse.LicensePlate = row.Detail.LicensePlate
+			rowResponse.BlockTime = row.Detail.BlockTime
+			rowResponse.AirTime = row.Detail.AirTime
+			if result.Saved {
+				rowResponse.DetailID, _ = h.EncodeID(row.Detail.ID)
+				rowResponse.LogbookID, _ = h.EncodeID(row.Detail.DailyLogbookID)
+			}
+		}
+		response.Rows = append(response.Rows, rowResponse)
+	}
+	return response
+}
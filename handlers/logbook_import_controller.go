@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportFileSize limits the uploaded CSV (bytes)
+const maxImportFileSize = 5 << 20
+
+// ============================================
+// POST /daily-logbooks/import
+// Importación masiva de segmentos de vuelo desde CSV
+// ============================================
+
+// ImportDailyLogbooks imports flight segments for the authenticated employee from a CSV file
+// @Summary Bulk import flight segments
+// @Description Imports flight segments from a CSV file (multipart field "file" or a text/csv body). Every row is validated like a single create; daily logbooks are created for days without one. Nothing is saved if any row is invalid. With dry_run=true the rows are only validated.
+// @Tags DailyLogbookDetails
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Produce json
+// @Param file formData file false "CSV file with a header row"
+// @Param dry_run query bool false "Validate only, do not save"
+// @Success 200 {object} middleware.APIResponse{data=ImportResponse} "Dry run"
+// @Success 201 {object} middleware.APIResponse{data=ImportResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 422 {object} middleware.APIResponse{data=ImportResponse}
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/import [post]
+// @Security BearerAuth
+func (h *handler) ImportDailyLogbooks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookImportError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+
+		// The file may come as a multipart upload or as the raw request body
+		var body io.Reader
+		if file, err := c.FormFile("file"); err == nil {
+			if file.Size > maxImportFileSize {
+				h.Response.Error(c, domain.MsgImportInvalidFile, "file too large")
+				return
+			}
+			f, err := file.Open()
+			if err != nil {
+				log.Warn(logger.LogLogbookImportError, "error", err)
+				h.Response.Error(c, domain.MsgImportInvalidFile, "file could not be read")
+				return
+			}
+			defer f.Close()
+			body = f
+		} else {
+			body = io.LimitReader(c.Request.Body, maxImportFileSize)
+		}
+
+		rows, err := ParseLogbookImportCSV(body)
+		if err != nil {
+			log.Warn(logger.LogLogbookImportError, "error", err)
+			if err == domain.ErrImportTooManyRows {
+				h.Response.Error(c, domain.MsgImportTooManyRows, strconv.Itoa(domain.MaxLogbookImportRows))
+				return
+			}
+			var fileErr *ImportFileError
+			if errors.As(err, &fileErr) {
+				h.Response.Error(c, domain.MsgImportInvalidFile, fileErr.Detail)
+				return
+			}
+			h.Response.Error(c, domain.MsgImportInvalidFile, err.Error())
+			return
+		}
+
+		result, err := h.DailyLogbookDetailInteractor.ImportDailyLogbookDetails(c.Request.Context(), traceID, employee.ID, rows, dryRun)
+		if err != nil {
+			if err == domain.ErrImportTooManyRows {
+				h.Response.Error(c, domain.MsgImportTooManyRows, strconv.Itoa(domain.MaxLogbookImportRows))
+				return
+			}
+			log.Error(logger.LogLogbookImportError, "error", err)
+			h.Response.Error(c, domain.MsgImportErr)
+			return
+		}
+
+		response := h.toImportResponse(result)
+		if response.InvalidRows > 0 {
+			h.Response.ErrorWithData(c, domain.MsgImportValidationErr, response, strconv.Itoa(response.InvalidRows))
+			return
+		}
+		if result.DryRun {
+			h.Response.SuccessWithData(c, domain.MsgImportDryRunOK, response)
+			return
+		}
+
+		log.Info(logger.LogLogbookImportOK, "employee_id", employee.ID, "rows", response.TotalRows)
+		h.Response.SuccessWithData(c, domain.MsgImportOK, response)
+	}
+}
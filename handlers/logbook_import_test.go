@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+func TestParseImportFile_ForeFlight(t *testing.T) {
+	file := strings.Join([]string{
+		"ForeFlight Logbook Import,This row is required for importing into ForeFlight.",
+		"Aircraft Table,,,",
+		"AircraftID,TypeCode,Year,Make",
+		"HK5000,A320,2015,Airbus",
+		",,,",
+		"Flights Table,,,",
+		"Date,AircraftID,From,To,FlightNumber,TimeOut,TimeOff,TimeOn,TimeIn",
+		"2024-03-10,HK5000,SKBO,SKCL,AV9340,0800,0815,9:20,0930",
+	}, "\n")
+
+	rows, err := parseImportFile(strings.NewReader(file), elogbookAdapters[domain.ElogbookFormatForeFlight], nil, importDefaults{PilotRole: "PF"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+	row := rows[0]
+	if row.Line != 8 || row.Origin != "SKBO" || row.Destination != "SKCL" || row.LicensePlate != "HK5000" {
+		t.Errorf("unexpected row %+v", row)
+	}
+	if row.OutTime != "08:00" || row.LandingTime != "09:20" || row.PilotRole != "PF" || row.TimeReference != "UTC" {
+		t.Errorf("unexpected normalized values %+v", row)
+	}
+}
+
+func TestParseImportFile_FieldMap(t *testing.T) {
+	file := "Day,Reg,Dep,Arr,Flt,Out,Off,On,In,PF\n03/10/2024,HK5000,BOG,CLO,AV1,08:00,08:15,09:20,09:30,1\n"
+	fieldMap := map[string]string{
+		"flight_real_date": "Day", "license_plate": "Reg", "origin": "Dep", "destination": "Arr",
+		"flight_number": "Flt", "out_time": "Out", "takeoff_time": "Off", "landing_time": "On",
+		"in_time": "In", "pilot_flying": "PF",
+	}
+
+	adapter := elogbookAdapters[domain.ElogbookFormatCustom]
+	adapter.DateLayouts = []string{"01/02/2006"}
+	rows, err := parseImportFile(strings.NewReader(file), adapter, fieldMap, importDefaults{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rows[0].FlightRealDate != "2024-03-10" || rows[0].PilotRole != "PF" {
+		t.Errorf("unexpected row %+v", rows[0])
+	}
+
+	// A field map pointing at a header the file does not have is reported
+	fieldMap["flight_number"] = "Flight"
+	_, err = parseImportFile(strings.NewReader(file), adapter, fieldMap, importDefaults{})
+	var fileErr *ImportFileError
+	if !errors.As(err, &fileErr) || !strings.Contains(fileErr.Detail, "Flight") {
+		t.Fatalf("expected missing Flight column, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// LogbookValidationIssueResponse represents one finding of the consistency report
+type LogbookValidationIssueResponse struct {
+	Severity        string `json:"severity"` // ERROR or WARNING
+	Code            string `json:"code"`
+	Message         string `json:"message,omitempty"`
+	DetailID        string `json:"detail_id"`
+	RelatedDetailID string `json:"related_detail_id,omitempty"`
+}
+
+// LogbookValidationResponse represents the response for GET /daily-logbooks/:id/validation
+type LogbookValidationResponse struct {
+	LogbookID    string                           `json:"logbook_id"`
+	LogDate      string                           `json:"log_date"`
+	SegmentCount int                              `json:"segment_count"`
+	Valid        bool                             `json:"valid"` // No ERROR findings
+	ErrorCount   int                              `json:"error_count"`
+	WarningCount int                              `json:"warning_count"`
+	Route        []string                         `json:"route"` // Airports in OUT-time order, e.g. [CLO BOG MDE CTG]
+	Issues       []LogbookValidationIssueResponse `json:"issues"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toLogbookValidationResponse maps the report, encoding segment IDs and resolving message contents.
+// Findings about another segment without params of their own (duplicates, overlaps) use its
+// obfuscated ID as ${0}, as on create/update.
+func (h *handler) toLogbookValidationResponse(report *domain.LogbookValidationReport, encodedLogbookID string) LogbookValidationResponse {
+	response := LogbookValidationResponse{
+		LogbookID:    encodedLogbookID,
+		LogDate:      report.LogDate,
+		SegmentCount: report.SegmentCount,
+		Valid:        report.Valid(),
+		ErrorCount:   report.Count(domain.ValidationSeverityError),
+		WarningCount: report.Count(domain.ValidationSeverityWarning),
+		Route:        report.Route,
+		Issues:       make([]LogbookValidationIssueResponse, 0, len(report.Issues)),
+	}
+	if response.Route == nil {
+		response.Route = []string{}
+	}
+
+	for _, issue := range report.Issues {
+		issueResponse := LogbookValidationIssueResponse{
+			Severity: string(issue.Severity),
+			Code:     issue.Code,
+		}
+		issueResponse.DetailID, _ = h.EncodeID(issue.DetailID)
+		params := issue.Params
+		if issue.RelatedDetailID != "" {
+			issueResponse.RelatedDetailID, _ = h.EncodeID(issue.RelatedDetailID)
+			if len(params) == 0 {
+				params = []string{issueResponse.RelatedDetailID}
+			}
+		}
+		if h.MessagingCache != nil {
+			if msg := h.MessagingCache.GetMessageResponse(issue.Code, params...); msg != nil {
+				issueResponse.Message = msg.Content
+			}
+		}
+		response.Issues = append(response.Issues, issueResponse)
+	}
+	return response
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /daily-logbooks/:id/validation
+// Reporte de consistencia de la bitácora diaria
+// ============================================
+
+// ValidateDailyLogbook returns the consistency report of a daily logbook
+// @Summary Validate daily logbook
+// @Description Checks the day's stored segments: time sequence, derived air/block times, duplicates and overlaps between segments, and route continuity (each leg departing from the previous leg's destination). ERROR findings would be rejected on create/update; WARNING findings are informative.
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Logbook ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=LogbookValidationResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /daily-logbooks/{id}/validation [get]
+// @Security BearerAuth
+func (h *handler) ValidateDailyLogbook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		// Get authenticated user
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogLogbookValidationError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		// Resolve logbook ID
+		logbookUUID, responseID := h.resolveID(c.Param("id"))
+		if logbookUUID == "" {
+			log.Warn(logger.LogLogbookValidationError, "error", "invalid logbook ID")
+			h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+			return
+		}
+
+		// Verify ownership
+		if err := h.DailyLogbookDetailInteractor.VerifyLogbookOwnership(c.Request.Context(), logbookUUID, employee.ID); err != nil {
+			log.Warn(logger.LogLogbookValidationError, "error", err)
+			if err == domain.ErrFlightUnauthorized {
+				h.Response.Error(c, domain.MsgFlightUnauthorized)
+				return
+			}
+			h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+			return
+		}
+
+		report, err := h.DailyLogbookDetailInteractor.ValidateDailyLogbook(c.Request.Context(), traceID, logbookUUID)
+		if err != nil {
+			log.Error(logger.LogLogbookValidationError, "error", err)
+			if err == domain.ErrFlightInvalidLogbook {
+				h.Response.Error(c, domain.MsgFlightInvalidLogbook)
+				return
+			}
+			h.Response.Error(c, domain.MsgLogbookValidationErr)
+			return
+		}
+
+		log.Info(logger.LogLogbookValidationOK, "logbook_id", logbookUUID, "issues", len(report.Issues))
+		h.Response.SuccessWithData(c, domain.MsgLogbookValidationOK, h.toLogbookValidationResponse(report, responseID))
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// NotificationResponse represents an in-app notification with its message catalog text
+type NotificationResponse struct {
+	ID          string   `json:"id"`
+	Kind        string   `json:"kind"` // CREDENTIAL_EXPIRY
+	Code        string   `json:"code"`
+	Message     string   `json:"message,omitempty"`
+	Params      []string `json:"params,omitempty"`
+	ReferenceID string   `json:"reference_id,omitempty"` // Encoded ID of what the notification is about
+	Read        bool     `json:"read"`
+	ReadAt      *string  `json:"read_at,omitempty"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toNotificationResponse maps a notification, encoding its IDs and resolving its message content
+func (h *handler) toNotificationResponse(n *domain.Notification) NotificationResponse {
+	id, _ := h.EncodeID(n.ID)
+
+	response := NotificationResponse{
+		ID:        id,
+		Kind:      string(n.Kind),
+		Code:      n.Code,
+		Params:    n.Params,
+		Read:      n.ReadAt != nil,
+		CreatedAt: n.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if n.ReferenceID != "" {
+		response.ReferenceID, _ = h.EncodeID(n.ReferenceID)
+	}
+	if n.ReadAt != nil {
+		readAt := n.ReadAt.UTC().Format(time.RFC3339)
+		response.ReadAt = &readAt
+	}
+	if h.MessagingCache != nil {
+		if msg := h.MessagingCache.GetMessageResponse(n.Code, n.Params...); msg != nil {
+			response.Message = msg.Content
+		}
+	}
+	return response
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /employees/me/notifications
+// Listar notificaciones del empleado
+// ============================================
+
+// GetMyNotifications lists the in-app notifications of the authenticated employee
+// @Summary List my notifications
+// @Description Notifications raised for the employee, latest first, such as credential expiry notices, with their message text
+// @Tags Notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Success 200 {object} middleware.APIResponse{data=[]NotificationResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /employees/me/notifications [get]
+// @Security BearerAuth
+func (h *handler) GetMyNotifications() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogNotificationError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		filter := domain.NotificationFilter{
+			EmployeeID: employee.ID,
+			UnreadOnly: c.Query("unread") == "true",
+		}
+		notifications, err := h.NotificationInteractor.ListNotifications(c.Request.Context(), traceID, filter)
+		if err != nil {
+			log.Error(logger.LogNotificationError, "error", err)
+			h.Response.Error(c, domain.MsgNotificationErr)
+			return
+		}
+
+		response := make([]NotificationResponse, 0, len(notifications))
+		for i := range notifications {
+			response = append(response, h.toNotificationResponse(&notifications[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgNotificationListOK, response)
+	}
+}
+
+// ============================================
+// PATCH /notifications/:id/read
+// Marcar notificación como leída
+// ============================================
+
+// MarkNotificationRead marks a notification of the authenticated employee as read
+// @Summary Mark notification as read
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=NotificationResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /notifications/{id}/read [patch]
+// @Security BearerAuth
+func (h *handler) MarkNotificationRead() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogNotificationError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		notificationUUID, _ := h.resolveID(c.Param("id"))
+		if notificationUUID == "" {
+			log.Warn(logger.LogNotificationError, "error", "invalid notification ID")
+			h.Response.Error(c, domain.MsgNotificationNotFound)
+			return
+		}
+
+		notification, err := h.NotificationInteractor.MarkNotificationRead(c.Request.Context(), traceID, notificationUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogNotificationError, "error", err)
+			h.Response.Error(c, notificationErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgNotificationRead, h.toNotificationResponse(notification))
+	}
+}
+
+// notificationErrorMessage maps a notification error to its message code
+func notificationErrorMessage(err error) string {
+	switch err {
+	case domain.ErrNotificationNotFound:
+		return domain.MsgNotificationNotFound
+	case domain.ErrNotificationUnauthorized:
+		return domain.MsgNotificationUnauthorized
+	default:
+		return domain.MsgNotificationErr
+	}
+}
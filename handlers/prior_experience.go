@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// LogbookColumnsRequest holds the opening balance of each logbook column (HH:MM, hours may exceed 99)
+type LogbookColumnsRequest struct {
+	SinglePilotSE string `json:"single_pilot_se,omitempty"`
+	SinglePilotME string `json:"single_pilot_me,omitempty"`
+	MultiPilot    string `json:"multi_pilot,omitempty"`
+	Turbine       string `json:"turbine,omitempty"`
+	PIC           string `json:"pic,omitempty"`
+	SIC           string `json:"sic,omitempty"`
+	Dual          string `json:"dual,omitempty"`
+	Instructor    string `json:"instructor,omitempty"`
+	PICUS         string `json:"picus,omitempty"`
+	IFR           string `json:"ifr,omitempty"`
+}
+
+// PriorExperienceRequest represents the request body for declaring or updating an opening balance
+type PriorExperienceRequest struct {
+	AsOfDate       string                `json:"as_of_date" binding:"required"` // YYYY-MM-DD, date the balances are brought up to
+	AircraftFamily *string               `json:"aircraft_family,omitempty"`     // Required with recent experience
+	Flights        int                   `json:"flights"`
+	TotalTime      string                `json:"total_time" binding:"required"` // HH:MM, hours may exceed 99
+	NightTime      string                `json:"night_time,omitempty"`          // HH:MM
+	LogbookColumns LogbookColumnsRequest `json:"logbook_columns"`
+	DayTakeoffs    int                   `json:"day_takeoffs"`
+	NightTakeoffs  int                   `json:"night_takeoffs"`
+	DayLandings    int                   `json:"day_landings"`
+	NightLandings  int                   `json:"night_landings"`
+
+	// Recent experience within the 90 days up to as_of_date, credited to currency on that date
+	RecentTakeoffs      int `json:"recent_takeoffs"`
+	RecentLandings      int `json:"recent_landings"`
+	RecentNightTakeoffs int `json:"recent_night_takeoffs"`
+	RecentNightLandings int `json:"recent_night_landings"`
+	RecentApproaches    int `json:"recent_approaches"`
+
+	DocumentType      string  `json:"document_type" binding:"required"`      // LOGBOOK, EMPLOYER_CERTIFICATE, AUTHORITY_RECORD or OTHER
+	DocumentReference string  `json:"document_reference" binding:"required"` // Number, title or location of the supporting document
+	Remarks           *string `json:"remarks,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields
+func (r *PriorExperienceRequest) Sanitize() {
+	r.AsOfDate = TrimString(r.AsOfDate)
+	r.AircraftFamily = TrimStringPtr(r.AircraftFamily)
+	r.TotalTime = TrimString(r.TotalTime)
+	r.NightTime = TrimString(r.NightTime)
+	r.DocumentType = strings.ToUpper(TrimString(r.DocumentType))
+	r.DocumentReference = TrimString(r.DocumentReference)
+	r.Remarks = TrimStringPtr(r.Remarks)
+
+	c := &r.LogbookColumns
+	for _, v := range []*string{&c.SinglePilotSE, &c.SinglePilotME, &c.MultiPilot, &c.Turbine, &c.PIC, &c.SIC,
+		&c.Dual, &c.Instructor, &c.PICUS, &c.IFR} {
+		*v = TrimString(*v)
+	}
+}
+
+// ToDomain converts the request to a domain prior experience of the employee.
+// Returns ErrPriorExperienceInvalidTime when a balance is not in HH:MM format.
+func (r *PriorExperienceRequest) ToDomain(employeeID string) (domain.PriorExperience, error) {
+	prior := domain.PriorExperience{
+		EmployeeID:          employeeID,
+		AsOfDate:            r.AsOfDate,
+		AircraftFamily:      r.AircraftFamily,
+		Flights:             r.Flights,
+		DayTakeoffs:         r.DayTakeoffs,
+		NightTakeoffs:       r.NightTakeoffs,
+		DayLandings:         r.DayLandings,
+		NightLandings:       r.NightLandings,
+		RecentTakeoffs:      r.RecentTakeoffs,
+		RecentLandings:      r.RecentLandings,
+		RecentNightTakeoffs: r.RecentNightTakeoffs,
+		RecentNightLandings: r.RecentNightLandings,
+		RecentApproaches:    r.RecentApproaches,
+		DocumentType:        domain.PriorExperienceDocumentType(r.DocumentType),
+		DocumentReference:   r.DocumentReference,
+		Remarks:             r.Remarks,
+	}
+
+	c := r.LogbookColumns
+	balances := []struct {
+		value string
+		dest  *time.Duration
+	}{
+		{r.TotalTime, &prior.TotalTime},
+		{r.NightTime, &prior.NightTime},
+		{c.SinglePilotSE, &prior.Columns.SinglePilotSE},
+		{c.SinglePilotME, &prior.Columns.SinglePilotME},
+		{c.MultiPilot, &prior.Columns.MultiPilot},
+		{c.Turbine, &prior.Columns.Turbine},
+		{c.PIC, &prior.Columns.PIC},
+		{c.SIC, &prior.Columns.SIC},
+		{c.Dual, &prior.Columns.Dual},
+		{c.Instructor, &prior.Columns.Instructor},
+		{c.PICUS, &prior.Columns.PICUS},
+		{c.IFR, &prior.Columns.IFR},
+	}
+	for _, b := range balances {
+		if b.value == "" {
+			continue
+		}
+		d, err := domain.ParseFlightDuration(b.value)
+		if err != nil {
+			return domain.PriorExperience{}, domain.ErrPriorExperienceInvalidTime
+		}
+		*b.dest = d
+	}
+	return prior, nil
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// PriorExperienceResponse represents an opening balance
+type PriorExperienceResponse struct {
+	ID                  string                 `json:"id"`
+	AsOfDate            string                 `json:"as_of_date"`
+	AircraftFamily      *string                `json:"aircraft_family,omitempty"`
+	Flights             int                    `json:"flights"`
+	TotalTime           string                 `json:"total_time"` // HH:MM
+	NightTime           string                 `json:"night_time"` // HH:MM
+	LogbookColumns      LogbookColumnsResponse `json:"logbook_columns"`
+	DayTakeoffs         int                    `json:"day_takeoffs"`
+	NightTakeoffs       int                    `json:"night_takeoffs"`
+	DayLandings         int                    `json:"day_landings"`
+	NightLandings       int                    `json:"night_landings"`
+	RecentTakeoffs      int                    `json:"recent_takeoffs"`
+	RecentLandings      int                    `json:"recent_landings"`
+	RecentNightTakeoffs int                    `json:"recent_night_takeoffs"`
+	RecentNightLandings int                    `json:"recent_night_landings"`
+	RecentApproaches    int                    `json:"recent_approaches"`
+	DocumentType        string                 `json:"document_type"`
+	DocumentReference   string                 `json:"document_reference"`
+	Remarks             *string                `json:"remarks,omitempty"`
+	CreatedAt           string                 `json:"created_at"`
+	UpdatedAt           string                 `json:"updated_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toPriorExperienceResponse maps an opening balance, encoding its ID
+func (h *handler) toPriorExperienceResponse(p *domain.PriorExperience) PriorExperienceResponse {
+	id, _ := h.EncodeID(p.ID)
+
+	return PriorExperienceResponse{
+		ID:                  id,
+		AsOfDate:            p.AsOfDate,
+		AircraftFamily:      p.AircraftFamily,
+		Flights:             p.Flights,
+		TotalTime:           domain.FormatFlightDuration(p.TotalTime),
+		NightTime:           domain.FormatFlightDuration(p.NightTime),
+		LogbookColumns:      FromDomainLogbookColumns(p.Columns),
+		DayTakeoffs:         p.DayTakeoffs,
+		NightTakeoffs:       p.NightTakeoffs,
+		DayLandings:         p.DayLandings,
+		NightLandings:       p.NightLandings,
+		RecentTakeoffs:      p.RecentTakeoffs,
+		RecentLandings:      p.RecentLandings,
+		RecentNightTakeoffs: p.RecentNightTakeoffs,
+		RecentNightLandings: p.RecentNightLandings,
+		RecentApproaches:    p.RecentApproaches,
+		DocumentType:        string(p.DocumentType),
+		DocumentReference:   p.DocumentReference,
+		Remarks:             p.Remarks,
+		CreatedAt:           p.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:           p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /prior-experience
+// Listar experiencia previa del empleado
+// ============================================
+
+// ListPriorExperience lists the opening balances of the authenticated employee
+// @Summary List prior experience
+// @Description Opening balances brought from before the logged segments, oldest first. They are added to the flight totals, the logbook export and the currency status.
+// @Tags PriorExperience
+// @Produce json
+// @Success 200 {object} middleware.APIResponse{data=[]PriorExperienceResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /prior-experience [get]
+// @Security BearerAuth
+func (h *handler) ListPriorExperience() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogPriorExperienceError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		entries, err := h.PriorExperienceInteractor.ListPriorExperience(c.Request.Context(), traceID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogPriorExperienceError, "error", err)
+			h.Response.Error(c, domain.MsgPriorExperienceErr)
+			return
+		}
+
+		response := make([]PriorExperienceResponse, 0, len(entries))
+		for i := range entries {
+			response = append(response, h.toPriorExperienceResponse(&entries[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgPriorExperienceListOK, response)
+	}
+}
+
+// ============================================
+// GET /prior-experience/:id
+// Consultar saldo inicial
+// ============================================
+
+// GetPriorExperience returns an opening balance of the authenticated employee
+// @Summary Get prior experience
+// @Tags PriorExperience
+// @Produce json
+// @Param id path string true "Prior experience ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=PriorExperienceResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /prior-experience/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetPriorExperience() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, priorUUID, ok := h.authorizePriorExperience(c)
+		if !ok {
+			return
+		}
+
+		prior, err := h.PriorExperienceInteractor.GetPriorExperience(c.Request.Context(), traceID, priorUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogPriorExperienceError, "error", err)
+			h.Response.Error(c, priorExperienceErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgPriorExperienceGetOK, h.toPriorExperienceResponse(prior))
+	}
+}
+
+// ============================================
+// POST /prior-experience
+// Registrar saldo inicial
+// ============================================
+
+// CreatePriorExperience declares an opening balance for the authenticated employee
+// @Summary Create prior experience
+// @Description Balances are dated as_of_date, which cannot be in the future. No logbook column may exceed the total time, and the aircraft category and function columns may not add up to more than it. Recent takeoffs, landings and approaches require the aircraft family they were flown on.
+// @Tags PriorExperience
+// @Accept json
+// @Produce json
+// @Param body body PriorExperienceRequest true "Opening balance"
+// @Success 201 {object} middleware.APIResponse{data=PriorExperienceResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /prior-experience [post]
+// @Security BearerAuth
+func (h *handler) CreatePriorExperience() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogPriorExperienceError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		prior, ok := h.bindPriorExperienceRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		created, err := h.PriorExperienceInteractor.CreatePriorExperience(c.Request.Context(), traceID, prior)
+		if err != nil {
+			log.Error(logger.LogPriorExperienceError, "error", err)
+			h.Response.Error(c, priorExperienceErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgPriorExperienceCreated, h.toPriorExperienceResponse(created))
+	}
+}
+
+// ============================================
+// PUT /prior-experience/:id
+// Actualizar saldo inicial
+// ============================================
+
+// UpdatePriorExperience replaces the values of an opening balance of the authenticated employee
+// @Summary Update prior experience
+// @Tags PriorExperience
+// @Accept json
+// @Produce json
+// @Param id path string true "Prior experience ID (obfuscated or UUID)"
+// @Param body body PriorExperienceRequest true "Opening balance"
+// @Success 200 {object} middleware.APIResponse{data=PriorExperienceResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /prior-experience/{id} [put]
+// @Security BearerAuth
+func (h *handler) UpdatePriorExperience() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, priorUUID, ok := h.authorizePriorExperience(c)
+		if !ok {
+			return
+		}
+
+		prior, ok := h.bindPriorExperienceRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		updated, err := h.PriorExperienceInteractor.UpdatePriorExperience(c.Request.Context(), traceID, priorUUID, employee.ID, prior)
+		if err != nil {
+			log.Error(logger.LogPriorExperienceError, "error", err)
+			h.Response.Error(c, priorExperienceErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgPriorExperienceUpdated, h.toPriorExperienceResponse(updated))
+	}
+}
+
+// ============================================
+// DELETE /prior-experience/:id
+// Eliminar saldo inicial
+// ============================================
+
+// DeletePriorExperience removes an opening balance of the authenticated employee
+// @Summary Delete prior experience
+// @Tags PriorExperience
+// @Produce json
+// @Param id path string true "Prior experience ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /prior-experience/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeletePriorExperience() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, priorUUID, ok := h.authorizePriorExperience(c)
+		if !ok {
+			return
+		}
+
+		if err := h.PriorExperienceInteractor.DeletePriorExperience(c.Request.Context(), traceID, priorUUID, employee.ID); err != nil {
+			log.Error(logger.LogPriorExperienceError, "error", err)
+			h.Response.Error(c, priorExperienceErrorMessage(err))
+			return
+		}
+
+		h.Response.Success(c, domain.MsgPriorExperienceDeleted)
+	}
+}
+
+// bindPriorExperienceRequest binds and sanitizes the request body and parses its balances, writing the
+// error response when it cannot
+func (h *handler) bindPriorExperienceRequest(c *gin.Context, employeeID string) (domain.PriorExperience, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	var req PriorExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(logger.LogPriorExperienceError, "error", err)
+		h.Response.Error(c, domain.MsgValJSONInvalid)
+		return domain.PriorExperience{}, false
+	}
+	req.Sanitize()
+
+	prior, err := req.ToDomain(employeeID)
+	if err != nil {
+		log.Warn(logger.LogPriorExperienceError, "error", err)
+		h.Response.Error(c, priorExperienceErrorMessage(err))
+		return domain.PriorExperience{}, false
+	}
+	return prior, true
+}
+
+// authorizePriorExperience resolves the :id opening balance of the authenticated employee, writing the
+// error response when it cannot; ownership is checked by the interactor
+func (h *handler) authorizePriorExperience(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogPriorExperienceError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	priorUUID, _ := h.resolveID(c.Param("id"))
+	if priorUUID == "" {
+		log.Warn(logger.LogPriorExperienceError, "error", "invalid prior experience ID")
+		h.Response.Error(c, domain.MsgPriorExperienceNotFound)
+		return nil, "", false
+	}
+	return employee, priorUUID, true
+}
+
+// priorExperienceErrorMessage maps a prior experience error to its message code
+func priorExperienceErrorMessage(err error) string {
+	switch err {
+	case domain.ErrPriorExperienceNotFound:
+		return domain.MsgPriorExperienceNotFound
+	case domain.ErrPriorExperienceUnauthorized:
+		return domain.MsgPriorExperienceUnauthorized
+	case domain.ErrPriorExperienceInvalid:
+		return domain.MsgPriorExperienceInvalid
+	case domain.ErrPriorExperienceInvalidTime:
+		return domain.MsgPriorExperienceInvalidTime
+	case domain.ErrPriorExperienceInconsistent:
+		return domain.MsgPriorExperienceInconsistent
+	case domain.ErrPriorExperienceInvalidDocument:
+		return domain.MsgPriorExperienceInvalidDocument
+	default:
+		return domain.MsgPriorExperienceErr
+	}
+}
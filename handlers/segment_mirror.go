@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// AcceptSegmentMirrorRequest represents the optional body for accepting a mirrored entry
+type AcceptSegmentMirrorRequest struct {
+	PilotRole string `json:"pilot_role,omitempty"` // Overrides the complementary role (PF↔PM, PFTO↔PFL)
+}
+
+// Sanitize trims whitespace from string fields
+func (r *AcceptSegmentMirrorRequest) Sanitize() {
+	r.PilotRole = strings.ToUpper(TrimString(r.PilotRole))
+}
+
+// ResolveSegmentMirrorRequest represents the request body for resolving a conflict between linked entries
+type ResolveSegmentMirrorRequest struct {
+	Resolution string `json:"resolution"` // ADOPT (take the companion's flight data) or UNLINK (stop syncing)
+}
+
+// Sanitize trims whitespace from string fields
+func (r *ResolveSegmentMirrorRequest) Sanitize() {
+	r.Resolution = strings.ToUpper(TrimString(r.Resolution))
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// SegmentMirrorResponse represents the link between a segment and the companion's mirrored entry
+type SegmentMirrorResponse struct {
+	ID                  string                      `json:"id"`
+	SourceDetailID      string                      `json:"source_detail_id"`
+	SourceEmployeeID    string                      `json:"source_employee_id"`
+	CompanionEmployeeID string                      `json:"companion_employee_id"`
+	MirrorDetailID      string                      `json:"mirror_detail_id,omitempty"`
+	Status              string                      `json:"status"` // PENDING, DECLINED, WITHDRAWN, LINKED, CONFLICT or UNLINKED
+	CreatedAt           string                      `json:"created_at"`
+	UpdatedAt           string                      `json:"updated_at"`
+	Proposed            *DailyLogbookDetailResponse `json:"proposed,omitempty"`    // Pre-filled entry offered to the companion
+	Differences         []FieldChangeResponse       `json:"differences,omitempty"` // Shared fields that differ (before: pilot's entry, after: companion's entry)
+	Warnings            []WarningResponse           `json:"warnings,omitempty"`    // Non-blocking findings on the accepted entry
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toSegmentMirrorResponse maps a segment mirror, encoding its IDs and those of the proposed entry
+func (h *handler) toSegmentMirrorResponse(m *domain.SegmentMirror) SegmentMirrorResponse {
+	id, _ := h.EncodeID(m.ID)
+	sourceDetailID, _ := h.EncodeID(m.SourceDetailID)
+	sourceEmployeeID, _ := h.EncodeID(m.SourceEmployeeID)
+	companionEmployeeID, _ := h.EncodeID(m.CompanionEmployeeID)
+
+	response := SegmentMirrorResponse{
+		ID:                  id,
+		SourceDetailID:      sourceDetailID,
+		SourceEmployeeID:    sourceEmployeeID,
+		CompanionEmployeeID: companionEmployeeID,
+		Status:              string(m.Status),
+		CreatedAt:           m.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:           m.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if m.MirrorDetailID != nil {
+		response.MirrorDetailID, _ = h.EncodeID(*m.MirrorDetailID)
+	}
+	if m.Proposed != nil {
+		encodedRouteID, _ := h.EncodeID(m.Proposed.AirlineRouteID)
+		encodedAircraftID, _ := h.EncodeID(m.Proposed.ActualAircraftRegistrationID)
+		proposed := FromDomainDailyLogbookDetail(m.Proposed, "", "", encodedRouteID, encodedAircraftID)
+		proposed.CompanionEmployeeID = h.encodeCompanionEmployeeID(m.Proposed)
+		response.Proposed = &proposed
+	}
+	response.Differences = h.toFieldChangeResponses(toFieldChanges(m.Differences))
+	return response
+}
+
+// toFieldChanges converts the differences of two linked entries to a field-level diff
+func toFieldChanges(differences []domain.SegmentMirrorDifference) []domain.FieldChange {
+	changes := make([]domain.FieldChange, 0, len(differences))
+	for _, d := range differences {
+		changes = append(changes, domain.FieldChange{Field: d.Field, Before: d.Source, After: d.Mirror})
+	}
+	return changes
+}
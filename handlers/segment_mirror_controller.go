@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /segment-mirrors
+// Listar vuelos espejo del empleado
+// ============================================
+
+// ListSegmentMirrors lists the mirrored entries the authenticated employee takes part in
+// @Summary List segment mirrors
+// @Description Offers received from a companion pilot (with the pre-filled entry), offers sent, and linked entries (with the differing fields when in conflict)
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param status query string false "PENDING, DECLINED, WITHDRAWN, LINKED, CONFLICT or UNLINKED"
+// @Success 200 {object} middleware.APIResponse{data=[]SegmentMirrorResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /segment-mirrors [get]
+// @Security BearerAuth
+func (h *handler) ListSegmentMirrors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogSegmentMirrorError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgFlightUnauthorized)
+			return
+		}
+
+		status := c.Query("status")
+		if status != "" && !domain.IsValidSegmentMirrorStatus(status) {
+			log.Warn(logger.LogSegmentMirrorError, "error", "invalid status", "status", status)
+			h.Response.Error(c, domain.MsgSegmentMirrorInvalid)
+			return
+		}
+
+		mirrors, err := h.DailyLogbookDetailInteractor.ListSegmentMirrors(c.Request.Context(), traceID, employee.ID, status)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			h.Response.Error(c, domain.MsgSegmentMirrorErr)
+			return
+		}
+
+		response := make([]SegmentMirrorResponse, 0, len(mirrors))
+		for i := range mirrors {
+			response = append(response, h.toSegmentMirrorResponse(&mirrors[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgSegmentMirrorListOK, response)
+	}
+}
+
+// ============================================
+// GET /segment-mirrors/:id
+// Consultar vuelo espejo
+// ============================================
+
+// GetSegmentMirror returns a mirrored entry the authenticated employee takes part in
+// @Summary Get segment mirror
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Segment mirror ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=SegmentMirrorResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /segment-mirrors/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetSegmentMirror() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, mirrorUUID, ok := h.authorizeSegmentMirror(c)
+		if !ok {
+			return
+		}
+
+		mirror, err := h.DailyLogbookDetailInteractor.GetSegmentMirror(c.Request.Context(), traceID, mirrorUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			code, params := segmentMirrorErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgSegmentMirrorListOK, h.toSegmentMirrorResponse(mirror))
+	}
+}
+
+// ============================================
+// POST /segment-mirrors/:id/accept
+// Aceptar vuelo espejo del compañero
+// ============================================
+
+// AcceptSegmentMirror records the pre-filled entry in the companion's logbook of the day
+// @Summary Accept segment mirror
+// @Description Only the companion can accept. The entry is added to their daily logbook of the same day (created if missing) with the complementary pilot role, validated like a direct create, and linked to the pilot's segment.
+// @Tags DailyLogbookDetails
+// @Accept json
+// @Produce json
+// @Param id path string true "Segment mirror ID (obfuscated or UUID)"
+// @Param body body AcceptSegmentMirrorRequest false "Pilot role override"
+// @Success 201 {object} middleware.APIResponse{data=SegmentMirrorResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse "Offer no longer pending, logbook signed, or the entry duplicates/overlaps another one"
+// @Failure 500 {object} middleware.APIResponse
+// @Router /segment-mirrors/{id}/accept [post]
+// @Security BearerAuth
+func (h *handler) AcceptSegmentMirror() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, mirrorUUID, ok := h.authorizeSegmentMirror(c)
+		if !ok {
+			return
+		}
+
+		// The body is optional
+		var req AcceptSegmentMirrorRequest
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				log.Error(logger.LogSegmentMirrorError, "error", err)
+				h.Response.Error(c, domain.MsgValJSONInvalid)
+				return
+			}
+		}
+		req.Sanitize()
+
+		mirror, warnings, err := h.DailyLogbookDetailInteractor.AcceptSegmentMirror(c.Request.Context(), traceID, mirrorUUID, employee.ID, req.PilotRole)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			code, params := segmentMirrorErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		response := h.toSegmentMirrorResponse(mirror)
+		response.Warnings = h.toWarningResponses(warnings)
+
+		log.Info(logger.LogSegmentMirrorAcceptOK, "mirror_id", mirror.ID)
+		h.Response.SuccessWithData(c, domain.MsgSegmentMirrorAccepted, response)
+	}
+}
+
+// ============================================
+// POST /segment-mirrors/:id/decline
+// Rechazar vuelo espejo del compañero
+// ============================================
+
+// DeclineSegmentMirror turns down a pending offer
+// @Summary Decline segment mirror
+// @Tags DailyLogbookDetails
+// @Produce json
+// @Param id path string true "Segment mirror ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=SegmentMirrorResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /segment-mirrors/{id}/decline [post]
+// @Security BearerAuth
+func (h *handler) DeclineSegmentMirror() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, mirrorUUID, ok := h.authorizeSegmentMirror(c)
+		if !ok {
+			return
+		}
+
+		mirror, err := h.DailyLogbookDetailInteractor.DeclineSegmentMirror(c.Request.Context(), traceID, mirrorUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			code, params := segmentMirrorErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgSegmentMirrorDeclined, h.toSegmentMirrorResponse(mirror))
+	}
+}
+
+// ============================================
+// POST /segment-mirrors/:id/resolve
+// Resolver conflicto entre vuelos enlazados
+// ============================================
+
+// ResolveSegmentMirror settles a conflict between the two linked entries
+// @Summary Resolve segment mirror conflict
+// @Description ADOPT copies the companion's flight data, times included, into the employee's own entry (its logbook must not be signed); UNLINK keeps both entries as they are and stops syncing them
+// @Tags DailyLogbookDetails
+// @Accept json
+// @Produce json
+// @Param id path string true "Segment mirror ID (obfuscated or UUID)"
+// @Param body body ResolveSegmentMirrorRequest true "Resolution"
+// @Success 200 {object} middleware.APIResponse{data=SegmentMirrorResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 409 {object} middleware.APIResponse "Link not in conflict or no longer active, logbook signed, or the entry duplicates/overlaps another one"
+// @Failure 500 {object} middleware.APIResponse
+// @Router /segment-mirrors/{id}/resolve [post]
+// @Security BearerAuth
+func (h *handler) ResolveSegmentMirror() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, mirrorUUID, ok := h.authorizeSegmentMirror(c)
+		if !ok {
+			return
+		}
+
+		var req ResolveSegmentMirrorRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			h.Response.Error(c, domain.MsgValJSONInvalid)
+			return
+		}
+		req.Sanitize()
+
+		mirror, err := h.DailyLogbookDetailInteractor.ResolveSegmentMirror(c.Request.Context(), traceID, mirrorUUID, employee.ID, req.Resolution)
+		if err != nil {
+			log.Error(logger.LogSegmentMirrorError, "error", err)
+			var conflictErr *domain.SegmentConflictError
+			if errors.As(err, &conflictErr) {
+				code, conflict := h.toSegmentConflictResponse(conflictErr)
+				h.Response.ErrorWithData(c, code, conflict, conflict.ConflictingDetailID)
+				return
+			}
+			code, params := segmentMirrorErrorMessage(err)
+			h.Response.Error(c, code, params...)
+			return
+		}
+
+		log.Info(logger.LogSegmentMirrorResolveOK, "mirror_id", mirror.ID, "status", mirror.Status)
+		h.Response.SuccessWithData(c, domain.MsgSegmentMirrorResolved, h.toSegmentMirrorResponse(mirror))
+	}
+}
+
+// authorizeSegmentMirror resolves the :id segment mirror of the authenticated employee, writing the error
+// response when it cannot; whether the employee takes part in it is checked by the interactor
+func (h *handler) authorizeSegmentMirror(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogSegmentMirrorError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgFlightUnauthorized)
+		return nil, "", false
+	}
+
+	mirrorUUID, _ := h.resolveID(c.Param("id"))
+	if mirrorUUID == "" {
+		log.Warn(logger.LogSegmentMirrorError, "error", "invalid segment mirror ID")
+		h.Response.Error(c, domain.MsgSegmentMirrorNotFound)
+		return nil, "", false
+	}
+	return employee, mirrorUUID, true
+}
+
+// segmentMirrorErrorMessage maps a segment mirror error to its message code and params; validation
+// errors of the mirrored entry use the same messages as a direct create/update
+func segmentMirrorErrorMessage(err error) (string, []string) {
+	switch err {
+	case domain.ErrSegmentMirrorNotFound:
+		return domain.MsgSegmentMirrorNotFound, nil
+	case domain.ErrSegmentMirrorNotPending:
+		return domain.MsgSegmentMirrorNotPending, nil
+	case domain.ErrSegmentMirrorNotInConflict:
+		return domain.MsgSegmentMirrorNotInConflict, nil
+	case domain.ErrSegmentMirrorNotLinked:
+		return domain.MsgSegmentMirrorNotLinked, nil
+	case domain.ErrSegmentMirrorInvalid:
+		return domain.MsgSegmentMirrorInvalid, nil
+	case domain.ErrSegmentMirrorInvalidCompanion:
+		return domain.MsgSegmentMirrorInvalidCompanion, nil
+	case domain.ErrFlightNotFound:
+		return domain.MsgFlightNotFound, nil
+	}
+	code, params := segmentErrorMessage(err)
+	if code == domain.MsgImportErr {
+		return domain.MsgSegmentMirrorErr, nil
+	}
+	return code, params
+}
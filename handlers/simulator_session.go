@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// SimulatorSessionRequest represents the request body for logging or updating a simulator session
+type SimulatorSessionRequest struct {
+	SessionDate        string   `json:"session_date" binding:"required"`      // YYYY-MM-DD
+	DeviceType         string   `json:"device_type" binding:"required"`       // FFS, FTD, FNPT or BITD
+	QualificationLevel string   `json:"qualification_level"`                  // e.g. "D" for an FFS or "II MCC" for an FNPT
+	DeviceIdentifier   *string  `json:"device_identifier,omitempty"`          // Operator's designation of the device
+	AircraftModelID    string   `json:"aircraft_model_id" binding:"required"` // Aircraft model emulated (obfuscated or UUID)
+	Duration           string   `json:"duration" binding:"required"`          // HH:MM
+	Role               string   `json:"role" binding:"required"`              // TRAINEE, PILOT, INSTRUCTOR or EXAMINER
+	Exercises          []string `json:"exercises,omitempty"`                  // Exercises or manoeuvres flown
+	Takeoffs           int      `json:"takeoffs"`
+	Landings           int      `json:"landings"`
+	NightTakeoffs      int      `json:"night_takeoffs"`
+	NightLandings      int      `json:"night_landings"`
+	Approaches         int      `json:"approaches"` // Instrument approaches
+	Remarks            *string  `json:"remarks,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields and drops empty exercises
+func (r *SimulatorSessionRequest) Sanitize() {
+	r.SessionDate = TrimString(r.SessionDate)
+	r.DeviceType = strings.ToUpper(TrimString(r.DeviceType))
+	r.QualificationLevel = TrimString(r.QualificationLevel)
+	r.DeviceIdentifier = TrimStringPtr(r.DeviceIdentifier)
+	r.AircraftModelID = TrimString(r.AircraftModelID)
+	r.Duration = TrimString(r.Duration)
+	r.Role = strings.ToUpper(TrimString(r.Role))
+	r.Remarks = TrimStringPtr(r.Remarks)
+
+	exercises := make([]string, 0, len(r.Exercises))
+	for _, e := range r.Exercises {
+		if e = TrimString(e); e != "" {
+			exercises = append(exercises, e)
+		}
+	}
+	r.Exercises = exercises
+}
+
+// ToDomain converts the request to a domain simulator session of the employee
+func (r *SimulatorSessionRequest) ToDomain(employeeID string) domain.SimulatorSession {
+	return domain.SimulatorSession{
+		EmployeeID:         employeeID,
+		SessionDate:        r.SessionDate,
+		DeviceType:         domain.SimulatorDeviceType(r.DeviceType),
+		QualificationLevel: r.QualificationLevel,
+		DeviceIdentifier:   r.DeviceIdentifier,
+		AircraftModelID:    r.AircraftModelID,
+		Duration:           r.Duration,
+		Role:               domain.SimulatorRole(r.Role),
+		Exercises:          r.Exercises,
+		Takeoffs:           r.Takeoffs,
+		Landings:           r.Landings,
+		NightTakeoffs:      r.NightTakeoffs,
+		NightLandings:      r.NightLandings,
+		Approaches:         r.Approaches,
+		Remarks:            r.Remarks,
+	}
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// SimulatorSessionResponse represents a simulator session
+type SimulatorSessionResponse struct {
+	ID                 string   `json:"id"`
+	SessionDate        string   `json:"session_date"`
+	DeviceType         string   `json:"device_type"`
+	QualificationLevel string   `json:"qualification_level,omitempty"`
+	DeviceIdentifier   *string  `json:"device_identifier,omitempty"`
+	AircraftModelID    string   `json:"aircraft_model_id"`
+	ModelName          string   `json:"model_name,omitempty"`
+	AircraftFamily     string   `json:"aircraft_family,omitempty"`
+	Duration           string   `json:"duration"` // HH:MM
+	Role               string   `json:"role"`
+	Exercises          []string `json:"exercises"`
+	Takeoffs           int      `json:"takeoffs"`
+	Landings           int      `json:"landings"`
+	NightTakeoffs      int      `json:"night_takeoffs"`
+	NightLandings      int      `json:"night_landings"`
+	Approaches         int      `json:"approaches"`
+	Remarks            *string  `json:"remarks,omitempty"`
+	CreatedAt          string   `json:"created_at"`
+	UpdatedAt          string   `json:"updated_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toSimulatorSessionResponse maps a simulator session, encoding its IDs
+func (h *handler) toSimulatorSessionResponse(s *domain.SimulatorSession) SimulatorSessionResponse {
+	id, _ := h.EncodeID(s.ID)
+	modelID, _ := h.EncodeID(s.AircraftModelID)
+
+	exercises := s.Exercises
+	if exercises == nil {
+		exercises = []string{}
+	}
+
+	return SimulatorSessionResponse{
+		ID:                 id,
+		SessionDate:        s.SessionDate,
+		DeviceType:         string(s.DeviceType),
+		QualificationLevel: s.QualificationLevel,
+		DeviceIdentifier:   s.DeviceIdentifier,
+		AircraftModelID:    modelID,
+		ModelName:          s.ModelName,
+		AircraftFamily:     s.AircraftFamily,
+		Duration:           s.Duration,
+		Role:               string(s.Role),
+		Exercises:          exercises,
+		Takeoffs:           s.Takeoffs,
+		Landings:           s.Landings,
+		NightTakeoffs:      s.NightTakeoffs,
+		NightLandings:      s.NightLandings,
+		Approaches:         s.Approaches,
+		Remarks:            s.Remarks,
+		CreatedAt:          s.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          s.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /simulator-sessions
+// Listar sesiones de simulador del empleado
+// ============================================
+
+// ListSimulatorSessions lists the simulator sessions of the authenticated employee
+// @Summary List simulator sessions
+// @Description Sessions flown in flight simulation training devices (FSTD), latest first, optionally filtered by session date
+// @Tags SimulatorSessions
+// @Produce json
+// @Param from query string false "Start session date (YYYY-MM-DD, inclusive)"
+// @Param to query string false "End session date (YYYY-MM-DD, inclusive)"
+// @Success 200 {object} middleware.APIResponse{data=[]SimulatorSessionResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /simulator-sessions [get]
+// @Security BearerAuth
+func (h *handler) ListSimulatorSessions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogSimulatorSessionError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		from, ok := parseDateQuery(c, "from")
+		if !ok {
+			log.Warn(logger.LogSimulatorSessionError, "error", "invalid from date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		to, ok := parseDateQuery(c, "to")
+		if !ok {
+			log.Warn(logger.LogSimulatorSessionError, "error", "invalid to date")
+			h.Response.Error(c, domain.MsgValInvalidDateFormat)
+			return
+		}
+		if from != nil && to != nil && from.After(*to) {
+			log.Warn(logger.LogSimulatorSessionError, "error", "from date after to date")
+			h.Response.Error(c, domain.MsgValStartDateAfterEndDate)
+			return
+		}
+
+		filter := domain.SimulatorSessionFilter{EmployeeID: employee.ID, From: from, To: to}
+		sessions, err := h.SimulatorSessionInteractor.ListSimulatorSessions(c.Request.Context(), traceID, filter)
+		if err != nil {
+			log.Error(logger.LogSimulatorSessionError, "error", err)
+			h.Response.Error(c, domain.MsgSimulatorSessionErr)
+			return
+		}
+
+		response := make([]SimulatorSessionResponse, 0, len(sessions))
+		for i := range sessions {
+			response = append(response, h.toSimulatorSessionResponse(&sessions[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgSimulatorSessionListOK, response)
+	}
+}
+
+// ============================================
+// GET /simulator-sessions/:id
+// Consultar sesión de simulador
+// ============================================
+
+// GetSimulatorSession returns a simulator session of the authenticated employee
+// @Summary Get simulator session
+// @Tags SimulatorSessions
+// @Produce json
+// @Param id path string true "Simulator session ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=SimulatorSessionResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /simulator-sessions/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetSimulatorSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, sessionUUID, ok := h.authorizeSimulatorSession(c)
+		if !ok {
+			return
+		}
+
+		session, err := h.SimulatorSessionInteractor.GetSimulatorSession(c.Request.Context(), traceID, sessionUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogSimulatorSessionError, "error", err)
+			h.Response.Error(c, simulatorSessionErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgSimulatorSessionGetOK, h.toSimulatorSessionResponse(session))
+	}
+}
+
+// ============================================
+// POST /simulator-sessions
+// Registrar sesión de simulador
+// ============================================
+
+// CreateSimulatorSession logs a simulator session for the authenticated employee
+// @Summary Create simulator session
+// @Description Simulator time is logged apart from flight time. Sessions flown as TRAINEE or PILOT count toward the currency rules that credit the device type.
+// @Tags SimulatorSessions
+// @Accept json
+// @Produce json
+// @Param body body SimulatorSessionRequest true "Simulator session"
+// @Success 201 {object} middleware.APIResponse{data=SimulatorSessionResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /simulator-sessions [post]
+// @Security BearerAuth
+func (h *handler) CreateSimulatorSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogSimulatorSessionError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		session, ok := h.bindSimulatorSessionRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		created, err := h.SimulatorSessionInteractor.CreateSimulatorSession(c.Request.Context(), traceID, session)
+		if err != nil {
+			log.Error(logger.LogSimulatorSessionError, "error", err)
+			h.Response.Error(c, simulatorSessionErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgSimulatorSessionCreated, h.toSimulatorSessionResponse(created))
+	}
+}
+
+// ============================================
+// PUT /simulator-sessions/:id
+// Actualizar sesión de simulador
+// ============================================
+
+// UpdateSimulatorSession replaces the values of a simulator session of the authenticated employee
+// @Summary Update simulator session
+// @Tags SimulatorSessions
+// @Accept json
+// @Produce json
+// @Param id path string true "Simulator session ID (obfuscated or UUID)"
+// @Param body body SimulatorSessionRequest true "Simulator session"
+// @Success 200 {object} middleware.APIResponse{data=SimulatorSessionResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /simulator-sessions/{id} [put]
+// @Security BearerAuth
+func (h *handler) UpdateSimulatorSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, sessionUUID, ok := h.authorizeSimulatorSession(c)
+		if !ok {
+			return
+		}
+
+		session, ok := h.bindSimulatorSessionRequest(c, employee.ID)
+		if !ok {
+			return
+		}
+
+		updated, err := h.SimulatorSessionInteractor.UpdateSimulatorSession(c.Request.Context(), traceID, sessionUUID, employee.ID, session)
+		if err != nil {
+			log.Error(logger.LogSimulatorSessionError, "error", err)
+			h.Response.Error(c, simulatorSessionErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgSimulatorSessionUpdated, h.toSimulatorSessionResponse(updated))
+	}
+}
+
+// ============================================
+// DELETE /simulator-sessions/:id
+// Eliminar sesión de simulador
+// ============================================
+
+// DeleteSimulatorSession removes a simulator session of the authenticated employee
+// @Summary Delete simulator session
+// @Tags SimulatorSessions
+// @Produce json
+// @Param id path string true "Simulator session ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /simulator-sessions/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeleteSimulatorSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, sessionUUID, ok := h.authorizeSimulatorSession(c)
+		if !ok {
+			return
+		}
+
+		if err := h.SimulatorSessionInteractor.DeleteSimulatorSession(c.Request.Context(), traceID, sessionUUID, employee.ID); err != nil {
+			log.Error(logger.LogSimulatorSessionError, "error", err)
+			h.Response.Error(c, simulatorSessionErrorMessage(err))
+			return
+		}
+
+		h.Response.Success(c, domain.MsgSimulatorSessionDeleted)
+	}
+}
+
+// bindSimulatorSessionRequest binds and sanitizes the request body and resolves the emulated aircraft
+// model, writing the error response when it cannot
+func (h *handler) bindSimulatorSessionRequest(c *gin.Context, employeeID string) (domain.SimulatorSession, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	var req SimulatorSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "error", err)
+		h.Response.Error(c, domain.MsgValJSONInvalid)
+		return domain.SimulatorSession{}, false
+	}
+	req.Sanitize()
+
+	modelUUID, _ := h.resolveID(req.AircraftModelID)
+	if modelUUID == "" {
+		log.Warn(logger.LogSimulatorSessionError, "error", "invalid aircraft_model_id", "id", req.AircraftModelID)
+		h.Response.Error(c, domain.MsgSimulatorSessionInvalidModel)
+		return domain.SimulatorSession{}, false
+	}
+	req.AircraftModelID = modelUUID
+
+	return req.ToDomain(employeeID), true
+}
+
+// authorizeSimulatorSession resolves the :id simulator session of the authenticated employee, writing the
+// error response when it cannot; ownership is checked by the interactor
+func (h *handler) authorizeSimulatorSession(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogSimulatorSessionError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	sessionUUID, _ := h.resolveID(c.Param("id"))
+	if sessionUUID == "" {
+		log.Warn(logger.LogSimulatorSessionError, "error", "invalid simulator session ID")
+		h.Response.Error(c, domain.MsgSimulatorSessionNotFound)
+		return nil, "", false
+	}
+	return employee, sessionUUID, true
+}
+
+// simulatorSessionErrorMessage maps a simulator session error to its message code
+func simulatorSessionErrorMessage(err error) string {
+	switch err {
+	case domain.ErrSimulatorSessionNotFound:
+		return domain.MsgSimulatorSessionNotFound
+	case domain.ErrSimulatorSessionUnauthorized:
+		return domain.MsgSimulatorSessionUnauthorized
+	case domain.ErrSimulatorSessionInvalid:
+		return domain.MsgSimulatorSessionInvalid
+	case domain.ErrSimulatorSessionInvalidDuration:
+		return domain.MsgSimulatorSessionInvalidDuration
+	case domain.ErrSimulatorSessionInvalidModel:
+		return domain.MsgSimulatorSessionInvalidModel
+	default:
+		return domain.MsgSimulatorSessionErr
+	}
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ============================================
+// REQUEST DTOs
+// ============================================
+
+// TypeRatingRequest represents the request body for recording or updating a type rating
+type TypeRatingRequest struct {
+	AircraftFamily string  `json:"aircraft_family" binding:"required"` // Aircraft model family the rating covers
+	IssueDate      string  `json:"issue_date" binding:"required"`      // YYYY-MM-DD
+	ExpiryDate     *string `json:"expiry_date,omitempty"`              // YYYY-MM-DD, omitted when the rating does not expire
+	Remarks        *string `json:"remarks,omitempty"`
+}
+
+// Sanitize trims whitespace from string fields
+func (r *TypeRatingRequest) Sanitize() {
+	r.AircraftFamily = TrimString(r.AircraftFamily)
+	r.IssueDate = TrimString(r.IssueDate)
+	r.ExpiryDate = TrimStringPtr(r.ExpiryDate)
+	r.Remarks = TrimStringPtr(r.Remarks)
+}
+
+// ToDomain converts the request to a domain type rating of the employee
+func (r *TypeRatingRequest) ToDomain(employeeID string) domain.TypeRating {
+	return domain.TypeRating{
+		EmployeeID:     employeeID,
+		AircraftFamily: r.AircraftFamily,
+		IssueDate:      r.IssueDate,
+		ExpiryDate:     r.ExpiryDate,
+		Remarks:        r.Remarks,
+	}
+}
+
+// ============================================
+// RESPONSE DTOs
+// ============================================
+
+// TypeRatingResponse represents a type rating
+type TypeRatingResponse struct {
+	ID             string  `json:"id"`
+	AircraftFamily string  `json:"aircraft_family"`
+	IssueDate      string  `json:"issue_date"`
+	ExpiryDate     *string `json:"expiry_date,omitempty"`
+	Valid          bool    `json:"valid"` // In force today (UTC)
+	Remarks        *string `json:"remarks,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+// ============================================
+// MAPPERS
+// ============================================
+
+// toTypeRatingResponse maps a type rating, encoding its ID
+func (h *handler) toTypeRatingResponse(r *domain.TypeRating) TypeRatingResponse {
+	id, _ := h.EncodeID(r.ID)
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	return TypeRatingResponse{
+		ID:             id,
+		AircraftFamily: r.AircraftFamily,
+		IssueDate:      r.IssueDate,
+		ExpiryDate:     r.ExpiryDate,
+		Valid:          r.ValidOn(today),
+		Remarks:        r.Remarks,
+		CreatedAt:      r.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      r.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
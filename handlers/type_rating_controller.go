@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/middleware"
+	"github.com/champion19/flighthours-api/platform/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================
+// GET /type-ratings
+// Listar habilitaciones de tipo del empleado
+// ============================================
+
+// ListTypeRatings lists the type ratings of the authenticated employee
+// @Summary List type ratings
+// @Description Type ratings per aircraft family, ordered by family and issue date, with whether each one is in force today
+// @Tags TypeRatings
+// @Produce json
+// @Success 200 {object} middleware.APIResponse{data=[]TypeRatingResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /type-ratings [get]
+// @Security BearerAuth
+func (h *handler) ListTypeRatings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ok := middleware.GetAuthenticatedUser(c)
+		if !ok || employee == nil {
+			log.Error(logger.LogTypeRatingError, "error", "unauthorized")
+			h.Response.Error(c, domain.MsgUnauthorized)
+			return
+		}
+
+		ratings, err := h.TypeRatingInteractor.ListTypeRatings(c.Request.Context(), traceID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, domain.MsgTypeRatingErr)
+			return
+		}
+
+		response := make([]TypeRatingResponse, 0, len(ratings))
+		for i := range ratings {
+			response = append(response, h.toTypeRatingResponse(&ratings[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgTypeRatingListOK, response)
+	}
+}
+
+// ============================================
+// GET /type-ratings/:id
+// Consultar habilitación de tipo
+// ============================================
+
+// GetTypeRating returns a type rating of the authenticated employee
+// @Summary Get type rating
+// @Tags TypeRatings
+// @Produce json
+// @Param id path string true "Type rating ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=TypeRatingResponse}
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /type-ratings/{id} [get]
+// @Security BearerAuth
+func (h *handler) GetTypeRating() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		employee, ratingUUID, ok := h.authorizeTypeRating(c)
+		if !ok {
+			return
+		}
+
+		rating, err := h.TypeRatingInteractor.GetTypeRating(c.Request.Context(), traceID, ratingUUID, employee.ID)
+		if err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, typeRatingErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgTypeRatingGetOK, h.toTypeRatingResponse(rating))
+	}
+}
+
+// ============================================
+// GET /airline-employees/:id/type-ratings
+// Listar habilitaciones de tipo de un empleado de la aerolínea
+// ============================================
+
+// ListEmployeeTypeRatings lists the type ratings of an employee of the crew manager's airline
+// @Summary List an employee's type ratings
+// @Description Crew managers only; the employee must belong to the manager's airline.
+// @Tags TypeRatings
+// @Produce json
+// @Param id path string true "Employee ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse{data=[]TypeRatingResponse}
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /airline-employees/{id}/type-ratings [get]
+// @Security BearerAuth
+func (h *handler) ListEmployeeTypeRatings() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		manager, employeeUUID, ok := h.authorizeEmployeeTypeRatings(c)
+		if !ok {
+			return
+		}
+
+		ratings, err := h.TypeRatingInteractor.ListEmployeeTypeRatings(c.Request.Context(), traceID, employeeUUID, manager.Airline)
+		if err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, typeRatingErrorMessage(err))
+			return
+		}
+
+		response := make([]TypeRatingResponse, 0, len(ratings))
+		for i := range ratings {
+			response = append(response, h.toTypeRatingResponse(&ratings[i]))
+		}
+		h.Response.SuccessWithData(c, domain.MsgTypeRatingListOK, response)
+	}
+}
+
+// ============================================
+// POST /airline-employees/:id/type-ratings
+// Registrar habilitación de tipo
+// ============================================
+
+// CreateTypeRating records a type rating for an employee of the crew manager's airline
+// @Summary Create type rating
+// @Description Crew managers only; the employee must belong to the manager's airline. Segments logged as pilot flying (PF, PFTO or PFL) on an aircraft whose model family has no rating valid on the flight date are rejected for COMMERCIAL or unspecified flights and flagged for FERRY and POSITIONING flights; TRAINING and CHECK flights need no rating.
+// @Tags TypeRatings
+// @Accept json
+// @Produce json
+// @Param id path string true "Employee ID (obfuscated or UUID)"
+// @Param body body TypeRatingRequest true "Type rating"
+// @Success 201 {object} middleware.APIResponse{data=TypeRatingResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 401 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /airline-employees/{id}/type-ratings [post]
+// @Security BearerAuth
+func (h *handler) CreateTypeRating() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		manager, employeeUUID, ok := h.authorizeEmployeeTypeRatings(c)
+		if !ok {
+			return
+		}
+
+		rating, ok := h.bindTypeRatingRequest(c, employeeUUID)
+		if !ok {
+			return
+		}
+
+		created, err := h.TypeRatingInteractor.CreateTypeRating(c.Request.Context(), traceID, rating, manager.Airline)
+		if err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, typeRatingErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgTypeRatingCreated, h.toTypeRatingResponse(created))
+	}
+}
+
+// ============================================
+// PUT /type-ratings/:id
+// Actualizar habilitación de tipo
+// ============================================
+
+// UpdateTypeRating replaces the values of a type rating of an employee of the crew manager's airline
+// @Summary Update type rating
+// @Description Crew managers only. Segments already logged are not checked again.
+// @Tags TypeRatings
+// @Accept json
+// @Produce json
+// @Param id path string true "Type rating ID (obfuscated or UUID)"
+// @Param body body TypeRatingRequest true "Type rating"
+// @Success 200 {object} middleware.APIResponse{data=TypeRatingResponse}
+// @Failure 400 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /type-ratings/{id} [put]
+// @Security BearerAuth
+func (h *handler) UpdateTypeRating() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		manager, ratingUUID, ok := h.authorizeTypeRating(c)
+		if !ok {
+			return
+		}
+
+		rating, ok := h.bindTypeRatingRequest(c, "")
+		if !ok {
+			return
+		}
+
+		updated, err := h.TypeRatingInteractor.UpdateTypeRating(c.Request.Context(), traceID, ratingUUID, manager.Airline, rating)
+		if err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, typeRatingErrorMessage(err))
+			return
+		}
+
+		h.Response.SuccessWithData(c, domain.MsgTypeRatingUpdated, h.toTypeRatingResponse(updated))
+	}
+}
+
+// ============================================
+// DELETE /type-ratings/:id
+// Eliminar habilitación de tipo
+// ============================================
+
+// DeleteTypeRating removes a type rating of an employee of the crew manager's airline
+// @Summary Delete type rating
+// @Description Crew managers only.
+// @Tags TypeRatings
+// @Produce json
+// @Param id path string true "Type rating ID (obfuscated or UUID)"
+// @Success 200 {object} middleware.APIResponse
+// @Failure 403 {object} middleware.APIResponse
+// @Failure 404 {object} middleware.APIResponse
+// @Failure 500 {object} middleware.APIResponse
+// @Router /type-ratings/{id} [delete]
+// @Security BearerAuth
+func (h *handler) DeleteTypeRating() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceID := middleware.GetRequestID(c)
+		log := Logger.WithTraceID(traceID)
+
+		manager, ratingUUID, ok := h.authorizeTypeRating(c)
+		if !ok {
+			return
+		}
+
+		if err := h.TypeRatingInteractor.DeleteTypeRating(c.Request.Context(), traceID, ratingUUID, manager.Airline); err != nil {
+			log.Error(logger.LogTypeRatingError, "error", err)
+			h.Response.Error(c, typeRatingErrorMessage(err))
+			return
+		}
+
+		h.Response.Success(c, domain.MsgTypeRatingDeleted)
+	}
+}
+
+// bindTypeRatingRequest binds and sanitizes the request body, writing the error response when it cannot
+func (h *handler) bindTypeRatingRequest(c *gin.Context, employeeID string) (domain.TypeRating, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	var req TypeRatingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(logger.LogTypeRatingError, "error", err)
+		h.Response.Error(c, domain.MsgValJSONInvalid)
+		return domain.TypeRating{}, false
+	}
+	req.Sanitize()
+
+	return req.ToDomain(employeeID), true
+}
+
+// authorizeTypeRating resolves the :id type rating for the authenticated employee, writing the error
+// response when it cannot; ownership or airline is checked by the interactor
+func (h *handler) authorizeTypeRating(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	employee, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || employee == nil {
+		log.Error(logger.LogTypeRatingError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	ratingUUID, _ := h.resolveID(c.Param("id"))
+	if ratingUUID == "" {
+		log.Warn(logger.LogTypeRatingError, "error", "invalid type rating ID")
+		h.Response.Error(c, domain.MsgTypeRatingNotFound)
+		return nil, "", false
+	}
+	return employee, ratingUUID, true
+}
+
+// authorizeEmployeeTypeRatings resolves the :id employee whose type ratings a crew manager manages,
+// writing the error response when it cannot; the airline is checked by the interactor
+func (h *handler) authorizeEmployeeTypeRatings(c *gin.Context) (*domain.Employee, string, bool) {
+	log := Logger.WithTraceID(middleware.GetRequestID(c))
+
+	manager, ok := middleware.GetAuthenticatedUser(c)
+	if !ok || manager == nil {
+		log.Error(logger.LogTypeRatingError, "error", "unauthorized")
+		h.Response.Error(c, domain.MsgUnauthorized)
+		return nil, "", false
+	}
+
+	employeeUUID, _ := h.resolveID(c.Param("id"))
+	if employeeUUID == "" {
+		log.Warn(logger.LogTypeRatingError, "error", "invalid employee ID")
+		h.Response.Error(c, domain.MsgValIDInvalid)
+		return nil, "", false
+	}
+	return manager, employeeUUID, true
+}
+
+// typeRatingErrorMessage maps a type rating error to its message code
+func typeRatingErrorMessage(err error) string {
+	switch err {
+	case domain.ErrTypeRatingNotFound:
+		return domain.MsgTypeRatingNotFound
+	case domain.ErrTypeRatingUnauthorized:
+		return domain.MsgTypeRatingUnauthorized
+	case domain.ErrTypeRatingInvalid:
+		return domain.MsgTypeRatingInvalid
+	case domain.ErrPersonNotFound:
+		return domain.MsgPersonNotFound
+	default:
+		return domain.MsgTypeRatingErr
+	}
+}
@@ -87,11 +87,22 @@ var errorToMessageCode = map[error]string{
 	domain.ErrUserNotFound: domain.MsgUserNotFound,
 
 	// DailyLogbook errors (BIT_*)
-	domain.ErrDailyLogbookNotFound:     domain.MsgDailyLogbookNotFound,
-	domain.ErrDailyLogbookCannotSave:   domain.MsgDailyLogbookSaveError,
-	domain.ErrDailyLogbookCannotUpdate: domain.MsgDailyLogbookUpdateError,
-	domain.ErrDailyLogbookCannotDelete: domain.MsgDailyLogbookDeleteError,
-	domain.ErrDailyLogbookUnauthorized: domain.MsgDailyLogbookUnauthorized,
+	domain.ErrDailyLogbookNotFound:          domain.MsgDailyLogbookNotFound,
+	domain.ErrDailyLogbookCannotSave:        domain.MsgDailyLogbookSaveError,
+	domain.ErrDailyLogbookCannotUpdate:      domain.MsgDailyLogbookUpdateError,
+	domain.ErrDailyLogbookCannotDelete:      domain.MsgDailyLogbookDeleteError,
+	domain.ErrDailyLogbookCannotRestore:     domain.MsgDailyLogbookRestoreErr,
+	domain.ErrDailyLogbookUnauthorized:      domain.MsgDailyLogbookUnauthorized,
+	domain.ErrDailyLogbookInvalidTransition: domain.MsgDailyLogbookInvalidState,
+	domain.ErrDailyLogbookSigned:            domain.MsgDailyLogbookSigned,
+	domain.ErrAmendmentNotFound:             domain.MsgAmendmentNotFound,
+	domain.ErrAmendmentNotPending:           domain.MsgAmendmentNotPending,
+	domain.ErrAmendmentNotAllowed:           domain.MsgAmendmentNotAllowed,
+	domain.ErrAmendmentInvalid:              domain.MsgAmendmentInvalid,
+	domain.ErrAmendmentCannotSave:           domain.MsgAmendmentErr,
+	domain.ErrLogbookChainBroken:            domain.MsgLogbookChainSeal,
+	domain.ErrLogbookHistoryNotFound:        domain.MsgLogbookHistoryNotFound,
+	domain.ErrLogbookHistoryCannotSave:      domain.MsgLogbookHistoryErr,
 
 	// AircraftRegistration errors (MAT_*)
 	domain.ErrAircraftRegistrationNotFound:       domain.MsgAircraftRegistrationNotFound,
@@ -109,15 +120,77 @@ var errorToMessageCode = map[error]string{
 	domain.ErrAirlineRouteInvalidAirline: domain.MsgAirlineRouteInvalidAirline,
 
 	// Flight/DailyLogbookDetail errors (VUE_*)
-	domain.ErrFlightNotFound:            domain.MsgFlightNotFound,
-	domain.ErrFlightCannotSave:          domain.MsgFlightSaveError,
-	domain.ErrFlightCannotUpdate:        domain.MsgFlightUpdateError,
-	domain.ErrFlightCannotDelete:        domain.MsgFlightDeleteError,
-	domain.ErrFlightUnauthorized:        domain.MsgFlightUnauthorized,
-	domain.ErrFlightInvalidRoute:        domain.MsgFlightInvalidRoute,
-	domain.ErrFlightInvalidLogbook:      domain.MsgFlightInvalidLogbook,
-	domain.ErrFlightInvalidAircraft:     domain.MsgFlightInvalidAircraft,
-	domain.ErrFlightInvalidTimeSequence: domain.MsgFlightInvalidTimeSequence,
+	domain.ErrFlightNotFound:             domain.MsgFlightNotFound,
+	domain.ErrFlightCannotSave:           domain.MsgFlightSaveError,
+	domain.ErrFlightCannotUpdate:         domain.MsgFlightUpdateError,
+	domain.ErrFlightCannotDelete:         domain.MsgFlightDeleteError,
+	domain.ErrFlightUnauthorized:         domain.MsgFlightUnauthorized,
+	domain.ErrFlightInvalidRoute:         domain.MsgFlightInvalidRoute,
+	domain.ErrFlightInvalidLogbook:       domain.MsgFlightInvalidLogbook,
+	domain.ErrFlightInvalidAircraft:      domain.MsgFlightInvalidAircraft,
+	domain.ErrFlightInvalidTimeSequence:  domain.MsgFlightInvalidTimeSequence,
+	domain.ErrFlightAircraftNotInAirline: domain.MsgFlightAircraftNotInAirline,
+	domain.ErrFlightRouteInactive:        domain.MsgFlightRouteInactive,
+	domain.ErrFlightAirlineInactive:      domain.MsgFlightAirlineInactive,
+	domain.ErrFlightDateOutsideLogDate:   domain.MsgFlightDateOutsideLogDate,
+	domain.ErrFlightInvalidIFRTime:       domain.MsgFlightInvalidIFRTime,
+	domain.ErrFlightCannotRestore:        domain.MsgFlightRestoreErr,
+	domain.ErrFlightLogbookDeleted:       domain.MsgFlightLogbookDeleted,
+
+	// Segment mirror errors
+	domain.ErrSegmentMirrorNotFound:         domain.MsgSegmentMirrorNotFound,
+	domain.ErrSegmentMirrorNotPending:       domain.MsgSegmentMirrorNotPending,
+	domain.ErrSegmentMirrorNotInConflict:    domain.MsgSegmentMirrorNotInConflict,
+	domain.ErrSegmentMirrorNotLinked:        domain.MsgSegmentMirrorNotLinked,
+	domain.ErrSegmentMirrorInvalidCompanion: domain.MsgSegmentMirrorInvalidCompanion,
+	domain.ErrSegmentMirrorInvalid:          domain.MsgSegmentMirrorInvalid,
+	domain.ErrSegmentMirrorCannotSave:       domain.MsgSegmentMirrorErr,
+
+	// Simulator session errors (SIM_*)
+	domain.ErrSimulatorSessionNotFound:        domain.MsgSimulatorSessionNotFound,
+	domain.ErrSimulatorSessionUnauthorized:    domain.MsgSimulatorSessionUnauthorized,
+	domain.ErrSimulatorSessionInvalid:         domain.MsgSimulatorSessionInvalid,
+	domain.ErrSimulatorSessionInvalidDuration: domain.MsgSimulatorSessionInvalidDuration,
+	domain.ErrSimulatorSessionInvalidModel:    domain.MsgSimulatorSessionInvalidModel,
+	domain.ErrSimulatorSessionCannotSave:      domain.MsgSimulatorSessionErr,
+
+	// Prior experience errors (PRX_*)
+	domain.ErrPriorExperienceNotFound:        domain.MsgPriorExperienceNotFound,
+	domain.ErrPriorExperienceUnauthorized:    domain.MsgPriorExperienceUnauthorized,
+	domain.ErrPriorExperienceInvalid:         domain.MsgPriorExperienceInvalid,
+	domain.ErrPriorExperienceInvalidTime:     domain.MsgPriorExperienceInvalidTime,
+	domain.ErrPriorExperienceInconsistent:    domain.MsgPriorExperienceInconsistent,
+	domain.ErrPriorExperienceInvalidDocument: domain.MsgPriorExperienceInvalidDocument,
+	domain.ErrPriorExperienceCannotSave:      domain.MsgPriorExperienceErr,
+
+	// Duty period errors (DUT_*)
+	domain.ErrDutyPeriodNotFound:       domain.MsgDutyPeriodNotFound,
+	domain.ErrDutyPeriodUnauthorized:   domain.MsgDutyPeriodUnauthorized,
+	domain.ErrDutyPeriodInvalid:        domain.MsgDutyPeriodInvalid,
+	domain.ErrDutyPeriodOverlap:        domain.MsgDutyPeriodOverlap,
+	domain.ErrDutyPeriodInvalidSegment: domain.MsgDutyPeriodInvalidSegment,
+	domain.ErrDutyPeriodSegmentOutside: domain.MsgDutyPeriodSegmentOutside,
+	domain.ErrDutyPeriodCannotSave:     domain.MsgDutyPeriodErr,
+
+	// Type rating errors (HAB_*)
+	domain.ErrTypeRatingNotFound:     domain.MsgTypeRatingNotFound,
+	domain.ErrTypeRatingUnauthorized: domain.MsgTypeRatingUnauthorized,
+	domain.ErrTypeRatingInvalid:      domain.MsgTypeRatingInvalid,
+	domain.ErrTypeRatingRequired:     domain.MsgTypeRatingRequired,
+	domain.ErrTypeRatingCannotSave:   domain.MsgTypeRatingErr,
+
+	// Credential errors (CRD_*)
+	domain.ErrCredentialNotFound:     domain.MsgCredentialNotFound,
+	domain.ErrCredentialUnauthorized: domain.MsgCredentialUnauthorized,
+	domain.ErrCredentialInvalid:      domain.MsgCredentialInvalid,
+	domain.ErrCredentialInvalidScan:  domain.MsgCredentialInvalidScan,
+	domain.ErrCredentialScanNotFound: domain.MsgCredentialScanNotFound,
+	domain.ErrCredentialCannotSave:   domain.MsgCredentialErr,
+
+	// Notification errors (NTF_*)
+	domain.ErrNotificationNotFound:     domain.MsgNotificationNotFound,
+	domain.ErrNotificationUnauthorized: domain.MsgNotificationUnauthorized,
+	domain.ErrNotificationCannotSave:   domain.MsgNotificationErr,
 
 	// Engine errors (MOT_*)
 	domain.ErrEngineNotFound: domain.MsgEngineNotFound,
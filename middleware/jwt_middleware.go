@@ -89,6 +89,12 @@ func RequireAuth(employeeService input.Service, msgCache *messaging.MessageCache
 		// Inject authenticated user into context
 		c.Set("authenticated_user", employee)
 
+		// Actor of the changes recorded in the logbook history
+		c.Request = c.Request.WithContext(domain.ContextWithHistoryActor(c.Request.Context(), domain.HistoryActor{
+			EmployeeID: employee.ID,
+			TraceID:    GetRequestID(c),
+		}))
+
 		c.Next()
 	}
 }
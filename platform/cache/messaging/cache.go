@@ -392,6 +392,11 @@ var messageCodeToHTTPStatus = map[string]int{
 	"BIT_INA_ERR_01403": http.StatusConflict,            // 409 - Bitácora ya está inactiva
 	"BIT_INA_ERR_01404": http.StatusInternalServerError, // 500 - Error técnico al inactivar
 
+	// Papelera y restauración (BIT_RES_*)
+	"BIT_RES_EXI_01101": http.StatusOK,                  // 200 - Bitácora restaurada
+	"BIT_RES_EXI_01102": http.StatusOK,                  // 200 - Bitácoras eliminadas consultadas
+	"BIT_RES_ERR_01103": http.StatusInternalServerError, // 500 - Error técnico al restaurar
+
 	// Listar
 	"BIT_LIST_EXI_01001": http.StatusOK,                  // 200 - Lista obtenida exitosamente
 	"BIT_LIST_ERR_01002": http.StatusInternalServerError, // 500 - Error al listar
@@ -399,6 +404,23 @@ var messageCodeToHTTPStatus = map[string]int{
 	// Autorización
 	"BIT_AUTH_ERR_00001": http.StatusForbidden, // 403 - No autorizado para esta bitácora
 
+	// Firma y bloqueo (BIT_EST_*)
+	"BIT_EST_EXI_01301": http.StatusOK,                  // 200 - Estado actualizado
+	"BIT_EST_ERR_01302": http.StatusConflict,            // 409 - Transición no permitida
+	"BIT_EST_ERR_01303": http.StatusConflict,            // 409 - Bitácora firmada o bloqueada
+	"BIT_EST_ERR_01304": http.StatusInternalServerError, // 500 - Error técnico al cambiar el estado
+
+	// Enmiendas (BIT_ENM_*)
+	"BIT_ENM_EXI_01201": http.StatusCreated,             // 201 - Enmienda registrada
+	"BIT_ENM_EXI_01202": http.StatusOK,                  // 200 - Enmienda firmada y aplicada
+	"BIT_ENM_EXI_01203": http.StatusOK,                  // 200 - Enmienda rechazada
+	"BIT_ENM_EXI_01204": http.StatusOK,                  // 200 - Enmiendas consultadas
+	"BIT_ENM_ERR_01205": http.StatusNotFound,            // 404 - Enmienda no encontrada
+	"BIT_ENM_ERR_01206": http.StatusConflict,            // 409 - Enmienda ya resuelta
+	"BIT_ENM_ERR_01207": http.StatusBadRequest,          // 400 - Enmienda incompleta
+	"BIT_ENM_ERR_01208": http.StatusConflict,            // 409 - Bitácora no firmada
+	"BIT_ENM_ERR_01209": http.StatusInternalServerError, // 500 - Error técnico en enmiendas
+
 	// ========================================
 	// Aircraft Registration Module (MAT_*) - Matrícula
 	// ========================================
@@ -525,10 +547,30 @@ var messageCodeToHTTPStatus = map[string]int{
 	"VUE_LIST_ERR_04802": http.StatusInternalServerError, // 500 - Error al listar vuelos
 
 	// Validaciones
-	"VUE_VAL_ERR_04805": http.StatusBadRequest, // 400 - Ruta de aerolínea inválida
-	"VUE_VAL_ERR_04806": http.StatusBadRequest, // 400 - Bitácora inválida
-	"VUE_VAL_ERR_04807": http.StatusBadRequest, // 400 - Matrícula de aeronave inválida
-	"VUE_VAL_ERR_04808": http.StatusBadRequest, // 400 - Secuencia de tiempos inválida
+	"VUE_VAL_ERR_04805": http.StatusBadRequest,          // 400 - Ruta de aerolínea inválida
+	"VUE_VAL_ERR_04806": http.StatusBadRequest,          // 400 - Bitácora inválida
+	"VUE_VAL_ERR_04807": http.StatusBadRequest,          // 400 - Matrícula de aeronave inválida
+	"VUE_VAL_ERR_04808": http.StatusBadRequest,          // 400 - Secuencia de tiempos inválida
+	"VUE_VAL_ERR_04809": http.StatusBadRequest,          // 400 - Tiempos de vuelo/bloque no coinciden con OUT/OFF/ON/IN
+	"VUE_VAL_ERR_04810": http.StatusBadRequest,          // 400 - Duración del segmento excede el máximo permitido
+	"VUE_VAL_ERR_04811": http.StatusUnprocessableEntity, // 422 - Aeropuerto sin zona horaria válida
+	"VUE_VAL_ERR_04812": http.StatusBadRequest,          // 400 - time_reference inválido
+	"VUE_VAL_ERR_04813": http.StatusConflict,            // 409 - Segmento duplicado
+	"VUE_VAL_ERR_04814": http.StatusConflict,            // 409 - Segmento solapado
+	"VUE_VAL_WRN_04815": http.StatusOK,                  // 200 - Conflicto aceptado (override)
+	"VUE_VAL_WRN_04816": http.StatusOK,                  // 200 - Discontinuidad de ruta
+	"VUE_VAL_EXI_04817": http.StatusOK,                  // 200 - Reporte de consistencia generado
+	"VUE_VAL_ERR_04818": http.StatusInternalServerError, // 500 - Error técnico al validar la bitácora
+	"VUE_VAL_ERR_04819": http.StatusUnprocessableEntity, // 422 - Matrícula de otra aerolínea (sin wet lease)
+	"VUE_VAL_ERR_04820": http.StatusUnprocessableEntity, // 422 - Ruta de aerolínea inactiva
+	"VUE_VAL_ERR_04821": http.StatusUnprocessableEntity, // 422 - Aerolínea inactiva
+	"VUE_VAL_ERR_04822": http.StatusBadRequest,          // 400 - Fecha de vuelo fuera de la fecha de la bitácora
+	"VUE_VAL_ERR_04823": http.StatusBadRequest,          // 400 - ifr_time inválido o mayor que el bloque
+
+	// Totales
+	"VUE_TOT_EXI_05201": http.StatusOK,                  // 200 - Totales de tiempo de vuelo calculados
+	"VUE_TOT_ERR_05202": http.StatusInternalServerError, // 500 - Error técnico al calcular totales
+	"VUE_TOT_ERR_05203": http.StatusBadRequest,          // 400 - Agrupación no soportada
 
 	// Eliminar (HU18)
 	"VUE_DEL_EXI_01801": http.StatusOK,                  // 200 - Vuelo eliminado exitosamente
@@ -536,11 +578,186 @@ var messageCodeToHTTPStatus = map[string]int{
 	"VUE_DEL_ERR_01803": http.StatusNotFound,            // 404 - Vuelo no existe o ya eliminado
 	"VUE_DEL_ERR_01804": http.StatusInternalServerError, // 500 - Error técnico al eliminar
 
+	// Restaurar (VUE_RES_*)
+	"VUE_RES_EXI_06101": http.StatusOK,                  // 200 - Vuelo restaurado
+	"VUE_RES_ERR_06102": http.StatusConflict,            // 409 - Bitácora del vuelo eliminada
+	"VUE_RES_ERR_06103": http.StatusInternalServerError, // 500 - Error técnico al restaurar
+
+	// Vuelo espejo del acompañante (VUE_ESP_*)
+	"VUE_ESP_EXI_06201": http.StatusOK,                  // 200 - Vuelos espejo consultados
+	"VUE_ESP_EXI_06202": http.StatusCreated,             // 201 - Vuelo espejo registrado
+	"VUE_ESP_EXI_06203": http.StatusOK,                  // 200 - Vuelo espejo rechazado
+	"VUE_ESP_EXI_06204": http.StatusOK,                  // 200 - Conflicto resuelto
+	"VUE_ESP_ERR_06205": http.StatusNotFound,            // 404 - Vuelo espejo no encontrado
+	"VUE_ESP_ERR_06206": http.StatusConflict,            // 409 - Vuelo espejo ya respondido
+	"VUE_ESP_ERR_06207": http.StatusConflict,            // 409 - Sin conflicto que resolver
+	"VUE_ESP_ERR_06208": http.StatusBadRequest,          // 400 - Acompañante inválido
+	"VUE_ESP_ERR_06209": http.StatusBadRequest,          // 400 - Resolución o rol inválido
+	"VUE_ESP_ERR_06210": http.StatusInternalServerError, // 500 - Error técnico en vuelos espejo
+	"VUE_ESP_WRN_06211": http.StatusOK,                  // 200 - Advertencia: vuelo enlazado difiere
+	"VUE_ESP_ERR_06212": http.StatusConflict,            // 409 - Vuelos ya no enlazados
+
 	// Autorización
 	"VUE_AUTH_ERR_00001": http.StatusForbidden, // 403 - No autorizado para este vuelo
 
 	// ========================================
-	// Airline Employee Module (EMP_AIR_*) - Empleado Aerolínea 
+	// FLIGHT TIME LIMITATIONS (FTL_*)
+	// ========================================
+	"FTL_VAL_ERR_05301": http.StatusUnprocessableEntity, // 422 - Limitación bloqueante excedida
+	"FTL_VAL_WRN_05302": http.StatusOK,                  // 200 - Uso cercano a la limitación
+	"FTL_VAL_WRN_05303": http.StatusOK,                  // 200 - Limitación no bloqueante excedida
+	"FTL_CON_EXI_05304": http.StatusOK,                  // 200 - Estado de limitaciones consultado
+	"FTL_CON_ERR_05305": http.StatusInternalServerError, // 500 - Error técnico al consultar
+
+	// ========================================
+	// CURRENCY (CUR_*) - Experiencia reciente
+	// ========================================
+	"CUR_CON_EXI_05401": http.StatusOK,                  // 200 - Experiencia reciente consultada
+	"CUR_CON_ERR_05402": http.StatusInternalServerError, // 500 - Error técnico al consultar
+
+	// ========================================
+	// LOGBOOK EXPORT (EXP_*) - Exportación de bitácora
+	// ========================================
+	"EXP_VAL_ERR_05501": http.StatusBadRequest,          // 400 - Formato de exportación no soportado
+	"EXP_CON_ERR_05502": http.StatusInternalServerError, // 500 - Error técnico al generar la exportación
+
+	// ========================================
+	// LOGBOOK IMPORT (IMP_*) - Importación masiva CSV
+	// ========================================
+	"IMP_REG_EXI_05601": http.StatusCreated,             // 201 - Segmentos importados
+	"IMP_VAL_EXI_05602": http.StatusOK,                  // 200 - Dry-run sin errores
+	"IMP_VAL_ERR_05603": http.StatusUnprocessableEntity, // 422 - Filas con errores de validación
+	"IMP_VAL_ERR_05604": http.StatusBadRequest,          // 400 - Archivo CSV inválido
+	"IMP_VAL_ERR_05605": http.StatusBadRequest,          // 400 - Demasiadas filas
+	"IMP_VAL_ERR_05606": http.StatusUnprocessableEntity, // 422 - Ruta ambigua
+	"IMP_REG_ERR_05607": http.StatusInternalServerError, // 500 - Error técnico al importar
+
+	// ========================================
+	// ELECTRONIC LOGBOOK IMPORT (ELB_*) - Formatos de terceros
+	// ========================================
+	"ELB_REG_EXI_05701": http.StatusCreated,             // 201 - Segmentos importados
+	"ELB_VAL_EXI_05702": http.StatusOK,                  // 200 - Vista previa
+	"ELB_VAL_ERR_05703": http.StatusBadRequest,          // 400 - Formato no soportado
+	"ELB_VAL_ERR_05704": http.StatusBadRequest,          // 400 - Mapeo de campos inválido
+	"ELB_VAL_ERR_05705": http.StatusBadRequest,          // 400 - Decisión de mapeo inválida
+	"ELB_VAL_ERR_05706": http.StatusUnprocessableEntity, // 422 - Referencia sin mapeo
+	"ELB_CON_EXI_05707": http.StatusOK,                  // 200 - Mapeos consultados
+	"ELB_CON_ERR_05708": http.StatusNotFound,            // 404 - Mapeo no encontrado
+	"ELB_DEL_EXI_05709": http.StatusOK,                  // 200 - Mapeo eliminado
+	"ELB_CON_ERR_05710": http.StatusInternalServerError, // 500 - Error técnico en mapeos
+
+	// ========================================
+	// FLIGHT TIME ANOMALIES (ANO_*) - Tiempos frente al estimado de la ruta
+	// ========================================
+	"ANO_CON_EXI_05801": http.StatusOK,                  // 200 - Reporte de anomalías generado
+	"ANO_CON_ERR_05802": http.StatusInternalServerError, // 500 - Error técnico al generar el reporte
+
+	// ========================================
+	// LOGBOOK CHAIN (CAD_*) - Cadena de hashes sobre los segmentos firmados
+	// ========================================
+	"CAD_VER_EXI_05901": http.StatusOK,                  // 200 - Cadena verificada
+	"CAD_VER_WRN_05902": http.StatusOK,                  // 200 - Cadena rota (reporte)
+	"CAD_SEL_ERR_05903": http.StatusConflict,            // 409 - Cadena rota, no se puede firmar
+	"CAD_VER_ERR_05904": http.StatusInternalServerError, // 500 - Error técnico al verificar la cadena
+
+	// ========================================
+	// LOGBOOK HISTORY (HIS_*) - Historial de cambios de bitácoras y segmentos
+	// ========================================
+	"HIS_CON_EXI_06001": http.StatusOK,                  // 200 - Historial consultado
+	"HIS_CON_ERR_06002": http.StatusNotFound,            // 404 - Sin historial para el registro
+	"HIS_CON_ERR_06003": http.StatusInternalServerError, // 500 - Error técnico al consultar el historial
+
+	// ========================================
+	// SIMULATOR SESSIONS (SIM_*) - Sesiones en dispositivos de simulación
+	// ========================================
+	"SIM_CON_EXI_06301":  http.StatusOK,                  // 200 - Sesiones consultadas
+	"SIM_CON_EXI_06302":  http.StatusOK,                  // 200 - Sesión consultada
+	"SIM_REG_EXI_06303":  http.StatusCreated,             // 201 - Sesión registrada
+	"SIM_ACT_EXI_06304":  http.StatusOK,                  // 200 - Sesión actualizada
+	"SIM_DEL_EXI_06305":  http.StatusOK,                  // 200 - Sesión eliminada
+	"SIM_CON_ERR_06306":  http.StatusNotFound,            // 404 - Sesión no encontrada
+	"SIM_VAL_ERR_06307":  http.StatusBadRequest,          // 400 - Datos de la sesión inválidos
+	"SIM_VAL_ERR_06308":  http.StatusBadRequest,          // 400 - Duración inválida
+	"SIM_VAL_ERR_06309":  http.StatusBadRequest,          // 400 - Modelo emulado inválido
+	"SIM_AUTH_ERR_06310": http.StatusForbidden,           // 403 - No autorizado para esta sesión
+	"SIM_CON_ERR_06311":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// PRIOR EXPERIENCE (PRX_*) - Experiencia previa y saldos iniciales
+	// ========================================
+	"PRX_CON_EXI_06401":  http.StatusOK,                  // 200 - Experiencia previa consultada
+	"PRX_CON_EXI_06402":  http.StatusOK,                  // 200 - Saldo inicial consultado
+	"PRX_REG_EXI_06403":  http.StatusCreated,             // 201 - Saldo inicial registrado
+	"PRX_ACT_EXI_06404":  http.StatusOK,                  // 200 - Saldo inicial actualizado
+	"PRX_DEL_EXI_06405":  http.StatusOK,                  // 200 - Saldo inicial eliminado
+	"PRX_CON_ERR_06406":  http.StatusNotFound,            // 404 - Saldo inicial no encontrado
+	"PRX_VAL_ERR_06407":  http.StatusBadRequest,          // 400 - Datos inválidos
+	"PRX_VAL_ERR_06408":  http.StatusBadRequest,          // 400 - Formato de tiempo inválido
+	"PRX_VAL_ERR_06409":  http.StatusBadRequest,          // 400 - Columnas inconsistentes con el total
+	"PRX_VAL_ERR_06410":  http.StatusBadRequest,          // 400 - Documento de soporte inválido
+	"PRX_AUTH_ERR_06411": http.StatusForbidden,           // 403 - No autorizado para este saldo
+	"PRX_CON_ERR_06412":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// DUTY PERIODS (DUT_*) - Periodos de servicio
+	// ========================================
+	"DUT_CON_EXI_06501":  http.StatusOK,                  // 200 - Periodos de servicio consultados
+	"DUT_CON_EXI_06502":  http.StatusOK,                  // 200 - Periodo de servicio consultado
+	"DUT_REG_EXI_06503":  http.StatusCreated,             // 201 - Periodo de servicio registrado
+	"DUT_ACT_EXI_06504":  http.StatusOK,                  // 200 - Periodo de servicio actualizado
+	"DUT_DEL_EXI_06505":  http.StatusOK,                  // 200 - Periodo de servicio eliminado
+	"DUT_CON_ERR_06506":  http.StatusNotFound,            // 404 - Periodo de servicio no encontrado
+	"DUT_VAL_ERR_06507":  http.StatusBadRequest,          // 400 - Presentación o liberación inválidas
+	"DUT_VAL_ERR_06508":  http.StatusConflict,            // 409 - Superposición con otro periodo
+	"DUT_VAL_ERR_06509":  http.StatusBadRequest,          // 400 - Vuelo inválido para el periodo
+	"DUT_VAL_ERR_06510":  http.StatusBadRequest,          // 400 - Vuelo fuera del periodo
+	"DUT_AUTH_ERR_06511": http.StatusForbidden,           // 403 - No autorizado para este periodo
+	"DUT_CON_ERR_06512":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// TYPE RATINGS (HAB_*) - Habilitaciones de tipo
+	// ========================================
+	"HAB_CON_EXI_06601":  http.StatusOK,                  // 200 - Habilitaciones de tipo consultadas
+	"HAB_CON_EXI_06602":  http.StatusOK,                  // 200 - Habilitación de tipo consultada
+	"HAB_REG_EXI_06603":  http.StatusCreated,             // 201 - Habilitación de tipo registrada
+	"HAB_ACT_EXI_06604":  http.StatusOK,                  // 200 - Habilitación de tipo actualizada
+	"HAB_DEL_EXI_06605":  http.StatusOK,                  // 200 - Habilitación de tipo eliminada
+	"HAB_CON_ERR_06606":  http.StatusNotFound,            // 404 - Habilitación de tipo no encontrada
+	"HAB_VAL_ERR_06607":  http.StatusBadRequest,          // 400 - Familia o fechas inválidas
+	"HAB_VAL_ERR_06608":  http.StatusUnprocessableEntity, // 422 - Piloto volando sin habilitación vigente
+	"HAB_VAL_WRN_06609":  http.StatusOK,                  // 200 - Sin habilitación vigente (advertencia)
+	"HAB_AUTH_ERR_06610": http.StatusForbidden,           // 403 - No autorizado para esta habilitación
+	"HAB_CON_ERR_06611":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// CREDENTIALS (CRD_*) - Licencias, certificados médicos y vencimientos
+	// ========================================
+	"CRD_CON_EXI_06701":  http.StatusOK,                  // 200 - Credenciales consultadas
+	"CRD_CON_EXI_06702":  http.StatusOK,                  // 200 - Credencial consultada
+	"CRD_REG_EXI_06703":  http.StatusCreated,             // 201 - Credencial registrada
+	"CRD_ACT_EXI_06704":  http.StatusOK,                  // 200 - Credencial actualizada
+	"CRD_DEL_EXI_06705":  http.StatusOK,                  // 200 - Credencial eliminada
+	"CRD_ACT_EXI_06706":  http.StatusOK,                  // 200 - Documento escaneado adjuntado
+	"CRD_CON_EXI_06707":  http.StatusOK,                  // 200 - Reporte de vencimientos generado
+	"CRD_CON_ERR_06708":  http.StatusNotFound,            // 404 - Credencial no encontrada
+	"CRD_CON_ERR_06709":  http.StatusNotFound,            // 404 - Sin documento escaneado
+	"CRD_VAL_ERR_06710":  http.StatusBadRequest,          // 400 - Tipo, título o fechas inválidos
+	"CRD_VAL_ERR_06711":  http.StatusBadRequest,          // 400 - Documento vacío, grande o no admitido
+	"CRD_NOT_WRN_06712":  http.StatusOK,                  // 200 - Aviso de vencimiento (notificación)
+	"CRD_AUTH_ERR_06713": http.StatusForbidden,           // 403 - No autorizado para esta credencial
+	"CRD_CON_ERR_06714":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// NOTIFICATIONS (NTF_*) - Notificaciones en la aplicación
+	// ========================================
+	"NTF_CON_EXI_06801":  http.StatusOK,                  // 200 - Notificaciones consultadas
+	"NTF_ACT_EXI_06802":  http.StatusOK,                  // 200 - Notificación marcada como leída
+	"NTF_CON_ERR_06803":  http.StatusNotFound,            // 404 - Notificación no encontrada
+	"NTF_AUTH_ERR_06804": http.StatusForbidden,           // 403 - No autorizado para esta notificación
+	"NTF_CON_ERR_06805":  http.StatusInternalServerError, // 500 - Error técnico
+
+	// ========================================
+	// Airline Employee Module (EMP_AIR_*) - Empleado Aerolínea
 	// ========================================
 	// Consultar (HU26)
 	"EMP_AIR_CON_EXI_02601": http.StatusOK,                  // 200 - Airline employee retrieved successfully
@@ -10,6 +10,9 @@ type AircraftModel struct {
 	EngineTypeName   string `db:"engine_type_name"`
 	Family           string `db:"family"`
 	Manufacturer     string `db:"manufacturer"`
+	MultiEngine      bool   `db:"multi_engine"`
+	Turbine          bool   `db:"turbine"`
+	MultiPilot       bool   `db:"multi_pilot"`
 }
 
 // ToDomain converts the database entity to domain model
@@ -21,6 +24,9 @@ func (a *AircraftModel) ToDomain() *domain.AircraftModel {
 		EngineTypeName:   a.EngineTypeName,
 		Family:           a.Family,
 		Manufacturer:     a.Manufacturer,
+		MultiEngine:      a.MultiEngine,
+		Turbine:          a.Turbine,
+		MultiPilot:       a.MultiPilot,
 	}
 }
 
@@ -33,5 +39,8 @@ func FromDomain(domainModel *domain.AircraftModel) *AircraftModel {
 		EngineTypeName:   domainModel.EngineTypeName,
 		Family:           domainModel.Family,
 		Manufacturer:     domainModel.Manufacturer,
+		MultiEngine:      domainModel.MultiEngine,
+		Turbine:          domainModel.Turbine,
+		MultiPilot:       domainModel.MultiPilot,
 	}
 }
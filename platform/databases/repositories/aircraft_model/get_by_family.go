@@ -28,6 +28,9 @@ func (r *repository) GetAircraftModelsByFamily(ctx context.Context, family strin
 			&engineTypeName,
 			&model.Family,
 			&manufacturer,
+			&model.MultiEngine,
+			&model.Turbine,
+			&model.MultiPilot,
 		); err != nil {
 			return nil, err
 		}
@@ -20,6 +20,9 @@ func (r *repository) GetAircraftModelByID(ctx context.Context, id string) (*doma
 		&engineTypeName,
 		&model.Family,
 		&manufacturer,
+		&model.MultiEngine,
+		&model.Turbine,
+		&model.MultiPilot,
 	)
 
 	if err != nil {
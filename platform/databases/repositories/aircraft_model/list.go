@@ -37,6 +37,9 @@ func (r *repository) ListAircraftModels(ctx context.Context, filters map[string]
 			&engineTypeName,
 			&model.Family,
 			&manufacturer,
+			&model.MultiEngine,
+			&model.Turbine,
+			&model.MultiPilot,
 		); err != nil {
 			return nil, err
 		}
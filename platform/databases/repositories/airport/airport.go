@@ -4,21 +4,26 @@ import domain "github.com/champion19/flighthours-api/core/interactor/services/do
 
 // Airport is the database entity for airport table
 type Airport struct {
-	ID          string  `db:"id"`
-	Name        string  `db:"name"`
-	City        *string `db:"city"`
-	Country     *string `db:"country"`
-	IATACode    *string `db:"iata_code"`
-	Status      bool    `db:"status"`
-	AirportType *string `db:"airport_type"`
+	ID          string   `db:"id"`
+	Name        string   `db:"name"`
+	City        *string  `db:"city"`
+	Country     *string  `db:"country"`
+	IATACode    *string  `db:"iata_code"`
+	Status      bool     `db:"status"`
+	AirportType *string  `db:"airport_type"`
+	TimeZone    *string  `db:"time_zone"` // IANA time zone (e.g., "America/Bogota")
+	Latitude    *float64 `db:"latitude"`  // Decimal degrees, north positive
+	Longitude   *float64 `db:"longitude"` // Decimal degrees, east positive
 }
 
 // ToDomain converts the database entity to domain model
 func (a *Airport) ToDomain() *domain.Airport {
 	airport := &domain.Airport{
-		ID:     a.ID,
-		Name:   a.Name,
-		Status: a.Status,
+		ID:        a.ID,
+		Name:      a.Name,
+		Status:    a.Status,
+		Latitude:  a.Latitude,
+		Longitude: a.Longitude,
 	}
 
 	if a.City != nil {
@@ -33,6 +38,9 @@ func (a *Airport) ToDomain() *domain.Airport {
 	if a.AirportType != nil {
 		airport.AirportType = *a.AirportType
 	}
+	if a.TimeZone != nil {
+		airport.TimeZone = *a.TimeZone
+	}
 
 	return airport
 }
@@ -40,9 +48,11 @@ func (a *Airport) ToDomain() *domain.Airport {
 // FromDomain converts a domain model to database entity
 func FromDomain(domainAirport *domain.Airport) *Airport {
 	airport := &Airport{
-		ID:     domainAirport.ID,
-		Name:   domainAirport.Name,
-		Status: domainAirport.Status,
+		ID:        domainAirport.ID,
+		Name:      domainAirport.Name,
+		Status:    domainAirport.Status,
+		Latitude:  domainAirport.Latitude,
+		Longitude: domainAirport.Longitude,
 	}
 
 	if domainAirport.City != "" {
@@ -57,6 +67,9 @@ func FromDomain(domainAirport *domain.Airport) *Airport {
 	if domainAirport.AirportType != "" {
 		airport.AirportType = &domainAirport.AirportType
 	}
+	if domainAirport.TimeZone != "" {
+		airport.TimeZone = &domainAirport.TimeZone
+	}
 
 	return airport
 }
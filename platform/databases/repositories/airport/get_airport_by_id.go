@@ -18,6 +18,9 @@ func (r *repository) GetAirportByID(ctx context.Context, id string) (*domain.Air
 		&a.IATACode,
 		&a.Status,
 		&a.AirportType,
+		&a.TimeZone,
+		&a.Latitude,
+		&a.Longitude,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -19,7 +19,7 @@ func (r *repository) GetAirportsByCity(ctx context.Context, city string) ([]doma
 	var airports []domain.Airport
 	for rows.Next() {
 		var a Airport
-		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType); err != nil {
+		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType, &a.TimeZone, &a.Latitude, &a.Longitude); err != nil {
 			return nil, err
 		}
 		airports = append(airports, *a.ToDomain())
@@ -49,7 +49,7 @@ func (r *repository) GetAirportsByCountry(ctx context.Context, country string) (
 	var airports []domain.Airport
 	for rows.Next() {
 		var a Airport
-		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType); err != nil {
+		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType, &a.TimeZone, &a.Latitude, &a.Longitude); err != nil {
 			return nil, err
 		}
 		airports = append(airports, *a.ToDomain())
@@ -79,7 +79,7 @@ func (r *repository) GetAirportsByType(ctx context.Context, airportType string)
 	var airports []domain.Airport
 	for rows.Next() {
 		var a Airport
-		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType); err != nil {
+		if err := rows.Scan(&a.ID, &a.Name, &a.City, &a.Country, &a.IATACode, &a.Status, &a.AirportType, &a.TimeZone, &a.Latitude, &a.Longitude); err != nil {
 			return nil, err
 		}
 		airports = append(airports, *a.ToDomain())
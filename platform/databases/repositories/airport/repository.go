@@ -10,16 +10,16 @@ import (
 )
 
 const (
-	QueryByID         = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport WHERE id = ? LIMIT 1"
+	QueryByID         = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport WHERE id = ? LIMIT 1"
 	QueryUpdateStatus = "UPDATE airport SET status = ? WHERE id = ?"
-	QueryGetAll       = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport ORDER BY name"
-	QueryGetByStatus  = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport WHERE status = ? ORDER BY name"
+	QueryGetAll       = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport ORDER BY name"
+	QueryGetByStatus  = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport WHERE status = ? ORDER BY name"
 	// HU13 - Get airports by city (Virtual Entity pattern - no new table needed)
-	QueryGetByCity = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport WHERE city = ? ORDER BY name"
+	QueryGetByCity = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport WHERE city = ? ORDER BY name"
 	// HU38 - Get airports by country (Virtual Entity pattern - no new table needed)
-	QueryGetByCountry = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport WHERE country = ? ORDER BY name"
+	QueryGetByCountry = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport WHERE country = ? ORDER BY name"
 	// HU46 - Get airports by type (Virtual Entity pattern - no new table needed)
-	QueryGetByType = "SELECT id, name, city, country, iata_code, status, airport_type FROM airport WHERE airport_type = ? ORDER BY name"
+	QueryGetByType = "SELECT id, name, city, country, iata_code, status, airport_type, time_zone, latitude, longitude FROM airport WHERE airport_type = ? ORDER BY name"
 )
 
 var log logger.Logger = logger.NewSlogLogger()
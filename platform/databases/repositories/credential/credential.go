@@ -0,0 +1,103 @@
+package credential
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// Credential is the database entity for credential table, without the scan content
+type Credential struct {
+	ID               string         `db:"id"`
+	EmployeeID       string         `db:"employee_id"`
+	CredentialType   string         `db:"credential_type"`
+	Title            string         `db:"title"`
+	Number           sql.NullString `db:"number"`
+	IssuingAuthority sql.NullString `db:"issuing_authority"`
+	IssueDate        time.Time      `db:"issue_date"`
+	ExpiryDate       sql.NullTime   `db:"expiry_date"`
+	ScanFileName     sql.NullString `db:"scan_file_name"`
+	ScanContentType  sql.NullString `db:"scan_content_type"`
+	ScanSize         sql.NullInt64  `db:"scan_size"`
+	ScanUploadedAt   sql.NullTime   `db:"scan_uploaded_at"`
+	Remarks          sql.NullString `db:"remarks"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (c *Credential) scanDest() []interface{} {
+	return []interface{}{&c.ID, &c.EmployeeID, &c.CredentialType, &c.Title, &c.Number, &c.IssuingAuthority,
+		&c.IssueDate, &c.ExpiryDate, &c.ScanFileName, &c.ScanContentType, &c.ScanSize, &c.ScanUploadedAt,
+		&c.Remarks, &c.CreatedAt, &c.UpdatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (c *Credential) ToDomain() *domain.Credential {
+	credential := &domain.Credential{
+		ID:         c.ID,
+		EmployeeID: c.EmployeeID,
+		Type:       domain.CredentialType(c.CredentialType),
+		Title:      c.Title,
+		IssueDate:  c.IssueDate.Format("2006-01-02"),
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+	if c.Number.Valid {
+		credential.Number = &c.Number.String
+	}
+	if c.IssuingAuthority.Valid {
+		credential.IssuingAuthority = &c.IssuingAuthority.String
+	}
+	if c.ExpiryDate.Valid {
+		expiry := c.ExpiryDate.Time.Format("2006-01-02")
+		credential.ExpiryDate = &expiry
+	}
+	if c.ScanFileName.Valid {
+		credential.Scan = &domain.CredentialScan{
+			FileName:    c.ScanFileName.String,
+			ContentType: c.ScanContentType.String,
+			Size:        c.ScanSize.Int64,
+			UploadedAt:  c.ScanUploadedAt.Time,
+		}
+	}
+	if c.Remarks.Valid {
+		credential.Remarks = &c.Remarks.String
+	}
+	return credential
+}
+
+// FromDomain converts a domain model to database entity; the scan is stored separately
+func FromDomain(credential *domain.Credential) (*Credential, error) {
+	issueDate, err := time.Parse("2006-01-02", credential.IssueDate)
+	if err != nil {
+		return nil, err
+	}
+	entity := &Credential{
+		ID:             credential.ID,
+		EmployeeID:     credential.EmployeeID,
+		CredentialType: string(credential.Type),
+		Title:          credential.Title,
+		IssueDate:      issueDate,
+		CreatedAt:      credential.CreatedAt,
+		UpdatedAt:      credential.UpdatedAt,
+	}
+	if credential.Number != nil {
+		entity.Number = sql.NullString{String: *credential.Number, Valid: true}
+	}
+	if credential.IssuingAuthority != nil {
+		entity.IssuingAuthority = sql.NullString{String: *credential.IssuingAuthority, Valid: true}
+	}
+	if credential.ExpiryDate != nil {
+		expiryDate, err := time.Parse("2006-01-02", *credential.ExpiryDate)
+		if err != nil {
+			return nil, err
+		}
+		entity.ExpiryDate = sql.NullTime{Time: expiryDate, Valid: true}
+	}
+	if credential.Remarks != nil {
+		entity.Remarks = sql.NullString{String: *credential.Remarks, Valid: true}
+	}
+	return entity, nil
+}
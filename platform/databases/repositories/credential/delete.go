@@ -0,0 +1,30 @@
+package credential
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// DeleteCredential removes a credential
+func (r *repository) DeleteCredential(ctx context.Context, tx output.Tx, id string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, id)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCredentialNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package credential
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetCredentialByID retrieves a credential by its UUID, with the metadata of its scan
+func (r *repository) GetCredentialByID(ctx context.Context, id string) (*domain.Credential, error) {
+	var c Credential
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(c.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrCredentialNotFound
+		}
+		return nil, err
+	}
+	return c.ToDomain(), nil
+}
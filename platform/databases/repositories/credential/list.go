@@ -0,0 +1,71 @@
+package credential
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListCredentialsByEmployee retrieves an employee's credentials grouped by type, soonest expiry first
+func (r *repository) ListCredentialsByEmployee(ctx context.Context, employeeID string) ([]domain.Credential, error) {
+	rows, err := r.db.QueryContext(ctx, QueryByEmployee, employeeID)
+	if err != nil {
+		log.Error(logger.LogCredentialError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	return scanCredentials(rows, false)
+}
+
+// ListCredentialsExpiringBetween retrieves every employee's credentials with an expiry date in [from, to]
+func (r *repository) ListCredentialsExpiringBetween(ctx context.Context, from, to time.Time) ([]domain.Credential, error) {
+	rows, err := r.db.QueryContext(ctx, QueryExpiringBetween, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		log.Error(logger.LogCredentialError, "error", err)
+		return nil, err
+	}
+	return scanCredentials(rows, false)
+}
+
+// ListAirlineCredentialsExpiringBy retrieves the credentials of the airline's active employees with an
+// expiry date up to the date, already expired ones included, with the employee name
+func (r *repository) ListAirlineCredentialsExpiringBy(ctx context.Context, airlineID string, until time.Time) ([]domain.Credential, error) {
+	rows, err := r.db.QueryContext(ctx, QueryAirlineExpiringBy, airlineID, until.Format("2006-01-02"))
+	if err != nil {
+		log.Error(logger.LogCredentialError, "airline_id", airlineID, "error", err)
+		return nil, err
+	}
+	return scanCredentials(rows, true)
+}
+
+// scanCredentials reads and closes the rows; withEmployeeName reads the employee name after the credential
+// columns
+func scanCredentials(rows *sql.Rows, withEmployeeName bool) ([]domain.Credential, error) {
+	defer rows.Close()
+
+	var credentials []domain.Credential
+	for rows.Next() {
+		var c Credential
+		var employeeName string
+		dest := c.scanDest()
+		if withEmployeeName {
+			dest = append(dest, &employeeName)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			log.Error(logger.LogCredentialError, "error", err)
+			return nil, err
+		}
+		credential := c.ToDomain()
+		credential.EmployeeName = employeeName
+		credentials = append(credentials, *credential)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogCredentialError, "error", err)
+		return nil, err
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,88 @@
+package credential
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	// credentialColumns lists the columns in the order of scanDest; the scan content is only read by
+	// QueryScan
+	credentialColumns = `
+			c.id, c.employee_id, c.credential_type, c.title, c.number, c.issuing_authority, c.issue_date,
+			c.expiry_date, c.scan_file_name, c.scan_content_type, c.scan_size, c.scan_uploaded_at, c.remarks,
+			c.created_at, c.updated_at`
+	queryCredentialSelect = "SELECT" + credentialColumns + " FROM credential c"
+
+	QueryByID              = queryCredentialSelect + " WHERE c.id = ? LIMIT 1"
+	QueryByEmployee        = queryCredentialSelect + " WHERE c.employee_id = ? ORDER BY c.credential_type, c.expiry_date IS NULL, c.expiry_date, c.title"
+	QueryExpiringBetween   = queryCredentialSelect + " WHERE c.expiry_date BETWEEN ? AND ? ORDER BY c.expiry_date"
+	QueryAirlineExpiringBy = "SELECT" + credentialColumns + `, e.name
+		FROM credential c
+		JOIN employee e ON e.id = c.employee_id
+		WHERE e.airline = ? AND e.active = 1 AND c.expiry_date <= ?
+		ORDER BY c.expiry_date, e.name, c.title
+	`
+	QueryScan = `
+		SELECT scan_file_name, scan_content_type, scan_size, scan_uploaded_at, scan_content
+		FROM credential
+		WHERE id = ? AND scan_content IS NOT NULL
+		LIMIT 1
+	`
+	QueryInsert = `
+		INSERT INTO credential (
+			id, employee_id, credential_type, title, number, issuing_authority, issue_date, expiry_date,
+			remarks, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryUpdate = `
+		UPDATE credential SET
+			credential_type = ?, title = ?, number = ?, issuing_authority = ?, issue_date = ?, expiry_date = ?,
+			remarks = ?, updated_at = ?
+		WHERE id = ?
+	`
+	QueryUpdateScan = `
+		UPDATE credential SET
+			scan_file_name = ?, scan_content_type = ?, scan_size = ?, scan_uploaded_at = ?, scan_content = ?
+		WHERE id = ?
+	`
+	QueryDelete = "DELETE FROM credential WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewCredentialRepository creates a new credential repository with prepared statements
+func NewCredentialRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogCredentialRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
@@ -0,0 +1,72 @@
+package credential
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveCredential stores a new credential
+func (r *repository) SaveCredential(ctx context.Context, tx output.Tx, credential domain.Credential) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	c, err := FromDomain(&credential)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		c.ID,
+		c.EmployeeID,
+		c.CredentialType,
+		c.Title,
+		c.Number,
+		c.IssuingAuthority,
+		c.IssueDate,
+		c.ExpiryDate,
+		c.Remarks,
+		c.CreatedAt,
+		c.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+
+	return nil
+}
+
+// UpdateCredential stores the editable fields of a credential; the attached scan is kept
+func (r *repository) UpdateCredential(ctx context.Context, tx output.Tx, credential domain.Credential) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	c, err := FromDomain(&credential)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		c.CredentialType,
+		c.Title,
+		c.Number,
+		c.IssuingAuthority,
+		c.IssueDate,
+		c.ExpiryDate,
+		c.Remarks,
+		c.UpdatedAt,
+		c.ID,
+	)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCredentialNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package credential
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// GetCredentialScan retrieves the scan attached to a credential, with its content
+func (r *repository) GetCredentialScan(ctx context.Context, id string) (*domain.CredentialScan, error) {
+	var (
+		fileName, contentType string
+		size                  int64
+		uploadedAt            time.Time
+		content               []byte
+	)
+	err := r.db.QueryRowContext(ctx, QueryScan, id).Scan(&fileName, &contentType, &size, &uploadedAt, &content)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrCredentialScanNotFound
+		}
+		return nil, err
+	}
+	return &domain.CredentialScan{
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		Content:     content,
+		UploadedAt:  uploadedAt,
+	}, nil
+}
+
+// SaveCredentialScan attaches a scan to a credential, replacing the previous one
+func (r *repository) SaveCredentialScan(ctx context.Context, tx output.Tx, id string, scan domain.CredentialScan) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryUpdateScan,
+		scan.FileName,
+		scan.ContentType,
+		scan.Size,
+		scan.UploadedAt,
+		scan.Content,
+		id,
+	)
+	if err != nil {
+		return domain.ErrCredentialCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCredentialNotFound
+	}
+
+	return nil
+}
@@ -13,17 +13,33 @@ type DailyLogbook struct {
 	EmployeeID string    `db:"employee_id"`
 	BookPage   *int      `db:"book_page"`
 	Status     bool      `db:"status"`
+
+	State       *string    `db:"state"` // NULL for logbooks created before the sign-off workflow
+	SubmittedAt *time.Time `db:"submitted_at"`
+	SignedAt    *time.Time `db:"signed_at"`
+	SignedBy    *string    `db:"signed_by"`
+	LockedAt    *time.Time `db:"locked_at"`
+	DeletedAt   *time.Time `db:"deleted_at"`
 }
 
 // ToDomain converts the database entity to domain model
 func (d *DailyLogbook) ToDomain() *domain.DailyLogbook {
-	return &domain.DailyLogbook{
-		ID:         d.ID,
-		LogDate:    d.LogDate,
-		EmployeeID: d.EmployeeID,
-		BookPage:   d.BookPage,
-		Status:     d.Status,
+	logbook := &domain.DailyLogbook{
+		ID:          d.ID,
+		LogDate:     d.LogDate,
+		EmployeeID:  d.EmployeeID,
+		BookPage:    d.BookPage,
+		Status:      d.Status,
+		SubmittedAt: d.SubmittedAt,
+		SignedAt:    d.SignedAt,
+		SignedBy:    d.SignedBy,
+		LockedAt:    d.LockedAt,
+		DeletedAt:   d.DeletedAt,
+	}
+	if d.State != nil {
+		logbook.State = domain.LogbookState(*d.State)
 	}
+	return logbook
 }
 
 // FromDomain converts a domain model to database entity
@@ -36,3 +52,8 @@ func FromDomain(domainLogbook *domain.DailyLogbook) *DailyLogbook {
 		Status:     domainLogbook.Status,
 	}
 }
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (d *DailyLogbook) scanDest() []interface{} {
+	return []interface{}{&d.ID, &d.LogDate, &d.EmployeeID, &d.BookPage, &d.Status, &d.State, &d.SubmittedAt, &d.SignedAt, &d.SignedBy, &d.LockedAt, &d.DeletedAt}
+}
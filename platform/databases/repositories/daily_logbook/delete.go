@@ -2,17 +2,18 @@ package daily_logbook
 
 import (
 	"context"
+	"time"
 
 	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/output"
 	"github.com/champion19/flighthours-api/platform/databases/common"
 )
 
-// DeleteDailyLogbook removes a daily logbook entry
-func (r *repository) DeleteDailyLogbook(ctx context.Context, tx output.Tx, id string) error {
+// DeleteDailyLogbook soft deletes a daily logbook entry and its segments; they can be restored until purged
+func (r *repository) DeleteDailyLogbook(ctx context.Context, tx output.Tx, id string, deletedAt time.Time) error {
 	sqlTx := tx.(*common.SQLTX)
 
-	result, err := sqlTx.ExecContext(ctx, QueryDelete, id)
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, deletedAt, id)
 	if err != nil {
 		return domain.ErrDailyLogbookCannotDelete
 	}
@@ -26,5 +27,47 @@ func (r *repository) DeleteDailyLogbook(ctx context.Context, tx output.Tx, id st
 		return domain.ErrDailyLogbookNotFound
 	}
 
+	if _, err = sqlTx.ExecContext(ctx, QueryDeleteDetails, deletedAt, id); err != nil {
+		return domain.ErrDailyLogbookCannotDelete
+	}
+
+	return nil
+}
+
+// RestoreDailyLogbook brings back a soft deleted daily logbook with the segments deleted along with it
+func (r *repository) RestoreDailyLogbook(ctx context.Context, tx output.Tx, id string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	// Segments first: they are matched against the logbook's deleted_at
+	if _, err := sqlTx.ExecContext(ctx, QueryRestoreDetails, id); err != nil {
+		return domain.ErrDailyLogbookCannotRestore
+	}
+
+	result, err := sqlTx.ExecContext(ctx, QueryRestore, id)
+	if err != nil {
+		return domain.ErrDailyLogbookCannotRestore
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrDailyLogbookNotFound
+	}
+
 	return nil
 }
+
+// PurgeDeletedDailyLogbooks permanently removes the logbooks deleted before the given instant. Their
+// segments must be purged first (see the detail repository).
+func (r *repository) PurgeDeletedDailyLogbooks(ctx context.Context, tx output.Tx, deletedBefore time.Time) (int64, error) {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryPurge, deletedBefore)
+	if err != nil {
+		return 0, domain.ErrDailyLogbookCannotDelete
+	}
+	return result.RowsAffected()
+}
@@ -10,13 +10,7 @@ import (
 // GetDailyLogbookByID retrieves a daily logbook by its UUID
 func (r *repository) GetDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error) {
 	var d DailyLogbook
-	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(
-		&d.ID,
-		&d.LogDate,
-		&d.EmployeeID,
-		&d.BookPage,
-		&d.Status,
-	)
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(d.scanDest()...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, domain.ErrDailyLogbookNotFound
@@ -0,0 +1,45 @@
+package daily_logbook
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetDeletedDailyLogbookByID retrieves a soft deleted daily logbook by its UUID
+func (r *repository) GetDeletedDailyLogbookByID(ctx context.Context, id string) (*domain.DailyLogbook, error) {
+	var d DailyLogbook
+	err := r.stmtDeletedByID.QueryRowContext(ctx, id).Scan(d.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrDailyLogbookNotFound
+		}
+		return nil, err
+	}
+	return d.ToDomain(), nil
+}
+
+// ListDeletedDailyLogbooksByEmployee retrieves the soft deleted daily logbooks of an employee, most recently deleted first
+func (r *repository) ListDeletedDailyLogbooksByEmployee(ctx context.Context, employeeID string) ([]domain.DailyLogbook, error) {
+	rows, err := r.stmtDeletedByEmployee.QueryContext(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logbooks []domain.DailyLogbook
+	for rows.Next() {
+		var d DailyLogbook
+		if err := rows.Scan(d.scanDest()...); err != nil {
+			return nil, err
+		}
+		logbooks = append(logbooks, *d.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return logbooks, nil
+}
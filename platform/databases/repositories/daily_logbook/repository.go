@@ -9,14 +9,26 @@ import (
 	"github.com/champion19/flighthours-api/platform/logger"
 )
 
+// Deleted logbooks keep their row with deleted_at set until they are purged; every query except the
+// QueryDeleted* ones only sees the logbooks that are not deleted
 const (
-	QueryByID                = "SELECT id, log_date, employee_id, book_page, status FROM daily_logbook WHERE id = ? LIMIT 1"
-	QueryByEmployee          = "SELECT id, log_date, employee_id, book_page, status FROM daily_logbook WHERE employee_id = ? ORDER BY log_date DESC"
-	QueryByEmployeeAndStatus = "SELECT id, log_date, employee_id, book_page, status FROM daily_logbook WHERE employee_id = ? AND status = ? ORDER BY log_date DESC"
-	QueryInsert              = "INSERT INTO daily_logbook (id, log_date, employee_id, book_page, status) VALUES (?, ?, ?, ?, ?)"
-	QueryUpdate              = "UPDATE daily_logbook SET log_date = ?, book_page = ?, status = ? WHERE id = ?"
-	QueryDelete              = "DELETE FROM daily_logbook WHERE id = ?"
-	QueryUpdateStatus        = "UPDATE daily_logbook SET status = ? WHERE id = ?"
+	QueryByID                = "SELECT id, log_date, employee_id, book_page, status, state, submitted_at, signed_at, signed_by, locked_at, deleted_at FROM daily_logbook WHERE id = ? AND deleted_at IS NULL LIMIT 1"
+	QueryByEmployee          = "SELECT id, log_date, employee_id, book_page, status, state, submitted_at, signed_at, signed_by, locked_at, deleted_at FROM daily_logbook WHERE employee_id = ? AND deleted_at IS NULL ORDER BY log_date DESC"
+	QueryByEmployeeAndStatus = "SELECT id, log_date, employee_id, book_page, status, state, submitted_at, signed_at, signed_by, locked_at, deleted_at FROM daily_logbook WHERE employee_id = ? AND status = ? AND deleted_at IS NULL ORDER BY log_date DESC"
+	QueryDeletedByID         = "SELECT id, log_date, employee_id, book_page, status, state, submitted_at, signed_at, signed_by, locked_at, deleted_at FROM daily_logbook WHERE id = ? AND deleted_at IS NOT NULL LIMIT 1"
+	QueryDeletedByEmployee   = "SELECT id, log_date, employee_id, book_page, status, state, submitted_at, signed_at, signed_by, locked_at, deleted_at FROM daily_logbook WHERE employee_id = ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC"
+	QueryInsert              = "INSERT INTO daily_logbook (id, log_date, employee_id, book_page, status, state) VALUES (?, ?, ?, ?, ?, ?)"
+	QueryUpdate              = "UPDATE daily_logbook SET log_date = ?, book_page = ?, status = ? WHERE id = ? AND deleted_at IS NULL"
+	QueryDelete              = "UPDATE daily_logbook SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL"
+	QueryRestore             = "UPDATE daily_logbook SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL"
+	QueryPurge               = "DELETE FROM daily_logbook WHERE deleted_at IS NOT NULL AND deleted_at < ?"
+	QueryUpdateStatus        = "UPDATE daily_logbook SET status = ? WHERE id = ? AND deleted_at IS NULL"
+	QueryUpdateState         = "UPDATE daily_logbook SET state = ?, submitted_at = ?, signed_at = ?, signed_by = ?, locked_at = ? WHERE id = ? AND deleted_at IS NULL"
+
+	// The segments of a logbook are deleted and restored with it; restore only brings back the ones
+	// deleted together with the logbook (same deleted_at), not those deleted earlier on their own
+	QueryDeleteDetails  = "UPDATE daily_logbook_detail SET deleted_at = ? WHERE daily_logbook_id = ? AND deleted_at IS NULL"
+	QueryRestoreDetails = "UPDATE daily_logbook_detail dld INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id SET dld.deleted_at = NULL WHERE dl.id = ? AND dld.deleted_at = dl.deleted_at"
 )
 
 var log logger.Logger = logger.NewSlogLogger()
@@ -29,6 +41,8 @@ type repository struct {
 	stmtUpdate                 *sql.Stmt
 	stmtDelete                 *sql.Stmt
 	stmtUpdateStatus           *sql.Stmt
+	stmtDeletedByID            *sql.Stmt
+	stmtDeletedByEmployee      *sql.Stmt
 	db                         *sql.DB
 }
 
@@ -80,6 +94,18 @@ func NewDailyLogbookRepository(db *sql.DB) (*repository, error) {
 		return nil, err
 	}
 
+	stmtDeletedByID, err := db.Prepare(QueryDeletedByID)
+	if err != nil {
+		log.Error(logger.LogDatabaseUnavailable, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtDeletedByEmployee, err := db.Prepare(QueryDeletedByEmployee)
+	if err != nil {
+		log.Error(logger.LogDatabaseUnavailable, "error preparing statement", err)
+		return nil, err
+	}
+
 	return &repository{
 		db:                         db,
 		stmtGetByID:                stmtGetByID,
@@ -89,6 +115,8 @@ func NewDailyLogbookRepository(db *sql.DB) (*repository, error) {
 		stmtUpdate:                 stmtUpdate,
 		stmtDelete:                 stmtDelete,
 		stmtUpdateStatus:           stmtUpdateStatus,
+		stmtDeletedByID:            stmtDeletedByID,
+		stmtDeletedByEmployee:      stmtDeletedByEmployee,
 	}, nil
 }
 
@@ -18,6 +18,7 @@ func (r *repository) SaveDailyLogbook(ctx context.Context, tx output.Tx, logbook
 		logbook.EmployeeID,
 		logbook.BookPage,
 		logbook.Status,
+		string(logbook.CurrentState()),
 	)
 	if err != nil {
 		return domain.ErrDailyLogbookCannotSave
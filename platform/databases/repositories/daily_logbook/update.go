@@ -34,6 +34,34 @@ func (r *repository) UpdateDailyLogbook(ctx context.Context, tx output.Tx, logbo
 	return nil
 }
 
+// UpdateDailyLogbookState stores the sign-off state of a daily logbook with its timestamps and signer
+func (r *repository) UpdateDailyLogbookState(ctx context.Context, tx output.Tx, logbook domain.DailyLogbook) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryUpdateState,
+		string(logbook.CurrentState()),
+		logbook.SubmittedAt,
+		logbook.SignedAt,
+		logbook.SignedBy,
+		logbook.LockedAt,
+		logbook.ID,
+	)
+	if err != nil {
+		return domain.ErrDailyLogbookCannotUpdate
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrDailyLogbookNotFound
+	}
+
+	return nil
+}
+
 // UpdateDailyLogbookStatus updates only the status of a daily logbook
 func (r *repository) UpdateDailyLogbookStatus(ctx context.Context, tx output.Tx, id string, status bool) error {
 	sqlTx := tx.(*common.SQLTX)
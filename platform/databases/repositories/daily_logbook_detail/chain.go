@@ -0,0 +1,55 @@
+package daily_logbook_detail
+
+import (
+	"context"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListChainEntries retrieves an employee's segments of signed and locked logbooks, in hash chain order
+func (r *repository) ListChainEntries(ctx context.Context, employeeID string) ([]domain.DailyLogbookDetail, error) {
+	rows, err := r.stmtChain.QueryContext(ctx, employeeID)
+	if err != nil {
+		log.Error(logger.LogLogbookChainError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var details []domain.DailyLogbookDetail
+	for rows.Next() {
+		entity, err := scanDetail(rows)
+		if err != nil {
+			log.Error(logger.LogLogbookChainError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		details = append(details, *entity.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error(logger.LogLogbookChainError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// UpdateChainHash stores the hash chain link of a segment
+func (r *repository) UpdateChainHash(ctx context.Context, tx output.Tx, detailID, hash string) error {
+	log.Info(logger.LogDailyLogbookDetailHash, "id", detailID)
+
+	sqlTx, ok := tx.(*common.SQLTX)
+	if !ok {
+		log.Error(logger.LogLogbookChainError, "error", "invalid transaction type")
+		return domain.ErrInvalidTransaction
+	}
+
+	stmt := sqlTx.Tx.StmtContext(ctx, r.stmtUpdateChainHash)
+	if _, err := stmt.ExecContext(ctx, hash, detailID); err != nil {
+		log.Error(logger.LogLogbookChainError, "id", detailID, "error", err)
+		return domain.ErrFlightCannotUpdate
+	}
+	return nil
+}
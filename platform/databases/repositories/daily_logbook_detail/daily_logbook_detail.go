@@ -2,6 +2,7 @@ package daily_logbook_detail
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 )
@@ -21,21 +22,101 @@ type DailyLogbookDetail struct {
 	InTime                       string
 	PilotRole                    string
 	CompanionName                sql.NullString
+	CompanionEmployeeID          sql.NullString // Companion pilot as employee, offered a mirrored entry
+	PilotFunction                sql.NullString // PIC, SIC, DUAL, INSTRUCTOR or PICUS
 	AirTime                      string         // TIME stored as string HH:MM:SS
 	BlockTime                    string         // TIME stored as string HH:MM:SS
 	DutyTime                     sql.NullString // TIME stored as string HH:MM:SS (nullable)
+	IFRTime                      sql.NullString // TIME stored as string HH:MM:SS (nullable)
 	ApproachType                 sql.NullString
 	FlightType                   sql.NullString
 	EmployeeLogbookID            sql.NullString
+	NightTime                    sql.NullString // TIME stored as string HH:MM:SS (NULL when airport coordinates are unknown)
+	DayTakeoffs                  sql.NullInt64
+	NightTakeoffs                sql.NullInt64
+	DayLandings                  sql.NullInt64
+	NightLandings                sql.NullInt64
+	WetLease                     bool
+	ChainHash                    sql.NullString // Hash chain link, NULL until the logbook is signed
+	DutyPeriodID                 sql.NullString // Duty period the segment was flown in
+	DeletedAt                    sql.NullTime   // Soft delete, NULL for live segments
 
 	// Denormalized fields from JOINs
 	LogDate             sql.NullString
+	BookPage            sql.NullInt64
 	LicensePlate        sql.NullString
 	ModelName           sql.NullString
+	MultiEngine         sql.NullBool
+	Turbine             sql.NullBool
+	MultiPilot          sql.NullBool
 	RouteCode           sql.NullString
 	OriginIataCode      sql.NullString
 	DestinationIataCode sql.NullString
 	AirlineCode         sql.NullString
+	OriginTimeZone      sql.NullString
+	DestinationTimeZone sql.NullString
+	EstimatedFlightTime sql.NullString
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanDetail scans a row selected with queryDetailSelect
+func scanDetail(row rowScanner) (*DailyLogbookDetail, error) {
+	var entity DailyLogbookDetail
+	err := row.Scan(
+		&entity.ID,
+		&entity.DailyLogbookID,
+		&entity.FlightRealDate,
+		&entity.FlightNumber,
+		&entity.AirlineRouteID,
+		&entity.ActualAircraftRegistrationID,
+		&entity.Passengers,
+		&entity.OutTime,
+		&entity.TakeoffTime,
+		&entity.LandingTime,
+		&entity.InTime,
+		&entity.PilotRole,
+		&entity.CompanionName,
+		&entity.CompanionEmployeeID,
+		&entity.PilotFunction,
+		&entity.AirTime,
+		&entity.BlockTime,
+		&entity.DutyTime,
+		&entity.IFRTime,
+		&entity.ApproachType,
+		&entity.FlightType,
+		&entity.EmployeeLogbookID,
+		&entity.NightTime,
+		&entity.DayTakeoffs,
+		&entity.NightTakeoffs,
+		&entity.DayLandings,
+		&entity.NightLandings,
+		&entity.WetLease,
+		&entity.ChainHash,
+		&entity.DutyPeriodID,
+		&entity.DeletedAt,
+		&entity.LogDate,
+		&entity.BookPage,
+		&entity.LicensePlate,
+		&entity.ModelName,
+		&entity.MultiEngine,
+		&entity.Turbine,
+		&entity.MultiPilot,
+		&entity.RouteCode,
+		&entity.OriginIataCode,
+		&entity.DestinationIataCode,
+		&entity.AirlineCode,
+		&entity.OriginTimeZone,
+		&entity.DestinationTimeZone,
+		&entity.EstimatedFlightTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &entity, nil
 }
 
 // ToDomain converts database entity to domain model
@@ -54,6 +135,7 @@ func (d *DailyLogbookDetail) ToDomain() *domain.DailyLogbookDetail {
 		PilotRole:                    domain.PilotRole(d.PilotRole),
 		AirTime:                      d.AirTime,
 		BlockTime:                    d.BlockTime,
+		WetLease:                     d.WetLease,
 	}
 
 	// Handle nullable fields
@@ -66,10 +148,23 @@ func (d *DailyLogbookDetail) ToDomain() *domain.DailyLogbookDetail {
 		detail.CompanionName = &d.CompanionName.String
 	}
 
+	if d.CompanionEmployeeID.Valid {
+		detail.CompanionEmployeeID = &d.CompanionEmployeeID.String
+	}
+
+	if d.PilotFunction.Valid {
+		pilotFunction := domain.PilotFunction(d.PilotFunction.String)
+		detail.PilotFunction = &pilotFunction
+	}
+
 	if d.DutyTime.Valid {
 		detail.DutyTime = &d.DutyTime.String
 	}
 
+	if d.IFRTime.Valid {
+		detail.IFRTime = &d.IFRTime.String
+	}
+
 	if d.ApproachType.Valid {
 		approachType := domain.ApproachType(d.ApproachType.String)
 		detail.ApproachType = &approachType
@@ -83,16 +178,37 @@ func (d *DailyLogbookDetail) ToDomain() *domain.DailyLogbookDetail {
 		detail.EmployeeLogbookID = &d.EmployeeLogbookID.String
 	}
 
+	if d.NightTime.Valid {
+		detail.NightTime = &d.NightTime.String
+	}
+	if d.ChainHash.Valid {
+		detail.ChainHash = &d.ChainHash.String
+	}
+	if d.DutyPeriodID.Valid {
+		detail.DutyPeriodID = &d.DutyPeriodID.String
+	}
+	if d.DeletedAt.Valid {
+		detail.DeletedAt = &d.DeletedAt.Time
+	}
+	detail.DayTakeoffs = nullIntToPtr(d.DayTakeoffs)
+	detail.NightTakeoffs = nullIntToPtr(d.NightTakeoffs)
+	detail.DayLandings = nullIntToPtr(d.DayLandings)
+	detail.NightLandings = nullIntToPtr(d.NightLandings)
+
 	// Denormalized fields
 	if d.LogDate.Valid {
 		detail.LogDate = d.LogDate.String
 	}
+	detail.BookPage = nullIntToPtr(d.BookPage)
 	if d.LicensePlate.Valid {
 		detail.LicensePlate = d.LicensePlate.String
 	}
 	if d.ModelName.Valid {
 		detail.ModelName = d.ModelName.String
 	}
+	detail.MultiEngine = d.MultiEngine.Bool
+	detail.Turbine = d.Turbine.Bool
+	detail.MultiPilot = d.MultiPilot.Bool
 	if d.RouteCode.Valid {
 		detail.RouteCode = d.RouteCode.String
 	}
@@ -105,6 +221,15 @@ func (d *DailyLogbookDetail) ToDomain() *domain.DailyLogbookDetail {
 	if d.AirlineCode.Valid {
 		detail.AirlineCode = d.AirlineCode.String
 	}
+	if d.OriginTimeZone.Valid {
+		detail.OriginTimeZone = d.OriginTimeZone.String
+	}
+	if d.DestinationTimeZone.Valid {
+		detail.DestinationTimeZone = d.DestinationTimeZone.String
+	}
+	if d.EstimatedFlightTime.Valid {
+		detail.EstimatedFlightTime = d.EstimatedFlightTime.String
+	}
 
 	return detail
 }
@@ -125,6 +250,7 @@ func FromDomain(d *domain.DailyLogbookDetail) *DailyLogbookDetail {
 		PilotRole:                    string(d.PilotRole),
 		AirTime:                      d.AirTime,
 		BlockTime:                    d.BlockTime,
+		WetLease:                     d.WetLease,
 	}
 
 	// Handle nullable fields
@@ -136,10 +262,22 @@ func FromDomain(d *domain.DailyLogbookDetail) *DailyLogbookDetail {
 		entity.CompanionName = sql.NullString{String: *d.CompanionName, Valid: true}
 	}
 
+	if d.CompanionEmployeeID != nil {
+		entity.CompanionEmployeeID = sql.NullString{String: *d.CompanionEmployeeID, Valid: true}
+	}
+
+	if d.PilotFunction != nil {
+		entity.PilotFunction = sql.NullString{String: string(*d.PilotFunction), Valid: true}
+	}
+
 	if d.DutyTime != nil {
 		entity.DutyTime = sql.NullString{String: *d.DutyTime, Valid: true}
 	}
 
+	if d.IFRTime != nil {
+		entity.IFRTime = sql.NullString{String: *d.IFRTime, Valid: true}
+	}
+
 	if d.ApproachType != nil {
 		entity.ApproachType = sql.NullString{String: string(*d.ApproachType), Valid: true}
 	}
@@ -152,5 +290,57 @@ func FromDomain(d *domain.DailyLogbookDetail) *DailyLogbookDetail {
 		entity.EmployeeLogbookID = sql.NullString{String: *d.EmployeeLogbookID, Valid: true}
 	}
 
+	if d.NightTime != nil {
+		entity.NightTime = sql.NullString{String: *d.NightTime, Valid: true}
+	}
+	entity.DayTakeoffs = ptrToNullInt(d.DayTakeoffs)
+	entity.NightTakeoffs = ptrToNullInt(d.NightTakeoffs)
+	entity.DayLandings = ptrToNullInt(d.DayLandings)
+	entity.NightLandings = ptrToNullInt(d.NightLandings)
+
 	return entity
 }
+
+func nullIntToPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
+
+func ptrToNullInt(v *int) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*v), Valid: true}
+}
+
+// logbookColumnSeconds holds the sums selected with queryLogbookColumnSums, in column order
+type logbookColumnSeconds [10]int64
+
+// dest returns the scan destinations of the sums
+func (c *logbookColumnSeconds) dest() []any {
+	dest := make([]any, len(c))
+	for i := range c {
+		dest[i] = &c[i]
+	}
+	return dest
+}
+
+// ToDomain converts the sums to logbook column times
+func (c *logbookColumnSeconds) ToDomain() domain.LogbookColumnTimes {
+	d := func(seconds int64) time.Duration { return time.Duration(seconds) * time.Second }
+	return domain.LogbookColumnTimes{
+		SinglePilotSE: d(c[0]),
+		SinglePilotME: d(c[1]),
+		MultiPilot:    d(c[2]),
+		Turbine:       d(c[3]),
+		PIC:           d(c[4]),
+		SIC:           d(c[5]),
+		Dual:          d(c[6]),
+		Instructor:    d(c[7]),
+		PICUS:         d(c[8]),
+		IFR:           d(c[9]),
+	}
+}
@@ -2,6 +2,8 @@ package daily_logbook_detail
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/core/ports/output"
@@ -9,8 +11,8 @@ import (
 	"github.com/champion19/flighthours-api/platform/logger"
 )
 
-// DeleteDailyLogbookDetail deletes a daily logbook detail by its ID
-func (r *repository) DeleteDailyLogbookDetail(ctx context.Context, tx output.Tx, id string) error {
+// DeleteDailyLogbookDetail soft deletes a daily logbook detail by its ID; it can be restored until purged
+func (r *repository) DeleteDailyLogbookDetail(ctx context.Context, tx output.Tx, id string, deletedAt time.Time) error {
 	log.Info(logger.LogDailyLogbookDetailDelete, "id", id)
 
 	sqlTx, ok := tx.(*common.SQLTX)
@@ -21,7 +23,7 @@ func (r *repository) DeleteDailyLogbookDetail(ctx context.Context, tx output.Tx,
 
 	stmt := sqlTx.Tx.StmtContext(ctx, r.stmtDelete)
 
-	result, err := stmt.ExecContext(ctx, id)
+	result, err := stmt.ExecContext(ctx, deletedAt, id)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailDeleteError, "id", id, "error", err)
 		return domain.ErrFlightCannotDelete
@@ -41,3 +43,64 @@ func (r *repository) DeleteDailyLogbookDetail(ctx context.Context, tx output.Tx,
 	log.Info(logger.LogDailyLogbookDetailDeleteOK, "id", id)
 	return nil
 }
+
+// GetDeletedDailyLogbookDetailByID retrieves a soft deleted daily logbook detail by its ID with JOIN data
+func (r *repository) GetDeletedDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error) {
+	entity, err := scanDetail(r.stmtDeletedByID.QueryRowContext(ctx, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Warn(logger.LogDailyLogbookDetailNotFound, "id", id)
+			return nil, nil
+		}
+		log.Error(logger.LogDailyLogbookDetailGetError, "id", id, "error", err)
+		return nil, err
+	}
+	return entity.ToDomain(), nil
+}
+
+// RestoreDailyLogbookDetail brings back a soft deleted daily logbook detail
+func (r *repository) RestoreDailyLogbookDetail(ctx context.Context, tx output.Tx, id string) error {
+	log.Info(logger.LogDailyLogbookDetailRestore, "id", id)
+
+	sqlTx, ok := tx.(*common.SQLTX)
+	if !ok {
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "error", "invalid transaction type")
+		return domain.ErrInvalidTransaction
+	}
+
+	result, err := sqlTx.ExecContext(ctx, QueryRestore, id)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "id", id, "error", err)
+		return domain.ErrFlightCannotRestore
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRestoreErr, "id", id, "error", err)
+		return domain.ErrFlightCannotRestore
+	}
+
+	if rowsAffected == 0 {
+		log.Warn(logger.LogDailyLogbookDetailNotFound, "id", id)
+		return domain.ErrFlightNotFound
+	}
+
+	log.Info(logger.LogDailyLogbookDetailRestoreOK, "id", id)
+	return nil
+}
+
+// PurgeDeletedDailyLogbookDetails permanently removes the details deleted before the given instant
+func (r *repository) PurgeDeletedDailyLogbookDetails(ctx context.Context, tx output.Tx, deletedBefore time.Time) (int64, error) {
+	sqlTx, ok := tx.(*common.SQLTX)
+	if !ok {
+		log.Error(logger.LogLogbookPurgeError, "error", "invalid transaction type")
+		return 0, domain.ErrInvalidTransaction
+	}
+
+	result, err := sqlTx.ExecContext(ctx, QueryPurge, deletedBefore)
+	if err != nil {
+		log.Error(logger.LogLogbookPurgeError, "error", err)
+		return 0, domain.ErrFlightCannotDelete
+	}
+	return result.RowsAffected()
+}
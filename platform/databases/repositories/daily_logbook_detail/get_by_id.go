@@ -12,36 +12,7 @@ import (
 func (r *repository) GetDailyLogbookDetailByID(ctx context.Context, id string) (*domain.DailyLogbookDetail, error) {
 	log.Info(logger.LogDailyLogbookDetailGet, "id", id)
 
-	var entity DailyLogbookDetail
-	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(
-		&entity.ID,
-		&entity.DailyLogbookID,
-		&entity.FlightRealDate,
-		&entity.FlightNumber,
-		&entity.AirlineRouteID,
-		&entity.ActualAircraftRegistrationID,
-		&entity.Passengers,
-		&entity.OutTime,
-		&entity.TakeoffTime,
-		&entity.LandingTime,
-		&entity.InTime,
-		&entity.PilotRole,
-		&entity.CompanionName,
-		&entity.AirTime,
-		&entity.BlockTime,
-		&entity.DutyTime,
-		&entity.ApproachType,
-		&entity.FlightType,
-		&entity.EmployeeLogbookID,
-		&entity.LogDate,
-		&entity.LicensePlate,
-		&entity.ModelName,
-		&entity.RouteCode,
-		&entity.OriginIataCode,
-		&entity.DestinationIataCode,
-		&entity.AirlineCode,
-	)
-
+	entity, err := scanDetail(r.stmtGetByID.QueryRowContext(ctx, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.Warn(logger.LogDailyLogbookDetailNotFound, "id", id)
@@ -0,0 +1,53 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// GetCurrencyEvents returns an employee's segments between from and to (inclusive)
+// with the aircraft family, pilot role, approach type and night counts used by the currency rules
+func (r *repository) GetCurrencyEvents(ctx context.Context, employeeID string, from, to time.Time) ([]domain.CurrencyEvent, error) {
+	rows, err := r.stmtCurrencyEvents.QueryContext(ctx, employeeID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		log.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []domain.CurrencyEvent
+	for rows.Next() {
+		var date time.Time
+		var family, pilotRole string
+		var approachType sql.NullString
+		var nightTakeoffs, nightLandings sql.NullInt64
+		if err := rows.Scan(&date, &family, &pilotRole, &approachType, &nightTakeoffs, &nightLandings); err != nil {
+			log.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+
+		event := domain.CurrencyEvent{
+			Date:           time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+			AircraftFamily: family,
+			PilotRole:      domain.PilotRole(pilotRole),
+			NightTakeoffs:  int(nightTakeoffs.Int64),
+			NightLandings:  int(nightLandings.Int64),
+		}
+		if approachType.Valid {
+			at := domain.ApproachType(approachType.String)
+			event.ApproachType = &at
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogCurrencyStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return events, nil
+}
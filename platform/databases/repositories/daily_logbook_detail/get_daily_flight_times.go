@@ -0,0 +1,43 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// GetDailyFlightTimes returns an employee's accumulated block and duty time per flight date
+// between from and to (inclusive), ignoring the detail with excludeDetailID (may be empty).
+// Segments linked to a duty period contribute no duty time; see FTLService.
+func (r *repository) GetDailyFlightTimes(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyFlightTime, error) {
+	rows, err := r.stmtDailyTimes.QueryContext(ctx, employeeID, from.Format("2006-01-02"), to.Format("2006-01-02"), excludeDetailID)
+	if err != nil {
+		log.Error(logger.LogFTLStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []domain.DailyFlightTime
+	for rows.Next() {
+		var date time.Time
+		var blockSeconds, dutySeconds int64
+		if err := rows.Scan(&date, &blockSeconds, &dutySeconds); err != nil {
+			log.Error(logger.LogFTLStatusError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		days = append(days, domain.DailyFlightTime{
+			Date:      time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+			BlockTime: time.Duration(blockSeconds) * time.Second,
+			DutyTime:  time.Duration(dutySeconds) * time.Second,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogFTLStatusError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return days, nil
+}
@@ -0,0 +1,114 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// flightTotalsGroupColumns maps each grouping dimension to its SQL expression.
+// Only these expressions are ever interpolated into the totals query.
+var flightTotalsGroupColumns = map[domain.FlightTotalsGroupBy]string{
+	domain.FlightTotalsByMonth:          "DATE_FORMAT(dld.flight_real_date, '%Y-%m')",
+	domain.FlightTotalsByAircraftModel:  "COALESCE(am.model_name, '')",
+	domain.FlightTotalsByAircraftFamily: "COALESCE(am.family, '')",
+	domain.FlightTotalsByAirline:        "COALESCE(airl.airline_code, '')",
+	domain.FlightTotalsByPilotRole:      "dld.pilot_role",
+	domain.FlightTotalsByPilotFunction:  "COALESCE(dld.pilot_function, '')",
+	domain.FlightTotalsByFlightType:     "COALESCE(dld.flight_type, '')",
+	domain.FlightTotalsByApproachType:   "COALESCE(dld.approach_type, '')",
+}
+
+// GetFlightTotals aggregates block, air, duty and night time, the standard logbook columns and segment
+// counts for an employee, optionally restricted to a flight date range and grouped by the requested dimensions
+func (r *repository) GetFlightTotals(ctx context.Context, filter domain.FlightTotalsFilter) ([]domain.FlightTotals, error) {
+	log.Info(logger.LogFlightTotalsGet, "employee_id", filter.EmployeeID, "group_by", filter.GroupBy)
+
+	columns := make([]string, 0, len(filter.GroupBy))
+	for _, g := range filter.GroupBy {
+		column, ok := flightTotalsGroupColumns[g]
+		if !ok {
+			return nil, domain.ErrFlightTotalsInvalidGroupBy
+		}
+		columns = append(columns, column)
+	}
+
+	selectColumns := ""
+	if len(columns) > 0 {
+		selectColumns = strings.Join(columns, ", ") + ","
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(QueryFlightTotalsSelect, selectColumns))
+	args := []interface{}{filter.EmployeeID}
+	if filter.From != nil {
+		sb.WriteString(" AND dld.flight_real_date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+	if filter.To != nil {
+		sb.WriteString(" AND dld.flight_real_date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+	if len(columns) > 0 {
+		sb.WriteString(" GROUP BY " + strings.Join(columns, ", "))
+		sb.WriteString(" ORDER BY " + strings.Join(columns, ", "))
+	}
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		log.Error(logger.LogFlightTotalsGetError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []domain.FlightTotals
+	for rows.Next() {
+		keys := make([]string, len(columns))
+		var segmentCount int
+		var blockSeconds, airSeconds, dutySeconds, nightSeconds int64
+		var columnSeconds logbookColumnSeconds
+
+		dest := make([]interface{}, 0, len(columns)+5+len(columnSeconds))
+		for i := range keys {
+			dest = append(dest, &keys[i])
+		}
+		dest = append(dest, &segmentCount, &blockSeconds, &airSeconds, &dutySeconds, &nightSeconds)
+		dest = append(dest, columnSeconds.dest()...)
+
+		if err := rows.Scan(dest...); err != nil {
+			log.Error(logger.LogFlightTotalsGetError, "employee_id", filter.EmployeeID, "error", err)
+			return nil, err
+		}
+
+		// Without GROUP BY an employee with no segments still yields one row of zeros
+		if segmentCount == 0 {
+			continue
+		}
+
+		group := domain.FlightTotals{
+			Keys:         make(map[domain.FlightTotalsGroupBy]string, len(columns)),
+			SegmentCount: segmentCount,
+			BlockTime:    time.Duration(blockSeconds) * time.Second,
+			AirTime:      time.Duration(airSeconds) * time.Second,
+			DutyTime:     time.Duration(dutySeconds) * time.Second,
+			NightTime:    time.Duration(nightSeconds) * time.Second,
+			Columns:      columnSeconds.ToDomain(),
+		}
+		for i, g := range filter.GroupBy {
+			group.Keys[g] = keys[i]
+		}
+		totals = append(totals, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogFlightTotalsGetError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogFlightTotalsGetOK, "employee_id", filter.EmployeeID, "groups", len(totals))
+	return totals, nil
+}
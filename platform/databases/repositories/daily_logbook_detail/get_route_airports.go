@@ -0,0 +1,40 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// GetRouteAirports retrieves the origin and destination airports of an airline route
+// Only the fields needed for segment time handling (IATA code, time zone, coordinates) are populated
+func (r *repository) GetRouteAirports(ctx context.Context, airlineRouteID string) (*domain.Airport, *domain.Airport, error) {
+	var origin, destination domain.Airport
+	var originTZ, destinationTZ sql.NullString
+
+	err := r.stmtRouteAirports.QueryRowContext(ctx, airlineRouteID).Scan(
+		&origin.ID,
+		&origin.IATACode,
+		&originTZ,
+		&origin.Latitude,
+		&origin.Longitude,
+		&destination.ID,
+		&destination.IATACode,
+		&destinationTZ,
+		&destination.Latitude,
+		&destination.Longitude,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, domain.ErrFlightInvalidRoute
+		}
+		log.Error(logger.LogDailyLogbookDetailGetError, "airline_route_id", airlineRouteID, "error", err)
+		return nil, nil, err
+	}
+
+	origin.TimeZone = originTZ.String
+	destination.TimeZone = destinationTZ.String
+	return &origin, &destination, nil
+}
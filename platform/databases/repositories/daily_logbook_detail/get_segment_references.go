@@ -0,0 +1,39 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// GetSegmentReferences loads the airline route, its airline and the aircraft registration of a segment
+// for cross-entity checks. Returns ErrFlightInvalidRoute or ErrFlightInvalidAircraft when they do not exist.
+func (r *repository) GetSegmentReferences(ctx context.Context, airlineRouteID, aircraftRegistrationID string) (*domain.SegmentReferences, error) {
+	var refs domain.SegmentReferences
+	var airlineStatus string
+	var aircraftAirlineID sql.NullString
+
+	err := r.stmtSegmentReferences.QueryRowContext(ctx, aircraftRegistrationID, airlineRouteID).Scan(
+		&refs.RouteAirlineID,
+		&refs.RouteActive,
+		&airlineStatus,
+		&aircraftAirlineID,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrFlightInvalidRoute
+		}
+		log.Error(logger.LogDailyLogbookDetailGetError, "airline_route_id", airlineRouteID, "error", err)
+		return nil, err
+	}
+	if !aircraftAirlineID.Valid {
+		return nil, domain.ErrFlightInvalidAircraft
+	}
+
+	airline := domain.Airline{Status: airlineStatus}
+	refs.AirlineActive = airline.IsActive()
+	refs.AircraftAirlineID = aircraftAirlineID.String
+	return &refs, nil
+}
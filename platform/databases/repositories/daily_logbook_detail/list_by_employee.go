@@ -0,0 +1,99 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListDailyLogbookDetailsByEmployee retrieves an employee's details between from and to (inclusive),
+// ordered by logbook page, flight date and OUT time
+func (r *repository) ListDailyLogbookDetailsByEmployee(ctx context.Context, employeeID string, from, to time.Time) ([]domain.DailyLogbookDetail, error) {
+	log.Info(logger.LogDailyLogbookDetailList, "employee_id", employeeID)
+
+	rows, err := r.stmtByEmployee.QueryContext(ctx, employeeID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailListError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	return scanEmployeeDetails(rows, employeeID)
+}
+
+// ListDailyLogbookDetailsBetween retrieves an employee's details between two logbook positions (inclusive),
+// ordered by logbook page, flight date and OUT time
+func (r *repository) ListDailyLogbookDetailsBetween(ctx context.Context, employeeID string, first, last domain.LogbookPosition) ([]domain.DailyLogbookDetail, error) {
+	log.Info(logger.LogDailyLogbookDetailList, "employee_id", employeeID)
+
+	args := append([]any{employeeID}, positionArgs(first)...)
+	rows, err := r.stmtByPositions.QueryContext(ctx, append(args, positionArgs(last)...)...)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailListError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	return scanEmployeeDetails(rows, employeeID)
+}
+
+func scanEmployeeDetails(rows *sql.Rows, employeeID string) ([]domain.DailyLogbookDetail, error) {
+	defer rows.Close()
+
+	var details []domain.DailyLogbookDetail
+	for rows.Next() {
+		entity, err := scanDetail(rows)
+		if err != nil {
+			log.Error(logger.LogDailyLogbookDetailListError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		details = append(details, *entity.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogDailyLogbookDetailListError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	log.Info(logger.LogDailyLogbookDetailListOK, "employee_id", employeeID, "count", len(details))
+	return details, nil
+}
+
+// positionArgs returns the arguments of a queryLogbookPosition row comparison
+func positionArgs(p domain.LogbookPosition) []any {
+	page := 0
+	if p.BookPage != nil {
+		page = *p.BookPage
+	}
+	return []any{p.BookPage == nil, page, p.FlightRealDate, p.OutTime, p.DetailID}
+}
+
+// GetLogbookTotalsBefore returns an employee's accumulated logbook totals for the segments before the given
+// position in logbook page order
+func (r *repository) GetLogbookTotalsBefore(ctx context.Context, employeeID string, before domain.LogbookPosition) (domain.LogbookTotals, error) {
+	var totals domain.LogbookTotals
+	var blockSeconds, airSeconds, nightSeconds, dutySeconds int64
+	var columns logbookColumnSeconds
+	dest := []any{
+		&totals.Segments,
+		&blockSeconds,
+		&airSeconds,
+		&nightSeconds,
+		&dutySeconds,
+		&totals.DayTakeoffs,
+		&totals.NightTakeoffs,
+		&totals.DayLandings,
+		&totals.NightLandings,
+	}
+	err := r.stmtTotalsBefore.QueryRowContext(ctx, append([]any{employeeID}, positionArgs(before)...)...).Scan(append(dest, columns.dest()...)...)
+	if err != nil {
+		log.Error(logger.LogLogbookExportError, "employee_id", employeeID, "error", err)
+		return domain.LogbookTotals{}, err
+	}
+
+	totals.BlockTime = time.Duration(blockSeconds) * time.Second
+	totals.AirTime = time.Duration(airSeconds) * time.Second
+	totals.NightTime = time.Duration(nightSeconds) * time.Second
+	totals.DutyTime = time.Duration(dutySeconds) * time.Second
+	totals.Columns = columns.ToDomain()
+	return totals, nil
+}
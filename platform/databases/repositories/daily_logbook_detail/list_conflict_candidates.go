@@ -0,0 +1,41 @@
+package daily_logbook_detail
+
+import (
+	"context"
+	"time"
+
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListConflictCandidates returns an employee's segments, across all logbooks, with a flight date between
+// from and to (inclusive), ignoring the detail with excludeDetailID (may be empty).
+// Only the fields needed for duplicate and overlap detection are loaded.
+func (r *repository) ListConflictCandidates(ctx context.Context, employeeID string, from, to time.Time, excludeDetailID string) ([]domain.DailyLogbookDetail, error) {
+	rows, err := r.stmtConflicts.QueryContext(ctx, employeeID, from.Format("2006-01-02"), to.Format("2006-01-02"), excludeDetailID)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailConflictError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var details []domain.DailyLogbookDetail
+	for rows.Next() {
+		var d domain.DailyLogbookDetail
+		var flightDate time.Time
+		if err := rows.Scan(&d.ID, &d.DailyLogbookID, &flightDate, &d.FlightNumber, &d.ActualAircraftRegistrationID,
+			&d.OutTime, &d.TakeoffTime, &d.LandingTime, &d.InTime); err != nil {
+			log.Error(logger.LogDailyLogbookDetailConflictError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		d.FlightRealDate = flightDate.Format("2006-01-02")
+		details = append(details, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogDailyLogbookDetailConflictError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return details, nil
+}
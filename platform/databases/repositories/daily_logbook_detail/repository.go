@@ -9,9 +9,11 @@ import (
 	"github.com/champion19/flighthours-api/platform/logger"
 )
 
+// Deleted segments keep their row with deleted_at set until they are purged; the segments of a deleted
+// logbook are deleted with it. Every query except QueryDeletedByID only sees live segments of live logbooks.
 const (
-	// Query for getting a detail by ID with JOINs for denormalized data
-	QueryByID = `
+	// Base SELECT for a detail with JOINs for denormalized data; columns match scanDetail
+	queryDetailSelect = `
 		SELECT
 			dld.id,
 			dld.daily_logbook_id,
@@ -26,19 +28,38 @@ const (
 			dld.in_time,
 			dld.pilot_role,
 			dld.companion_name,
+			dld.companion_employee_id,
+			dld.pilot_function,
 			dld.air_time,
 			dld.block_time,
 			dld.duty_time,
+			dld.ifr_time,
 			dld.approach_type,
 			dld.flight_type,
 			dld.employee_logbook_id,
+			dld.night_time,
+			dld.day_takeoffs,
+			dld.night_takeoffs,
+			dld.day_landings,
+			dld.night_landings,
+			dld.wet_lease,
+			dld.chain_hash,
+			dld.duty_period_id,
+			dld.deleted_at,
 			dl.log_date,
+			dl.book_page,
 			ar.license_plate,
 			am.model_name,
+			am.multi_engine,
+			am.turbine,
+			am.multi_pilot,
 			CONCAT(orig.iata_code, '-', dest.iata_code) as route_code,
 			orig.iata_code as origin_iata_code,
 			dest.iata_code as destination_iata_code,
-			airl.airline_code
+			airl.airline_code,
+			orig.time_zone as origin_time_zone,
+			dest.time_zone as destination_time_zone,
+			r.estimated_flight_time
 		FROM daily_logbook_detail dld
 		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
 		INNER JOIN aircraft_registration ar ON dld.actual_aircraft_registration_id = ar.id
@@ -48,50 +69,197 @@ const (
 		INNER JOIN airport orig ON r.origin_airport_id = orig.id
 		INNER JOIN airport dest ON r.destination_airport_id = dest.id
 		INNER JOIN airline airl ON alr.airline_id = airl.id
+	`
+
+	// Query for getting a detail by ID with JOINs for denormalized data
+	QueryByID = queryDetailSelect + `
 		WHERE dld.id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+		LIMIT 1
+	`
+
+	// Query for getting a deleted detail by ID (restore)
+	QueryDeletedByID = queryDetailSelect + `
+		WHERE dld.id = ?
+			AND dld.deleted_at IS NOT NULL
 		LIMIT 1
 	`
 
 	// Query for listing details by logbook ID
-	QueryByLogbook = `
+	QueryByLogbook = queryDetailSelect + `
+		WHERE dld.daily_logbook_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+		ORDER BY dld.flight_real_date ASC, dld.out_time ASC
+	`
+
+	// Query for an employee's segments of signed and locked logbooks, in hash chain order
+	QueryChainByEmployee = queryDetailSelect + `
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND dl.state IN ('SIGNED', 'LOCKED')
+		ORDER BY dld.flight_real_date ASC, dld.out_time ASC, dld.id ASC
+	`
+
+	// Logbook page order of a segment (see domain.LogbookPosition): logbook page, segments without
+	// a page last, then flight date, OUT time and ID
+	queryLogbookPosition = `(dl.book_page IS NULL, COALESCE(dl.book_page, 0), dld.flight_real_date, dld.out_time, dld.id)`
+
+	// Query for listing an employee's details in a flight date range, in logbook page order (export)
+	QueryByEmployeeRange = queryDetailSelect + `
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND dld.flight_real_date BETWEEN ? AND ?
+		ORDER BY dl.book_page IS NULL, dl.book_page ASC, dld.flight_real_date ASC, dld.out_time ASC, dld.id ASC
+	`
+
+	// Query for listing an employee's details between two logbook positions (inclusive), in logbook page order (export)
+	QueryByEmployeePositions = queryDetailSelect + `
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND ` + queryLogbookPosition + ` >= (?, ?, ?, ?, ?)
+			AND ` + queryLogbookPosition + ` <= (?, ?, ?, ?, ?)
+		ORDER BY dl.book_page IS NULL, dl.book_page ASC, dld.flight_real_date ASC, dld.out_time ASC, dld.id ASC
+	`
+
+	// Sums of block time per standard logbook column (see domain.LogbookColumnTimes); columns match
+	// logbookColumnSeconds and need the aircraft_model join as am
+	queryLogbookColumnSums = `
+			COALESCE(SUM(CASE WHEN am.multi_pilot = 0 AND am.multi_engine = 0 THEN TIME_TO_SEC(dld.block_time) END), 0) as single_pilot_se_seconds,
+			COALESCE(SUM(CASE WHEN am.multi_pilot = 0 AND am.multi_engine = 1 THEN TIME_TO_SEC(dld.block_time) END), 0) as single_pilot_me_seconds,
+			COALESCE(SUM(CASE WHEN am.multi_pilot = 1 THEN TIME_TO_SEC(dld.block_time) END), 0) as multi_pilot_seconds,
+			COALESCE(SUM(CASE WHEN am.turbine = 1 THEN TIME_TO_SEC(dld.block_time) END), 0) as turbine_seconds,
+			COALESCE(SUM(CASE WHEN dld.pilot_function = 'PIC' THEN TIME_TO_SEC(dld.block_time) END), 0) as pic_seconds,
+			COALESCE(SUM(CASE WHEN dld.pilot_function = 'SIC' THEN TIME_TO_SEC(dld.block_time) END), 0) as sic_seconds,
+			COALESCE(SUM(CASE WHEN dld.pilot_function = 'DUAL' THEN TIME_TO_SEC(dld.block_time) END), 0) as dual_seconds,
+			COALESCE(SUM(CASE WHEN dld.pilot_function = 'INSTRUCTOR' THEN TIME_TO_SEC(dld.block_time) END), 0) as instructor_seconds,
+			COALESCE(SUM(CASE WHEN dld.pilot_function = 'PICUS' THEN TIME_TO_SEC(dld.block_time) END), 0) as picus_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.ifr_time)), 0) as ifr_seconds
+	`
+
+	// Query for an employee's accumulated totals before a logbook position (brought forward on export)
+	QueryTotalsBefore = `
+		SELECT
+			COUNT(*) as segment_count,
+			COALESCE(SUM(TIME_TO_SEC(dld.block_time)), 0) as block_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.air_time)), 0) as air_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.night_time)), 0) as night_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.duty_time)), 0) as duty_seconds,
+			COALESCE(SUM(dld.day_takeoffs), 0) as day_takeoffs,
+			COALESCE(SUM(dld.night_takeoffs), 0) as night_takeoffs,
+			COALESCE(SUM(dld.day_landings), 0) as day_landings,
+			COALESCE(SUM(dld.night_landings), 0) as night_landings,` + queryLogbookColumnSums + `
+		FROM daily_logbook_detail dld
+		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
+		INNER JOIN aircraft_registration ar ON dld.actual_aircraft_registration_id = ar.id
+		INNER JOIN aircraft_model am ON ar.aircraft_model_id = am.id
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND ` + queryLogbookPosition + ` < (?, ?, ?, ?, ?)
+	`
+
+	// Query for resolving the origin and destination airports of an airline route
+	QueryRouteAirports = `
+		SELECT
+			orig.id, orig.iata_code, orig.time_zone, orig.latitude, orig.longitude,
+			dest.id, dest.iata_code, dest.time_zone, dest.latitude, dest.longitude
+		FROM airline_route alr
+		INNER JOIN route r ON alr.route_id = r.id
+		INNER JOIN airport orig ON r.origin_airport_id = orig.id
+		INNER JOIN airport dest ON r.destination_airport_id = dest.id
+		WHERE alr.id = ?
+		LIMIT 1
+	`
+
+	// Base query for flight totals of an employee; grouping columns, date filters and
+	// GROUP BY are appended at runtime from a fixed whitelist (see get_flight_totals.go)
+	QueryFlightTotalsSelect = `
+		SELECT %s
+			COUNT(*) as segment_count,
+			COALESCE(SUM(TIME_TO_SEC(dld.block_time)), 0) as block_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.air_time)), 0) as air_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.duty_time)), 0) as duty_seconds,
+			COALESCE(SUM(TIME_TO_SEC(dld.night_time)), 0) as night_seconds,` + queryLogbookColumnSums + `
+		FROM daily_logbook_detail dld
+		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
+		INNER JOIN aircraft_registration ar ON dld.actual_aircraft_registration_id = ar.id
+		INNER JOIN aircraft_model am ON ar.aircraft_model_id = am.id
+		INNER JOIN airline_route alr ON dld.airline_route_id = alr.id
+		INNER JOIN airline airl ON alr.airline_id = airl.id
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+	`
+
+	// Query for an employee's block and duty time per flight date (used by the FTL engine)
+	// The last parameter excludes a detail being updated so its previous values are not counted twice.
+	// The duty time of segments linked to a duty period is left out: the duty period counts instead.
+	QueryDailyFlightTimes = `
+		SELECT
+			dld.flight_real_date,
+			COALESCE(SUM(TIME_TO_SEC(dld.block_time)), 0) as block_seconds,
+			COALESCE(SUM(CASE WHEN dld.duty_period_id IS NULL THEN TIME_TO_SEC(dld.duty_time) END), 0) as duty_seconds
+		FROM daily_logbook_detail dld
+		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND dld.flight_real_date BETWEEN ? AND ?
+			AND dld.id <> ?
+		GROUP BY dld.flight_real_date
+		ORDER BY dld.flight_real_date
+	`
+
+	// Query for an employee's segments with the data needed by the currency (recency) rules
+	QueryCurrencyEvents = `
+		SELECT
+			dld.flight_real_date,
+			COALESCE(am.family, '') as aircraft_family,
+			dld.pilot_role,
+			dld.approach_type,
+			dld.night_takeoffs,
+			dld.night_landings
+		FROM daily_logbook_detail dld
+		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
+		INNER JOIN aircraft_registration ar ON dld.actual_aircraft_registration_id = ar.id
+		INNER JOIN aircraft_model am ON ar.aircraft_model_id = am.id
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND dld.flight_real_date BETWEEN ? AND ?
+		ORDER BY dld.flight_real_date DESC
+	`
+
+	// Query for the airline route, its airline and the aircraft's airline of a segment (cross-entity checks)
+	// Parameters: aircraft registration ID, airline route ID
+	QuerySegmentReferences = `
+		SELECT
+			alr.airline_id,
+			alr.status,
+			airl.status,
+			(SELECT ar.airline_id FROM aircraft_registration ar WHERE ar.id = ?) as aircraft_airline_id
+		FROM airline_route alr
+		INNER JOIN airline airl ON alr.airline_id = airl.id
+		WHERE alr.id = ?
+		LIMIT 1
+	`
+
+	// Query for an employee's segments around a flight date, across all logbooks (duplicate and overlap detection)
+	// The last parameter excludes the detail being updated
+	QueryConflictCandidates = `
 		SELECT
 			dld.id,
 			dld.daily_logbook_id,
 			dld.flight_real_date,
 			dld.flight_number,
-			dld.airline_route_id,
 			dld.actual_aircraft_registration_id,
-			dld.passengers,
 			dld.out_time,
 			dld.takeoff_time,
 			dld.landing_time,
-			dld.in_time,
-			dld.pilot_role,
-			dld.companion_name,
-			dld.air_time,
-			dld.block_time,
-			dld.duty_time,
-			dld.approach_type,
-			dld.flight_type,
-			dld.employee_logbook_id,
-			dl.log_date,
-			ar.license_plate,
-			am.model_name,
-			CONCAT(orig.iata_code, '-', dest.iata_code) as route_code,
-			orig.iata_code as origin_iata_code,
-			dest.iata_code as destination_iata_code,
-			airl.airline_code
+			dld.in_time
 		FROM daily_logbook_detail dld
 		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
-		INNER JOIN aircraft_registration ar ON dld.actual_aircraft_registration_id = ar.id
-		INNER JOIN aircraft_model am ON ar.aircraft_model_id = am.id
-		INNER JOIN airline_route alr ON dld.airline_route_id = alr.id
-		INNER JOIN route r ON alr.route_id = r.id
-		INNER JOIN airport orig ON r.origin_airport_id = orig.id
-		INNER JOIN airport dest ON r.destination_airport_id = dest.id
-		INNER JOIN airline airl ON alr.airline_id = airl.id
-		WHERE dld.daily_logbook_id = ?
-		ORDER BY dld.out_time ASC
+		WHERE dl.employee_id = ?
+			AND dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+			AND dld.flight_real_date BETWEEN ? AND ?
+			AND dld.id <> ?
+		ORDER BY dld.flight_real_date, dld.out_time
 	`
 
 	// Insert query
@@ -100,10 +268,12 @@ const (
 			id, daily_logbook_id, flight_real_date, flight_number,
 			airline_route_id, actual_aircraft_registration_id, passengers,
 			out_time, takeoff_time, landing_time, in_time,
-			pilot_role, companion_name,
-			air_time, block_time, duty_time,
-			approach_type, flight_type, employee_logbook_id
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			pilot_role, companion_name, companion_employee_id, pilot_function,
+			air_time, block_time, duty_time, ifr_time,
+			approach_type, flight_type, employee_logbook_id,
+			night_time, day_takeoffs, night_takeoffs, day_landings, night_landings,
+			wet_lease
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	// Update query
@@ -120,27 +290,52 @@ const (
 			in_time = ?,
 			pilot_role = ?,
 			companion_name = ?,
+			companion_employee_id = ?,
+			pilot_function = ?,
 			air_time = ?,
 			block_time = ?,
 			duty_time = ?,
+			ifr_time = ?,
 			approach_type = ?,
-			flight_type = ?
-		WHERE id = ?
+			flight_type = ?,
+			night_time = ?,
+			day_takeoffs = ?,
+			night_takeoffs = ?,
+			day_landings = ?,
+			night_landings = ?,
+			wet_lease = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	// Delete query
-	QueryDelete = `DELETE FROM daily_logbook_detail WHERE id = ?`
+	// Soft delete, restore and purge queries
+	QueryDelete  = `UPDATE daily_logbook_detail SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
+	QueryRestore = `UPDATE daily_logbook_detail SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+	QueryPurge   = `DELETE FROM daily_logbook_detail WHERE deleted_at IS NOT NULL AND deleted_at < ?`
+
+	// Query for storing the hash chain link of a signed segment
+	QueryUpdateChainHash = `UPDATE daily_logbook_detail SET chain_hash = ? WHERE id = ?`
 )
 
 var log logger.Logger = logger.NewSlogLogger()
 
 type repository struct {
-	stmtGetByID      *sql.Stmt
-	stmtGetByLogbook *sql.Stmt
-	stmtInsert       *sql.Stmt
-	stmtUpdate       *sql.Stmt
-	stmtDelete       *sql.Stmt
-	db               *sql.DB
+	stmtGetByID           *sql.Stmt
+	stmtGetByLogbook      *sql.Stmt
+	stmtRouteAirports     *sql.Stmt
+	stmtDailyTimes        *sql.Stmt
+	stmtCurrencyEvents    *sql.Stmt
+	stmtByEmployee        *sql.Stmt
+	stmtByPositions       *sql.Stmt
+	stmtTotalsBefore      *sql.Stmt
+	stmtConflicts         *sql.Stmt
+	stmtSegmentReferences *sql.Stmt
+	stmtInsert            *sql.Stmt
+	stmtUpdate            *sql.Stmt
+	stmtDelete            *sql.Stmt
+	stmtDeletedByID       *sql.Stmt
+	stmtChain             *sql.Stmt
+	stmtUpdateChainHash   *sql.Stmt
+	db                    *sql.DB
 }
 
 // NewDailyLogbookDetailRepository creates a new daily logbook detail repository with prepared statements
@@ -161,6 +356,54 @@ func NewDailyLogbookDetailRepository(db *sql.DB) (*repository, error) {
 		return nil, err
 	}
 
+	stmtRouteAirports, err := db.Prepare(QueryRouteAirports)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtDailyTimes, err := db.Prepare(QueryDailyFlightTimes)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtCurrencyEvents, err := db.Prepare(QueryCurrencyEvents)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtByEmployee, err := db.Prepare(QueryByEmployeeRange)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtByPositions, err := db.Prepare(QueryByEmployeePositions)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtTotalsBefore, err := db.Prepare(QueryTotalsBefore)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtConflicts, err := db.Prepare(QueryConflictCandidates)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtSegmentReferences, err := db.Prepare(QuerySegmentReferences)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
 	stmtInsert, err := db.Prepare(QueryInsert)
 	if err != nil {
 		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
@@ -179,15 +422,44 @@ func NewDailyLogbookDetailRepository(db *sql.DB) (*repository, error) {
 		return nil, err
 	}
 
+	stmtDeletedByID, err := db.Prepare(QueryDeletedByID)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtChain, err := db.Prepare(QueryChainByEmployee)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtUpdateChainHash, err := db.Prepare(QueryUpdateChainHash)
+	if err != nil {
+		log.Error(logger.LogDailyLogbookDetailRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
 	log.Info(logger.LogDailyLogbookDetailRepoInitOK)
 
 	return &repository{
-		db:               db,
-		stmtGetByID:      stmtGetByID,
-		stmtGetByLogbook: stmtGetByLogbook,
-		stmtInsert:       stmtInsert,
-		stmtUpdate:       stmtUpdate,
-		stmtDelete:       stmtDelete,
+		db:                    db,
+		stmtGetByID:           stmtGetByID,
+		stmtGetByLogbook:      stmtGetByLogbook,
+		stmtRouteAirports:     stmtRouteAirports,
+		stmtDailyTimes:        stmtDailyTimes,
+		stmtCurrencyEvents:    stmtCurrencyEvents,
+		stmtByEmployee:        stmtByEmployee,
+		stmtByPositions:       stmtByPositions,
+		stmtTotalsBefore:      stmtTotalsBefore,
+		stmtConflicts:         stmtConflicts,
+		stmtSegmentReferences: stmtSegmentReferences,
+		stmtInsert:            stmtInsert,
+		stmtUpdate:            stmtUpdate,
+		stmtDelete:            stmtDelete,
+		stmtDeletedByID:       stmtDeletedByID,
+		stmtChain:             stmtChain,
+		stmtUpdateChainHash:   stmtUpdateChainHash,
 	}, nil
 }
 
@@ -38,12 +38,21 @@ func (r *repository) SaveDailyLogbookDetail(ctx context.Context, tx output.Tx, d
 		entity.InTime,
 		entity.PilotRole,
 		entity.CompanionName,
+		entity.CompanionEmployeeID,
+		entity.PilotFunction,
 		entity.AirTime,
 		entity.BlockTime,
 		entity.DutyTime,
+		entity.IFRTime,
 		entity.ApproachType,
 		entity.FlightType,
 		entity.EmployeeLogbookID,
+		entity.NightTime,
+		entity.DayTakeoffs,
+		entity.NightTakeoffs,
+		entity.DayLandings,
+		entity.NightLandings,
+		entity.WetLease,
 	)
 
 	if err != nil {
@@ -36,11 +36,20 @@ func (r *repository) UpdateDailyLogbookDetail(ctx context.Context, tx output.Tx,
 		entity.InTime,
 		entity.PilotRole,
 		entity.CompanionName,
+		entity.CompanionEmployeeID,
+		entity.PilotFunction,
 		entity.AirTime,
 		entity.BlockTime,
 		entity.DutyTime,
+		entity.IFRTime,
 		entity.ApproachType,
 		entity.FlightType,
+		entity.NightTime,
+		entity.DayTakeoffs,
+		entity.NightTakeoffs,
+		entity.DayLandings,
+		entity.NightLandings,
+		entity.WetLease,
 		entity.ID, // WHERE clause
 	)
 
@@ -0,0 +1,30 @@
+package duty_period
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// DeleteDutyPeriod removes a duty period; its segments must be unlinked first
+func (r *repository) DeleteDutyPeriod(ctx context.Context, tx output.Tx, id string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, id)
+	if err != nil {
+		return domain.ErrDutyPeriodCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrDutyPeriodNotFound
+	}
+
+	return nil
+}
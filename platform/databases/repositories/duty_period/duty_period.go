@@ -0,0 +1,110 @@
+package duty_period
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// DutyPeriod is the database entity for duty_period table
+type DutyPeriod struct {
+	ID          string         `db:"id"`
+	EmployeeID  string         `db:"employee_id"`
+	ReportTime  time.Time      `db:"report_time"`
+	ReleaseTime time.Time      `db:"release_time"`
+	SplitDuty   bool           `db:"split_duty"`
+	Standby     bool           `db:"standby"`
+	Remarks     sql.NullString `db:"remarks"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (p *DutyPeriod) scanDest() []interface{} {
+	return []interface{}{&p.ID, &p.EmployeeID, &p.ReportTime, &p.ReleaseTime, &p.SplitDuty, &p.Standby,
+		&p.Remarks, &p.CreatedAt, &p.UpdatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (p *DutyPeriod) ToDomain() *domain.DutyPeriod {
+	period := &domain.DutyPeriod{
+		ID:          p.ID,
+		EmployeeID:  p.EmployeeID,
+		ReportTime:  p.ReportTime.UTC(),
+		ReleaseTime: p.ReleaseTime.UTC(),
+		SplitDuty:   p.SplitDuty,
+		Standby:     p.Standby,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+	if p.Remarks.Valid {
+		period.Remarks = &p.Remarks.String
+	}
+	return period
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(period *domain.DutyPeriod) *DutyPeriod {
+	entity := &DutyPeriod{
+		ID:          period.ID,
+		EmployeeID:  period.EmployeeID,
+		ReportTime:  period.ReportTime.UTC(),
+		ReleaseTime: period.ReleaseTime.UTC(),
+		SplitDuty:   period.SplitDuty,
+		Standby:     period.Standby,
+		CreatedAt:   period.CreatedAt,
+		UpdatedAt:   period.UpdatedAt,
+	}
+	if period.Remarks != nil {
+		entity.Remarks = sql.NullString{String: *period.Remarks, Valid: true}
+	}
+	return entity
+}
+
+// Segment is a daily_logbook_detail row as read by a duty period
+type Segment struct {
+	ID             string         `db:"id"`
+	EmployeeID     string         `db:"employee_id"`
+	DailyLogbookID string         `db:"daily_logbook_id"`
+	LogbookState   sql.NullString `db:"state"` // NULL for logbooks created before the sign-off workflow
+	DutyPeriodID   sql.NullString `db:"duty_period_id"`
+	FlightNumber   string         `db:"flight_number"`
+	FlightRealDate string         `db:"flight_real_date"` // DATE stored as string
+	OutTime        string         `db:"out_time"`         // TIME stored as string HH:MM:SS, UTC
+	TakeoffTime    string         `db:"takeoff_time"`
+	LandingTime    string         `db:"landing_time"`
+	InTime         string         `db:"in_time"`
+	RouteCode      sql.NullString `db:"route_code"`
+}
+
+// scanDest returns the scan destinations in the column order of querySegmentSelect
+func (s *Segment) scanDest() []interface{} {
+	return []interface{}{&s.ID, &s.EmployeeID, &s.DailyLogbookID, &s.LogbookState, &s.DutyPeriodID, &s.FlightNumber, &s.FlightRealDate,
+		&s.OutTime, &s.TakeoffTime, &s.LandingTime, &s.InTime, &s.RouteCode}
+}
+
+// ToDomain converts the segment row, resolving its OUT and IN instants against the flight date
+func (s *Segment) ToDomain() (*domain.DutyPeriodSegment, error) {
+	times, err := domain.ResolveSegmentTimes(s.FlightRealDate, s.OutTime, s.TakeoffTime, s.LandingTime, s.InTime)
+	if err != nil {
+		return nil, err
+	}
+
+	flightDate, _ := domain.ParseFlightDate(s.FlightRealDate)
+	segment := &domain.DutyPeriodSegment{
+		ID:             s.ID,
+		EmployeeID:     s.EmployeeID,
+		DailyLogbookID: s.DailyLogbookID,
+		LogbookState:   domain.LogbookState(s.LogbookState.String),
+		FlightNumber:   s.FlightNumber,
+		FlightRealDate: flightDate.Format("2006-01-02"),
+		RouteCode:      s.RouteCode.String,
+		Out:            times.Out,
+		In:             times.In,
+	}
+	if s.DutyPeriodID.Valid {
+		segment.DutyPeriodID = &s.DutyPeriodID.String
+	}
+	return segment, nil
+}
@@ -0,0 +1,46 @@
+package duty_period
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetDutyPeriodByID retrieves a duty period by its UUID, without its segments
+func (r *repository) GetDutyPeriodByID(ctx context.Context, id string) (*domain.DutyPeriod, error) {
+	var p DutyPeriod
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(p.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrDutyPeriodNotFound
+		}
+		return nil, err
+	}
+	return p.ToDomain(), nil
+}
+
+// GetPreviousDutyPeriod retrieves the employee's last duty period reported before the instant,
+// nil when there is none
+func (r *repository) GetPreviousDutyPeriod(ctx context.Context, employeeID string, before time.Time) (*domain.DutyPeriod, error) {
+	var p DutyPeriod
+	err := r.db.QueryRowContext(ctx, QueryPrevious, employeeID, before.UTC()).Scan(p.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p.ToDomain(), nil
+}
+
+// HasOverlappingDutyPeriod reports whether another duty period of the employee overlaps the span
+func (r *repository) HasOverlappingDutyPeriod(ctx context.Context, employeeID string, report, release time.Time, excludeID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, QueryOverlapping, employeeID, release.UTC(), report.UTC(), excludeID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,97 @@
+package duty_period
+
+import (
+	"context"
+	"strings"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListDutyPeriods retrieves an employee's duty periods, optionally restricted to a report date range,
+// in chronological order and without their segments
+func (r *repository) ListDutyPeriods(ctx context.Context, filter domain.DutyPeriodFilter) ([]domain.DutyPeriod, error) {
+	var sb strings.Builder
+	sb.WriteString(QueryByEmployee)
+	args := []interface{}{filter.EmployeeID}
+	if filter.From != nil {
+		sb.WriteString(" AND report_time >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+	if filter.To != nil {
+		sb.WriteString(" AND report_time < ?")
+		args = append(args, filter.To.AddDate(0, 0, 1).Format("2006-01-02"))
+	}
+	sb.WriteString(" ORDER BY report_time")
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []domain.DutyPeriod
+	for rows.Next() {
+		var p DutyPeriod
+		if err := rows.Scan(p.scanDest()...); err != nil {
+			log.Error(logger.LogDutyPeriodError, "employee_id", filter.EmployeeID, "error", err)
+			return nil, err
+		}
+		periods = append(periods, *p.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogDutyPeriodError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// ListSegmentsByID retrieves the live segments with the given IDs in OUT order; unknown IDs are left out
+func (r *repository) ListSegmentsByID(ctx context.Context, segmentIDs []string) ([]domain.DutyPeriodSegment, error) {
+	return r.listSegments(ctx, QuerySegmentsByID, segmentIDs)
+}
+
+// ListDutyPeriodSegments retrieves the live segments linked to any of the duty periods, in OUT order
+func (r *repository) ListDutyPeriodSegments(ctx context.Context, dutyPeriodIDs []string) ([]domain.DutyPeriodSegment, error) {
+	return r.listSegments(ctx, QuerySegmentsByDutyPeriod, dutyPeriodIDs)
+}
+
+// listSegments runs a segment query completed with the IN list of the IDs
+func (r *repository) listSegments(ctx context.Context, query string, ids []string) ([]domain.DutyPeriodSegment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	clause, args := inList(nil, ids)
+
+	rows, err := r.db.QueryContext(ctx, query+clause+querySegmentOrder, args...)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodError, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var segments []domain.DutyPeriodSegment
+	for rows.Next() {
+		var s Segment
+		if err := rows.Scan(s.scanDest()...); err != nil {
+			log.Error(logger.LogDutyPeriodError, "error", err)
+			return nil, err
+		}
+		segment, err := s.ToDomain()
+		if err != nil {
+			log.Error(logger.LogDutyPeriodError, "segment_id", s.ID, "error", err)
+			return nil, err
+		}
+		segments = append(segments, *segment)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogDutyPeriodError, "error", err)
+		return nil, err
+	}
+
+	return segments, nil
+}
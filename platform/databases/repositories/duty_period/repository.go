@@ -0,0 +1,109 @@
+package duty_period
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// Segments are linked through daily_logbook_detail.duty_period_id; only live segments of live logbooks
+// are read back.
+const (
+	// queryDutyPeriodSelect lists the columns in the order of scanDest; times are stored in UTC
+	queryDutyPeriodSelect = `
+		SELECT
+			id, employee_id, report_time, release_time, split_duty, standby, remarks, created_at, updated_at
+		FROM duty_period
+	`
+	QueryByID = queryDutyPeriodSelect + " WHERE id = ? LIMIT 1"
+	// QueryByEmployee is completed with the optional report date range and the ORDER BY clause
+	QueryByEmployee = queryDutyPeriodSelect + " WHERE employee_id = ?"
+	QueryPrevious   = queryDutyPeriodSelect + `
+		WHERE employee_id = ? AND report_time < ?
+		ORDER BY report_time DESC
+		LIMIT 1
+	`
+	QueryOverlapping = `
+		SELECT COUNT(*)
+		FROM duty_period
+		WHERE employee_id = ? AND report_time < ? AND release_time > ? AND id <> ?
+	`
+	QueryInsert = `
+		INSERT INTO duty_period (
+			id, employee_id, report_time, release_time, split_duty, standby, remarks, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryUpdate = `
+		UPDATE duty_period SET
+			report_time = ?, release_time = ?, split_duty = ?, standby = ?, remarks = ?, updated_at = ?
+		WHERE id = ?
+	`
+	QueryDelete = "DELETE FROM duty_period WHERE id = ?"
+
+	// querySegmentSelect lists the columns in the order of Segment.scanDest; the WHERE clause is completed
+	// with an IN list by each query, followed by querySegmentOrder
+	querySegmentSelect = `
+		SELECT
+			dld.id, dl.employee_id, dld.daily_logbook_id, dl.state, dld.duty_period_id, dld.flight_number, dld.flight_real_date,
+			dld.out_time, dld.takeoff_time, dld.landing_time, dld.in_time,
+			CONCAT(orig.iata_code, '-', dest.iata_code) as route_code
+		FROM daily_logbook_detail dld
+		INNER JOIN daily_logbook dl ON dld.daily_logbook_id = dl.id
+		INNER JOIN airline_route alr ON dld.airline_route_id = alr.id
+		INNER JOIN route r ON alr.route_id = r.id
+		INNER JOIN airport orig ON r.origin_airport_id = orig.id
+		INNER JOIN airport dest ON r.destination_airport_id = dest.id
+		WHERE dld.deleted_at IS NULL AND dl.deleted_at IS NULL
+	`
+	querySegmentOrder         = " ORDER BY dld.flight_real_date, dld.out_time"
+	QuerySegmentsByID         = querySegmentSelect + " AND dld.id IN "
+	QuerySegmentsByDutyPeriod = querySegmentSelect + " AND dld.duty_period_id IN "
+	QueryUnlinkSegments       = "UPDATE daily_logbook_detail SET duty_period_id = NULL WHERE duty_period_id = ?"
+	QueryLinkSegments         = "UPDATE daily_logbook_detail SET duty_period_id = ? WHERE id IN "
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewDutyPeriodRepository creates a new duty period repository with prepared statements
+func NewDutyPeriodRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogDutyPeriodRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
+
+// inList returns the "(?, ?, ...)" clause for the IDs and appends them to the arguments
+func inList(args []interface{}, ids []string) (string, []interface{}) {
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ") + ")", args
+}
@@ -0,0 +1,81 @@
+package duty_period
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveDutyPeriod stores a new duty period
+func (r *repository) SaveDutyPeriod(ctx context.Context, tx output.Tx, period domain.DutyPeriod) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	p := FromDomain(&period)
+	_, err := sqlTx.ExecContext(ctx, QueryInsert,
+		p.ID,
+		p.EmployeeID,
+		p.ReportTime,
+		p.ReleaseTime,
+		p.SplitDuty,
+		p.Standby,
+		p.Remarks,
+		p.CreatedAt,
+		p.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrDutyPeriodCannotSave
+	}
+
+	return nil
+}
+
+// UpdateDutyPeriod stores the editable fields of a duty period
+func (r *repository) UpdateDutyPeriod(ctx context.Context, tx output.Tx, period domain.DutyPeriod) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	p := FromDomain(&period)
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		p.ReportTime,
+		p.ReleaseTime,
+		p.SplitDuty,
+		p.Standby,
+		p.Remarks,
+		p.UpdatedAt,
+		p.ID,
+	)
+	if err != nil {
+		return domain.ErrDutyPeriodCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrDutyPeriodNotFound
+	}
+
+	return nil
+}
+
+// LinkDutyPeriodSegments unlinks every segment of the duty period and links the given ones
+func (r *repository) LinkDutyPeriodSegments(ctx context.Context, tx output.Tx, dutyPeriodID string, segmentIDs []string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	if _, err := sqlTx.ExecContext(ctx, QueryUnlinkSegments, dutyPeriodID); err != nil {
+		return domain.ErrDutyPeriodCannotSave
+	}
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+
+	clause, args := inList([]interface{}{dutyPeriodID}, segmentIDs)
+	if _, err := sqlTx.ExecContext(ctx, QueryLinkSegments+clause, args...); err != nil {
+		return domain.ErrDutyPeriodCannotSave
+	}
+
+	return nil
+}
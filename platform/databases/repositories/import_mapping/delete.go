@@ -0,0 +1,30 @@
+package import_mapping
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// DeleteImportMapping removes one of the employee's mapping decisions
+func (r *repository) DeleteImportMapping(ctx context.Context, tx output.Tx, id, employeeID string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, id, employeeID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrImportMappingNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package import_mapping
+
+import (
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ImportMapping is the database entity for import_mapping table
+type ImportMapping struct {
+	ID          string    `db:"id"`
+	EmployeeID  string    `db:"employee_id"`
+	Kind        string    `db:"kind"`
+	SourceValue string    `db:"source_value"`
+	TargetID    string    `db:"target_id"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// ToDomain converts the database entity to domain model
+func (m *ImportMapping) ToDomain() *domain.ImportMapping {
+	return &domain.ImportMapping{
+		ID:          m.ID,
+		EmployeeID:  m.EmployeeID,
+		Kind:        domain.ImportMappingKind(m.Kind),
+		SourceValue: m.SourceValue,
+		TargetID:    m.TargetID,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package import_mapping
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ListImportMappingsByEmployee retrieves the mapping decisions remembered for an employee
+func (r *repository) ListImportMappingsByEmployee(ctx context.Context, employeeID string) ([]domain.ImportMapping, error) {
+	rows, err := r.stmtGetByEmployee.QueryContext(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mappings []domain.ImportMapping
+	for rows.Next() {
+		var m ImportMapping
+		if err := rows.Scan(&m.ID, &m.EmployeeID, &m.Kind, &m.SourceValue, &m.TargetID, &m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, *m.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
@@ -0,0 +1,52 @@
+package import_mapping
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	QueryByEmployee = "SELECT id, employee_id, kind, source_value, target_id, updated_at FROM import_mapping WHERE employee_id = ? ORDER BY kind, source_value"
+	// QueryUpsert replaces the target of an existing decision (unique key employee_id, kind, source_value)
+	QueryUpsert = "INSERT INTO import_mapping (id, employee_id, kind, source_value, target_id, updated_at) VALUES (?, ?, ?, ?, ?, ?) " +
+		"ON DUPLICATE KEY UPDATE target_id = VALUES(target_id), updated_at = VALUES(updated_at)"
+	QueryDelete = "DELETE FROM import_mapping WHERE id = ? AND employee_id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByEmployee *sql.Stmt
+	db                *sql.DB
+}
+
+// NewImportMappingRepository creates a new import mapping repository with prepared statements
+func NewImportMappingRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByEmployee, err := db.Prepare(QueryByEmployee)
+	if err != nil {
+		log.Error(logger.LogDatabaseUnavailable, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:                db,
+		stmtGetByEmployee: stmtGetByEmployee,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
@@ -0,0 +1,28 @@
+package import_mapping
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveImportMapping creates a mapping decision or replaces the target of the existing one
+func (r *repository) SaveImportMapping(ctx context.Context, tx output.Tx, mapping domain.ImportMapping) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	_, err := sqlTx.ExecContext(ctx, QueryUpsert,
+		mapping.ID,
+		mapping.EmployeeID,
+		string(mapping.Kind),
+		mapping.SourceValue,
+		mapping.TargetID,
+		mapping.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrImportMappingCannotSave
+	}
+
+	return nil
+}
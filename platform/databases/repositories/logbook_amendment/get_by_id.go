@@ -0,0 +1,21 @@
+package logbook_amendment
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetAmendmentByID retrieves an amendment by its UUID
+func (r *repository) GetAmendmentByID(ctx context.Context, id string) (*domain.LogbookAmendment, error) {
+	var a LogbookAmendment
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(a.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAmendmentNotFound
+		}
+		return nil, err
+	}
+	return a.ToDomain()
+}
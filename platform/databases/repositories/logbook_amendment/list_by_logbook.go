@@ -0,0 +1,35 @@
+package logbook_amendment
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ListAmendmentsByLogbook retrieves the amendments of a daily logbook, newest first
+func (r *repository) ListAmendmentsByLogbook(ctx context.Context, logbookID string) ([]domain.LogbookAmendment, error) {
+	rows, err := r.stmtGetByLogbook.QueryContext(ctx, logbookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var amendments []domain.LogbookAmendment
+	for rows.Next() {
+		var a LogbookAmendment
+		if err := rows.Scan(a.scanDest()...); err != nil {
+			return nil, err
+		}
+		amendment, err := a.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		amendments = append(amendments, *amendment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return amendments, nil
+}
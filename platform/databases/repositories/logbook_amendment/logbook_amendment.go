@@ -0,0 +1,89 @@
+package logbook_amendment
+
+import (
+	"encoding/json"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// LogbookAmendment is the database entity for logbook_amendment table
+type LogbookAmendment struct {
+	ID                   string     `db:"id"`
+	DailyLogbookID       string     `db:"daily_logbook_id"`
+	DailyLogbookDetailID string     `db:"daily_logbook_detail_id"`
+	Action               string     `db:"action"`
+	Reason               string     `db:"reason"`
+	AuthorID             string     `db:"author_id"`
+	Changes              []byte     `db:"changes"`  // JSON array of domain.FieldChange
+	Proposed             []byte     `db:"proposed"` // JSON domain.DailyLogbookDetail, NULL for DELETE
+	OverrideConflicts    bool       `db:"override_conflicts"`
+	Status               string     `db:"status"`
+	CreatedAt            time.Time  `db:"created_at"`
+	ResolvedBy           *string    `db:"resolved_by"`
+	ResolvedAt           *time.Time `db:"resolved_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (a *LogbookAmendment) scanDest() []interface{} {
+	return []interface{}{&a.ID, &a.DailyLogbookID, &a.DailyLogbookDetailID, &a.Action, &a.Reason, &a.AuthorID, &a.Changes,
+		&a.Proposed, &a.OverrideConflicts, &a.Status, &a.CreatedAt, &a.ResolvedBy, &a.ResolvedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (a *LogbookAmendment) ToDomain() (*domain.LogbookAmendment, error) {
+	amendment := &domain.LogbookAmendment{
+		ID:                   a.ID,
+		DailyLogbookID:       a.DailyLogbookID,
+		DailyLogbookDetailID: a.DailyLogbookDetailID,
+		Action:               domain.AmendmentAction(a.Action),
+		Reason:               a.Reason,
+		AuthorID:             a.AuthorID,
+		OverrideConflicts:    a.OverrideConflicts,
+		Status:               domain.AmendmentStatus(a.Status),
+		CreatedAt:            a.CreatedAt,
+		ResolvedBy:           a.ResolvedBy,
+		ResolvedAt:           a.ResolvedAt,
+	}
+	if len(a.Changes) > 0 {
+		if err := json.Unmarshal(a.Changes, &amendment.Changes); err != nil {
+			return nil, err
+		}
+	}
+	if len(a.Proposed) > 0 {
+		amendment.Proposed = &domain.DailyLogbookDetail{}
+		if err := json.Unmarshal(a.Proposed, amendment.Proposed); err != nil {
+			return nil, err
+		}
+	}
+	return amendment, nil
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(amendment *domain.LogbookAmendment) (*LogbookAmendment, error) {
+	changes, err := json.Marshal(amendment.Changes)
+	if err != nil {
+		return nil, err
+	}
+	var proposed []byte
+	if amendment.Proposed != nil {
+		if proposed, err = json.Marshal(amendment.Proposed); err != nil {
+			return nil, err
+		}
+	}
+	return &LogbookAmendment{
+		ID:                   amendment.ID,
+		DailyLogbookID:       amendment.DailyLogbookID,
+		DailyLogbookDetailID: amendment.DailyLogbookDetailID,
+		Action:               string(amendment.Action),
+		Reason:               amendment.Reason,
+		AuthorID:             amendment.AuthorID,
+		Changes:              changes,
+		Proposed:             proposed,
+		OverrideConflicts:    amendment.OverrideConflicts,
+		Status:               string(amendment.Status),
+		CreatedAt:            amendment.CreatedAt,
+		ResolvedBy:           amendment.ResolvedBy,
+		ResolvedAt:           amendment.ResolvedAt,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package logbook_amendment
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	queryAmendmentSelect = "SELECT id, daily_logbook_id, daily_logbook_detail_id, action, reason, author_id, changes, proposed, " +
+		"override_conflicts, status, created_at, resolved_by, resolved_at FROM logbook_amendment"
+	QueryByID      = queryAmendmentSelect + " WHERE id = ? LIMIT 1"
+	QueryByLogbook = queryAmendmentSelect + " WHERE daily_logbook_id = ? ORDER BY created_at DESC"
+	QueryInsert    = "INSERT INTO logbook_amendment (id, daily_logbook_id, daily_logbook_detail_id, action, reason, author_id, changes, proposed, override_conflicts, status, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	QueryResolve   = "UPDATE logbook_amendment SET status = ?, resolved_by = ?, resolved_at = ? WHERE id = ? AND status = 'PENDING'"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID      *sql.Stmt
+	stmtGetByLogbook *sql.Stmt
+	db               *sql.DB
+}
+
+// NewLogbookAmendmentRepository creates a new logbook amendment repository with prepared statements
+func NewLogbookAmendmentRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogAmendmentRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtGetByLogbook, err := db.Prepare(QueryByLogbook)
+	if err != nil {
+		log.Error(logger.LogAmendmentRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:               db,
+		stmtGetByID:      stmtGetByID,
+		stmtGetByLogbook: stmtGetByLogbook,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
@@ -0,0 +1,65 @@
+package logbook_amendment
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveAmendment creates a new amendment request
+func (r *repository) SaveAmendment(ctx context.Context, tx output.Tx, amendment domain.LogbookAmendment) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	a, err := FromDomain(&amendment)
+	if err != nil {
+		return domain.ErrAmendmentCannotSave
+	}
+
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		a.ID,
+		a.DailyLogbookID,
+		a.DailyLogbookDetailID,
+		a.Action,
+		a.Reason,
+		a.AuthorID,
+		a.Changes,
+		a.Proposed,
+		a.OverrideConflicts,
+		a.Status,
+		a.CreatedAt,
+	)
+	if err != nil {
+		return domain.ErrAmendmentCannotSave
+	}
+
+	return nil
+}
+
+// ResolveAmendment stores the outcome of a pending amendment (applied or rejected)
+func (r *repository) ResolveAmendment(ctx context.Context, tx output.Tx, amendment domain.LogbookAmendment) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryResolve,
+		string(amendment.Status),
+		amendment.ResolvedBy,
+		amendment.ResolvedAt,
+		amendment.ID,
+	)
+	if err != nil {
+		return domain.ErrAmendmentCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// Already resolved by a concurrent request
+	if rowsAffected == 0 {
+		return domain.ErrAmendmentNotPending
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package logbook_history
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListHistoryByLogbook retrieves the change history of a daily logbook and its segments, oldest first
+func (r *repository) ListHistoryByLogbook(ctx context.Context, logbookID string) ([]domain.LogbookHistoryEntry, error) {
+	rows, err := r.stmtGetByLogbook.QueryContext(ctx, logbookID)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "logbook_id", logbookID, "error", err)
+		return nil, err
+	}
+	return scanHistory(rows)
+}
+
+// ListHistoryByEntity retrieves the change history of a single logbook or segment, oldest first
+func (r *repository) ListHistoryByEntity(ctx context.Context, entityType domain.HistoryEntityType, entityID string) ([]domain.LogbookHistoryEntry, error) {
+	rows, err := r.stmtGetByEntity.QueryContext(ctx, string(entityType), entityID)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "entity_id", entityID, "error", err)
+		return nil, err
+	}
+	return scanHistory(rows)
+}
+
+func scanHistory(rows *sql.Rows) ([]domain.LogbookHistoryEntry, error) {
+	defer rows.Close()
+
+	var entries []domain.LogbookHistoryEntry
+	for rows.Next() {
+		var h LogbookHistory
+		if err := rows.Scan(h.scanDest()...); err != nil {
+			log.Error(logger.LogLogbookHistoryError, "error", err)
+			return nil, err
+		}
+		entry, err := h.ToDomain()
+		if err != nil {
+			log.Error(logger.LogLogbookHistoryError, "id", h.ID, "error", err)
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogLogbookHistoryError, "error", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,67 @@
+package logbook_history
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// LogbookHistory is the database entity for logbook_history table
+type LogbookHistory struct {
+	ID             string         `db:"id"`
+	EntityType     string         `db:"entity_type"`
+	EntityID       string         `db:"entity_id"`
+	DailyLogbookID string         `db:"daily_logbook_id"`
+	Action         string         `db:"action"`
+	EmployeeID     sql.NullString `db:"employee_id"`
+	TraceID        sql.NullString `db:"trace_id"`
+	Changes        []byte         `db:"changes"` // JSON array of domain.FieldChange
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (h *LogbookHistory) scanDest() []interface{} {
+	return []interface{}{&h.ID, &h.EntityType, &h.EntityID, &h.DailyLogbookID, &h.Action, &h.EmployeeID, &h.TraceID,
+		&h.Changes, &h.CreatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (h *LogbookHistory) ToDomain() (*domain.LogbookHistoryEntry, error) {
+	entry := &domain.LogbookHistoryEntry{
+		ID:             h.ID,
+		EntityType:     domain.HistoryEntityType(h.EntityType),
+		EntityID:       h.EntityID,
+		DailyLogbookID: h.DailyLogbookID,
+		Action:         domain.HistoryAction(h.Action),
+		EmployeeID:     h.EmployeeID.String,
+		TraceID:        h.TraceID.String,
+		CreatedAt:      h.CreatedAt,
+	}
+	if len(h.Changes) > 0 {
+		if err := json.Unmarshal(h.Changes, &entry.Changes); err != nil {
+			return nil, err
+		}
+	}
+	return entry, nil
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(entry *domain.LogbookHistoryEntry) (*LogbookHistory, error) {
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return nil, err
+	}
+	return &LogbookHistory{
+		ID:             entry.ID,
+		EntityType:     string(entry.EntityType),
+		EntityID:       entry.EntityID,
+		DailyLogbookID: entry.DailyLogbookID,
+		Action:         string(entry.Action),
+		EmployeeID:     sql.NullString{String: entry.EmployeeID, Valid: entry.EmployeeID != ""},
+		TraceID:        sql.NullString{String: entry.TraceID, Valid: entry.TraceID != ""},
+		Changes:        changes,
+		CreatedAt:      entry.CreatedAt,
+	}, nil
+}
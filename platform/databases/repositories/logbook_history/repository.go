@@ -0,0 +1,47 @@
+package logbook_history
+
+import (
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	queryHistorySelect = "SELECT id, entity_type, entity_id, daily_logbook_id, action, employee_id, trace_id, changes, created_at FROM logbook_history"
+	QueryByLogbook     = queryHistorySelect + " WHERE daily_logbook_id = ? ORDER BY created_at ASC, id ASC"
+	QueryByEntity      = queryHistorySelect + " WHERE entity_type = ? AND entity_id = ? ORDER BY created_at ASC, id ASC"
+	QueryInsert        = "INSERT INTO logbook_history (id, entity_type, entity_id, daily_logbook_id, action, employee_id, trace_id, changes, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByLogbook *sql.Stmt
+	stmtGetByEntity  *sql.Stmt
+	db               *sql.DB
+}
+
+// NewLogbookHistoryRepository creates a new logbook change history repository with prepared statements
+func NewLogbookHistoryRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByLogbook, err := db.Prepare(QueryByLogbook)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtGetByEntity, err := db.Prepare(QueryByEntity)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:               db,
+		stmtGetByLogbook: stmtGetByLogbook,
+		stmtGetByEntity:  stmtGetByEntity,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package logbook_history
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// SaveHistoryEntry records a change of a logbook or segment, in the transaction of the change
+func (r *repository) SaveHistoryEntry(ctx context.Context, tx output.Tx, entry domain.LogbookHistoryEntry) error {
+	sqlTx, ok := tx.(*common.SQLTX)
+	if !ok {
+		log.Error(logger.LogLogbookHistoryError, "error", "invalid transaction type")
+		return domain.ErrInvalidTransaction
+	}
+
+	h, err := FromDomain(&entry)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "error", err)
+		return domain.ErrLogbookHistoryCannotSave
+	}
+
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		h.ID,
+		h.EntityType,
+		h.EntityID,
+		h.DailyLogbookID,
+		h.Action,
+		h.EmployeeID,
+		h.TraceID,
+		h.Changes,
+		h.CreatedAt,
+	)
+	if err != nil {
+		log.Error(logger.LogLogbookHistoryError, "entity_id", entry.EntityID, "error", err)
+		return domain.ErrLogbookHistoryCannotSave
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetNotificationByID retrieves a notification by its UUID
+func (r *repository) GetNotificationByID(ctx context.Context, id string) (*domain.Notification, error) {
+	var n Notification
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(n.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNotificationNotFound
+		}
+		return nil, err
+	}
+	return n.ToDomain()
+}
@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListNotifications retrieves an employee's notifications, latest first
+func (r *repository) ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error) {
+	query := QueryByEmployee
+	if filter.UnreadOnly {
+		query += QueryUnread
+	}
+	query += QueryOrder
+
+	rows, err := r.db.QueryContext(ctx, query, filter.EmployeeID)
+	if err != nil {
+		log.Error(logger.LogNotificationError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []domain.Notification
+	for rows.Next() {
+		var n Notification
+		if err := rows.Scan(n.scanDest()...); err != nil {
+			log.Error(logger.LogNotificationError, "error", err)
+			return nil, err
+		}
+		notification, err := n.ToDomain()
+		if err != nil {
+			log.Error(logger.LogNotificationError, "notification_id", n.ID, "error", err)
+			return nil, err
+		}
+		notifications = append(notifications, *notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogNotificationError, "error", err)
+		return nil, err
+	}
+
+	return notifications, nil
+}
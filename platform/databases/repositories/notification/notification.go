@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// Notification is the database entity for notification table
+type Notification struct {
+	ID          string       `db:"id"`
+	EmployeeID  string       `db:"employee_id"`
+	Kind        string       `db:"kind"`
+	Code        string       `db:"code"`
+	Params      []byte       `db:"params"` // JSON array of strings
+	ReferenceID string       `db:"reference_id"`
+	DedupKey    string       `db:"dedup_key"`
+	ReadAt      sql.NullTime `db:"read_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (n *Notification) scanDest() []interface{} {
+	return []interface{}{&n.ID, &n.EmployeeID, &n.Kind, &n.Code, &n.Params, &n.ReferenceID, &n.DedupKey,
+		&n.ReadAt, &n.CreatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (n *Notification) ToDomain() (*domain.Notification, error) {
+	notification := &domain.Notification{
+		ID:          n.ID,
+		EmployeeID:  n.EmployeeID,
+		Kind:        domain.NotificationKind(n.Kind),
+		Code:        n.Code,
+		ReferenceID: n.ReferenceID,
+		DedupKey:    n.DedupKey,
+		CreatedAt:   n.CreatedAt,
+	}
+	if n.ReadAt.Valid {
+		notification.ReadAt = &n.ReadAt.Time
+	}
+	if len(n.Params) > 0 {
+		if err := json.Unmarshal(n.Params, &notification.Params); err != nil {
+			return nil, err
+		}
+	}
+	return notification, nil
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(notification *domain.Notification) (*Notification, error) {
+	params, err := json.Marshal(notification.Params)
+	if err != nil {
+		return nil, err
+	}
+	entity := &Notification{
+		ID:          notification.ID,
+		EmployeeID:  notification.EmployeeID,
+		Kind:        string(notification.Kind),
+		Code:        notification.Code,
+		Params:      params,
+		ReferenceID: notification.ReferenceID,
+		DedupKey:    notification.DedupKey,
+		CreatedAt:   notification.CreatedAt,
+	}
+	if notification.ReadAt != nil {
+		entity.ReadAt = sql.NullTime{Time: *notification.ReadAt, Valid: true}
+	}
+	return entity, nil
+}
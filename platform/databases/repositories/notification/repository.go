@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	// queryNotificationSelect lists the columns in the order of scanDest
+	queryNotificationSelect = `
+		SELECT id, employee_id, kind, code, params, reference_id, dedup_key, read_at, created_at
+		FROM notification
+	`
+	QueryByID       = queryNotificationSelect + " WHERE id = ? LIMIT 1"
+	QueryByEmployee = queryNotificationSelect + " WHERE employee_id = ?"
+	QueryUnread     = " AND read_at IS NULL"
+	QueryOrder      = " ORDER BY created_at DESC"
+	// QueryInsert skips notifications whose dedup_key (unique) was already raised
+	QueryInsert = `
+		INSERT IGNORE INTO notification (
+			id, employee_id, kind, code, params, reference_id, dedup_key, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryMarkRead = "UPDATE notification SET read_at = COALESCE(read_at, ?) WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewNotificationRepository creates a new notification repository with prepared statements
+func NewNotificationRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogNotificationRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
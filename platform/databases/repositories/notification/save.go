@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveNotification stores a new notification unless one with the same dedup key exists; reports whether
+// it was stored
+func (r *repository) SaveNotification(ctx context.Context, tx output.Tx, notification domain.Notification) (bool, error) {
+	sqlTx := tx.(*common.SQLTX)
+
+	n, err := FromDomain(&notification)
+	if err != nil {
+		return false, domain.ErrNotificationCannotSave
+	}
+	result, err := sqlTx.ExecContext(ctx, QueryInsert,
+		n.ID,
+		n.EmployeeID,
+		n.Kind,
+		n.Code,
+		n.Params,
+		n.ReferenceID,
+		n.DedupKey,
+		n.CreatedAt,
+	)
+	if err != nil {
+		return false, domain.ErrNotificationCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// MarkNotificationRead records when a notification was read; a notification already read keeps its time
+func (r *repository) MarkNotificationRead(ctx context.Context, tx output.Tx, id string, readAt time.Time) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryMarkRead, readAt, id)
+	if err != nil {
+		return domain.ErrNotificationCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotificationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package prior_experience
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// DeletePriorExperience removes an opening balance
+func (r *repository) DeletePriorExperience(ctx context.Context, tx output.Tx, id string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, id)
+	if err != nil {
+		return domain.ErrPriorExperienceCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrPriorExperienceNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package prior_experience
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetPriorExperienceByID retrieves an opening balance by its UUID
+func (r *repository) GetPriorExperienceByID(ctx context.Context, id string) (*domain.PriorExperience, error) {
+	var p PriorExperience
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(p.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrPriorExperienceNotFound
+		}
+		return nil, err
+	}
+	return p.ToDomain(), nil
+}
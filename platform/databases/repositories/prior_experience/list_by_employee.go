@@ -0,0 +1,35 @@
+package prior_experience
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListPriorExperienceByEmployee retrieves an employee's opening balances, oldest first
+func (r *repository) ListPriorExperienceByEmployee(ctx context.Context, employeeID string) ([]domain.PriorExperience, error) {
+	rows, err := r.db.QueryContext(ctx, QueryByEmployee, employeeID)
+	if err != nil {
+		log.Error(logger.LogPriorExperienceError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.PriorExperience
+	for rows.Next() {
+		var p PriorExperience
+		if err := rows.Scan(p.scanDest()...); err != nil {
+			log.Error(logger.LogPriorExperienceError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		entries = append(entries, *p.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogPriorExperienceError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
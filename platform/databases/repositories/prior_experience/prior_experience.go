@@ -0,0 +1,148 @@
+package prior_experience
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// PriorExperience is the database entity for prior_experience table
+type PriorExperience struct {
+	ID                   string         `db:"id"`
+	EmployeeID           string         `db:"employee_id"`
+	AsOfDate             time.Time      `db:"as_of_date"`
+	AircraftFamily       sql.NullString `db:"aircraft_family"`
+	Flights              int            `db:"flights"`
+	TotalMinutes         int64          `db:"total_minutes"`
+	NightMinutes         int64          `db:"night_minutes"`
+	SinglePilotSEMinutes int64          `db:"single_pilot_se_minutes"`
+	SinglePilotMEMinutes int64          `db:"single_pilot_me_minutes"`
+	MultiPilotMinutes    int64          `db:"multi_pilot_minutes"`
+	TurbineMinutes       int64          `db:"turbine_minutes"`
+	PICMinutes           int64          `db:"pic_minutes"`
+	SICMinutes           int64          `db:"sic_minutes"`
+	DualMinutes          int64          `db:"dual_minutes"`
+	InstructorMinutes    int64          `db:"instructor_minutes"`
+	PICUSMinutes         int64          `db:"picus_minutes"`
+	IFRMinutes           int64          `db:"ifr_minutes"`
+	DayTakeoffs          int            `db:"day_takeoffs"`
+	NightTakeoffs        int            `db:"night_takeoffs"`
+	DayLandings          int            `db:"day_landings"`
+	NightLandings        int            `db:"night_landings"`
+	RecentTakeoffs       int            `db:"recent_takeoffs"`
+	RecentLandings       int            `db:"recent_landings"`
+	RecentNightTakeoffs  int            `db:"recent_night_takeoffs"`
+	RecentNightLandings  int            `db:"recent_night_landings"`
+	RecentApproaches     int            `db:"recent_approaches"`
+	DocumentType         string         `db:"document_type"`
+	DocumentReference    string         `db:"document_reference"`
+	Remarks              sql.NullString `db:"remarks"`
+	CreatedAt            time.Time      `db:"created_at"`
+	UpdatedAt            time.Time      `db:"updated_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (p *PriorExperience) scanDest() []interface{} {
+	return []interface{}{&p.ID, &p.EmployeeID, &p.AsOfDate, &p.AircraftFamily, &p.Flights, &p.TotalMinutes,
+		&p.NightMinutes, &p.SinglePilotSEMinutes, &p.SinglePilotMEMinutes, &p.MultiPilotMinutes, &p.TurbineMinutes,
+		&p.PICMinutes, &p.SICMinutes, &p.DualMinutes, &p.InstructorMinutes, &p.PICUSMinutes, &p.IFRMinutes,
+		&p.DayTakeoffs, &p.NightTakeoffs, &p.DayLandings, &p.NightLandings,
+		&p.RecentTakeoffs, &p.RecentLandings, &p.RecentNightTakeoffs, &p.RecentNightLandings, &p.RecentApproaches,
+		&p.DocumentType, &p.DocumentReference, &p.Remarks, &p.CreatedAt, &p.UpdatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (p *PriorExperience) ToDomain() *domain.PriorExperience {
+	prior := &domain.PriorExperience{
+		ID:         p.ID,
+		EmployeeID: p.EmployeeID,
+		AsOfDate:   p.AsOfDate.Format("2006-01-02"),
+		Flights:    p.Flights,
+		TotalTime:  minutes(p.TotalMinutes),
+		NightTime:  minutes(p.NightMinutes),
+		Columns: domain.LogbookColumnTimes{
+			SinglePilotSE: minutes(p.SinglePilotSEMinutes),
+			SinglePilotME: minutes(p.SinglePilotMEMinutes),
+			MultiPilot:    minutes(p.MultiPilotMinutes),
+			Turbine:       minutes(p.TurbineMinutes),
+			PIC:           minutes(p.PICMinutes),
+			SIC:           minutes(p.SICMinutes),
+			Dual:          minutes(p.DualMinutes),
+			Instructor:    minutes(p.InstructorMinutes),
+			PICUS:         minutes(p.PICUSMinutes),
+			IFR:           minutes(p.IFRMinutes),
+		},
+		DayTakeoffs:         p.DayTakeoffs,
+		NightTakeoffs:       p.NightTakeoffs,
+		DayLandings:         p.DayLandings,
+		NightLandings:       p.NightLandings,
+		RecentTakeoffs:      p.RecentTakeoffs,
+		RecentLandings:      p.RecentLandings,
+		RecentNightTakeoffs: p.RecentNightTakeoffs,
+		RecentNightLandings: p.RecentNightLandings,
+		RecentApproaches:    p.RecentApproaches,
+		DocumentType:        domain.PriorExperienceDocumentType(p.DocumentType),
+		DocumentReference:   p.DocumentReference,
+		CreatedAt:           p.CreatedAt,
+		UpdatedAt:           p.UpdatedAt,
+	}
+	if p.AircraftFamily.Valid {
+		prior.AircraftFamily = &p.AircraftFamily.String
+	}
+	if p.Remarks.Valid {
+		prior.Remarks = &p.Remarks.String
+	}
+	return prior
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(prior *domain.PriorExperience) (*PriorExperience, error) {
+	asOfDate, err := time.Parse("2006-01-02", prior.AsOfDate)
+	if err != nil {
+		return nil, err
+	}
+	c := prior.Columns
+	entity := &PriorExperience{
+		ID:                   prior.ID,
+		EmployeeID:           prior.EmployeeID,
+		AsOfDate:             asOfDate,
+		Flights:              prior.Flights,
+		TotalMinutes:         int64(prior.TotalTime / time.Minute),
+		NightMinutes:         int64(prior.NightTime / time.Minute),
+		SinglePilotSEMinutes: int64(c.SinglePilotSE / time.Minute),
+		SinglePilotMEMinutes: int64(c.SinglePilotME / time.Minute),
+		MultiPilotMinutes:    int64(c.MultiPilot / time.Minute),
+		TurbineMinutes:       int64(c.Turbine / time.Minute),
+		PICMinutes:           int64(c.PIC / time.Minute),
+		SICMinutes:           int64(c.SIC / time.Minute),
+		DualMinutes:          int64(c.Dual / time.Minute),
+		InstructorMinutes:    int64(c.Instructor / time.Minute),
+		PICUSMinutes:         int64(c.PICUS / time.Minute),
+		IFRMinutes:           int64(c.IFR / time.Minute),
+		DayTakeoffs:          prior.DayTakeoffs,
+		NightTakeoffs:        prior.NightTakeoffs,
+		DayLandings:          prior.DayLandings,
+		NightLandings:        prior.NightLandings,
+		RecentTakeoffs:       prior.RecentTakeoffs,
+		RecentLandings:       prior.RecentLandings,
+		RecentNightTakeoffs:  prior.RecentNightTakeoffs,
+		RecentNightLandings:  prior.RecentNightLandings,
+		RecentApproaches:     prior.RecentApproaches,
+		DocumentType:         string(prior.DocumentType),
+		DocumentReference:    prior.DocumentReference,
+		CreatedAt:            prior.CreatedAt,
+		UpdatedAt:            prior.UpdatedAt,
+	}
+	if prior.AircraftFamily != nil {
+		entity.AircraftFamily = sql.NullString{String: *prior.AircraftFamily, Valid: true}
+	}
+	if prior.Remarks != nil {
+		entity.Remarks = sql.NullString{String: *prior.Remarks, Valid: true}
+	}
+	return entity, nil
+}
+
+func minutes(m int64) time.Duration {
+	return time.Duration(m) * time.Minute
+}
@@ -0,0 +1,81 @@
+package prior_experience
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	// queryPriorExperienceSelect lists the columns in the order of scanDest; times are stored in minutes
+	queryPriorExperienceSelect = `
+		SELECT
+			id, employee_id, as_of_date, aircraft_family, flights, total_minutes, night_minutes,
+			single_pilot_se_minutes, single_pilot_me_minutes, multi_pilot_minutes, turbine_minutes,
+			pic_minutes, sic_minutes, dual_minutes, instructor_minutes, picus_minutes, ifr_minutes,
+			day_takeoffs, night_takeoffs, day_landings, night_landings,
+			recent_takeoffs, recent_landings, recent_night_takeoffs, recent_night_landings, recent_approaches,
+			document_type, document_reference, remarks, created_at, updated_at
+		FROM prior_experience
+	`
+	QueryByID       = queryPriorExperienceSelect + " WHERE id = ? LIMIT 1"
+	QueryByEmployee = queryPriorExperienceSelect + " WHERE employee_id = ? ORDER BY as_of_date, created_at"
+	QueryInsert     = `
+		INSERT INTO prior_experience (
+			id, employee_id, as_of_date, aircraft_family, flights, total_minutes, night_minutes,
+			single_pilot_se_minutes, single_pilot_me_minutes, multi_pilot_minutes, turbine_minutes,
+			pic_minutes, sic_minutes, dual_minutes, instructor_minutes, picus_minutes, ifr_minutes,
+			day_takeoffs, night_takeoffs, day_landings, night_landings,
+			recent_takeoffs, recent_landings, recent_night_takeoffs, recent_night_landings, recent_approaches,
+			document_type, document_reference, remarks, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryUpdate = `
+		UPDATE prior_experience SET
+			as_of_date = ?, aircraft_family = ?, flights = ?, total_minutes = ?, night_minutes = ?,
+			single_pilot_se_minutes = ?, single_pilot_me_minutes = ?, multi_pilot_minutes = ?, turbine_minutes = ?,
+			pic_minutes = ?, sic_minutes = ?, dual_minutes = ?, instructor_minutes = ?, picus_minutes = ?, ifr_minutes = ?,
+			day_takeoffs = ?, night_takeoffs = ?, day_landings = ?, night_landings = ?,
+			recent_takeoffs = ?, recent_landings = ?, recent_night_takeoffs = ?, recent_night_landings = ?, recent_approaches = ?,
+			document_type = ?, document_reference = ?, remarks = ?, updated_at = ?
+		WHERE id = ?
+	`
+	QueryDelete = "DELETE FROM prior_experience WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewPriorExperienceRepository creates a new prior experience repository with prepared statements
+func NewPriorExperienceRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogPriorExperienceRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
@@ -0,0 +1,112 @@
+package prior_experience
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SavePriorExperience stores a new opening balance
+func (r *repository) SavePriorExperience(ctx context.Context, tx output.Tx, prior domain.PriorExperience) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	p, err := FromDomain(&prior)
+	if err != nil {
+		return domain.ErrPriorExperienceCannotSave
+	}
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		p.ID,
+		p.EmployeeID,
+		p.AsOfDate,
+		p.AircraftFamily,
+		p.Flights,
+		p.TotalMinutes,
+		p.NightMinutes,
+		p.SinglePilotSEMinutes,
+		p.SinglePilotMEMinutes,
+		p.MultiPilotMinutes,
+		p.TurbineMinutes,
+		p.PICMinutes,
+		p.SICMinutes,
+		p.DualMinutes,
+		p.InstructorMinutes,
+		p.PICUSMinutes,
+		p.IFRMinutes,
+		p.DayTakeoffs,
+		p.NightTakeoffs,
+		p.DayLandings,
+		p.NightLandings,
+		p.RecentTakeoffs,
+		p.RecentLandings,
+		p.RecentNightTakeoffs,
+		p.RecentNightLandings,
+		p.RecentApproaches,
+		p.DocumentType,
+		p.DocumentReference,
+		p.Remarks,
+		p.CreatedAt,
+		p.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrPriorExperienceCannotSave
+	}
+
+	return nil
+}
+
+// UpdatePriorExperience stores the editable fields of an opening balance
+func (r *repository) UpdatePriorExperience(ctx context.Context, tx output.Tx, prior domain.PriorExperience) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	p, err := FromDomain(&prior)
+	if err != nil {
+		return domain.ErrPriorExperienceCannotSave
+	}
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		p.AsOfDate,
+		p.AircraftFamily,
+		p.Flights,
+		p.TotalMinutes,
+		p.NightMinutes,
+		p.SinglePilotSEMinutes,
+		p.SinglePilotMEMinutes,
+		p.MultiPilotMinutes,
+		p.TurbineMinutes,
+		p.PICMinutes,
+		p.SICMinutes,
+		p.DualMinutes,
+		p.InstructorMinutes,
+		p.PICUSMinutes,
+		p.IFRMinutes,
+		p.DayTakeoffs,
+		p.NightTakeoffs,
+		p.DayLandings,
+		p.NightLandings,
+		p.RecentTakeoffs,
+		p.RecentLandings,
+		p.RecentNightTakeoffs,
+		p.RecentNightLandings,
+		p.RecentApproaches,
+		p.DocumentType,
+		p.DocumentReference,
+		p.Remarks,
+		p.UpdatedAt,
+		p.ID,
+	)
+	if err != nil {
+		return domain.ErrPriorExperienceCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrPriorExperienceNotFound
+	}
+
+	return nil
+}
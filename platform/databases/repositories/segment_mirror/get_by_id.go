@@ -0,0 +1,35 @@
+package segment_mirror
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetSegmentMirrorByID retrieves a segment mirror by its UUID
+func (r *repository) GetSegmentMirrorByID(ctx context.Context, id string) (*domain.SegmentMirror, error) {
+	var m SegmentMirror
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(m.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSegmentMirrorNotFound
+		}
+		return nil, err
+	}
+	return m.ToDomain(), nil
+}
+
+// GetOpenSegmentMirrorByDetail retrieves the pending or active link of a segment, on either side.
+// Returns nil, nil when the segment has none.
+func (r *repository) GetOpenSegmentMirrorByDetail(ctx context.Context, detailID string) (*domain.SegmentMirror, error) {
+	var m SegmentMirror
+	err := r.stmtGetOpenByDetail.QueryRowContext(ctx, detailID, detailID).Scan(m.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m.ToDomain(), nil
+}
@@ -0,0 +1,32 @@
+package segment_mirror
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// ListSegmentMirrorsByEmployee retrieves the links the employee takes part in, as pilot or companion,
+// most recently changed first
+func (r *repository) ListSegmentMirrorsByEmployee(ctx context.Context, employeeID string) ([]domain.SegmentMirror, error) {
+	rows, err := r.stmtGetByEmployee.QueryContext(ctx, employeeID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mirrors []domain.SegmentMirror
+	for rows.Next() {
+		var m SegmentMirror
+		if err := rows.Scan(m.scanDest()...); err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, *m.ToDomain())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mirrors, nil
+}
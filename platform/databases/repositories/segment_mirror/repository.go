@@ -0,0 +1,72 @@
+package segment_mirror
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	querySegmentMirrorSelect = "SELECT id, source_detail_id, source_employee_id, companion_employee_id, mirror_detail_id, status, " +
+		"created_at, updated_at FROM segment_mirror"
+	QueryByID = querySegmentMirrorSelect + " WHERE id = ? LIMIT 1"
+	// A segment has at most one open link: pending, linked or in conflict
+	QueryOpenByDetail = querySegmentMirrorSelect + " WHERE (source_detail_id = ? OR mirror_detail_id = ?) " +
+		"AND status IN ('PENDING', 'LINKED', 'CONFLICT') ORDER BY created_at DESC LIMIT 1"
+	QueryByEmployee = querySegmentMirrorSelect + " WHERE source_employee_id = ? OR companion_employee_id = ? ORDER BY updated_at DESC"
+	QueryInsert     = "INSERT INTO segment_mirror (id, source_detail_id, source_employee_id, companion_employee_id, mirror_detail_id, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	QueryUpdate     = "UPDATE segment_mirror SET mirror_detail_id = ?, status = ?, updated_at = ? WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID         *sql.Stmt
+	stmtGetOpenByDetail *sql.Stmt
+	stmtGetByEmployee   *sql.Stmt
+	db                  *sql.DB
+}
+
+// NewSegmentMirrorRepository creates a new segment mirror repository with prepared statements
+func NewSegmentMirrorRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtGetOpenByDetail, err := db.Prepare(QueryOpenByDetail)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	stmtGetByEmployee, err := db.Prepare(QueryByEmployee)
+	if err != nil {
+		log.Error(logger.LogSegmentMirrorRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:                  db,
+		stmtGetByID:         stmtGetByID,
+		stmtGetOpenByDetail: stmtGetOpenByDetail,
+		stmtGetByEmployee:   stmtGetByEmployee,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
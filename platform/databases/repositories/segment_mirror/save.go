@@ -0,0 +1,57 @@
+package segment_mirror
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveSegmentMirror stores a new mirrored entry offer
+func (r *repository) SaveSegmentMirror(ctx context.Context, tx output.Tx, mirror domain.SegmentMirror) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	m := FromDomain(&mirror)
+	_, err := sqlTx.ExecContext(ctx, QueryInsert,
+		m.ID,
+		m.SourceDetailID,
+		m.SourceEmployeeID,
+		m.CompanionEmployeeID,
+		m.MirrorDetailID,
+		m.Status,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrSegmentMirrorCannotSave
+	}
+
+	return nil
+}
+
+// UpdateSegmentMirror stores the status and companion entry of a link
+func (r *repository) UpdateSegmentMirror(ctx context.Context, tx output.Tx, mirror domain.SegmentMirror) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		mirror.MirrorDetailID,
+		string(mirror.Status),
+		mirror.UpdatedAt,
+		mirror.ID,
+	)
+	if err != nil {
+		return domain.ErrSegmentMirrorCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrSegmentMirrorNotFound
+	}
+
+	return nil
+}
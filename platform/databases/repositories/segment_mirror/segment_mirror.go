@@ -0,0 +1,53 @@
+package segment_mirror
+
+import (
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// SegmentMirror is the database entity for segment_mirror table
+type SegmentMirror struct {
+	ID                  string    `db:"id"`
+	SourceDetailID      string    `db:"source_detail_id"`
+	SourceEmployeeID    string    `db:"source_employee_id"`
+	CompanionEmployeeID string    `db:"companion_employee_id"`
+	MirrorDetailID      *string   `db:"mirror_detail_id"` // NULL until the companion accepts
+	Status              string    `db:"status"`
+	CreatedAt           time.Time `db:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (m *SegmentMirror) scanDest() []interface{} {
+	return []interface{}{&m.ID, &m.SourceDetailID, &m.SourceEmployeeID, &m.CompanionEmployeeID, &m.MirrorDetailID,
+		&m.Status, &m.CreatedAt, &m.UpdatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (m *SegmentMirror) ToDomain() *domain.SegmentMirror {
+	return &domain.SegmentMirror{
+		ID:                  m.ID,
+		SourceDetailID:      m.SourceDetailID,
+		SourceEmployeeID:    m.SourceEmployeeID,
+		CompanionEmployeeID: m.CompanionEmployeeID,
+		MirrorDetailID:      m.MirrorDetailID,
+		Status:              domain.SegmentMirrorStatus(m.Status),
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(mirror *domain.SegmentMirror) *SegmentMirror {
+	return &SegmentMirror{
+		ID:                  mirror.ID,
+		SourceDetailID:      mirror.SourceDetailID,
+		SourceEmployeeID:    mirror.SourceEmployeeID,
+		CompanionEmployeeID: mirror.CompanionEmployeeID,
+		MirrorDetailID:      mirror.MirrorDetailID,
+		Status:              string(mirror.Status),
+		CreatedAt:           mirror.CreatedAt,
+		UpdatedAt:           mirror.UpdatedAt,
+	}
+}
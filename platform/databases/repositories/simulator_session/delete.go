@@ -0,0 +1,30 @@
+package simulator_session
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// DeleteSimulatorSession removes a simulator session
+func (r *repository) DeleteSimulatorSession(ctx context.Context, tx output.Tx, id string) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	result, err := sqlTx.ExecContext(ctx, QueryDelete, id)
+	if err != nil {
+		return domain.ErrSimulatorSessionCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrSimulatorSessionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package simulator_session
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetSimulatorSessionByID retrieves a simulator session by its UUID
+func (r *repository) GetSimulatorSessionByID(ctx context.Context, id string) (*domain.SimulatorSession, error) {
+	var s SimulatorSession
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(s.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSimulatorSessionNotFound
+		}
+		return nil, err
+	}
+	return s.ToDomain()
+}
@@ -0,0 +1,99 @@
+package simulator_session
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListSimulatorSessions retrieves an employee's sessions, optionally restricted to a date range,
+// latest first
+func (r *repository) ListSimulatorSessions(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorSession, error) {
+	var sb strings.Builder
+	sb.WriteString(QueryByEmployee)
+	args := appendDateRange(&sb, []interface{}{filter.EmployeeID}, filter)
+	sb.WriteString(" ORDER BY ss.session_date DESC, ss.created_at DESC")
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []domain.SimulatorSession
+	for rows.Next() {
+		var s SimulatorSession
+		if err := rows.Scan(s.scanDest()...); err != nil {
+			log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+			return nil, err
+		}
+		session, err := s.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// GetSimulatorTotals adds up an employee's session count and time per device type, optionally
+// restricted to a date range
+func (r *repository) GetSimulatorTotals(ctx context.Context, filter domain.SimulatorSessionFilter) ([]domain.SimulatorTotals, error) {
+	var sb strings.Builder
+	sb.WriteString(QueryTotalsByEmployee)
+	args := appendDateRange(&sb, []interface{}{filter.EmployeeID}, filter)
+	sb.WriteString(" GROUP BY ss.device_type ORDER BY ss.device_type")
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []domain.SimulatorTotals
+	for rows.Next() {
+		var deviceType string
+		var count int
+		var seconds int64
+		if err := rows.Scan(&deviceType, &count, &seconds); err != nil {
+			log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+			return nil, err
+		}
+		totals = append(totals, domain.SimulatorTotals{
+			DeviceType:   domain.SimulatorDeviceType(deviceType),
+			SessionCount: count,
+			Duration:     time.Duration(seconds) * time.Second,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogSimulatorSessionError, "employee_id", filter.EmployeeID, "error", err)
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// appendDateRange adds the optional session date bounds of the filter to the query and its arguments
+func appendDateRange(sb *strings.Builder, args []interface{}, filter domain.SimulatorSessionFilter) []interface{} {
+	if filter.From != nil {
+		sb.WriteString(" AND ss.session_date >= ?")
+		args = append(args, filter.From.Format("2006-01-02"))
+	}
+	if filter.To != nil {
+		sb.WriteString(" AND ss.session_date <= ?")
+		args = append(args, filter.To.Format("2006-01-02"))
+	}
+	return args
+}
@@ -0,0 +1,81 @@
+package simulator_session
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	// querySimulatorSessionSelect joins the emulated aircraft model; the WHERE clause is appended by each query
+	querySimulatorSessionSelect = `
+		SELECT
+			ss.id, ss.employee_id, ss.session_date, ss.device_type, ss.qualification_level, ss.device_identifier,
+			ss.aircraft_model_id, ss.duration, ss.role, ss.exercises, ss.takeoffs, ss.landings, ss.night_takeoffs,
+			ss.night_landings, ss.approaches, ss.remarks, ss.created_at, ss.updated_at,
+			COALESCE(am.model_name, '') as model_name, COALESCE(am.family, '') as aircraft_family
+		FROM simulator_session ss
+		LEFT JOIN aircraft_model am ON ss.aircraft_model_id = am.id
+	`
+	QueryByID = querySimulatorSessionSelect + " WHERE ss.id = ? LIMIT 1"
+	// QueryByEmployee is completed with the optional date range and the ORDER BY clause
+	QueryByEmployee = querySimulatorSessionSelect + " WHERE ss.employee_id = ?"
+	// QueryTotalsByEmployee is completed with the optional date range and the GROUP BY clause
+	QueryTotalsByEmployee = `
+		SELECT ss.device_type, COUNT(*), COALESCE(SUM(TIME_TO_SEC(ss.duration)), 0)
+		FROM simulator_session ss
+		WHERE ss.employee_id = ?
+	`
+	QueryInsert = `
+		INSERT INTO simulator_session (
+			id, employee_id, session_date, device_type, qualification_level, device_identifier, aircraft_model_id,
+			duration, role, exercises, takeoffs, landings, night_takeoffs, night_landings, approaches, remarks,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryUpdate = `
+		UPDATE simulator_session SET
+			session_date = ?, device_type = ?, qualification_level = ?, device_identifier = ?, aircraft_model_id = ?,
+			duration = ?, role = ?, exercises = ?, takeoffs = ?, landings = ?, night_takeoffs = ?, night_landings = ?,
+			approaches = ?, remarks = ?, updated_at = ?
+		WHERE id = ?
+	`
+	QueryDelete = "DELETE FROM simulator_session WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewSimulatorSessionRepository creates a new simulator session repository with prepared statements
+func NewSimulatorSessionRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogSimulatorSessionRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
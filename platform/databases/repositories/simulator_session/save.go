@@ -0,0 +1,86 @@
+package simulator_session
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveSimulatorSession stores a new simulator session
+func (r *repository) SaveSimulatorSession(ctx context.Context, tx output.Tx, session domain.SimulatorSession) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	s, err := FromDomain(&session)
+	if err != nil {
+		return domain.ErrSimulatorSessionCannotSave
+	}
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		s.ID,
+		s.EmployeeID,
+		s.SessionDate,
+		s.DeviceType,
+		s.QualificationLevel,
+		s.DeviceIdentifier,
+		s.AircraftModelID,
+		s.Duration,
+		s.Role,
+		s.Exercises,
+		s.Takeoffs,
+		s.Landings,
+		s.NightTakeoffs,
+		s.NightLandings,
+		s.Approaches,
+		s.Remarks,
+		s.CreatedAt,
+		s.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrSimulatorSessionCannotSave
+	}
+
+	return nil
+}
+
+// UpdateSimulatorSession stores the editable fields of a simulator session
+func (r *repository) UpdateSimulatorSession(ctx context.Context, tx output.Tx, session domain.SimulatorSession) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	s, err := FromDomain(&session)
+	if err != nil {
+		return domain.ErrSimulatorSessionCannotSave
+	}
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		s.SessionDate,
+		s.DeviceType,
+		s.QualificationLevel,
+		s.DeviceIdentifier,
+		s.AircraftModelID,
+		s.Duration,
+		s.Role,
+		s.Exercises,
+		s.Takeoffs,
+		s.Landings,
+		s.NightTakeoffs,
+		s.NightLandings,
+		s.Approaches,
+		s.Remarks,
+		s.UpdatedAt,
+		s.ID,
+	)
+	if err != nil {
+		return domain.ErrSimulatorSessionCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrSimulatorSessionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package simulator_session
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// SimulatorSession is the database entity for simulator_session table
+type SimulatorSession struct {
+	ID                 string         `db:"id"`
+	EmployeeID         string         `db:"employee_id"`
+	SessionDate        time.Time      `db:"session_date"`
+	DeviceType         string         `db:"device_type"`
+	QualificationLevel string         `db:"qualification_level"`
+	DeviceIdentifier   sql.NullString `db:"device_identifier"`
+	AircraftModelID    string         `db:"aircraft_model_id"`
+	Duration           string         `db:"duration"` // TIME stored as string HH:MM:SS
+	Role               string         `db:"role"`
+	Exercises          []byte         `db:"exercises"` // JSON array of strings
+	Takeoffs           int            `db:"takeoffs"`
+	Landings           int            `db:"landings"`
+	NightTakeoffs      int            `db:"night_takeoffs"`
+	NightLandings      int            `db:"night_landings"`
+	Approaches         int            `db:"approaches"`
+	Remarks            sql.NullString `db:"remarks"`
+	CreatedAt          time.Time      `db:"created_at"`
+	UpdatedAt          time.Time      `db:"updated_at"`
+
+	// From JOIN with aircraft_model
+	ModelName      string `db:"model_name"`
+	AircraftFamily string `db:"aircraft_family"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (s *SimulatorSession) scanDest() []interface{} {
+	return []interface{}{&s.ID, &s.EmployeeID, &s.SessionDate, &s.DeviceType, &s.QualificationLevel, &s.DeviceIdentifier,
+		&s.AircraftModelID, &s.Duration, &s.Role, &s.Exercises, &s.Takeoffs, &s.Landings, &s.NightTakeoffs,
+		&s.NightLandings, &s.Approaches, &s.Remarks, &s.CreatedAt, &s.UpdatedAt, &s.ModelName, &s.AircraftFamily}
+}
+
+// ToDomain converts the database entity to domain model
+func (s *SimulatorSession) ToDomain() (*domain.SimulatorSession, error) {
+	session := &domain.SimulatorSession{
+		ID:                 s.ID,
+		EmployeeID:         s.EmployeeID,
+		SessionDate:        s.SessionDate.Format("2006-01-02"),
+		DeviceType:         domain.SimulatorDeviceType(s.DeviceType),
+		QualificationLevel: s.QualificationLevel,
+		AircraftModelID:    s.AircraftModelID,
+		Duration:           s.Duration,
+		Role:               domain.SimulatorRole(s.Role),
+		Takeoffs:           s.Takeoffs,
+		Landings:           s.Landings,
+		NightTakeoffs:      s.NightTakeoffs,
+		NightLandings:      s.NightLandings,
+		Approaches:         s.Approaches,
+		CreatedAt:          s.CreatedAt,
+		UpdatedAt:          s.UpdatedAt,
+		ModelName:          s.ModelName,
+		AircraftFamily:     s.AircraftFamily,
+	}
+	if s.DeviceIdentifier.Valid {
+		session.DeviceIdentifier = &s.DeviceIdentifier.String
+	}
+	if s.Remarks.Valid {
+		session.Remarks = &s.Remarks.String
+	}
+	if len(s.Exercises) > 0 {
+		if err := json.Unmarshal(s.Exercises, &session.Exercises); err != nil {
+			return nil, err
+		}
+	}
+	return session, nil
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(session *domain.SimulatorSession) (*SimulatorSession, error) {
+	sessionDate, err := time.Parse("2006-01-02", session.SessionDate)
+	if err != nil {
+		return nil, err
+	}
+	exercises, err := json.Marshal(session.Exercises)
+	if err != nil {
+		return nil, err
+	}
+	entity := &SimulatorSession{
+		ID:                 session.ID,
+		EmployeeID:         session.EmployeeID,
+		SessionDate:        sessionDate,
+		DeviceType:         string(session.DeviceType),
+		QualificationLevel: session.QualificationLevel,
+		AircraftModelID:    session.AircraftModelID,
+		Duration:           session.Duration,
+		Role:               string(session.Role),
+		Exercises:          exercises,
+		Takeoffs:           session.Takeoffs,
+		Landings:           session.Landings,
+		NightTakeoffs:      session.NightTakeoffs,
+		NightLandings:      session.NightLandings,
+		Approaches:         session.Approaches,
+		CreatedAt:          session.CreatedAt,
+		UpdatedAt:          session.UpdatedAt,
+	}
+	if session.DeviceIdentifier != nil {
+		entity.DeviceIdentifier = sql.NullString{String: *session.DeviceIdentifier, Valid: true}
+	}
+	if session.Remarks != nil {
+		entity.Remarks = sql.NullString{String: *session.Remarks, Valid: true}
+	}
+	return entity, nil
+}
@@ -0,0 +1,21 @@
+package type_rating
+
+import (
+	"context"
+	"database/sql"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// GetTypeRatingByID retrieves a type rating by its UUID
+func (r *repository) GetTypeRatingByID(ctx context.Context, id string) (*domain.TypeRating, error) {
+	var t TypeRating
+	err := r.stmtGetByID.QueryRowContext(ctx, id).Scan(t.scanDest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrTypeRatingNotFound
+		}
+		return nil, err
+	}
+	return t.ToDomain(), nil
+}
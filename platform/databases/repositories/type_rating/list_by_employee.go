@@ -0,0 +1,35 @@
+package type_rating
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+// ListTypeRatingsByEmployee retrieves an employee's type ratings ordered by family and issue date
+func (r *repository) ListTypeRatingsByEmployee(ctx context.Context, employeeID string) ([]domain.TypeRating, error) {
+	rows, err := r.db.QueryContext(ctx, QueryByEmployee, employeeID)
+	if err != nil {
+		log.Error(logger.LogTypeRatingError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ratings []domain.TypeRating
+	for rows.Next() {
+		var t TypeRating
+		if err := rows.Scan(t.scanDest()...); err != nil {
+			log.Error(logger.LogTypeRatingError, "employee_id", employeeID, "error", err)
+			return nil, err
+		}
+		ratings = append(ratings, *t.ToDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error(logger.LogTypeRatingError, "employee_id", employeeID, "error", err)
+		return nil, err
+	}
+
+	return ratings, nil
+}
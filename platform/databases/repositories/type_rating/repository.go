@@ -0,0 +1,65 @@
+package type_rating
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+	"github.com/champion19/flighthours-api/platform/logger"
+)
+
+const (
+	// queryTypeRatingSelect lists the columns in the order of scanDest
+	queryTypeRatingSelect = `
+		SELECT id, employee_id, aircraft_family, issue_date, expiry_date, remarks, created_at, updated_at
+		FROM type_rating
+	`
+	QueryByID       = queryTypeRatingSelect + " WHERE id = ? LIMIT 1"
+	QueryByEmployee = queryTypeRatingSelect + " WHERE employee_id = ? ORDER BY aircraft_family, issue_date"
+	QueryInsert     = `
+		INSERT INTO type_rating (
+			id, employee_id, aircraft_family, issue_date, expiry_date, remarks, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	QueryUpdate = `
+		UPDATE type_rating SET
+			aircraft_family = ?, issue_date = ?, expiry_date = ?, remarks = ?, updated_at = ?
+		WHERE id = ?
+	`
+	QueryDelete = "DELETE FROM type_rating WHERE id = ?"
+)
+
+var log logger.Logger = logger.NewSlogLogger()
+
+type repository struct {
+	stmtGetByID *sql.Stmt
+	db          *sql.DB
+}
+
+// NewTypeRatingRepository creates a new type rating repository with prepared statements
+func NewTypeRatingRepository(db *sql.DB) (*repository, error) {
+	if db == nil {
+		return nil, sql.ErrConnDone
+	}
+
+	stmtGetByID, err := db.Prepare(QueryByID)
+	if err != nil {
+		log.Error(logger.LogTypeRatingRepoInitError, "error preparing statement", err)
+		return nil, err
+	}
+
+	return &repository{
+		db:          db,
+		stmtGetByID: stmtGetByID,
+	}, nil
+}
+
+// BeginTx starts a new database transaction
+func (r *repository) BeginTx(ctx context.Context) (output.Tx, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewSQLTx(tx), nil
+}
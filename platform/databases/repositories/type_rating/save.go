@@ -0,0 +1,66 @@
+package type_rating
+
+import (
+	"context"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+	"github.com/champion19/flighthours-api/core/ports/output"
+	"github.com/champion19/flighthours-api/platform/databases/common"
+)
+
+// SaveTypeRating stores a new type rating
+func (r *repository) SaveTypeRating(ctx context.Context, tx output.Tx, rating domain.TypeRating) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	t, err := FromDomain(&rating)
+	if err != nil {
+		return domain.ErrTypeRatingCannotSave
+	}
+	_, err = sqlTx.ExecContext(ctx, QueryInsert,
+		t.ID,
+		t.EmployeeID,
+		t.AircraftFamily,
+		t.IssueDate,
+		t.ExpiryDate,
+		t.Remarks,
+		t.CreatedAt,
+		t.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ErrTypeRatingCannotSave
+	}
+
+	return nil
+}
+
+// UpdateTypeRating stores the editable fields of a type rating
+func (r *repository) UpdateTypeRating(ctx context.Context, tx output.Tx, rating domain.TypeRating) error {
+	sqlTx := tx.(*common.SQLTX)
+
+	t, err := FromDomain(&rating)
+	if err != nil {
+		return domain.ErrTypeRatingCannotSave
+	}
+	result, err := sqlTx.ExecContext(ctx, QueryUpdate,
+		t.AircraftFamily,
+		t.IssueDate,
+		t.ExpiryDate,
+		t.Remarks,
+		t.UpdatedAt,
+		t.ID,
+	)
+	if err != nil {
+		return domain.ErrTypeRatingCannotSave
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrTypeRatingNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package type_rating
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/champion19/flighthours-api/core/interactor/services/domain"
+)
+
+// TypeRating is the database entity for type_rating table
+type TypeRating struct {
+	ID             string         `db:"id"`
+	EmployeeID     string         `db:"employee_id"`
+	AircraftFamily string         `db:"aircraft_family"`
+	IssueDate      time.Time      `db:"issue_date"`
+	ExpiryDate     sql.NullTime   `db:"expiry_date"`
+	Remarks        sql.NullString `db:"remarks"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+// scanDest returns the scan destinations in the column order of the SELECT queries
+func (t *TypeRating) scanDest() []interface{} {
+	return []interface{}{&t.ID, &t.EmployeeID, &t.AircraftFamily, &t.IssueDate, &t.ExpiryDate, &t.Remarks,
+		&t.CreatedAt, &t.UpdatedAt}
+}
+
+// ToDomain converts the database entity to domain model
+func (t *TypeRating) ToDomain() *domain.TypeRating {
+	rating := &domain.TypeRating{
+		ID:             t.ID,
+		EmployeeID:     t.EmployeeID,
+		AircraftFamily: t.AircraftFamily,
+		IssueDate:      t.IssueDate.Format("2006-01-02"),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+	if t.ExpiryDate.Valid {
+		expiry := t.ExpiryDate.Time.Format("2006-01-02")
+		rating.ExpiryDate = &expiry
+	}
+	if t.Remarks.Valid {
+		rating.Remarks = &t.Remarks.String
+	}
+	return rating
+}
+
+// FromDomain converts a domain model to database entity
+func FromDomain(rating *domain.TypeRating) (*TypeRating, error) {
+	issueDate, err := time.Parse("2006-01-02", rating.IssueDate)
+	if err != nil {
+		return nil, err
+	}
+	entity := &TypeRating{
+		ID:             rating.ID,
+		EmployeeID:     rating.EmployeeID,
+		AircraftFamily: rating.AircraftFamily,
+		IssueDate:      issueDate,
+		CreatedAt:      rating.CreatedAt,
+		UpdatedAt:      rating.UpdatedAt,
+	}
+	if rating.ExpiryDate != nil {
+		expiryDate, err := time.Parse("2006-01-02", *rating.ExpiryDate)
+		if err != nil {
+			return nil, err
+		}
+		entity.ExpiryDate = sql.NullTime{Time: expiryDate, Valid: true}
+	}
+	if rating.Remarks != nil {
+		entity.Remarks = sql.NullString{String: *rating.Remarks, Valid: true}
+	}
+	return entity, nil
+}
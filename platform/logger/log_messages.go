@@ -652,6 +652,25 @@ const (
 	LogDailyLogbookRepoInitError   = "Error inicializando repositorio de bitácoras diarias"
 )
 
+// ============================================
+// DAILY LOGBOOK SIGN-OFF (Firma, bloqueo y enmiendas)
+// ============================================
+const (
+	LogDailyLogbookTransition      = "Cambiando estado de bitácora diaria"
+	LogDailyLogbookTransitionOK    = "Estado de bitácora diaria actualizado"
+	LogDailyLogbookTransitionError = "Error cambiando estado de bitácora diaria"
+	LogDailyLogbookSignedChange    = "Cambio rechazado: la bitácora está firmada o bloqueada"
+	LogAmendmentCreate             = "Registrando enmienda de bitácora"
+	LogAmendmentCreateOK           = "Enmienda de bitácora registrada"
+	LogAmendmentApply              = "Firmando y aplicando enmienda de bitácora"
+	LogAmendmentApplyOK            = "Enmienda aplicada y bitácora firmada nuevamente"
+	LogAmendmentReject             = "Rechazando enmienda de bitácora"
+	LogAmendmentRejectOK           = "Enmienda de bitácora rechazada"
+	LogAmendmentList               = "Listando enmiendas de bitácora"
+	LogAmendmentError              = "Error procesando enmienda de bitácora"
+	LogAmendmentRepoInitError      = "Error inicializando repositorio de enmiendas"
+)
+
 // ============================================
 // AIRCRAFT REGISTRATION INTERACTOR
 // ============================================
@@ -767,25 +786,28 @@ const (
 // DAILY LOGBOOK DETAIL INTERACTOR
 // ============================================
 const (
-	LogDailyLogbookDetailGet           = "Obteniendo información de detalle de bitácora"
-	LogDailyLogbookDetailGetOK         = "Detalle de bitácora obtenido exitosamente"
-	LogDailyLogbookDetailGetError      = "Error obteniendo detalle de bitácora"
-	LogDailyLogbookDetailNotFound      = "Detalle de bitácora no encontrado"
-	LogDailyLogbookDetailList          = "Listando detalles de bitácora"
-	LogDailyLogbookDetailListOK        = "Detalles de bitácora listados exitosamente"
-	LogDailyLogbookDetailListError     = "Error listando detalles de bitácora"
-	LogDailyLogbookDetailCreate        = "Creando detalle de bitácora"
-	LogDailyLogbookDetailCreateOK      = "Detalle de bitácora creado exitosamente"
-	LogDailyLogbookDetailCreateError   = "Error creando detalle de bitácora"
-	LogDailyLogbookDetailUpdate        = "Actualizando detalle de bitácora"
-	LogDailyLogbookDetailUpdateOK      = "Detalle de bitácora actualizado exitosamente"
-	LogDailyLogbookDetailUpdateError   = "Error actualizando detalle de bitácora"
-	LogDailyLogbookDetailDelete        = "Eliminando detalle de bitácora"
-	LogDailyLogbookDetailDeleteOK      = "Detalle de bitácora eliminado exitosamente"
-	LogDailyLogbookDetailDeleteError   = "Error eliminando detalle de bitácora"
-	LogDailyLogbookDetailRepoInit      = "Inicializando repositorio de detalles de bitácora"
-	LogDailyLogbookDetailRepoInitOK    = "Repositorio de detalles de bitácora inicializado"
-	LogDailyLogbookDetailRepoInitError = "Error inicializando repositorio de detalles de bitácora"
+	LogDailyLogbookDetailGet              = "Obteniendo información de detalle de bitácora"
+	LogDailyLogbookDetailGetOK            = "Detalle de bitácora obtenido exitosamente"
+	LogDailyLogbookDetailGetError         = "Error obteniendo detalle de bitácora"
+	LogDailyLogbookDetailNotFound         = "Detalle de bitácora no encontrado"
+	LogDailyLogbookDetailList             = "Listando detalles de bitácora"
+	LogDailyLogbookDetailListOK           = "Detalles de bitácora listados exitosamente"
+	LogDailyLogbookDetailListError        = "Error listando detalles de bitácora"
+	LogDailyLogbookDetailCreate           = "Creando detalle de bitácora"
+	LogDailyLogbookDetailCreateOK         = "Detalle de bitácora creado exitosamente"
+	LogDailyLogbookDetailCreateError      = "Error creando detalle de bitácora"
+	LogDailyLogbookDetailUpdate           = "Actualizando detalle de bitácora"
+	LogDailyLogbookDetailUpdateOK         = "Detalle de bitácora actualizado exitosamente"
+	LogDailyLogbookDetailUpdateError      = "Error actualizando detalle de bitácora"
+	LogDailyLogbookDetailDelete           = "Eliminando detalle de bitácora"
+	LogDailyLogbookDetailDeleteOK         = "Detalle de bitácora eliminado exitosamente"
+	LogDailyLogbookDetailDeleteError      = "Error eliminando detalle de bitácora"
+	LogDailyLogbookDetailConflict         = "Tramo duplicado o solapado con otro tramo del empleado"
+	LogDailyLogbookDetailConflictOverride = "Conflicto de tramo ignorado por solicitud explícita"
+	LogDailyLogbookDetailConflictError    = "Error consultando tramos para detectar conflictos"
+	LogDailyLogbookDetailRepoInit         = "Inicializando repositorio de detalles de bitácora"
+	LogDailyLogbookDetailRepoInitOK       = "Repositorio de detalles de bitácora inicializado"
+	LogDailyLogbookDetailRepoInitError    = "Error inicializando repositorio de detalles de bitácora"
 )
 
 // ============================================
@@ -887,3 +909,262 @@ const (
 	LogAirlineEmployeeRepoInitOK      = "Repositorio de empleados aerolínea inicializado"
 	LogAirlineEmployeeRepoInitError   = "Error inicializando repositorio de empleados aerolínea"
 )
+
+// ============================================
+// FLIGHT TOTALS
+// ============================================
+const (
+	LogFlightTotalsGet      = "Calculando totales de tiempo de vuelo"
+	LogFlightTotalsGetOK    = "Totales de tiempo de vuelo calculados exitosamente"
+	LogFlightTotalsGetError = "Error calculando totales de tiempo de vuelo"
+)
+
+// ============================================
+// LOGBOOK VALIDATION (Consistencia del día)
+// ============================================
+const (
+	LogLogbookValidation      = "Validando consistencia de la bitácora diaria"
+	LogLogbookValidationOK    = "Reporte de consistencia de la bitácora generado"
+	LogLogbookValidationError = "Error validando consistencia de la bitácora diaria"
+	LogRouteContinuityGap     = "Discontinuidad de ruta entre tramos del día"
+)
+
+// ============================================
+// FLIGHT TIME ANOMALIES (Tiempos frente al estimado de la ruta)
+// ============================================
+const (
+	LogFlightAnomalyReport      = "Generando reporte de anomalías de tiempo de vuelo"
+	LogFlightAnomalyReportOK    = "Reporte de anomalías de tiempo de vuelo generado"
+	LogFlightAnomalyReportError = "Error generando reporte de anomalías de tiempo de vuelo"
+)
+
+// ============================================
+// LOGBOOK CHAIN (Cadena de hashes de segmentos firmados)
+// ============================================
+const (
+	LogLogbookChainVerify     = "Verificando cadena de hashes de la bitácora"
+	LogLogbookChainVerifyOK   = "Cadena de hashes de la bitácora verificada"
+	LogLogbookChainBroken     = "Cadena de hashes de la bitácora rota"
+	LogLogbookChainSeal       = "Sellando segmentos firmados en la cadena de hashes"
+	LogLogbookChainSealOK     = "Segmentos firmados sellados en la cadena de hashes"
+	LogLogbookChainError      = "Error en la cadena de hashes de la bitácora"
+	LogDailyLogbookDetailHash = "Actualizando hash de cadena del detalle de bitácora"
+)
+
+// ============================================
+// LOGBOOK HISTORY (Historial de cambios de bitácoras y segmentos)
+// ============================================
+const (
+	LogLogbookHistory              = "Consultando historial de cambios"
+	LogLogbookHistoryOK            = "Historial de cambios consultado"
+	LogLogbookHistoryError         = "Error en el historial de cambios"
+	LogLogbookHistoryRepoInitOK    = "Repositorio de historial de cambios inicializado"
+	LogLogbookHistoryRepoInitError = "Error inicializando repositorio de historial de cambios"
+)
+
+// ============================================
+// LOGBOOK TRASH (Papelera, restauración y purga de bitácoras y segmentos)
+// ============================================
+const (
+	LogDailyLogbookRestore          = "Restaurando bitácora diaria eliminada"
+	LogDailyLogbookRestoreOK        = "Bitácora diaria restaurada con sus segmentos"
+	LogDailyLogbookRestoreError     = "Error restaurando bitácora diaria"
+	LogDailyLogbookDeletedList      = "Consultando bitácoras diarias eliminadas"
+	LogDailyLogbookDeletedListOK    = "Bitácoras diarias eliminadas consultadas"
+	LogDailyLogbookDetailRestore    = "Restaurando detalle de bitácora eliminado"
+	LogDailyLogbookDetailRestoreOK  = "Detalle de bitácora restaurado exitosamente"
+	LogDailyLogbookDetailRestoreErr = "Error restaurando detalle de bitácora"
+	LogLogbookPurgeStart            = "Iniciando purga periódica de bitácoras eliminadas"
+	LogLogbookPurgeDisabled         = "Purga periódica de bitácoras eliminadas deshabilitada"
+	LogLogbookPurgeStop             = "Deteniendo purga periódica de bitácoras eliminadas"
+	LogLogbookPurgeOK               = "Bitácoras y segmentos eliminados purgados definitivamente"
+	LogLogbookPurgeError            = "Error purgando bitácoras y segmentos eliminados"
+)
+
+// ============================================
+// SEGMENT MIRROR (Vuelo espejo del piloto acompañante)
+// ============================================
+const (
+	LogSegmentMirrorOffer         = "Ofreciendo vuelo espejo al piloto acompañante"
+	LogSegmentMirrorList          = "Listando vuelos espejo del empleado"
+	LogSegmentMirrorAccept        = "Aceptando vuelo espejo"
+	LogSegmentMirrorAcceptOK      = "Vuelo espejo registrado en la bitácora del acompañante"
+	LogSegmentMirrorDecline       = "Rechazando vuelo espejo"
+	LogSegmentMirrorDeclineOK     = "Vuelo espejo rechazado"
+	LogSegmentMirrorSync          = "Sincronizando vuelo enlazado del acompañante"
+	LogSegmentMirrorConflict      = "Vuelos enlazados en conflicto"
+	LogSegmentMirrorClose         = "Cerrando enlace de vuelo espejo"
+	LogSegmentMirrorResolve       = "Resolviendo conflicto de vuelo espejo"
+	LogSegmentMirrorResolveOK     = "Conflicto de vuelo espejo resuelto"
+	LogSegmentMirrorError         = "Error procesando vuelo espejo"
+	LogSegmentMirrorRepoInitError = "Error inicializando repositorio de vuelos espejo"
+	LogSegmentMirrorRepoInitOK    = "Repositorio de vuelos espejo inicializado"
+)
+
+// ============================================
+// SIMULATOR SESSIONS (Sesiones en dispositivos de simulación FSTD)
+// ============================================
+const (
+	LogSimulatorSessionList          = "Listando sesiones de simulador del empleado"
+	LogSimulatorSessionGet           = "Consultando sesión de simulador"
+	LogSimulatorSessionCreate        = "Registrando sesión de simulador"
+	LogSimulatorSessionCreateOK      = "Sesión de simulador registrada"
+	LogSimulatorSessionUpdate        = "Actualizando sesión de simulador"
+	LogSimulatorSessionUpdateOK      = "Sesión de simulador actualizada"
+	LogSimulatorSessionDelete        = "Eliminando sesión de simulador"
+	LogSimulatorSessionDeleteOK      = "Sesión de simulador eliminada"
+	LogSimulatorSessionError         = "Error procesando sesión de simulador"
+	LogSimulatorSessionRepoInitError = "Error inicializando repositorio de sesiones de simulador"
+	LogSimulatorSessionRepoInitOK    = "Repositorio de sesiones de simulador inicializado"
+)
+
+// ============================================
+// PRIOR EXPERIENCE (Experiencia previa y saldos iniciales)
+// ============================================
+const (
+	LogPriorExperienceList          = "Listando experiencia previa del empleado"
+	LogPriorExperienceGet           = "Consultando saldo inicial de experiencia previa"
+	LogPriorExperienceCreate        = "Registrando saldo inicial de experiencia previa"
+	LogPriorExperienceCreateOK      = "Saldo inicial de experiencia previa registrado"
+	LogPriorExperienceUpdate        = "Actualizando saldo inicial de experiencia previa"
+	LogPriorExperienceUpdateOK      = "Saldo inicial de experiencia previa actualizado"
+	LogPriorExperienceDelete        = "Eliminando saldo inicial de experiencia previa"
+	LogPriorExperienceDeleteOK      = "Saldo inicial de experiencia previa eliminado"
+	LogPriorExperienceError         = "Error procesando experiencia previa"
+	LogPriorExperienceRepoInitError = "Error inicializando repositorio de experiencia previa"
+	LogPriorExperienceRepoInitOK    = "Repositorio de experiencia previa inicializado"
+)
+
+// ============================================
+// DUTY PERIODS (Periodos de servicio)
+// ============================================
+const (
+	LogDutyPeriodList          = "Listando periodos de servicio del empleado"
+	LogDutyPeriodGet           = "Consultando periodo de servicio"
+	LogDutyPeriodCreate        = "Registrando periodo de servicio"
+	LogDutyPeriodCreateOK      = "Periodo de servicio registrado"
+	LogDutyPeriodUpdate        = "Actualizando periodo de servicio"
+	LogDutyPeriodUpdateOK      = "Periodo de servicio actualizado"
+	LogDutyPeriodDelete        = "Eliminando periodo de servicio"
+	LogDutyPeriodDeleteOK      = "Periodo de servicio eliminado"
+	LogDutyPeriodError         = "Error procesando periodo de servicio"
+	LogDutyPeriodRepoInitError = "Error inicializando repositorio de periodos de servicio"
+	LogDutyPeriodRepoInitOK    = "Repositorio de periodos de servicio inicializado"
+)
+
+// ============================================
+// TYPE RATINGS (Habilitaciones de tipo)
+// ============================================
+const (
+	LogTypeRatingList          = "Listando habilitaciones de tipo del empleado"
+	LogTypeRatingGet           = "Consultando habilitación de tipo"
+	LogTypeRatingCreate        = "Registrando habilitación de tipo"
+	LogTypeRatingCreateOK      = "Habilitación de tipo registrada"
+	LogTypeRatingUpdate        = "Actualizando habilitación de tipo"
+	LogTypeRatingUpdateOK      = "Habilitación de tipo actualizada"
+	LogTypeRatingDelete        = "Eliminando habilitación de tipo"
+	LogTypeRatingDeleteOK      = "Habilitación de tipo eliminada"
+	LogTypeRatingMissing       = "Segmento como piloto volando sin habilitación de tipo vigente"
+	LogTypeRatingError         = "Error procesando habilitación de tipo"
+	LogTypeRatingRepoInitError = "Error inicializando repositorio de habilitaciones de tipo"
+	LogTypeRatingRepoInitOK    = "Repositorio de habilitaciones de tipo inicializado"
+)
+
+// ============================================
+// CREDENTIALS (Licencias, certificados médicos y vencimientos)
+// ============================================
+const (
+	LogCredentialList           = "Listando credenciales del empleado"
+	LogCredentialGet            = "Consultando credencial"
+	LogCredentialCreate         = "Registrando credencial"
+	LogCredentialCreateOK       = "Credencial registrada"
+	LogCredentialUpdate         = "Actualizando credencial"
+	LogCredentialUpdateOK       = "Credencial actualizada"
+	LogCredentialDelete         = "Eliminando credencial"
+	LogCredentialDeleteOK       = "Credencial eliminada"
+	LogCredentialScanUpload     = "Adjuntando documento escaneado a la credencial"
+	LogCredentialScanUploadOK   = "Documento escaneado adjuntado"
+	LogCredentialScanGet        = "Descargando documento escaneado de la credencial"
+	LogCredentialExpiryReport   = "Generando reporte de vencimientos de credenciales de la aerolínea"
+	LogCredentialExpiryReportOK = "Reporte de vencimientos de credenciales generado"
+	LogCredentialError          = "Error procesando credencial"
+	LogCredentialRepoInitError  = "Error inicializando repositorio de credenciales"
+	LogCredentialRepoInitOK     = "Repositorio de credenciales inicializado"
+
+	LogCredentialNoticeStart    = "Iniciando tarea periódica de avisos de vencimiento de credenciales"
+	LogCredentialNoticeStop     = "Tarea periódica de avisos de vencimiento de credenciales detenida"
+	LogCredentialNoticeDisabled = "Tarea periódica de avisos de vencimiento de credenciales deshabilitada"
+	LogCredentialNoticeOK       = "Avisos de vencimiento de credenciales generados"
+	LogCredentialNoticeError    = "Error generando avisos de vencimiento de credenciales"
+)
+
+// ============================================
+// NOTIFICATIONS (Notificaciones en la aplicación)
+// ============================================
+const (
+	LogNotificationList          = "Listando notificaciones del empleado"
+	LogNotificationRead          = "Marcando notificación como leída"
+	LogNotificationReadOK        = "Notificación marcada como leída"
+	LogNotificationError         = "Error procesando notificación"
+	LogNotificationRepoInitError = "Error inicializando repositorio de notificaciones"
+	LogNotificationRepoInitOK    = "Repositorio de notificaciones inicializado"
+)
+
+// ============================================
+// FLIGHT TIME LIMITATIONS (FTL)
+// ============================================
+const (
+	LogFTLStatus        = "Consultando estado de limitaciones de tiempo de vuelo"
+	LogFTLStatusOK      = "Estado de limitaciones de tiempo de vuelo obtenido exitosamente"
+	LogFTLStatusError   = "Error consultando estado de limitaciones de tiempo de vuelo"
+	LogFTLCheck         = "Evaluando limitaciones de tiempo de vuelo del segmento"
+	LogFTLCheckWarning  = "Segmento cercano o por encima de una limitación de tiempo de vuelo"
+	LogFTLCheckExceeded = "Segmento rechazado por exceder una limitación de tiempo de vuelo"
+)
+
+// ============================================
+// CURRENCY / RECENCY (Experiencia reciente)
+// ============================================
+const (
+	LogCurrencyStatus      = "Consultando experiencia reciente del piloto"
+	LogCurrencyStatusOK    = "Experiencia reciente del piloto obtenida exitosamente"
+	LogCurrencyStatusError = "Error consultando experiencia reciente del piloto"
+)
+
+// ============================================
+// LOGBOOK EXPORT (Exportación de bitácora)
+// ============================================
+const (
+	LogLogbookExport              = "Exportando bitácora del piloto"
+	LogLogbookExportOK            = "Bitácora del piloto exportada exitosamente"
+	LogLogbookExportError         = "Error exportando bitácora del piloto"
+	LogLogbookExportInconsistency = "Segmento con fecha inconsistente con su página de bitácora"
+)
+
+// ============================================
+// LOGBOOK IMPORT (Importación masiva de segmentos)
+// ============================================
+const (
+	LogLogbookImport           = "Importando segmentos de vuelo desde CSV"
+	LogLogbookImportOK         = "Segmentos de vuelo importados exitosamente"
+	LogLogbookImportDryRunOK   = "Validación de importación (dry-run) completada"
+	LogLogbookImportInvalidRow = "Fila de importación con errores de validación"
+	LogLogbookImportError      = "Error importando segmentos de vuelo"
+)
+
+// ============================================
+// ELECTRONIC LOGBOOK IMPORT (Formatos de terceros y mapeos)
+// ============================================
+const (
+	LogElogbookImport           = "Importando bitácora electrónica de terceros"
+	LogElogbookImportOK         = "Bitácora electrónica importada"
+	LogElogbookImportPreviewOK  = "Vista previa de bitácora electrónica generada"
+	LogElogbookImportUnresolved = "Fila pendiente de mapeo"
+	LogImportMappingList        = "Consultando mapeos de importación"
+	LogImportMappingListOK      = "Mapeos de importación consultados"
+	LogImportMappingDelete      = "Eliminando mapeo de importación"
+	LogImportMappingDeleteOK    = "Mapeo de importación eliminado"
+	LogImportMappingError       = "Error en mapeos de importación"
+	LogImportMappingRepoInitOK  = "Repositorio de mapeos de importación inicializado"
+	LogImportMappingRepoInitErr = "Error inicializando repositorio de mapeos de importación"
+)
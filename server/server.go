@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/champion19/flighthours-api/cmd/dependency"
+	"github.com/champion19/flighthours-api/core/interactor/services/domain"
 	"github.com/champion19/flighthours-api/handlers"
 	"github.com/champion19/flighthours-api/middleware"
 	"github.com/champion19/flighthours-api/platform/logger"
@@ -66,6 +67,12 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		dependencies.EngineInteractor,
 		dependencies.ManufacturerInteractor,
 		dependencies.AirlineEmployeeInteractor,
+		dependencies.SimulatorSessionInteractor,
+		dependencies.PriorExperienceInteractor,
+		dependencies.DutyPeriodInteractor,
+		dependencies.TypeRatingInteractor,
+		dependencies.CredentialInteractor,
+		dependencies.NotificationInteractor,
 	)
 
 	validators, err := schema.NewValidator(&schema.DefaultFileReader{})
@@ -261,6 +268,17 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		// Query params: ?status=true (active) or ?status=false (inactive)
 		protected.GET("/daily-logbooks", handler.ListDailyLogbooks())
 
+		// POST /daily-logbooks/import - Bulk import flight segments from CSV for authenticated employee
+		// Query params: ?dry_run=true (validate only, nothing is saved)
+		protected.POST("/daily-logbooks/import", handler.ImportDailyLogbooks())
+
+		// POST /daily-logbooks/import/elogbook - Import a third-party electronic logbook export
+		// Query params: ?format=foreflight|logten|mccpilotlog|custom&dry_run=true (preview)
+		protected.POST("/daily-logbooks/import/elogbook", handler.ImportElogbook())
+
+		// GET /daily-logbooks/deleted - Deleted daily logbooks that can still be restored
+		protected.GET("/daily-logbooks/deleted", handler.ListDeletedDailyLogbooks())
+
 		// GET /daily-logbooks/:id - Get a specific daily logbook by ID
 		protected.GET("/daily-logbooks/:id", handler.GetDailyLogbookByID())
 
@@ -273,12 +291,27 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		// DELETE /daily-logbooks/:id - Delete a daily logbook
 		protected.DELETE("/daily-logbooks/:id", handler.DeleteDailyLogbook())
 
+		// PATCH /daily-logbooks/:id/restore - Restore a deleted daily logbook with its segments
+		protected.PATCH("/daily-logbooks/:id/restore", handler.RestoreDailyLogbook())
+
 		// PATCH /daily-logbooks/:id/activate - Activate a daily logbook
 		protected.PATCH("/daily-logbooks/:id/activate", handler.ActivateDailyLogbook())
 
 		// PATCH /daily-logbooks/:id/deactivate - Deactivate a daily logbook
 		protected.PATCH("/daily-logbooks/:id/deactivate", handler.DeactivateDailyLogbook())
 
+		// PATCH /daily-logbooks/:id/submit - Submit a draft logbook for sign-off
+		protected.PATCH("/daily-logbooks/:id/submit", handler.SubmitDailyLogbook())
+
+		// PATCH /daily-logbooks/:id/reopen - Return a submitted logbook to draft
+		protected.PATCH("/daily-logbooks/:id/reopen", handler.ReopenDailyLogbook())
+
+		// PATCH /daily-logbooks/:id/sign - Sign a submitted logbook (segments become read-only)
+		protected.PATCH("/daily-logbooks/:id/sign", handler.SignDailyLogbook())
+
+		// PATCH /daily-logbooks/:id/lock - Lock a signed logbook (no further amendments)
+		protected.PATCH("/daily-logbooks/:id/lock", handler.LockDailyLogbook())
+
 		// ---- Aircraft Registrations Management (Protected) ----
 		// GET /aircraft-registrations - List all aircraft registrations
 		// Query params: ?airline_id=xxx (filter by airline)
@@ -310,6 +343,12 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		// DELETE /daily-logbook-details/:id - Delete a specific detail (HU18)
 		protected.DELETE("/daily-logbook-details/:id", handler.DeleteDailyLogbookDetail())
 
+		// PATCH /daily-logbook-details/:id/restore - Restore a deleted detail (its logbook must not be deleted)
+		protected.PATCH("/daily-logbook-details/:id/restore", handler.RestoreDailyLogbookDetail())
+
+		// GET /daily-logbook-details/:id/history - Change history of a segment (also once deleted)
+		protected.GET("/daily-logbook-details/:id/history", handler.GetDailyLogbookDetailHistory())
+
 		// POST /daily-logbooks/:id/details - Add a new detail to logbook (HU16)
 		protected.POST("/daily-logbooks/:id/details", handler.CreateDailyLogbookDetail())
 
@@ -317,6 +356,168 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		//el id debe ser el id del logbook , no el id del detail, se debe tener en cuenta que el employee_id es el id del employee que esta autenticado y que el detail es un registro de la tabla daily_logbook_details
 		protected.GET("/daily-logbooks/:id/details", handler.ListDailyLogbookDetails())
 
+		// GET /daily-logbooks/:id/validation - Consistency report of the day's segments (route continuity, overlaps, times)
+		protected.GET("/daily-logbooks/:id/validation", handler.ValidateDailyLogbook())
+
+		// POST /daily-logbooks/:id/amendments - Request an amendment of a signed logbook (reason, author and diff)
+		protected.POST("/daily-logbooks/:id/amendments", handler.RequestLogbookAmendment())
+
+		// GET /daily-logbooks/:id/amendments - List the amendments of a logbook
+		protected.GET("/daily-logbooks/:id/amendments", handler.ListLogbookAmendments())
+
+		// POST /daily-logbooks/:id/amendments/:amendment_id/sign - Sign and apply a pending amendment
+		protected.POST("/daily-logbooks/:id/amendments/:amendment_id/sign", handler.SignLogbookAmendment())
+
+		// POST /daily-logbooks/:id/amendments/:amendment_id/reject - Reject a pending amendment
+		protected.POST("/daily-logbooks/:id/amendments/:amendment_id/reject", handler.RejectLogbookAmendment())
+
+		// GET /daily-logbooks/:id/history - Change history of the logbook and its segments (actor, trace ID, field diff)
+		protected.GET("/daily-logbooks/:id/history", handler.GetDailyLogbookHistory())
+
+		// GET /segment-mirrors - Mirrored entries offered to or by the authenticated employee
+		// Query params: ?status=PENDING|DECLINED|WITHDRAWN|LINKED|CONFLICT|UNLINKED
+		protected.GET("/segment-mirrors", handler.ListSegmentMirrors())
+
+		// GET /segment-mirrors/:id - Get a segment mirror (pre-filled entry or differing fields)
+		protected.GET("/segment-mirrors/:id", handler.GetSegmentMirror())
+
+		// POST /segment-mirrors/:id/accept - Add the companion's pre-filled entry to their logbook of the day
+		protected.POST("/segment-mirrors/:id/accept", handler.AcceptSegmentMirror())
+
+		// POST /segment-mirrors/:id/decline - Decline a pending mirrored entry
+		protected.POST("/segment-mirrors/:id/decline", handler.DeclineSegmentMirror())
+
+		// POST /segment-mirrors/:id/resolve - Resolve a conflict between linked entries (ADOPT or UNLINK)
+		protected.POST("/segment-mirrors/:id/resolve", handler.ResolveSegmentMirror())
+
+		// GET /simulator-sessions - Simulator (FSTD) sessions of the authenticated employee
+		// Query params: ?from=YYYY-MM-DD&to=YYYY-MM-DD
+		protected.GET("/simulator-sessions", handler.ListSimulatorSessions())
+
+		// POST /simulator-sessions - Log a simulator session
+		protected.POST("/simulator-sessions", handler.CreateSimulatorSession())
+
+		// GET /simulator-sessions/:id - Get a simulator session
+		protected.GET("/simulator-sessions/:id", handler.GetSimulatorSession())
+
+		// PUT /simulator-sessions/:id - Update a simulator session
+		protected.PUT("/simulator-sessions/:id", handler.UpdateSimulatorSession())
+
+		// DELETE /simulator-sessions/:id - Delete a simulator session
+		protected.DELETE("/simulator-sessions/:id", handler.DeleteSimulatorSession())
+
+		// GET /prior-experience - Opening balances of the authenticated employee
+		protected.GET("/prior-experience", handler.ListPriorExperience())
+
+		// POST /prior-experience - Declare an opening balance with its supporting document
+		protected.POST("/prior-experience", handler.CreatePriorExperience())
+
+		// GET /prior-experience/:id - Get an opening balance
+		protected.GET("/prior-experience/:id", handler.GetPriorExperience())
+
+		// PUT /prior-experience/:id - Update an opening balance
+		protected.PUT("/prior-experience/:id", handler.UpdatePriorExperience())
+
+		// DELETE /prior-experience/:id - Delete an opening balance
+		protected.DELETE("/prior-experience/:id", handler.DeletePriorExperience())
+
+		// GET /duty-periods - Duty periods of the authenticated employee with duty, FDP and rest
+		// Query params: ?from=YYYY-MM-DD&to=YYYY-MM-DD (report date)
+		protected.GET("/duty-periods", handler.ListDutyPeriods())
+
+		// POST /duty-periods - Record a duty period and link its segments
+		protected.POST("/duty-periods", handler.CreateDutyPeriod())
+
+		// GET /duty-periods/:id - Get a duty period
+		protected.GET("/duty-periods/:id", handler.GetDutyPeriod())
+
+		// PUT /duty-periods/:id - Update a duty period and replace its segments
+		protected.PUT("/duty-periods/:id", handler.UpdateDutyPeriod())
+
+		// DELETE /duty-periods/:id - Delete a duty period, unlinking its segments
+		protected.DELETE("/duty-periods/:id", handler.DeleteDutyPeriod())
+
+		// GET /type-ratings - Type ratings of the authenticated employee
+		protected.GET("/type-ratings", handler.ListTypeRatings())
+
+		// GET /type-ratings/:id - Get a type rating
+		protected.GET("/type-ratings/:id", handler.GetTypeRating())
+
+		// Type ratings are recorded by the crew managers of the employee's airline; pilots only read theirs
+		// GET /airline-employees/:id/type-ratings - Type ratings of an employee of the manager's airline
+		protected.GET("/airline-employees/:id/type-ratings", middleware.RequireRole(domain.RoleCrewManager), handler.ListEmployeeTypeRatings())
+
+		// POST /airline-employees/:id/type-ratings - Record a type rating on an aircraft family
+		protected.POST("/airline-employees/:id/type-ratings", middleware.RequireRole(domain.RoleCrewManager), handler.CreateTypeRating())
+
+		// PUT /type-ratings/:id - Update a type rating
+		protected.PUT("/type-ratings/:id", middleware.RequireRole(domain.RoleCrewManager), handler.UpdateTypeRating())
+
+		// DELETE /type-ratings/:id - Delete a type rating
+		protected.DELETE("/type-ratings/:id", middleware.RequireRole(domain.RoleCrewManager), handler.DeleteTypeRating())
+
+		// GET /employees/me/credentials - Licences, medical certificates, language proficiency and recurrent training of the authenticated employee
+		protected.GET("/employees/me/credentials", handler.GetMyCredentials())
+
+		// POST /credentials - Record a credential
+		protected.POST("/credentials", handler.CreateCredential())
+
+		// GET /credentials/:id - Get a credential
+		protected.GET("/credentials/:id", handler.GetCredential())
+
+		// PUT /credentials/:id - Update a credential
+		protected.PUT("/credentials/:id", handler.UpdateCredential())
+
+		// DELETE /credentials/:id - Delete a credential with its scan
+		protected.DELETE("/credentials/:id", handler.DeleteCredential())
+
+		// PUT /credentials/:id/scan - Attach the scanned document (multipart "file": PDF, JPEG or PNG)
+		protected.PUT("/credentials/:id/scan", handler.UploadCredentialScan())
+
+		// GET /credentials/:id/scan - Download the scanned document
+		protected.GET("/credentials/:id/scan", handler.DownloadCredentialScan())
+
+		// GET /airlines/:id/credential-expiries - Credentials of the airline's employees expired or about to expire,
+		// for the crew managers of the airline
+		// Query params: ?as_of=YYYY-MM-DD (defaults to today)&within_days=N (defaults to the earliest notice days)
+		protected.GET("/airlines/:id/credential-expiries", middleware.RequireRole(domain.RoleCrewManager), handler.GetAirlineCredentialExpiries())
+
+		// GET /employees/me/notifications - In-app notifications of the authenticated employee, latest first
+		// Query params: ?unread=true
+		protected.GET("/employees/me/notifications", handler.GetMyNotifications())
+
+		// PATCH /notifications/:id/read - Mark a notification as read
+		protected.PATCH("/notifications/:id/read", handler.MarkNotificationRead())
+
+		// GET /employees/me/flight-totals - Flight time totals of the authenticated employee
+		// Query params: ?from=YYYY-MM-DD&to=YYYY-MM-DD&group_by=month,aircraft_model,aircraft_family,airline,pilot_role,pilot_function,flight_type,approach_type&include_simulator=true
+		protected.GET("/employees/me/flight-totals", handler.GetMyFlightTotals())
+
+		// GET /employees/me/ftl-status - Flight time limitations usage of the authenticated employee
+		// Query params: ?as_of=YYYY-MM-DD (defaults to today)
+		protected.GET("/employees/me/ftl-status", handler.GetMyFTLStatus())
+
+		// GET /employees/me/currency - Takeoff/landing recency per aircraft family of the authenticated employee
+		// Query params: ?as_of=YYYY-MM-DD (defaults to today)
+		protected.GET("/employees/me/currency", handler.GetMyCurrency())
+
+		// GET /employees/me/flight-anomalies - Segments whose block/air time deviates from the route estimate
+		// Query params: ?from=YYYY-MM-DD&to=YYYY-MM-DD (defaults to the last year)
+		protected.GET("/employees/me/flight-anomalies", handler.GetMyFlightAnomalies())
+
+		// GET /employees/me/logbook/verify - Recompute the hash chain over signed segments and report the first broken link
+		protected.GET("/employees/me/logbook/verify", handler.VerifyMyLogbook())
+
+		// GET /employees/me/logbook/export - Printable logbook (PDF) or CSV of the authenticated employee
+		// Query params: ?from=YYYY-MM-DD&to=YYYY-MM-DD&format=pdf|csv
+		protected.GET("/employees/me/logbook/export", handler.ExportMyLogbook())
+
+		// GET /employees/me/import-mappings - Mapping decisions remembered for electronic logbook imports
+		protected.GET("/employees/me/import-mappings", handler.ListMyImportMappings())
+
+		// DELETE /employees/me/import-mappings/:id - Forget a remembered mapping decision
+		protected.DELETE("/employees/me/import-mappings/:id", handler.DeleteMyImportMapping())
+
 		// ---- Airline Employees Management (Protected) ----
 		// GET /airline-employees - List all airline employees (employees with airline assigned)
 		// Query params: ?airline_id=xxx (filter by airline), ?active=true/false (filter by status)
@@ -0,0 +1,206 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+)
+
+// Tamaños de página en puntos (1/72 de pulgada)
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Font identifica una de las fuentes estándar de PDF (no requieren incrustación)
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+func (f Font) resourceName() string {
+	if f == HelveticaBold {
+		return "F2"
+	}
+	return "F1"
+}
+
+// Document es un escritor mínimo de PDF 1.4 en Go puro: texto con las fuentes estándar
+// Helvetica/Helvetica-Bold (codificación WinAnsi), líneas y rectángulos.
+// El origen de coordenadas es la esquina superior izquierda de la página.
+type Document struct {
+	width, height float64
+	pages         []*bytes.Buffer
+	title         string
+}
+
+// NewDocument crea un documento vacío con el tamaño de página indicado
+func NewDocument(width, height float64) *Document {
+	return &Document{width: width, height: height}
+}
+
+// SetTitle define el título que se guarda en los metadatos del documento
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+// AddPage agrega una página nueva; las operaciones de dibujo siguientes se aplican sobre ella
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount retorna el número de páginas del documento
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *Document) current() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text escribe texto con su línea base en (x, y)
+func (d *Document) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(d.current(), "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font.resourceName(), size, x, d.height-y, escape(encodeWinAnsi(text)))
+}
+
+// TextRight escribe texto alineado a la derecha terminando en x
+func (d *Document) TextRight(x, y float64, font Font, size float64, text string) {
+	d.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line dibuja una línea de (x1, y1) a (x2, y2) con el grosor indicado
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(d.current(), "%.2f w %.2f %.2f m %.2f %.2f l S\n", width, x1, d.height-y1, x2, d.height-y2)
+}
+
+// FillRect rellena un rectángulo con un tono de gris (0 = negro, 1 = blanco)
+func (d *Document) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(d.current(), "%.2f g %.2f %.2f %.2f %.2f re f 0 g\n", gray, x, d.height-y-h, w, h)
+}
+
+// TextWidth retorna el ancho en puntos del texto con la fuente y tamaño indicados
+func TextWidth(font Font, size float64, text string) float64 {
+	widths := helveticaWidths
+	if font == HelveticaBold {
+		widths = helveticaBoldWidths
+	}
+	total := 0
+	for _, c := range encodeWinAnsi(text) {
+		if c >= 32 && int(c-32) < len(widths) {
+			total += widths[c-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// Bytes serializa el documento
+func (d *Document) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1: catálogo, 2: árbol de páginas, 3-4: fuentes, 5: información; luego página + contenido por cada página
+	const firstPage = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (flighthours-api) >>", escape(encodeWinAnsi(d.title))))
+
+	for i, page := range d.pages {
+		var content bytes.Buffer
+		zw := zlib.NewWriter(&content)
+		if _, err := zw.Write(page.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			d.width, d.height, firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes(), nil
+}
+
+// encodeWinAnsi convierte UTF-8 a WinAnsi; los caracteres Latin-1 se conservan y el resto se reemplaza por '?'
+func encodeWinAnsi(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 128 || (r >= 0xA0 && r <= 0xFF):
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+func escape(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		switch c {
+		case '(', ')', '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case '\n', '\r':
+			sb.WriteByte(' ')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// Anchos de glifo (unidades de 1/1000 em) de los caracteres 32-126 según las métricas AFM estándar
+var helveticaWidths = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = []int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,53 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	doc := NewDocument(A4Height, A4Width)
+	doc.SetTitle("Bitácora (test)")
+	doc.AddPage()
+	doc.Text(20, 20, HelveticaBold, 12, "Página 1")
+	doc.Line(20, 30, 200, 30, 0.5)
+	doc.AddPage()
+	doc.TextRight(200, 20, Helvetica, 8, "12:30")
+
+	out, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Error("expected 2 pages in the page tree")
+	}
+	if !bytes.Contains(out, []byte(`/Title (Bit`+"\xe1"+`cora \(test\))`)) {
+		t.Error("expected WinAnsi encoded and escaped title")
+	}
+
+	// startxref must point at the xref table
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if m == nil {
+		t.Fatal("missing startxref")
+	}
+	offset, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(out[offset:], []byte("xref\n")) {
+		t.Errorf("startxref %d does not point at the xref table", offset)
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	// "00:00" = 4 digits (556) + colon (278) at 10pt
+	if w := TextWidth(Helvetica, 10, "00:00"); w < 25.0 || w > 25.1 {
+		t.Errorf("unexpected width %.2f", w)
+	}
+	if TextWidth(HelveticaBold, 10, "ABC") <= TextWidth(Helvetica, 10, "ABC")-0.01 {
+		t.Error("bold text should not be narrower than regular text")
+	}
+}